@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -22,6 +24,7 @@ import (
 var (
 	region  string
 	profile string
+	account string
 )
 
 func newRootCmd() *cobra.Command {
@@ -33,6 +36,7 @@ func newRootCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&region, "region", "r", "", "AWS region")
 	cmd.Flags().StringVarP(&profile, "profile", "p", "", "AWS profile")
+	cmd.Flags().StringVarP(&account, "account", "a", "", "named account from the config file")
 
 	return cmd
 }
@@ -79,22 +83,45 @@ func runApp(cmd *cobra.Command, args []string) error {
 	// Resolve region: CLI flag > last saved > app config > AWS SDK config > fallback
 	r := resolveRegion(ctx, cfg, region)
 	p := resolveProfile(cfg, profile)
-
-	sess, err := internalaws.NewSession(ctx, r, p)
+	acct, err := resolveAccount(cfg, account, profile)
 	if err != nil {
+		return err
+	}
+
+	register := func(reg *plugin.Registry, sess *internalaws.Session, scope *cache.Scope) {
+		services.Register(reg, sess.Config, sess.Region, sess.Profile, scope)
+	}
+
+	conn := internalaws.NewConnectivity()
+	roleCreds := internalaws.NewRoleCredentials()
+	mfa := internalaws.NewMFA(deferMFA)
+	sess, err := app.OpenSession(ctx, r, p, acct, roleCreds, mfa)
+	deferred := errors.Is(err, errMFADeferred)
+	switch {
+	case deferred:
+		logger.Info("deferring AWS session until an MFA code can be entered")
+	case err != nil:
 		logger.Error("failed to create AWS session", "err", err)
-	} else {
-		services.Register(reg, sess.Config, r, p)
+	default:
+		conn.Instrument(&sess.Config)
+		p = sess.Profile
+		register(reg, sess, cache.NewScope(cacheDB, r, sess.CacheProfile(), cfg.CacheTTLFor))
 	}
 
 	application := app.New(app.AppConfig{
-		Registry: reg,
-		Cache:    cacheDB,
-		Logger:   logger,
-		Config:   &cfg,
-		Session:  sess,
-		Region:   r,
-		Profile:  p,
+		Registry:        reg,
+		Cache:           cacheDB,
+		Logger:          logger,
+		Config:          &cfg,
+		Session:         sess,
+		Region:          r,
+		Profile:         p,
+		Account:         acct,
+		OpenSession:     deferred,
+		Register:        register,
+		Connectivity:    conn,
+		RoleCredentials: roleCreds,
+		MFA:             mfa,
 	})
 
 	prog := tea.NewProgram(application)
@@ -126,6 +153,32 @@ func resolveRegion(ctx context.Context, cfg config.Config, flag string) string {
 	return "us-east-1"
 }
 
+// errMFADeferred stops opening a session before the TUI has started when it
+// needs an MFA code; the App opens it again once it can ask for one.
+var errMFADeferred = errors.New("MFA code needed")
+
+// deferMFA is the MFA prompt until the App takes over.
+func deferMFA(context.Context, string) (string, error) {
+	return "", errMFADeferred
+}
+
+// resolveAccount picks the named account to start in: the --account flag,
+// else the last used account unless --profile asks for a plain profile.
+func resolveAccount(cfg config.Config, flag, profileFlag string) (*config.Account, error) {
+	if flag != "" {
+		for _, a := range cfg.Accounts {
+			if a.Name == flag {
+				return &a, nil
+			}
+		}
+		return nil, fmt.Errorf("account %q is not defined in the config file", flag)
+	}
+	if profileFlag != "" {
+		return nil, nil
+	}
+	return cfg.LastAccount, nil
+}
+
 // resolveProfile picks the profile with priority: CLI flag > last saved > app config > fallback.
 func resolveProfile(cfg config.Config, flag string) string {
 	if flag != "" {
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	awsorgsdk "github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	tea "charm.land/bubbletea/v2"
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+	awsorg "tasnim.dev/aws-tui/internal/aws/organizations"
+	"tasnim.dev/aws-tui/internal/config"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// accountsDiscoveredMsg carries the member accounts found in the
+// organization for the account picker.
+type accountsDiscoveredMsg struct {
+	accounts []config.Account
+	err      error
+}
+
+// openAccountPickerMsg is sent by the palette entry that switches accounts.
+type openAccountPickerMsg struct{}
+
+// OpenSession creates a session in region. With an account it assumes the
+// account's role from its source profile, reusing creds while they are
+// valid; otherwise it uses profile directly. mfa supplies codes to profiles
+// that require them.
+func OpenSession(ctx context.Context, region, profile string, account *config.Account, creds *internalaws.RoleCredentials, mfa *internalaws.MFA) (*internalaws.Session, error) {
+	if account == nil {
+		return internalaws.NewSession(ctx, region, profile, mfa)
+	}
+	role := internalaws.Role{
+		ARN:         account.RoleARN,
+		ExternalID:  account.ExternalID,
+		SessionName: account.SessionName,
+	}
+	return internalaws.NewRoleSession(ctx, region, account.SourceProfile, account.Name, role, creds, mfa)
+}
+
+// accountOf returns the configured account sess was opened for, or nil for
+// a plain profile session.
+func accountOf(sess *internalaws.Session) *config.Account {
+	if sess == nil || sess.Role == nil {
+		return nil
+	}
+	return &config.Account{
+		Name:          sess.Account,
+		SourceProfile: sess.Profile,
+		RoleARN:       sess.Role.ARN,
+		ExternalID:    sess.Role.ExternalID,
+		SessionName:   sess.Role.SessionName,
+	}
+}
+
+// profileLabel names the credentials of sess for the status bar and the
+// dashboard header.
+func profileLabel(sess *internalaws.Session) string {
+	if sess.Role != nil {
+		return sess.Account + " via " + sess.Profile
+	}
+	return sess.Profile
+}
+
+// openAccountPicker shows the configured accounts. When organization
+// discovery is configured the member accounts are listed first and the
+// picker opens once they arrive as an accountsDiscoveredMsg.
+func (a *App) openAccountPicker() tea.Cmd {
+	org := a.config.Organization
+	if org == nil {
+		a.showAccountPicker(nil)
+		return nil
+	}
+
+	a.toasts.Push(plugin.ToastInfo, "Discovering organization accounts...")
+	region, mfa := a.region, a.mfa
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		sess, err := internalaws.NewSession(ctx, region, org.SourceProfile, mfa)
+		if err != nil {
+			return accountsDiscoveredMsg{err: err}
+		}
+		members, err := awsorg.NewClient(awsorgsdk.NewFromConfig(sess.Config)).ListActiveAccounts(ctx)
+		if err != nil {
+			return accountsDiscoveredMsg{err: err}
+		}
+		accounts := make([]config.Account, 0, len(members))
+		for _, m := range members {
+			accounts = append(accounts, org.MemberAccount(m.ID, m.Name+" ("+m.ID+")"))
+		}
+		return accountsDiscoveredMsg{accounts: accounts}
+	}
+}
+
+// showAccountPicker opens the picker over the configured accounts followed
+// by discovered. Configured accounts win when both use the same name.
+func (a *App) showAccountPicker(discovered []config.Account) {
+	choices := make(map[string]config.Account, len(a.config.Accounts)+len(discovered))
+	var items []string
+	for _, list := range [][]config.Account{a.config.Accounts, discovered} {
+		for _, acct := range list {
+			if _, dup := choices[acct.Name]; dup {
+				continue
+			}
+			choices[acct.Name] = acct
+			items = append(items, acct.Name)
+		}
+	}
+	if len(items) == 0 {
+		a.toasts.Push(plugin.ToastWarning, "No accounts configured; add accounts to the config file")
+		return
+	}
+
+	p := ui.NewPicker("Select Account", items)
+	a.accountPicker = &p
+	a.accountChoices = choices
+}
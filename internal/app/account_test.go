@@ -0,0 +1,57 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+	"tasnim.dev/aws-tui/internal/config"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+func TestAccountOf(t *testing.T) {
+	plain := &internalaws.Session{Profile: "dev"}
+	assert.Nil(t, accountOf(plain))
+	assert.Equal(t, "dev", profileLabel(plain))
+
+	sess := &internalaws.Session{
+		Profile: "sso-admin",
+		Account: "prod",
+		Role:    &internalaws.Role{ARN: "arn:aws:iam::111111111111:role/ReadOnly", ExternalID: "x"},
+	}
+	assert.Equal(t, &config.Account{
+		Name:          "prod",
+		SourceProfile: "sso-admin",
+		RoleARN:       "arn:aws:iam::111111111111:role/ReadOnly",
+		ExternalID:    "x",
+	}, accountOf(sess))
+	assert.Equal(t, "prod via sso-admin", profileLabel(sess))
+	assert.Equal(t, "arn:aws:iam::111111111111:role/ReadOnly", sess.CacheProfile())
+}
+
+func TestShowAccountPicker(t *testing.T) {
+	org := &config.Organization{SourceProfile: "sso-admin"}
+	a := &App{
+		config: &config.Config{Accounts: []config.Account{
+			{Name: "prod", SourceProfile: "ops", RoleARN: "arn:aws:iam::111111111111:role/Admin"},
+		}},
+		toasts: ui.NewToastStack(),
+	}
+
+	a.showAccountPicker([]config.Account{
+		org.MemberAccount("111111111111", "prod"),
+		org.MemberAccount("222222222222", "staging (222222222222)"),
+	})
+	require.NotNil(t, a.accountPicker)
+	assert.Equal(t, 2, a.accountPicker.FilteredCount())
+	assert.Equal(t, "ops", a.accountChoices["prod"].SourceProfile, "configured accounts win over discovered ones")
+	assert.Equal(t, "arn:aws:iam::222222222222:role/OrganizationAccountAccessRole", a.accountChoices["staging (222222222222)"].RoleARN)
+
+	// Without any accounts the picker is not shown.
+	a = &App{config: &config.Config{}, toasts: ui.NewToastStack()}
+	a.showAccountPicker(nil)
+	assert.Nil(t, a.accountPicker)
+	assert.Len(t, a.toasts.Visible(), 1)
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -16,11 +18,55 @@ import (
 // tickMsg is sent periodically to drive toast expiry and other timers.
 type tickMsg time.Time
 
-// paletteNavigateMsg is sent when a palette entry navigates to a service.
+// paletteNavigateMsg is sent when a palette entry or a dashboard card
+// navigates to a service.
 type paletteNavigateMsg struct {
 	pluginID string
 }
 
+// openSearchMsg is sent by the palette entry that opens resource search.
+type openSearchMsg struct{}
+
+// sessionSwitchedMsg is sent when a new AWS session has been created after a
+// region or profile change. reload is set when the session was recreated for
+// the same region and profile after an SSO login.
+type sessionSwitchedMsg struct {
+	sess   *internalaws.Session
+	reload bool
+	err    error
+}
+
+// toggleAllRegionsMsg is sent by the palette entry that toggles all-regions
+// scope.
+type toggleAllRegionsMsg struct{}
+
+// ssoLoginMsg is sent by the palette entry that runs aws sso login.
+type ssoLoginMsg struct{}
+
+// ssoLoginFinishedMsg is sent when the aws sso login process exits.
+type ssoLoginFinishedMsg struct {
+	err error
+}
+
+// confirmSSOLogin identifies the prompt offering to run aws sso login.
+const confirmSSOLogin = "sso-login"
+
+// confirmAction identifies the prompt confirming a view's plugin.Action.
+const confirmAction = "action"
+
+// probeResultMsg carries the result of an offline connectivity probe.
+type probeResultMsg struct {
+	err error
+}
+
+// probeInterval is how often, in seconds, connectivity is probed while
+// offline.
+const probeInterval = 10
+
+// RegisterFunc populates a registry with service plugins built from a session.
+// Plugins read and write cached resources through scope, which may be nil.
+type RegisterFunc func(reg *plugin.Registry, sess *internalaws.Session, scope *cache.Scope)
+
 // AppConfig holds the dependencies needed to create the App.
 type AppConfig struct {
 	Registry *plugin.Registry
@@ -30,6 +76,22 @@ type AppConfig struct {
 	Session  *internalaws.Session
 	Region   string
 	Profile  string
+	// Register rebuilds the plugin registry when the session changes.
+	Register RegisterFunc
+	// Connectivity tracks offline mode. Session configs must be instrumented
+	// with it before clients are created.
+	Connectivity *internalaws.Connectivity
+	// RoleCredentials caches assumed-role credentials across account
+	// switches. It should be the one Session was opened with, if any.
+	RoleCredentials *internalaws.RoleCredentials
+	// MFA supplies codes to profiles with mfa_serial. The App takes over
+	// its prompt, so codes are entered in the TUI from then on.
+	MFA *internalaws.MFA
+	// OpenSession asks the App to open the session for Region, Profile and
+	// Account once it is running, when Session could not be opened before
+	// because it needs an MFA code.
+	OpenSession bool
+	Account     *config.Account
 }
 
 // refreshMsg is sent when the auto-refresh timer fires.
@@ -39,11 +101,16 @@ type refreshMsg struct{}
 type App struct {
 	router        *Router
 	palette       CommandPalette
+	search        SearchOverlay
 	toasts        *ui.ToastStack
 	statusBar     StatusBar
 	breadcrumb    Breadcrumb
 	helpOverlay   *ui.HelpOverlay
 	registry      *plugin.Registry
+	register      RegisterFunc
+	session       *internalaws.Session
+	region        string
+	profile       string
 	cache         *cache.DB
 	logger        *log.Logger
 	config        *config.Config
@@ -52,14 +119,38 @@ type App struct {
 	quitTime      time.Time
 	regionPicker  *ui.Picker
 	profilePicker *ui.Picker
+	accountPicker *ui.Picker
 	autoRefresh   bool
 	refreshCountdown int // seconds until next refresh
 	refreshInterval  int // seconds between refreshes
+	conn             *internalaws.Connectivity
+	offline          bool
+	probing          bool
+	probeCountdown   int // seconds until the next connectivity probe
+	confirm          *ui.Confirm
+	pendingAction    *plugin.Action
+	authDeclined     bool // the user skipped the SSO login prompt or was told to fix the credentials
+	usesSSO          func(profile string) bool // stubbed in tests
+	allRegions       bool // list views fan out across config.RegionSet
+	accountChoices   map[string]config.Account
+	roleCreds        *internalaws.RoleCredentials
+	mfa              *internalaws.MFA
+	mfaRequests      chan mfaRequest
+	mfaInput         *ui.Input
+	mfaPending       *mfaRequest
+	mfaDeclined      bool // the user skipped the MFA prompt
+	openAccount      *config.Account // opened by Init when openSession is set
+	openSession      bool
 }
 
 // New creates an App with all sub-components wired together.
 func New(cfg AppConfig) *App {
-	dashboard := NewDashboard(cfg.Registry, nil, cfg.Session, cfg.Cache, cfg.Region, cfg.Profile) // router set below
+	profile, cacheProfile := cfg.Profile, cfg.Profile
+	if cfg.Session != nil {
+		profile, cacheProfile = profileLabel(cfg.Session), cfg.Session.CacheProfile()
+	}
+	scope := cache.NewScope(cfg.Cache, cfg.Region, cacheProfile, cfg.Config.CacheTTLFor)
+	dashboard := NewDashboard(cfg.Registry, nil, cfg.Session, scope, cfg.Region, profile) // router set below
 	router := NewRouter(dashboard)
 	router.SetRegistry(cfg.Registry)
 
@@ -71,20 +162,16 @@ func New(cfg AppConfig) *App {
 		toasts.Push(level, msg)
 	})
 
-	// Build palette entries from registered plugins.
-	plugins := cfg.Registry.All()
-	entries := make([]PaletteEntry, 0, len(plugins))
-	for _, p := range plugins {
-		p := p
-		entries = append(entries, PaletteEntry{
-			Title:    p.Name(),
-			Keywords: []string{p.ID()},
-			Action: func() tea.Cmd {
-				return func() tea.Msg {
-					return paletteNavigateMsg{pluginID: p.ID()}
-				}
-			},
-		})
+	conn := cfg.Connectivity
+	if conn == nil {
+		conn = internalaws.NewConnectivity()
+	}
+	router.SetOfflineFn(conn.Offline)
+	router.SetReadOnly(cfg.Config.ReadOnly)
+
+	roleCreds := cfg.RoleCredentials
+	if roleCreds == nil {
+		roleCreds = internalaws.NewRoleCredentials()
 	}
 
 	interval := cfg.Config.AutoRefreshInterval
@@ -94,31 +181,291 @@ func New(cfg AppConfig) *App {
 
 	a := &App{
 		router:           router,
-		palette:          NewCommandPalette(entries),
+		palette:          NewCommandPalette(paletteEntries(cfg.Registry)),
+		search:           NewSearchOverlay(scope),
 		toasts:           toasts,
-		statusBar:        NewStatusBar(cfg.Region, cfg.Profile),
+		statusBar:        NewStatusBar(cfg.Region, profile),
 		breadcrumb:       NewBreadcrumb(),
 		helpOverlay:      ui.NewHelpOverlay(nil),
 		registry:         cfg.Registry,
+		register:         cfg.Register,
+		session:          cfg.Session,
+		region:           cfg.Region,
+		profile:          cfg.Profile,
 		cache:            cfg.Cache,
 		logger:           cfg.Logger,
 		config:           cfg.Config,
 		autoRefresh:      true,
 		refreshInterval:  interval,
 		refreshCountdown: interval,
+		conn:             conn,
+		roleCreds:        roleCreds,
+		mfaRequests:      make(chan mfaRequest),
+		openSession:      cfg.OpenSession && cfg.Session == nil,
+		openAccount:      cfg.Account,
+	}
+	a.mfa = cfg.MFA
+	a.usesSSO = func(profile string) bool {
+		return internalaws.UsesSSO(context.Background(), profile)
+	}
+	if a.mfa == nil {
+		a.mfa = internalaws.NewMFA(nil)
 	}
+	a.mfa.SetPrompt(a.promptMFA)
+	router.SetConfirmFn(a.promptAction)
+	a.statusBar.SetReadOnly(cfg.Config.ReadOnly)
 	a.statusBar.SetAutoRefresh(true)
 	a.statusBar.SetNextRefresh(time.Duration(interval) * time.Second)
 	return a
 }
 
-// Init runs the dashboard's Init and starts the tick timer.
+// paletteEntries builds one navigation entry per registered plugin, plus
+// entries that open resource search, switch accounts, toggle all-regions
+// scope and run aws sso login.
+func paletteEntries(reg *plugin.Registry) []PaletteEntry {
+	plugins := reg.All()
+	entries := make([]PaletteEntry, 0, len(plugins)+4)
+	entries = append(entries, PaletteEntry{
+		Title:    "Search Resources",
+		Keywords: []string{"search", "find", "resource", "id"},
+		Action: func() tea.Cmd {
+			return func() tea.Msg { return openSearchMsg{} }
+		},
+	}, PaletteEntry{
+		Title:    "Switch Account",
+		Keywords: []string{"account", "role", "assume", "organization"},
+		Action: func() tea.Cmd {
+			return func() tea.Msg { return openAccountPickerMsg{} }
+		},
+	}, PaletteEntry{
+		Title:    "Toggle All Regions",
+		Keywords: []string{"region", "all", "multi", "global"},
+		Action: func() tea.Cmd {
+			return func() tea.Msg { return toggleAllRegionsMsg{} }
+		},
+	}, PaletteEntry{
+		Title:    "SSO Login",
+		Keywords: []string{"sso", "login", "credentials", "auth"},
+		Action: func() tea.Cmd {
+			return func() tea.Msg { return ssoLoginMsg{} }
+		},
+	})
+	for _, p := range plugins {
+		p := p
+		entries = append(entries, PaletteEntry{
+			Title:    p.Name(),
+			Keywords: []string{p.ID()},
+			Action: func() tea.Cmd {
+				return func() tea.Msg {
+					return paletteNavigateMsg{pluginID: p.ID()}
+				}
+			},
+		})
+	}
+	return entries
+}
+
+// switchSession creates a new AWS session for the given region and profile,
+// or for account if it is not nil, in the background. The result arrives as
+// a sessionSwitchedMsg.
+func (a *App) switchSession(region, profile string, account *config.Account) tea.Cmd {
+	if account != nil {
+		a.toasts.Push(plugin.ToastInfo, "Switching to "+account.Name+" in "+region+"...")
+	} else {
+		a.toasts.Push(plugin.ToastInfo, "Switching to "+region+" ("+profile+")...")
+	}
+	return a.loadSession(region, profile, account, false)
+}
+
+// reloadSession recreates the current session so clients pick up fresh
+// credentials, keeping the user's place in the navigation stack. Assumed-role
+// credentials are dropped so the role is assumed again with the renewed
+// source credentials.
+func (a *App) reloadSession() tea.Cmd {
+	a.toasts.Push(plugin.ToastInfo, "Reloading session...")
+	account := accountOf(a.session)
+	if a.session != nil && a.session.Role != nil {
+		a.roleCreds.Forget(a.session.Profile, *a.session.Role)
+	}
+	a.mfa.Forget(a.profile)
+	a.mfaDeclined = false
+	return a.loadSession(a.region, a.profile, account, true)
+}
+
+func (a *App) loadSession(region, profile string, account *config.Account, reload bool) tea.Cmd {
+	conn, creds, mfa := a.conn, a.roleCreds, a.mfa
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		sess, err := OpenSession(ctx, region, profile, account, creds, mfa)
+		if err == nil {
+			conn.Instrument(&sess.Config)
+		}
+		return sessionSwitchedMsg{sess: sess, reload: reload, err: err}
+	}
+}
+
+// handleAuthExpired responds to AWS rejecting the credentials: profiles that
+// sign in with SSO are offered aws sso login, others, such as those with
+// static keys, are pointed at their credentials once.
+func (a *App) handleAuthExpired() {
+	if a.usesSSO(a.profile) {
+		a.promptSSOLogin()
+		return
+	}
+	a.authDeclined = true
+	a.toasts.Push(plugin.ToastError, "AWS rejected the credentials of profile "+a.profile+"; check its access keys or role")
+}
+
+// promptSSOLogin opens a modal offering to run aws sso login for the current
+// profile.
+func (a *App) promptSSOLogin() {
+	c := ui.NewConfirm(confirmSSOLogin, "Credentials Expired",
+		"The credentials for profile "+a.profile+" have expired.\nRun aws sso login now?")
+	a.confirm = &c
+}
+
+// promptAction opens a modal asking the user to type the action's phrase,
+// naming the account and region it runs in.
+func (a *App) promptAction(action plugin.Action) {
+	account := action.Account
+	if account == "" {
+		account = a.statusBar.account
+	}
+	if account == "" {
+		account = a.statusBar.profile
+	}
+	region := action.Region
+	if region == "" {
+		region = a.region
+	}
+	var b strings.Builder
+	b.WriteString("Account: " + account + "\n")
+	b.WriteString("Region:  " + region + "\n\n")
+	for _, t := range action.Targets {
+		b.WriteString("  " + t + "\n")
+	}
+	c := ui.NewTypedConfirm(confirmAction, action.Title, strings.TrimRight(b.String(), "\n"), action.Phrase)
+	a.confirm = &c
+	a.pendingAction = &action
+}
+
+// ssoLogin hands the terminal to aws sso login for the current profile. The
+// result arrives as an ssoLoginFinishedMsg.
+func (a *App) ssoLogin() tea.Cmd {
+	return tea.ExecProcess(internalaws.SSOLoginCommand(a.profile), func(err error) tea.Msg {
+		return ssoLoginFinishedMsg{err: err}
+	})
+}
+
+// setOffline updates the status bar and notifies the user when the app
+// switches between live and offline mode.
+func (a *App) setOffline(offline bool) {
+	a.offline = offline
+	a.statusBar.SetOffline(offline)
+	if offline {
+		a.probeCountdown = probeInterval
+		a.toasts.Push(plugin.ToastWarning, "AWS is unreachable, showing cached data")
+	} else {
+		a.toasts.Push(plugin.ToastInfo, "Back online")
+	}
+}
+
+// probe checks in the background whether AWS is reachable again. The result
+// arrives as a probeResultMsg.
+func (a *App) probe() tea.Cmd {
+	conn, region := a.conn, a.region
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return probeResultMsg{err: conn.Probe(ctx, region)}
+	}
+}
+
+// applyRegionScope hands the current region scope to every plugin that can
+// list across regions and updates the status bar.
+func (a *App) applyRegionScope() {
+	var scope plugin.RegionScope
+	if a.allRegions {
+		scope = plugin.RegionScope{Regions: a.config.RegionSet(), Concurrency: a.config.FanOutConcurrency()}
+	}
+	for _, p := range a.registry.All() {
+		if m, ok := p.(plugin.MultiRegion); ok {
+			m.SetRegionScope(scope)
+		}
+	}
+	a.statusBar.SetAllRegions(len(scope.Regions))
+}
+
+// toggleAllRegions switches list views between the session region and all
+// regions in the config. If a multi-region service is open, its list is
+// reopened in the new scope.
+func (a *App) toggleAllRegions() tea.Cmd {
+	a.allRegions = !a.allRegions
+	a.applyRegionScope()
+	if a.allRegions {
+		a.toasts.Push(plugin.ToastInfo, fmt.Sprintf("Listing across %d regions", len(a.config.RegionSet())))
+	} else {
+		a.toasts.Push(plugin.ToastInfo, "Listing in "+a.region+" only")
+	}
+
+	pluginID, _ := a.router.Location()
+	if _, ok := a.registry.Get(pluginID).(plugin.MultiRegion); !ok {
+		return nil
+	}
+	a.router.Navigate(pluginID)
+	return a.router.Current().Init()
+}
+
+// applySession rebuilds the plugin registry from sess, resets navigation to a
+// fresh dashboard and refreshes everything derived from the old session.
+func (a *App) applySession(sess *internalaws.Session) tea.Cmd {
+	scope := cache.NewScope(a.cache, sess.Region, sess.CacheProfile(), a.config.CacheTTLFor)
+	reg := plugin.NewRegistry()
+	if a.register != nil {
+		a.register(reg, sess, scope)
+	}
+
+	a.session = sess
+	a.registry = reg
+	a.mfaDeclined = false
+	a.region = sess.Region
+	a.profile = sess.Profile
+
+	dashboard := NewDashboard(reg, a.router, sess, scope, sess.Region, profileLabel(sess))
+	a.router.SetRegistry(reg)
+	a.router.Reset(dashboard)
+	a.applyRegionScope()
+	a.palette.SetEntries(paletteEntries(reg))
+	a.search.SetScope(scope)
+
+	a.statusBar.SetRegion(sess.Region)
+	a.statusBar.SetProfile(profileLabel(sess))
+	a.statusBar.SetAccount("", "")
+	a.config.LastRegion = sess.Region
+	a.config.LastProfile = sess.Profile
+	a.config.LastAccount = accountOf(sess)
+	if err := a.config.Save(); err != nil && a.logger != nil {
+		a.logger.Error("failed to save config", "err", err)
+	}
+
+	a.refreshCountdown = a.refreshInterval
+	return dashboard.Init()
+}
+
+// Init runs the dashboard's Init and starts the tick timer. It opens the
+// session if that had to wait for the TUI to ask for an MFA code.
 func (a *App) Init() tea.Cmd {
 	dashCmd := a.router.Current().Init()
 	tickCmd := tea.Tick(time.Second, func(t time.Time) tea.Msg {
 		return tickMsg(t)
 	})
-	return tea.Batch(dashCmd, tickCmd)
+	var openCmd tea.Cmd
+	if a.openSession {
+		a.openSession = false
+		openCmd = a.loadSession(a.region, a.profile, a.openAccount, false)
+	}
+	return tea.Batch(dashCmd, tickCmd, a.waitForMFA(), openCmd)
 }
 
 // Update handles all messages for the application.
@@ -147,16 +494,60 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			a.statusBar.SetNextRefresh(time.Duration(a.refreshCountdown) * time.Second)
 		}
+
+		if offline := a.conn.Offline(); offline != a.offline {
+			a.setOffline(offline)
+		}
+		if a.conn.AuthExpired() && a.confirm == nil && !a.authDeclined {
+			a.handleAuthExpired()
+		}
+		if a.offline && !a.probing {
+			a.probeCountdown--
+			if a.probeCountdown <= 0 {
+				a.probing = true
+				cmds = append(cmds, a.probe())
+			}
+		}
 		return a, tea.Batch(cmds...)
 
 	case refreshMsg:
+		// Offline views keep their cached data until the probe succeeds.
+		if a.offline {
+			return a, nil
+		}
 		// Re-init the current view to trigger a data refresh.
 		return a, a.router.Current().Init()
 
+	case probeResultMsg:
+		a.probing = false
+		if msg.err != nil {
+			a.probeCountdown = probeInterval
+			return a, nil
+		}
+		a.conn.SetOnline()
+		a.setOffline(false)
+		return a, a.router.Current().Init()
+
 	case paletteNavigateMsg:
 		a.router.Navigate(msg.pluginID)
 		return a, a.router.Current().Init()
 
+	case openSearchMsg:
+		a.search.Open()
+		return a, nil
+
+	case searchResultsMsg:
+		var cmd tea.Cmd
+		a.search, cmd = a.search.Update(msg)
+		return a, cmd
+
+	case SearchSelectMsg:
+		if a.registry.Get(msg.Hit.PluginID) == nil {
+			return a, nil
+		}
+		a.router.NavigateDetail(msg.Hit.PluginID, msg.Hit.ID)
+		return a, a.router.InitStack()
+
 	case PaletteSelectMsg:
 		if msg.Entry.Action != nil {
 			return a, msg.Entry.Action()
@@ -164,25 +555,130 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a, nil
 
 	case ui.PickerResult:
+		if a.regionPicker == nil && a.profilePicker == nil && a.accountPicker == nil {
+			// A picker opened by the current view.
+			break
+		}
 		if msg.Canceled {
 			a.regionPicker = nil
 			a.profilePicker = nil
+			a.accountPicker = nil
 			return a, nil
 		}
+		region, profile, account := a.region, a.profile, accountOf(a.session)
 		if a.regionPicker != nil {
-			a.statusBar.SetRegion(msg.Selected)
-			a.config.LastRegion = msg.Selected
-			a.config.Save()
+			region = msg.Selected
 			a.regionPicker = nil
 		}
 		if a.profilePicker != nil {
-			a.statusBar.SetProfile(msg.Selected)
-			a.config.LastProfile = msg.Selected
-			a.config.Save()
+			// Picking a profile leaves the named account.
+			profile, account = msg.Selected, nil
 			a.profilePicker = nil
 		}
+		if a.accountPicker != nil {
+			acct := a.accountChoices[msg.Selected]
+			profile, account = acct.SourceProfile, &acct
+			a.accountPicker = nil
+		}
+		return a, a.switchSession(region, profile, account)
+
+	case mfaRequestMsg:
+		return a, a.openMFAInput(mfaRequest(msg))
+
+	case mfaAbandonedMsg:
+		return a, a.abandonMFA(msg)
+
+	case ui.InputResult:
+		if a.mfaPending == nil {
+			break
+		}
+		return a, a.answerMFA(msg)
+
+	case openAccountPickerMsg:
+		return a, a.openAccountPicker()
+
+	case accountsDiscoveredMsg:
+		if msg.err != nil {
+			if a.logger != nil {
+				a.logger.Error("failed to list organization accounts", "err", msg.err)
+			}
+			a.toasts.Push(plugin.ToastError, "Account discovery failed: "+internalaws.FormatError(msg.err))
+		}
+		a.showAccountPicker(msg.accounts)
 		return a, nil
 
+	case identityMsg:
+		if msg.sess == a.session && msg.err == nil {
+			a.statusBar.SetAccount(msg.alias, msg.identity.Account)
+		}
+
+	case sessionSwitchedMsg:
+		if msg.err != nil {
+			if a.logger != nil {
+				a.logger.Error("failed to switch AWS session", "err", msg.err)
+			}
+			a.toasts.Push(plugin.ToastError, "Switch failed: "+internalaws.FormatError(msg.err))
+			return a, nil
+		}
+		if !msg.reload {
+			if a.session != nil {
+				a.toasts.Push(plugin.ToastInfo, "Switched to "+msg.sess.Region+" ("+profileLabel(msg.sess)+")")
+			}
+			return a, a.applySession(msg.sess)
+		}
+
+		// Rebuild the location the user was at so the failed view is retried
+		// with the new credentials.
+		pluginID, id := a.router.Location()
+		cmd := a.applySession(msg.sess)
+		a.toasts.Push(plugin.ToastInfo, "Session reloaded")
+		switch {
+		case id != "":
+			a.router.NavigateDetail(pluginID, id)
+		case pluginID != "":
+			a.router.Navigate(pluginID)
+		default:
+			return a, cmd
+		}
+		return a, tea.Batch(cmd, a.router.InitStack())
+
+	case toggleAllRegionsMsg:
+		return a, a.toggleAllRegions()
+
+	case ssoLoginMsg:
+		return a, a.ssoLogin()
+
+	case ssoLoginFinishedMsg:
+		if msg.err != nil {
+			// Don't prompt again; the palette entry remains available.
+			a.authDeclined = true
+			a.toasts.Push(plugin.ToastError, "SSO login failed: "+msg.err.Error())
+			return a, nil
+		}
+		a.conn.ClearAuth()
+		a.authDeclined = false
+		return a, a.reloadSession()
+
+	case ui.ConfirmResult:
+		if msg.ID == confirmAction {
+			action := a.pendingAction
+			a.confirm, a.pendingAction = nil, nil
+			if !msg.Confirmed || action == nil {
+				return a, nil
+			}
+			return a, action.Run()
+		}
+		if msg.ID != confirmSSOLogin {
+			break
+		}
+		a.confirm = nil
+		if !msg.Confirmed {
+			a.authDeclined = true
+			a.toasts.Push(plugin.ToastWarning, "Credentials expired; run SSO Login from the palette (ctrl+k) to log in")
+			return a, nil
+		}
+		return a, a.ssoLogin()
+
 	case tea.KeyPressMsg:
 		return a.handleKey(msg)
 	}
@@ -193,6 +689,18 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (a *App) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	// A confirmation prompt is modal and takes every key.
+	if a.confirm != nil {
+		c, cmd := a.confirm.Update(msg)
+		a.confirm = &c
+		return a, cmd
+	}
+	if a.mfaInput != nil {
+		in, cmd := a.mfaInput.Update(msg)
+		a.mfaInput = &in
+		return a, cmd
+	}
+
 	// If help overlay is visible, only handle ? and esc.
 	if a.helpOverlay.Visible() {
 		switch msg.String() {
@@ -209,6 +717,13 @@ func (a *App) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return a, cmd
 	}
 
+	// If search is active, forward to search.
+	if a.search.Active() {
+		var cmd tea.Cmd
+		a.search, cmd = a.search.Update(msg)
+		return a, cmd
+	}
+
 	// If a picker is active, forward to picker.
 	if a.regionPicker != nil {
 		p, cmd := a.regionPicker.Update(msg)
@@ -220,6 +735,17 @@ func (a *App) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		a.profilePicker = &p
 		return a, cmd
 	}
+	if a.accountPicker != nil {
+		p, cmd := a.accountPicker.Update(msg)
+		a.accountPicker = &p
+		return a, cmd
+	}
+
+	// A view reading text gets every key, so typing "q" does not quit.
+	if ic, ok := a.router.Current().(plugin.InputCapturer); ok && ic.CapturingInput() {
+		_, cmd := a.router.Current().Update(msg)
+		return a, cmd
+	}
 
 	switch msg.String() {
 	case "q":
@@ -247,11 +773,21 @@ func (a *App) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		a.palette.Open()
 		return a, nil
 
+	case "ctrl+f":
+		a.search.Open()
+		return a, nil
+
 	case "R":
 		p := ui.NewPicker("Select Region", internalaws.ListRegions())
 		a.regionPicker = &p
 		return a, nil
 
+	case "A":
+		return a, a.toggleAllRegions()
+
+	case "C":
+		return a, a.openAccountPicker()
+
 	case "P":
 		profiles := internalaws.ListProfiles()
 		if len(profiles) == 0 {
@@ -285,19 +821,32 @@ func (a *App) View() tea.View {
 
 	// Line 1: breadcrumb.
 	crumbs := a.router.Breadcrumbs()
-	b.WriteString(a.breadcrumb.View(crumbs, time.Time{}, a.width))
+	var updated time.Time
+	var stale bool
+	if f, ok := a.router.Current().(plugin.Freshness); ok {
+		updated, stale = f.UpdatedAt(), f.Stale()
+	}
+	b.WriteString(a.breadcrumb.View(crumbs, updated, stale, a.width))
 	b.WriteByte('\n')
 	b.WriteByte('\n') // margin below breadcrumb
 
 	// Determine main content: overlay takes precedence over the view.
-	hasOverlay := a.palette.Active() || a.regionPicker != nil || a.profilePicker != nil || a.helpOverlay.Visible()
+	hasOverlay := a.confirm != nil || a.mfaInput != nil || a.palette.Active() || a.search.Active() || a.regionPicker != nil || a.profilePicker != nil || a.accountPicker != nil || a.helpOverlay.Visible()
 	if hasOverlay {
-		if a.palette.Active() {
+		if a.confirm != nil {
+			b.WriteString(a.confirm.View())
+		} else if a.mfaInput != nil {
+			b.WriteString(a.mfaInput.View())
+		} else if a.palette.Active() {
 			b.WriteString(a.palette.View())
+		} else if a.search.Active() {
+			b.WriteString(a.search.View())
 		} else if a.regionPicker != nil {
 			b.WriteString(a.regionPicker.View())
 		} else if a.profilePicker != nil {
 			b.WriteString(a.profilePicker.View())
+		} else if a.accountPicker != nil {
+			b.WriteString(a.accountPicker.View())
 		} else if a.helpOverlay.Visible() {
 			b.WriteString(a.helpOverlay.View())
 		}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tasnim.dev/aws-tui/internal/config"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+func TestActionConfirm(t *testing.T) {
+	a := New(AppConfig{Registry: plugin.NewRegistry(), Config: &config.Config{}, Region: "us-east-1", Profile: "dev"})
+	ran := 0
+	action := plugin.Action{
+		Title:   "Stop instance",
+		Targets: []string{"i-abc  web"},
+		Phrase:  "i-abc",
+		Run: func() tea.Cmd {
+			ran++
+			return nil
+		},
+	}
+	typeText := func(s string) tea.Cmd {
+		var cmd tea.Cmd
+		for _, r := range s {
+			_, cmd = a.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+		}
+		return cmd
+	}
+	enter := tea.KeyPressMsg{Code: tea.KeyEnter}
+
+	// The prompt names the account, region and targets, and needs the phrase.
+	a.router.Confirm(action)
+	require.NotNil(t, a.confirm)
+	content := a.View().Content
+	assert.Contains(t, content, "dev")
+	assert.Contains(t, content, "us-east-1")
+	assert.Contains(t, content, "i-abc  web")
+	typeText("i-ab")
+	_, cmd := a.Update(enter)
+	assert.Nil(t, cmd)
+
+	typeText("c")
+	_, cmd = a.Update(enter)
+	require.NotNil(t, cmd)
+	a.Update(cmd())
+	assert.Nil(t, a.confirm)
+	assert.Equal(t, 1, ran)
+
+	// Cancelling does not run it.
+	a.router.Confirm(action)
+	_, cmd = a.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	a.Update(cmd())
+	assert.Nil(t, a.confirm)
+	assert.Equal(t, 1, ran)
+}
+
+func TestActionConfirmRegional(t *testing.T) {
+	a := New(AppConfig{Registry: plugin.NewRegistry(), Config: &config.Config{}, Region: "us-east-1", Profile: "dev"})
+
+	// Actions run through a regional client name their own region.
+	a.router.Confirm(plugin.Action{Title: "Terminate instance", Targets: []string{"i-abc"}, Phrase: "i-abc", Region: "eu-north-1"})
+	require.NotNil(t, a.confirm)
+	content := a.View().Content
+	assert.Contains(t, content, "Region:  eu-north-1")
+	assert.NotContains(t, content, "Region:  us-east-1")
+	assert.Contains(t, content, "dev")
+
+	_, cmd := a.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	a.Update(cmd())
+	a.router.Confirm(plugin.Action{Title: "Terminate instance", Phrase: "i-abc", Region: "eu-north-1", Account: "prod"})
+	assert.Contains(t, a.View().Content, "Account: prod")
+}
+
+func TestAuthExpired(t *testing.T) {
+	sso := false
+	a := New(AppConfig{Registry: plugin.NewRegistry(), Config: &config.Config{}, Region: "us-east-1", Profile: "dev"})
+	a.usesSSO = func(string) bool { return sso }
+
+	// Profiles with static keys are told to check them, without a login offer.
+	a.handleAuthExpired()
+	assert.Nil(t, a.confirm)
+	assert.True(t, a.authDeclined)
+	require.Len(t, a.toasts.Visible(), 1)
+	assert.Contains(t, a.toasts.Visible()[0].Message, "profile dev")
+
+	// SSO profiles are offered aws sso login.
+	sso = true
+	a.authDeclined = false
+	a.handleAuthExpired()
+	require.NotNil(t, a.confirm)
+	assert.Contains(t, a.View().Content, "aws sso login")
+}
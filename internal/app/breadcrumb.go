@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"charm.land/lipgloss/v2"
+	"tasnim.dev/aws-tui/internal/theme"
 )
 
 var (
@@ -15,6 +16,10 @@ var (
 
 	breadcrumbTimestampStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("245"))
+
+	breadcrumbStaleStyle = lipgloss.NewStyle().
+				Foreground(theme.Default.BreadcrumbStale).
+				Italic(true)
 )
 
 // Breadcrumb renders a navigation breadcrumb trail with an optional timestamp.
@@ -26,7 +31,9 @@ func NewBreadcrumb() Breadcrumb {
 }
 
 // View renders the breadcrumb trail and optional "Updated Xs ago" timestamp.
-func (b Breadcrumb) View(crumbs []string, lastUpdated time.Time, width int) string {
+// When stale is set the timestamp is rendered in the stale color to signal
+// that the view is showing cached data.
+func (b Breadcrumb) View(crumbs []string, lastUpdated time.Time, stale bool, width int) string {
 	left := breadcrumbStyle.Render(strings.Join(crumbs, " > "))
 
 	if lastUpdated.IsZero() {
@@ -34,13 +41,18 @@ func (b Breadcrumb) View(crumbs []string, lastUpdated time.Time, width int) stri
 	}
 
 	elapsed := time.Since(lastUpdated).Truncate(time.Second)
-	right := breadcrumbTimestampStyle.Render(fmt.Sprintf("Updated %s ago", elapsed))
+	rightText := fmt.Sprintf("Updated %s ago", elapsed)
+	style := breadcrumbTimestampStyle
+	if stale {
+		rightText = fmt.Sprintf("Cached %s ago (stale)", elapsed)
+		style = breadcrumbStaleStyle
+	}
+	right := style.Render(rightText)
 
 	// Calculate padding between left and right.
 	// Use a rough visible-length estimate (strip ANSI would be better,
 	// but for now use the raw string lengths of crumbs + timestamp).
 	leftLen := len(strings.Join(crumbs, " > "))
-	rightText := fmt.Sprintf("Updated %s ago", elapsed)
 	rightLen := len(rightText)
 
 	pad := width - leftLen - rightLen
@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 
 	tea "charm.land/bubbletea/v2"
@@ -9,6 +11,7 @@ import (
 	internalaws "tasnim.dev/aws-tui/internal/aws"
 	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/theme"
 )
 
 var (
@@ -39,49 +42,90 @@ var (
 
 	dashServiceDesc = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("240"))
+
+	dashSummaryText = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("252"))
+
+	dashSummaryStale = lipgloss.NewStyle().
+				Foreground(theme.Default.BreadcrumbStale).
+				Italic(true)
 )
 
+// maxSummaryStatuses caps how many status counts a service card shows.
+const maxSummaryStatuses = 4
+
 // serviceDescriptions maps plugin IDs to human-readable subtitles.
 var serviceDescriptions = map[string]string{
-	"ec2":  "Elastic Compute Cloud — Instances",
-	"ecs":  "Elastic Container Service — Clusters, Services, Tasks",
-	"eks":  "Elastic Kubernetes Service — Clusters, Pods, Services",
-	"vpc":  "Virtual Private Cloud — VPCs, Subnets, Security Groups",
-	"s3":   "Simple Storage Service — Buckets, Objects",
-	"iam":  "Identity & Access Management — Users, Roles, Policies",
-	"ecr":  "Elastic Container Registry — Repositories, Images",
-	"elb":  "Elastic Load Balancing — Load Balancers, Listeners, Target Groups",
-	"cost": "Cost Explorer — Spend Analysis, Forecasts",
+	"ec2":            "Elastic Compute Cloud — Instances",
+	"ecs":            "Elastic Container Service — Clusters, Services, Tasks",
+	"eks":            "Elastic Kubernetes Service — Clusters, Pods, Services",
+	"vpc":            "Virtual Private Cloud — VPCs, Subnets, Security Groups",
+	"s3":             "Simple Storage Service — Buckets, Objects",
+	"iam":            "Identity & Access Management — Users, Roles, Policies",
+	"ecr":            "Elastic Container Registry — Repositories, Images",
+	"elb":            "Elastic Load Balancing — Load Balancers, Listeners, Target Groups",
+	"alarms":         "CloudWatch Alarms — Firing, Insufficient Data, OK",
+	"lambda":         "AWS Lambda — Functions by Runtime",
+	"rds":            "Relational Database Service — Instances, Aurora Clusters",
+	"dynamodb":       "DynamoDB — Tables, Items, Queries",
+	"cloudformation": "CloudFormation — Stacks, Events, Resources, Drift",
+	"messaging":      "SQS & SNS — Queues, Dead-Letter Queues, Topics",
+	"cost":           "Cost Explorer — Spend Analysis, Forecasts",
 }
 
 type identityMsg struct {
+	sess     *internalaws.Session
 	identity internalaws.Identity
+	alias    string
 	err      error
 }
 
+// summaryMsg carries a service summary read from the cache or fetched live.
+type summaryMsg struct {
+	sess    *internalaws.Session
+	id      string
+	summary plugin.ServiceSummary
+	// stale is set when the summary came from an expired cache entry and a
+	// live fetch should follow.
+	stale bool
+	err   error
+}
+
+// serviceSummary is the summary currently shown on a service card.
+type serviceSummary struct {
+	summary plugin.ServiceSummary
+	stale   bool
+	err     error
+}
+
 // Dashboard is the home screen showing service cards from registered plugins.
 type Dashboard struct {
 	registry *plugin.Registry
 	router   plugin.Router
 	session  *internalaws.Session
-	cache    *cache.DB
+	cache    *cache.Scope
 	region   string
 	profile  string
 	identity *internalaws.Identity
-	cursor   int
-	width    int
-	height   int
+	alias    string
+	// summaries holds the latest summary per plugin ID.
+	summaries map[string]serviceSummary
+	cursor    int
+	width     int
+	height    int
 }
 
 // NewDashboard creates a Dashboard wired to the given registry and router.
-func NewDashboard(registry *plugin.Registry, router plugin.Router, sess *internalaws.Session, cacheDB *cache.DB, region, profile string) *Dashboard {
+// scope may be nil, in which case summaries are always fetched live.
+func NewDashboard(registry *plugin.Registry, router plugin.Router, sess *internalaws.Session, scope *cache.Scope, region, profile string) *Dashboard {
 	return &Dashboard{
-		registry: registry,
-		router:   router,
-		session:  sess,
-		cache:    cacheDB,
-		region:   region,
-		profile:  profile,
+		registry:  registry,
+		router:    router,
+		session:   sess,
+		cache:     scope,
+		region:    region,
+		profile:   profile,
+		summaries: make(map[string]serviceSummary),
 	}
 }
 
@@ -96,28 +140,101 @@ func (d *Dashboard) KeyHints() []plugin.KeyHint {
 		{Key: "j/k", Desc: "navigate"},
 		{Key: "enter", Desc: "open"},
 		{Key: "ctrl+k", Desc: "palette"},
+		{Key: "ctrl+f", Desc: "search resources"},
 		{Key: "q", Desc: "quit"},
 	}
 }
 
-// Init fetches AWS identity asynchronously.
+// Init fetches AWS identity and service summaries asynchronously.
 func (d *Dashboard) Init() tea.Cmd {
-	if d.session == nil || d.identity != nil {
+	if d.session == nil {
 		return nil
 	}
-	sess := d.session
+
+	var cmds []tea.Cmd
+	if d.identity == nil {
+		sess := d.session
+		cmds = append(cmds, func() tea.Msg {
+			ctx := context.TODO()
+			id, err := sess.CallerIdentity(ctx)
+			if err != nil {
+				return identityMsg{sess: sess, err: err}
+			}
+			return identityMsg{sess: sess, identity: id, alias: sess.AccountAlias(ctx)}
+		})
+	}
+
+	for _, p := range d.registry.All() {
+		// Without a cache there is nothing to revalidate against, so only
+		// fetch summaries that have never loaded.
+		if _, ok := d.summaries[p.ID()]; ok && d.cache == nil {
+			continue
+		}
+		cmds = append(cmds, d.loadSummary(p))
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadSummary returns the cached summary for p when there is one. A fresh
+// entry is used as is; an expired one is shown as stale while Update triggers
+// a live fetch. Without a cached entry the summary is fetched live directly.
+func (d *Dashboard) loadSummary(p plugin.ServicePlugin) tea.Cmd {
+	sess, scope := d.session, d.cache
 	return func() tea.Msg {
-		id, err := sess.CallerIdentity(context.TODO())
-		return identityMsg{identity: id, err: err}
+		summary, found, fresh, err := cache.LoadSummary[plugin.ServiceSummary](context.TODO(), scope, p.ID())
+		if err == nil && found {
+			return summaryMsg{sess: sess, id: p.ID(), summary: summary, stale: !fresh}
+		}
+		return fetchSummary(sess, scope, p)
 	}
 }
 
+// fetchSummary calls the plugin's Summary and caches the result.
+func fetchSummary(sess *internalaws.Session, scope *cache.Scope, p plugin.ServicePlugin) summaryMsg {
+	ctx := context.TODO()
+	summary, err := p.Summary(ctx)
+	if err != nil {
+		return summaryMsg{sess: sess, id: p.ID(), err: err}
+	}
+	_ = cache.StoreSummary(ctx, scope, p.ID(), summary)
+	return summaryMsg{sess: sess, id: p.ID(), summary: summary}
+}
+
 // Update handles messages for the dashboard.
 func (d *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case identityMsg:
+		// Ignore identities resolved for a session that has since been replaced.
+		if msg.sess != d.session {
+			return d, nil
+		}
 		if msg.err == nil {
 			d.identity = &msg.identity
+			d.alias = msg.alias
+		}
+		return d, nil
+
+	case summaryMsg:
+		if msg.sess != d.session {
+			return d, nil
+		}
+		if msg.err != nil {
+			// Keep showing the last known summary, marked stale.
+			prev, ok := d.summaries[msg.id]
+			if ok && prev.err == nil {
+				prev.stale = true
+				d.summaries[msg.id] = prev
+			} else {
+				d.summaries[msg.id] = serviceSummary{err: msg.err}
+			}
+			return d, nil
+		}
+		d.summaries[msg.id] = serviceSummary{summary: msg.summary, stale: msg.stale}
+		if msg.stale && !d.router.Offline() {
+			if p := d.registry.Get(msg.id); p != nil {
+				sess, scope := d.session, d.cache
+				return d, func() tea.Msg { return fetchSummary(sess, scope, p) }
+			}
 		}
 		return d, nil
 
@@ -134,10 +251,8 @@ func (d *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case "enter":
 			if len(plugins) > 0 && d.cursor < len(plugins) {
-				p := plugins[d.cursor]
-				view := p.ListView(d.router)
-				d.router.Push(view)
-				return d, view.Init()
+				id := plugins[d.cursor].ID()
+				return d, func() tea.Msg { return paletteNavigateMsg{pluginID: id} }
 			}
 		}
 		return d, nil
@@ -205,6 +320,15 @@ func (d *Dashboard) View() tea.View {
 			b.WriteByte('\n')
 		}
 
+		// Line 3: summary
+		if sum, ok := d.summaries[p.ID()]; ok {
+			b.WriteString("    ")
+			b.WriteString(bar)
+			b.WriteString("  ")
+			b.WriteString(renderSummary(sum))
+			b.WriteByte('\n')
+		}
+
 		// Blank line between services.
 		if i < len(plugins)-1 {
 			b.WriteString("    ")
@@ -223,8 +347,12 @@ func (d *Dashboard) renderHeader() string {
 
 	// Identity
 	if d.identity != nil {
+		account := d.identity.Account
+		if d.alias != "" {
+			account = d.alias + " (" + account + ")"
+		}
 		parts = append(parts,
-			dashHeaderLabelStyle.Render("Account: ")+dashHeaderValueStyle.Render(d.identity.Account),
+			dashHeaderLabelStyle.Render("Account: ")+dashHeaderValueStyle.Render(account),
 		)
 		// Extract user/role from ARN (last segment after / or :)
 		arn := d.identity.ARN
@@ -255,3 +383,61 @@ func (d *Dashboard) renderHeader() string {
 
 	return "  " + strings.Join(parts, sep)
 }
+
+// renderSummary renders a one-line summary: a health dot, the headline and a
+// few status counts, followed by a marker when the data is cached.
+func renderSummary(s serviceSummary) string {
+	if s.err != nil {
+		return dashServiceDesc.Render("summary unavailable: " + internalaws.FormatError(s.err))
+	}
+
+	sum := s.summary
+	headline := sum.Label
+	if headline == "" || !startsWithCount(headline) {
+		headline = strings.TrimSpace(fmt.Sprintf("%d %s", sum.Total, sum.Label))
+	}
+
+	keys := make([]string, 0, len(sum.Status))
+	for k := range sum.Status {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) > maxSummaryStatuses {
+		keys = keys[:maxSummaryStatuses]
+	}
+	counts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		counts = append(counts, fmt.Sprintf("%s %d", strings.ToLower(k), sum.Status[k]))
+	}
+
+	line := healthDot(sum.Health) + " " + dashSummaryText.Render(headline)
+	if len(counts) > 0 {
+		line += dashServiceDesc.Render("  " + strings.Join(counts, " · "))
+	}
+	if s.stale {
+		line += " " + dashSummaryStale.Render("(cached)")
+	}
+	return line
+}
+
+// startsWithCount reports whether a summary label already carries its own
+// figure, like "3 buckets" or "$12.00 this month".
+func startsWithCount(label string) bool {
+	c := label[0]
+	return c == '$' || (c >= '0' && c <= '9')
+}
+
+func healthDot(h plugin.HealthLevel) string {
+	var c lipgloss.Style
+	switch h {
+	case plugin.HealthHealthy:
+		c = lipgloss.NewStyle().Foreground(theme.Default.StatusHealthy)
+	case plugin.HealthWarning:
+		c = lipgloss.NewStyle().Foreground(theme.Default.StatusWarning)
+	case plugin.HealthCritical:
+		c = lipgloss.NewStyle().Foreground(theme.Default.StatusCritical)
+	default:
+		c = lipgloss.NewStyle().Foreground(theme.Default.Muted)
+	}
+	return c.Render("●")
+}
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+
+	tea "charm.land/bubbletea/v2"
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// mfaRequest asks the UI for a code on behalf of a goroutine retrieving
+// credentials, which waits on reply until ctx is done.
+type mfaRequest struct {
+	ctx    context.Context
+	serial string
+	reply  chan mfaReply
+}
+
+type mfaReply struct {
+	code string
+	err  error
+}
+
+// mfaRequestMsg delivers an mfaRequest to the App.
+type mfaRequestMsg mfaRequest
+
+// mfaAbandonedMsg is sent when the retrieval waiting on the request with
+// reply has given up, such as when opening a session timed out.
+type mfaAbandonedMsg struct {
+	reply chan mfaReply
+}
+
+// promptMFA implements internalaws.MFAPrompt. It hands the request to the
+// App's update loop and blocks until the user has answered or ctx is done.
+func (a *App) promptMFA(ctx context.Context, serial string) (string, error) {
+	req := mfaRequest{ctx: ctx, serial: serial, reply: make(chan mfaReply, 1)}
+	select {
+	case a.mfaRequests <- req:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	select {
+	case r := <-req.reply:
+		return r.code, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// waitForMFA delivers the next MFA request as an mfaRequestMsg. Requests
+// are served one at a time; the App waits again once one is answered.
+func (a *App) waitForMFA() tea.Cmd {
+	ch := a.mfaRequests
+	return func() tea.Msg {
+		return mfaRequestMsg(<-ch)
+	}
+}
+
+// openMFAInput shows the masked code prompt for req. After the user has
+// declined once, requests are refused without asking until the session is
+// switched or reloaded, so background refreshes do not keep prompting.
+func (a *App) openMFAInput(req mfaRequest) tea.Cmd {
+	if a.mfaDeclined {
+		req.reply <- mfaReply{err: internalaws.ErrMFACanceled}
+		return a.waitForMFA()
+	}
+	in := ui.NewInput("MFA Required", "Code for "+req.serial+":", true)
+	a.mfaInput = &in
+	a.mfaPending = &req
+	if req.ctx == nil || req.ctx.Done() == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		<-req.ctx.Done()
+		return mfaAbandonedMsg{reply: req.reply}
+	}
+}
+
+// abandonMFA closes the prompt of an abandoned request if it is still open.
+func (a *App) abandonMFA(msg mfaAbandonedMsg) tea.Cmd {
+	if a.mfaPending == nil || a.mfaPending.reply != msg.reply {
+		return nil
+	}
+	a.mfaPending, a.mfaInput = nil, nil
+	a.toasts.Push(plugin.ToastWarning, "MFA code not entered in time")
+	return a.waitForMFA()
+}
+
+// answerMFA replies to the pending request with the code the user entered.
+func (a *App) answerMFA(res ui.InputResult) tea.Cmd {
+	req := a.mfaPending
+	a.mfaPending, a.mfaInput = nil, nil
+	if res.Canceled {
+		a.mfaDeclined = true
+		req.reply <- mfaReply{err: internalaws.ErrMFACanceled}
+		a.toasts.Push(plugin.ToastWarning, "MFA code not entered; switch profile or region to try again")
+	} else {
+		req.reply <- mfaReply{code: res.Value}
+	}
+	return a.waitForMFA()
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+	"tasnim.dev/aws-tui/internal/config"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+type promptResult struct {
+	code string
+	err  error
+}
+
+func TestMFAPrompt(t *testing.T) {
+	a := New(AppConfig{Registry: plugin.NewRegistry(), Config: &config.Config{}})
+
+	ask := func() chan promptResult {
+		done := make(chan promptResult, 1)
+		go func() {
+			code, err := a.promptMFA(context.Background(), "arn:aws:iam::111111111111:mfa/dev")
+			done <- promptResult{code, err}
+		}()
+		return done
+	}
+
+	// The request opens a masked input; the code entered is handed back.
+	done := ask()
+	a.Update(a.waitForMFA()())
+	require.NotNil(t, a.mfaInput)
+	assert.Contains(t, a.View().Content, "arn:aws:iam::111111111111:mfa/dev")
+	_, cmd := a.Update(ui.InputResult{Value: "123456"})
+	assert.Nil(t, a.mfaInput)
+	assert.NotNil(t, cmd, "waits for the next request")
+	assert.Equal(t, promptResult{code: "123456"}, <-done)
+
+	// Cancelling refuses this and later requests without asking again.
+	done = ask()
+	a.Update(a.waitForMFA()())
+	a.Update(ui.InputResult{Canceled: true})
+	assert.ErrorIs(t, (<-done).err, internalaws.ErrMFACanceled)
+
+	done = ask()
+	a.Update(a.waitForMFA()())
+	assert.Nil(t, a.mfaInput)
+	assert.ErrorIs(t, (<-done).err, internalaws.ErrMFACanceled)
+}
+
+func TestMFAPrompt_Abandoned(t *testing.T) {
+	a := New(AppConfig{Registry: plugin.NewRegistry(), Config: &config.Config{}})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan promptResult, 1)
+	go func() {
+		code, err := a.promptMFA(ctx, "arn:aws:iam::111111111111:mfa/dev")
+		done <- promptResult{code, err}
+	}()
+
+	_, watch := a.Update(a.waitForMFA()())
+	require.NotNil(t, a.mfaInput)
+	require.NotNil(t, watch, "the prompt closes when the retrieval gives up")
+
+	// Opening the session timed out before a code was entered.
+	cancel()
+	assert.ErrorIs(t, (<-done).err, context.Canceled)
+	_, cmd := a.Update(watch())
+	assert.Nil(t, a.mfaInput)
+	assert.NotNil(t, cmd, "waits for the next request")
+
+	// A prompt that could not be delivered gives up too.
+	_, err := a.promptMFA(ctx, "arn:aws:iam::111111111111:mfa/dev")
+	assert.ErrorIs(t, err, context.Canceled)
+}
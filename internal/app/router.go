@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	tea "charm.land/bubbletea/v2"
 	"tasnim.dev/aws-tui/internal/plugin"
 )
@@ -8,16 +10,43 @@ import (
 // Router manages a stack of views and implements plugin.Router.
 type Router struct {
 	stack         []plugin.View
+	cancels       []context.CancelFunc // parallel to stack
+	contexts      []context.Context    // parallel to stack
 	registry      *plugin.Registry
 	toastFn       func(plugin.ToastLevel, string)
+	offlineFn     func() bool
+	readOnly      bool
+	confirmFn     func(plugin.Action)
 	width, height int
+	// navPlugin and navID record the last Navigate or NavigateDetail call so
+	// the location can be rebuilt after the session is reloaded.
+	navPlugin, navID string
 }
 
 // NewRouter creates a Router with the given root view on the stack.
 func NewRouter(root plugin.View) *Router {
-	return &Router{
-		stack: []plugin.View{root},
+	r := &Router{}
+	r.push(root)
+	return r
+}
+
+// push appends v to the stack together with a context that lives as long
+// as v stays on it.
+func (r *Router) push(v plugin.View) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.stack = append(r.stack, v)
+	r.contexts = append(r.contexts, ctx)
+	r.cancels = append(r.cancels, cancel)
+}
+
+// truncate cancels the contexts of all views above depth n and removes them.
+func (r *Router) truncate(n int) {
+	for _, cancel := range r.cancels[n:] {
+		cancel()
 	}
+	r.stack = r.stack[:n]
+	r.contexts = r.contexts[:n]
+	r.cancels = r.cancels[:n]
 }
 
 // SetRegistry sets the plugin registry used by Navigate and NavigateDetail.
@@ -30,6 +59,21 @@ func (r *Router) SetToastFn(fn func(plugin.ToastLevel, string)) {
 	r.toastFn = fn
 }
 
+// SetOfflineFn sets the function called by Offline.
+func (r *Router) SetOfflineFn(fn func() bool) {
+	r.offlineFn = fn
+}
+
+// SetReadOnly sets whether mutating actions are disabled.
+func (r *Router) SetReadOnly(readOnly bool) {
+	r.readOnly = readOnly
+}
+
+// SetConfirmFn sets the function called by Confirm.
+func (r *Router) SetConfirmFn(fn func(plugin.Action)) {
+	r.confirmFn = fn
+}
+
 // SetSize stores the current terminal dimensions so new views receive them.
 func (r *Router) SetSize(w, h int) {
 	r.width = w
@@ -38,7 +82,7 @@ func (r *Router) SetSize(w, h int) {
 
 // Push adds a view to the top of the stack and forwards the current window size.
 func (r *Router) Push(v plugin.View) {
-	r.stack = append(r.stack, v)
+	r.push(v)
 	if r.width > 0 && r.height > 0 {
 		v.Update(tea.WindowSizeMsg{Width: r.width, Height: r.height})
 	}
@@ -47,13 +91,24 @@ func (r *Router) Push(v plugin.View) {
 // Pop removes the top view. It is a no-op when at the root.
 func (r *Router) Pop() {
 	if len(r.stack) > 1 {
-		r.stack = r.stack[:len(r.stack)-1]
+		r.truncate(len(r.stack) - 1)
 	}
 }
 
 // Home pops all views except the root.
 func (r *Router) Home() {
-	r.stack = r.stack[:1]
+	r.truncate(1)
+}
+
+// Reset discards the whole stack and installs root as the new root view.
+// It is used when the AWS session changes and every existing view holds
+// clients bound to the old region or profile.
+func (r *Router) Reset(root plugin.View) {
+	r.truncate(0)
+	r.push(root)
+	if r.width > 0 && r.height > 0 {
+		root.Update(tea.WindowSizeMsg{Width: r.width, Height: r.height})
+	}
 }
 
 // Current returns the view at the top of the stack.
@@ -61,6 +116,17 @@ func (r *Router) Current() plugin.View {
 	return r.stack[len(r.stack)-1]
 }
 
+// Context returns a context that is cancelled when v is popped or the stack
+// is reset. Views not on the stack get a context that is never cancelled.
+func (r *Router) Context(v plugin.View) context.Context {
+	for i := len(r.stack) - 1; i >= 0; i-- {
+		if r.stack[i] == v {
+			return r.contexts[i]
+		}
+	}
+	return context.Background()
+}
+
 // Depth returns the number of views on the stack.
 func (r *Router) Depth() int {
 	return len(r.stack)
@@ -87,6 +153,7 @@ func (r *Router) Navigate(pluginID string) {
 	}
 	r.Home()
 	r.Push(p.ListView(r))
+	r.navPlugin, r.navID = pluginID, ""
 }
 
 // NavigateDetail goes Home, pushes the ListView, then pushes the DetailView.
@@ -102,6 +169,33 @@ func (r *Router) NavigateDetail(pluginID, id string) {
 	r.Home()
 	r.Push(p.ListView(r))
 	r.Push(p.DetailView(r, id))
+	r.navPlugin, r.navID = pluginID, id
+}
+
+// Location returns the plugin and resource ID of the views opened by the last
+// Navigate or NavigateDetail call that are still on the stack. Views pushed
+// on top of them are not included; id is empty when only the list view
+// remains and both are empty at the root.
+func (r *Router) Location() (pluginID, id string) {
+	switch {
+	case len(r.stack) < 2:
+		return "", ""
+	case len(r.stack) < 3:
+		return r.navPlugin, ""
+	default:
+		return r.navPlugin, r.navID
+	}
+}
+
+// InitStack returns the batched Init commands of every view above the root.
+// It is used after NavigateDetail, which pushes a list and a detail view at
+// once; both need to load their data.
+func (r *Router) InitStack() tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(r.stack)-1)
+	for _, v := range r.stack[1:] {
+		cmds = append(cmds, v.Init())
+	}
+	return tea.Batch(cmds...)
 }
 
 // Toast calls the configured toast function. It is a no-op if no function is set.
@@ -111,5 +205,27 @@ func (r *Router) Toast(level plugin.ToastLevel, msg string) {
 	}
 }
 
+// Offline calls the configured offline function. It reports false if no
+// function is set.
+func (r *Router) Offline() bool {
+	return r.offlineFn != nil && r.offlineFn()
+}
+
+// ReadOnly reports whether mutating actions are disabled.
+func (r *Router) ReadOnly() bool {
+	return r.readOnly
+}
+
+// Confirm asks the user to confirm action, refusing while read-only.
+func (r *Router) Confirm(action plugin.Action) {
+	if r.readOnly {
+		r.Toast(plugin.ToastWarning, action.Title+" is disabled in read-only mode")
+		return
+	}
+	if r.confirmFn != nil {
+		r.confirmFn(action)
+	}
+}
+
 // Compile-time check that Router implements plugin.Router.
 var _ plugin.Router = (*Router)(nil)
@@ -22,6 +22,17 @@ func (f *fakeView) View() tea.View                   { return tea.NewView("") }
 func (f *fakeView) Title() string                    { return f.title }
 func (f *fakeView) KeyHints() []plugin.KeyHint       { return nil }
 
+// initCountView counts Init calls.
+type initCountView struct {
+	fakeView
+	inits int
+}
+
+func (v *initCountView) Init() tea.Cmd {
+	v.inits++
+	return func() tea.Msg { return nil }
+}
+
 func newFakeView(title string) *fakeView {
 	return &fakeView{title: title}
 }
@@ -98,6 +109,21 @@ func TestRouter(t *testing.T) {
 				assert.Equal(t, root, r.Current())
 			},
 		},
+		{
+			name: "reset replaces the whole stack with a new root",
+			fn: func(t *testing.T) {
+				r := NewRouter(newFakeView("old"))
+				r.Push(newFakeView("a"))
+				r.Push(newFakeView("b"))
+
+				root := newFakeView("new")
+				r.Reset(root)
+
+				assert.Equal(t, 1, r.Depth())
+				assert.Equal(t, root, r.Current())
+				assert.Equal(t, []string{"new"}, r.Breadcrumbs())
+			},
+		},
 		{
 			name: "breadcrumbs returns ordered titles",
 			fn: func(t *testing.T) {
@@ -159,6 +185,69 @@ func TestRouter(t *testing.T) {
 				assert.Equal(t, []string{"root", "Pods List", "pod-xyz"}, r.Breadcrumbs())
 			},
 		},
+		{
+			name: "location tracks navigation still on the stack",
+			fn: func(t *testing.T) {
+				r := NewRouter(newFakeView("root"))
+				reg := plugin.NewRegistry()
+				reg.Add(&fakePlugin{id: "pods", listTitle: "Pods List", detailTitle: "pod-xyz"})
+				r.SetRegistry(reg)
+
+				r.NavigateDetail("pods", "xyz")
+				r.Push(newFakeView("nested"))
+				id, res := r.Location()
+				assert.Equal(t, "pods", id)
+				assert.Equal(t, "xyz", res)
+
+				r.Pop()
+				r.Pop()
+				id, res = r.Location()
+				assert.Equal(t, "pods", id)
+				assert.Empty(t, res)
+
+				r.Home()
+				id, res = r.Location()
+				assert.Empty(t, id)
+				assert.Empty(t, res)
+			},
+		},
+		{
+			name: "view context is cancelled when the view leaves the stack",
+			fn: func(t *testing.T) {
+				root := newFakeView("root")
+				r := NewRouter(root)
+				a := newFakeView("a")
+				b := newFakeView("b")
+				r.Push(a)
+				r.Push(b)
+				ctxA, ctxB := r.Context(a), r.Context(b)
+
+				r.Pop()
+				assert.Error(t, ctxB.Err())
+				assert.NoError(t, ctxA.Err())
+
+				r.Reset(newFakeView("new"))
+				assert.Error(t, ctxA.Err())
+				assert.NoError(t, r.Context(root).Err(), "views off the stack are never cancelled")
+			},
+		},
+		{
+			name: "init stack runs init on every view above the root",
+			fn: func(t *testing.T) {
+				root := &initCountView{fakeView: fakeView{title: "root"}}
+				r := NewRouter(root)
+				a := &initCountView{fakeView: fakeView{title: "a"}}
+				b := &initCountView{fakeView: fakeView{title: "b"}}
+				r.Push(a)
+				r.Push(b)
+
+				cmd := r.InitStack()
+				require.NotNil(t, cmd)
+				assert.Equal(t, 0, root.inits)
+				assert.Equal(t, 1, a.inits)
+				assert.Equal(t, 1, b.inits)
+			},
+		},
 		{
 			name: "toast calls toastFn",
 			fn: func(t *testing.T) {
@@ -178,6 +267,20 @@ func TestRouter(t *testing.T) {
 				assert.Equal(t, "something broke", gotMsg)
 			},
 		},
+		{
+			name: "offline reports offlineFn and defaults to online",
+			fn: func(t *testing.T) {
+				r := NewRouter(newFakeView("root"))
+				assert.False(t, r.Offline())
+
+				offline := true
+				r.SetOfflineFn(func() bool { return offline })
+				assert.True(t, r.Offline())
+
+				offline = false
+				assert.False(t, r.Offline())
+			},
+		},
 		{
 			name: "toast without fn set is no-op",
 			fn: func(t *testing.T) {
@@ -188,6 +291,25 @@ func TestRouter(t *testing.T) {
 				r.Toast(plugin.ToastInfo, "hello")
 			},
 		},
+		{
+			name: "confirm is refused with a toast while read-only",
+			fn: func(t *testing.T) {
+				r := NewRouter(newFakeView("root"))
+				var confirmed []string
+				var toasts []string
+				r.SetConfirmFn(func(a plugin.Action) { confirmed = append(confirmed, a.Title) })
+				r.SetToastFn(func(_ plugin.ToastLevel, msg string) { toasts = append(toasts, msg) })
+
+				r.Confirm(plugin.Action{Title: "Stop instance"})
+				assert.Equal(t, []string{"Stop instance"}, confirmed)
+
+				r.SetReadOnly(true)
+				assert.True(t, r.ReadOnly())
+				r.Confirm(plugin.Action{Title: "Stop instance"})
+				assert.Len(t, confirmed, 1)
+				assert.Equal(t, []string{"Stop instance is disabled in read-only mode"}, toasts)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,247 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"tasnim.dev/aws-tui/internal/cache"
+)
+
+var searchServiceStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("39"))
+
+// searchablePlugins lists the plugin IDs whose cached resources can be opened
+// from search. The cache service key of a resource is the plugin ID, optionally
+// followed by ":" and a sub-resource qualifier ("iam:roles").
+var searchablePlugins = map[string]bool{
+	"ec2":            true,
+	"ecs":            true,
+	"eks":            true,
+	"vpc":            true,
+	"s3":             true,
+	"iam":            true,
+	"ecr":            true,
+	"elb":            true,
+	"alarms":         true,
+	"lambda":         true,
+	"rds":            true,
+	"dynamodb":       true,
+	"cloudformation": true,
+	"messaging":      true,
+}
+
+// SearchHit is a cached resource matching a search query.
+type SearchHit struct {
+	PluginID string
+	ID       string
+	Name     string
+}
+
+// SearchSelectMsg is sent when the user picks a search hit.
+type SearchSelectMsg struct {
+	Hit SearchHit
+}
+
+// searchResultsMsg carries the hits for a query. seq identifies the query so
+// results for superseded queries can be dropped.
+type searchResultsMsg struct {
+	seq  int
+	hits []SearchHit
+	err  error
+}
+
+// SearchOverlay searches cached resource names and IDs across services.
+type SearchOverlay struct {
+	scope  *cache.Scope
+	query  string
+	hits   []SearchHit
+	err    error
+	cursor int
+	seq    int
+	active bool
+}
+
+// NewSearchOverlay creates a search overlay backed by scope.
+func NewSearchOverlay(scope *cache.Scope) SearchOverlay {
+	return SearchOverlay{scope: scope}
+}
+
+// SetScope replaces the cache scope, e.g. after a region or profile switch.
+func (s *SearchOverlay) SetScope(scope *cache.Scope) {
+	s.scope = scope
+	s.hits = nil
+}
+
+// Active returns whether the overlay is open.
+func (s SearchOverlay) Active() bool {
+	return s.active
+}
+
+// ResultCount returns the number of hits for the current query.
+func (s SearchOverlay) ResultCount() int {
+	return len(s.hits)
+}
+
+// Open activates the overlay with an empty query.
+func (s *SearchOverlay) Open() {
+	s.active = true
+	s.query = ""
+	s.hits = nil
+	s.err = nil
+	s.cursor = 0
+}
+
+// Close deactivates the overlay.
+func (s *SearchOverlay) Close() {
+	s.active = false
+}
+
+// Update handles key events and search results.
+func (s SearchOverlay) Update(msg tea.Msg) (SearchOverlay, tea.Cmd) {
+	switch msg := msg.(type) {
+	case searchResultsMsg:
+		if msg.seq != s.seq {
+			return s, nil
+		}
+		s.hits = msg.hits
+		s.err = msg.err
+		s.cursor = 0
+		return s, nil
+
+	case tea.KeyPressMsg:
+		if !s.active {
+			return s, nil
+		}
+
+		switch msg.String() {
+		case "esc":
+			s.Close()
+			return s, nil
+
+		case "enter":
+			if len(s.hits) == 0 {
+				return s, nil
+			}
+			selected := s.hits[s.cursor]
+			s.Close()
+			return s, func() tea.Msg {
+				return SearchSelectMsg{Hit: selected}
+			}
+
+		case "backspace":
+			if len(s.query) > 0 {
+				runes := []rune(s.query)
+				s.query = string(runes[:len(runes)-1])
+				return s, s.search()
+			}
+			return s, nil
+
+		case "up":
+			if s.cursor > 0 {
+				s.cursor--
+			}
+			return s, nil
+
+		case "down":
+			if len(s.hits) > 0 && s.cursor < len(s.hits)-1 {
+				s.cursor++
+			}
+			return s, nil
+
+		default:
+			for _, r := range msg.Text {
+				if unicode.IsPrint(r) {
+					s.query += msg.Text
+					return s, s.search()
+				}
+			}
+			return s, nil
+		}
+	}
+
+	return s, nil
+}
+
+// search queries the cache for the current query in the background.
+func (s *SearchOverlay) search() tea.Cmd {
+	s.seq++
+	seq, scope, query := s.seq, s.scope, strings.TrimSpace(s.query)
+	if query == "" {
+		s.hits = nil
+		return nil
+	}
+	return func() tea.Msg {
+		resources, err := scope.Search(context.TODO(), query)
+		if err != nil {
+			return searchResultsMsg{seq: seq, err: err}
+		}
+		return searchResultsMsg{seq: seq, hits: toSearchHits(resources)}
+	}
+}
+
+// toSearchHits maps cached resources to hits, dropping services that cannot
+// be opened from search.
+func toSearchHits(resources []cache.Resource) []SearchHit {
+	hits := make([]SearchHit, 0, len(resources))
+	for _, r := range resources {
+		pluginID, _, _ := strings.Cut(r.Service, ":")
+		if !searchablePlugins[pluginID] {
+			continue
+		}
+		hits = append(hits, SearchHit{PluginID: pluginID, ID: r.ID, Name: r.Name})
+	}
+	return hits
+}
+
+// View renders the search overlay.
+func (s SearchOverlay) View() string {
+	if !s.active {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString(paletteInputStyle.Render("Search Resources"))
+	b.WriteByte('\n')
+
+	if s.query != "" {
+		b.WriteString(paletteHintStyle.Render(fmt.Sprintf("/ %s", s.query)))
+	} else {
+		b.WriteString(paletteHintStyle.Render("/ (type a name or ID; searches cached resources)"))
+	}
+	b.WriteByte('\n')
+	b.WriteByte('\n')
+
+	if s.err != nil {
+		b.WriteString(paletteHintStyle.Render("  Search failed: " + s.err.Error()))
+		b.WriteByte('\n')
+		return b.String()
+	}
+	if s.query != "" && len(s.hits) == 0 {
+		b.WriteString(paletteHintStyle.Render("  No cached resources match. Open a service to populate the cache."))
+		b.WriteByte('\n')
+		return b.String()
+	}
+
+	for i, hit := range s.hits {
+		service := searchServiceStyle.Render(fmt.Sprintf("%-4s", strings.ToUpper(hit.PluginID)))
+		label := hit.Name
+		if label == "" || label == hit.ID {
+			label = hit.ID
+		} else {
+			label += paletteHintStyle.Render("  " + hit.ID)
+		}
+		if i == s.cursor {
+			b.WriteString(paletteCursorStyle.Render("  > ") + service + " " + label)
+		} else {
+			b.WriteString("    " + service + " " + paletteItemStyle.Render(label))
+		}
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
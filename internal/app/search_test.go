@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tasnim.dev/aws-tui/internal/cache"
+)
+
+func newSearchScope(t *testing.T) *cache.Scope {
+	t.Helper()
+	db, err := cache.NewTestDB()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+	require.NoError(t, db.UpsertResources(ctx, "ec2", "us-east-1", "default", []cache.Resource{
+		{ID: "i-0abc", Name: "web-server", Data: "{}"},
+	}, 300))
+	require.NoError(t, db.UpsertResources(ctx, "iam:roles", "us-east-1", "default", []cache.Resource{
+		{ID: "role:web-deployer", Name: "web-deployer", Data: "{}"},
+	}, 300))
+	require.NoError(t, db.UpsertResources(ctx, "cost", "us-east-1", "default", []cache.Resource{
+		{ID: "current", Name: "web spend", Data: "{}"},
+	}, 300))
+	return cache.NewScope(db, "us-east-1", "default", nil)
+}
+
+// typeQuery types each rune and runs the resulting search command.
+func typeQuery(s SearchOverlay, query string) SearchOverlay {
+	var cmd tea.Cmd
+	for _, r := range query {
+		s, cmd = s.Update(keyPress(r))
+	}
+	if cmd != nil {
+		s, _ = s.Update(cmd())
+	}
+	return s
+}
+
+func TestSearchOverlay(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T)
+	}{
+		{
+			name: "matches names across services and skips unsearchable ones",
+			fn: func(t *testing.T) {
+				s := NewSearchOverlay(newSearchScope(t))
+				s.Open()
+				s = typeQuery(s, "web")
+
+				require.Equal(t, 2, s.ResultCount())
+				assert.Equal(t, "ec2", s.hits[0].PluginID)
+				assert.Equal(t, "iam", s.hits[1].PluginID)
+				assert.Equal(t, "role:web-deployer", s.hits[1].ID)
+			},
+		},
+		{
+			name: "matches resource IDs",
+			fn: func(t *testing.T) {
+				s := NewSearchOverlay(newSearchScope(t))
+				s.Open()
+				s = typeQuery(s, "0abc")
+
+				require.Equal(t, 1, s.ResultCount())
+				assert.Equal(t, "i-0abc", s.hits[0].ID)
+			},
+		},
+		{
+			name: "enter emits the selected hit and closes",
+			fn: func(t *testing.T) {
+				s := NewSearchOverlay(newSearchScope(t))
+				s.Open()
+				s = typeQuery(s, "web")
+				s, _ = s.Update(specialKey(tea.KeyDown))
+
+				var cmd tea.Cmd
+				s, cmd = s.Update(specialKey(tea.KeyEnter))
+				require.NotNil(t, cmd)
+				assert.False(t, s.Active())
+
+				msg, ok := cmd().(SearchSelectMsg)
+				require.True(t, ok)
+				assert.Equal(t, SearchHit{PluginID: "iam", ID: "role:web-deployer", Name: "web-deployer"}, msg.Hit)
+			},
+		},
+		{
+			name: "backspace removes a whole multi-byte character",
+			fn: func(t *testing.T) {
+				s := NewSearchOverlay(newSearchScope(t))
+				s.Open()
+				s = typeQuery(s, "webé")
+				assert.Equal(t, 0, s.ResultCount())
+
+				s, cmd := s.Update(specialKey(tea.KeyBackspace))
+				assert.Equal(t, "web", s.query)
+				s, _ = s.Update(cmd())
+				assert.Equal(t, 2, s.ResultCount())
+			},
+		},
+		{
+			name: "results for a superseded query are dropped",
+			fn: func(t *testing.T) {
+				s := NewSearchOverlay(newSearchScope(t))
+				s.Open()
+				s, stale := s.Update(keyPress('w'))
+				s, _ = s.Update(keyPress('x'))
+
+				s, _ = s.Update(stale())
+				assert.Equal(t, 0, s.ResultCount())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, tc.fn)
+	}
+}
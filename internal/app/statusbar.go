@@ -14,15 +14,22 @@ var (
 
 	statusBarSepStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("240"))
+
+	statusBarOfflineStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("214")).
+				Bold(true)
 )
 
 // StatusBar renders contextual information at the bottom of the screen.
 type StatusBar struct {
 	region      string
 	profile     string
+	account     string // "alias (id)" or the bare ID once resolved
 	autoRefresh bool
 	nextRefresh time.Duration
 	offline     bool
+	allRegions  int // number of regions in all-regions scope, 0 when off
+	readOnly    bool
 }
 
 // NewStatusBar creates a StatusBar with the given region and profile.
@@ -43,6 +50,18 @@ func (s *StatusBar) SetProfile(profile string) {
 	s.profile = profile
 }
 
+// SetAccount shows the account alias and ID. alias may be empty.
+func (s *StatusBar) SetAccount(alias, id string) {
+	switch {
+	case id == "":
+		s.account = ""
+	case alias == "":
+		s.account = id
+	default:
+		s.account = alias + " (" + id + ")"
+	}
+}
+
 // SetAutoRefresh sets whether auto-refresh is enabled.
 func (s *StatusBar) SetAutoRefresh(on bool) {
 	s.autoRefresh = on
@@ -58,12 +77,30 @@ func (s *StatusBar) SetOffline(offline bool) {
 	s.offline = offline
 }
 
+// SetReadOnly sets the read-only indicator.
+func (s *StatusBar) SetReadOnly(readOnly bool) {
+	s.readOnly = readOnly
+}
+
+// SetAllRegions shows that list views span n regions. Zero shows the
+// session region again.
+func (s *StatusBar) SetAllRegions(n int) {
+	s.allRegions = n
+}
+
 // View renders the status bar to the given width.
 func (s StatusBar) View(width int) string {
 	sep := statusBarSepStyle.Render(" │ ")
 
 	var segments []string
-	segments = append(segments, statusBarStyle.Render(s.region))
+	if s.allRegions > 0 {
+		segments = append(segments, statusBarStyle.Render(fmt.Sprintf("all regions (%d)", s.allRegions)))
+	} else {
+		segments = append(segments, statusBarStyle.Render(s.region))
+	}
+	if s.account != "" {
+		segments = append(segments, statusBarStyle.Render(s.account))
+	}
 	segments = append(segments, statusBarStyle.Render(s.profile))
 
 	if s.autoRefresh {
@@ -72,7 +109,10 @@ func (s StatusBar) View(width int) string {
 	}
 
 	if s.offline {
-		segments = append(segments, statusBarStyle.Render("offline"))
+		segments = append(segments, statusBarOfflineStyle.Render("OFFLINE"))
+	}
+	if s.readOnly {
+		segments = append(segments, statusBarOfflineStyle.Render("READ-ONLY"))
 	}
 
 	segments = append(segments, statusBarStyle.Render("? help"))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	astypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 )
@@ -11,6 +12,7 @@ import (
 type ApplicationAutoScalingAPI interface {
 	DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
 	DescribeScalingPolicies(ctx context.Context, params *applicationautoscaling.DescribeScalingPoliciesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+	DescribeScalingActivities(ctx context.Context, params *applicationautoscaling.DescribeScalingActivitiesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingActivitiesOutput, error)
 }
 
 type Client struct {
@@ -67,20 +69,81 @@ func (c *Client) GetECSScalingPolicies(ctx context.Context, clusterName, service
 			PolicyName: derefStr(p.PolicyName),
 			PolicyType: string(p.PolicyType),
 		}
-		if p.TargetTrackingScalingPolicyConfiguration != nil {
-			policy.TargetValue = *p.TargetTrackingScalingPolicyConfiguration.TargetValue
-			if p.TargetTrackingScalingPolicyConfiguration.PredefinedMetricSpecification != nil {
-				policy.MetricName = string(p.TargetTrackingScalingPolicyConfiguration.PredefinedMetricSpecification.PredefinedMetricType)
+		if tt := p.TargetTrackingScalingPolicyConfiguration; tt != nil {
+			if tt.TargetValue != nil {
+				policy.TargetValue = *tt.TargetValue
+			}
+			if tt.PredefinedMetricSpecification != nil {
+				policy.MetricName = string(tt.PredefinedMetricSpecification.PredefinedMetricType)
+			} else if cm := tt.CustomizedMetricSpecification; cm != nil {
+				policy.MetricName = derefStr(cm.Namespace) + "/" + derefStr(cm.MetricName)
+			}
+			policy.ScaleInCooldown = int(derefInt32(tt.ScaleInCooldown))
+			policy.ScaleOutCooldown = int(derefInt32(tt.ScaleOutCooldown))
+			policy.DisableScaleIn = tt.DisableScaleIn != nil && *tt.DisableScaleIn
+		}
+		if st := p.StepScalingPolicyConfiguration; st != nil {
+			policy.AdjustmentType = string(st.AdjustmentType)
+			policy.Cooldown = int(derefInt32(st.Cooldown))
+			for _, step := range st.StepAdjustments {
+				policy.StepAdjustments = append(policy.StepAdjustments, StepAdjustment{
+					LowerBound: step.MetricIntervalLowerBound,
+					UpperBound: step.MetricIntervalUpperBound,
+					Adjustment: int(derefInt32(step.ScalingAdjustment)),
+				})
 			}
 		}
+		for _, a := range p.Alarms {
+			policy.Alarms = append(policy.Alarms, derefStr(a.AlarmName))
+		}
 		policies = append(policies, policy)
 	}
 	return policies, nil
 }
 
+// GetECSScalingActivities returns up to limit of the most recent scaling
+// activities of an ECS service, newest first.
+func (c *Client) GetECSScalingActivities(ctx context.Context, clusterName, serviceName string, limit int) ([]ScalingActivity, error) {
+	resourceID := fmt.Sprintf("service/%s/%s", clusterName, serviceName)
+	out, err := c.api.DescribeScalingActivities(ctx, &applicationautoscaling.DescribeScalingActivitiesInput{
+		ServiceNamespace:  astypes.ServiceNamespaceEcs,
+		ResourceId:        &resourceID,
+		ScalableDimension: astypes.ScalableDimensionECSServiceDesiredCount,
+		MaxResults:        aws.Int32(int32(limit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DescribeScalingActivities: %w", err)
+	}
+
+	var activities []ScalingActivity
+	for _, a := range out.ScalingActivities {
+		activity := ScalingActivity{
+			Status:        string(a.StatusCode),
+			Description:   derefStr(a.Description),
+			Cause:         derefStr(a.Cause),
+			StatusMessage: derefStr(a.StatusMessage),
+		}
+		if a.StartTime != nil {
+			activity.StartTime = *a.StartTime
+		}
+		if a.EndTime != nil {
+			activity.EndTime = *a.EndTime
+		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
+
 func derefStr(s *string) string {
 	if s == nil {
 		return ""
 	}
 	return *s
 }
+
+func derefInt32(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
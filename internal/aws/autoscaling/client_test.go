@@ -3,6 +3,7 @@ package autoscaling
 import (
 	"context"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
@@ -10,8 +11,9 @@ import (
 )
 
 type mockAutoScalingAPI struct {
-	describeScalableTargetsFunc func(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
-	describeScalingPoliciesFunc func(ctx context.Context, params *applicationautoscaling.DescribeScalingPoliciesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+	describeScalableTargetsFunc   func(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	describeScalingPoliciesFunc   func(ctx context.Context, params *applicationautoscaling.DescribeScalingPoliciesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+	describeScalingActivitiesFunc func(ctx context.Context, params *applicationautoscaling.DescribeScalingActivitiesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingActivitiesOutput, error)
 }
 
 func (m *mockAutoScalingAPI) DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
@@ -22,6 +24,10 @@ func (m *mockAutoScalingAPI) DescribeScalingPolicies(ctx context.Context, params
 	return m.describeScalingPoliciesFunc(ctx, params, optFns...)
 }
 
+func (m *mockAutoScalingAPI) DescribeScalingActivities(ctx context.Context, params *applicationautoscaling.DescribeScalingActivitiesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingActivitiesOutput, error) {
+	return m.describeScalingActivitiesFunc(ctx, params, optFns...)
+}
+
 func TestGetECSScalingTargets(t *testing.T) {
 	mock := &mockAutoScalingAPI{
 		describeScalableTargetsFunc: func(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
@@ -96,3 +102,91 @@ func TestGetECSScalingPolicies(t *testing.T) {
 		t.Errorf("MetricName = %s, want ECSServiceAverageCPUUtilization", policies[0].MetricName)
 	}
 }
+
+func TestGetECSScalingPolicies_Step(t *testing.T) {
+	mock := &mockAutoScalingAPI{
+		describeScalingPoliciesFunc: func(ctx context.Context, params *applicationautoscaling.DescribeScalingPoliciesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
+			return &applicationautoscaling.DescribeScalingPoliciesOutput{
+				ScalingPolicies: []astypes.ScalingPolicy{
+					{
+						PolicyName: awssdk.String("queue-depth"),
+						PolicyType: astypes.PolicyTypeStepScaling,
+						StepScalingPolicyConfiguration: &astypes.StepScalingPolicyConfiguration{
+							AdjustmentType: astypes.AdjustmentTypeChangeInCapacity,
+							Cooldown:       awssdk.Int32(120),
+							StepAdjustments: []astypes.StepAdjustment{
+								{MetricIntervalLowerBound: awssdk.Float64(0), MetricIntervalUpperBound: awssdk.Float64(100), ScalingAdjustment: awssdk.Int32(1)},
+								{MetricIntervalLowerBound: awssdk.Float64(100), ScalingAdjustment: awssdk.Int32(3)},
+							},
+						},
+						Alarms: []astypes.Alarm{{AlarmName: awssdk.String("queue-depth-high")}},
+					},
+				},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	policies, err := client.GetECSScalingPolicies(context.Background(), "prod", "worker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(policies))
+	}
+	p := policies[0]
+	if p.AdjustmentType != "ChangeInCapacity" || p.Cooldown != 120 {
+		t.Errorf("AdjustmentType, Cooldown = %s, %d, want ChangeInCapacity, 120", p.AdjustmentType, p.Cooldown)
+	}
+	if len(p.StepAdjustments) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(p.StepAdjustments))
+	}
+	if p.StepAdjustments[1].UpperBound != nil || p.StepAdjustments[1].Adjustment != 3 {
+		t.Errorf("second step = %+v, want unbounded above with adjustment 3", p.StepAdjustments[1])
+	}
+	if len(p.Alarms) != 1 || p.Alarms[0] != "queue-depth-high" {
+		t.Errorf("Alarms = %v, want [queue-depth-high]", p.Alarms)
+	}
+}
+
+func TestGetECSScalingActivities(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mock := &mockAutoScalingAPI{
+		describeScalingActivitiesFunc: func(ctx context.Context, params *applicationautoscaling.DescribeScalingActivitiesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingActivitiesOutput, error) {
+			if awssdk.ToString(params.ResourceId) != "service/prod/web" {
+				t.Errorf("ResourceId = %s, want service/prod/web", awssdk.ToString(params.ResourceId))
+			}
+			if awssdk.ToInt32(params.MaxResults) != 10 {
+				t.Errorf("MaxResults = %d, want 10", awssdk.ToInt32(params.MaxResults))
+			}
+			return &applicationautoscaling.DescribeScalingActivitiesOutput{
+				ScalingActivities: []astypes.ScalingActivity{
+					{
+						Description: awssdk.String("Setting desired count to 4."),
+						Cause:       awssdk.String("monitor alarm cpu-high in state ALARM triggered policy cpu-tracking"),
+						StatusCode:  astypes.ScalingActivityStatusCodeSuccessful,
+						StartTime:   awssdk.Time(start),
+					},
+				},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	activities, err := client.GetECSScalingActivities(context.Background(), "prod", "web", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(activities) != 1 {
+		t.Fatalf("expected 1 activity, got %d", len(activities))
+	}
+	if activities[0].Status != "Successful" {
+		t.Errorf("Status = %s, want Successful", activities[0].Status)
+	}
+	if !activities[0].StartTime.Equal(start) {
+		t.Errorf("StartTime = %v, want %v", activities[0].StartTime, start)
+	}
+	if activities[0].EndTime != (time.Time{}) {
+		t.Errorf("EndTime = %v, want zero", activities[0].EndTime)
+	}
+}
@@ -1,5 +1,7 @@
 package autoscaling
 
+import "time"
+
 type AutoScalingTarget struct {
 	MinCapacity int
 	MaxCapacity int
@@ -11,4 +13,35 @@ type AutoScalingPolicy struct {
 	PolicyType  string
 	TargetValue float64
 	MetricName  string
+
+	// Target tracking settings.
+	ScaleInCooldown  int // seconds
+	ScaleOutCooldown int // seconds
+	DisableScaleIn   bool
+
+	// Step scaling settings.
+	AdjustmentType  string
+	Cooldown        int // seconds
+	StepAdjustments []StepAdjustment
+
+	// Alarms are the CloudWatch alarms that invoke the policy.
+	Alarms []string
+}
+
+// StepAdjustment is one step of a step scaling policy. The bounds are
+// relative to the alarm threshold; nil means unbounded.
+type StepAdjustment struct {
+	LowerBound *float64
+	UpperBound *float64
+	Adjustment int
+}
+
+// ScalingActivity is a scale-out or scale-in performed on a scalable target.
+type ScalingActivity struct {
+	StartTime     time.Time
+	EndTime       time.Time
+	Status        string
+	Description   string
+	Cause         string
+	StatusMessage string
 }
@@ -18,7 +18,7 @@ type ServiceClient struct {
 // NewServiceClient creates a new Session and returns a ServiceClient wrapping
 // it. Service-specific clients will be initialised here once ported.
 func NewServiceClient(ctx context.Context, profile, region string) (*ServiceClient, error) {
-	sess, err := NewSession(ctx, region, profile)
+	sess, err := NewSession(ctx, region, profile, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating session: %w", err)
 	}
@@ -0,0 +1,210 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// maxEventPages caps the pages ListEvents reads looking for the last event
+// already seen.
+const maxEventPages = 10
+
+// CloudFormationAPI defines the subset of the CloudFormation API we use.
+type CloudFormationAPI interface {
+	DescribeStacks(ctx context.Context, params *awscfn.DescribeStacksInput, optFns ...func(*awscfn.Options)) (*awscfn.DescribeStacksOutput, error)
+	ListStackResources(ctx context.Context, params *awscfn.ListStackResourcesInput, optFns ...func(*awscfn.Options)) (*awscfn.ListStackResourcesOutput, error)
+	DescribeStackEvents(ctx context.Context, params *awscfn.DescribeStackEventsInput, optFns ...func(*awscfn.Options)) (*awscfn.DescribeStackEventsOutput, error)
+	GetTemplate(ctx context.Context, params *awscfn.GetTemplateInput, optFns ...func(*awscfn.Options)) (*awscfn.GetTemplateOutput, error)
+}
+
+// Client wraps the CloudFormation API.
+type Client struct {
+	api CloudFormationAPI
+}
+
+// NewClient creates a new CloudFormation client.
+func NewClient(api CloudFormationAPI) *Client {
+	return &Client{api: api}
+}
+
+// ListStacks returns every stack in the region that has not been deleted,
+// sorted by name.
+func (c *Client) ListStacks(ctx context.Context) ([]Stack, error) {
+	stacks, err := c.describeStacks(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(stacks, func(i, j int) bool { return stacks[i].Name < stacks[j].Name })
+	return stacks, nil
+}
+
+// GetStack returns the stack called name.
+func (c *Client) GetStack(ctx context.Context, name string) (Stack, error) {
+	stacks, err := c.describeStacks(ctx, aws.String(name))
+	if err != nil {
+		return Stack{}, err
+	}
+	if len(stacks) == 0 {
+		return Stack{}, fmt.Errorf("stack %s not found", name)
+	}
+	return stacks[0], nil
+}
+
+func (c *Client) describeStacks(ctx context.Context, name *string) ([]Stack, error) {
+	var stacks []Stack
+	var token *string
+	for {
+		out, err := c.api.DescribeStacks(ctx, &awscfn.DescribeStacksInput{
+			StackName: name,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeStacks: %w", err)
+		}
+		for _, s := range out.Stacks {
+			stacks = append(stacks, toStack(s))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+	return stacks, nil
+}
+
+// ListResources returns the resources of a stack, sorted by logical ID.
+func (c *Client) ListResources(ctx context.Context, stack string) ([]Resource, error) {
+	var resources []Resource
+	var token *string
+	for {
+		out, err := c.api.ListStackResources(ctx, &awscfn.ListStackResourcesInput{
+			StackName: aws.String(stack),
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListStackResources: %w", err)
+		}
+		for _, r := range out.StackResourceSummaries {
+			res := Resource{
+				LogicalID:    aws.ToString(r.LogicalResourceId),
+				PhysicalID:   aws.ToString(r.PhysicalResourceId),
+				Type:         aws.ToString(r.ResourceType),
+				Status:       string(r.ResourceStatus),
+				StatusReason: aws.ToString(r.ResourceStatusReason),
+				UpdatedAt:    aws.ToTime(r.LastUpdatedTimestamp),
+			}
+			if r.DriftInformation != nil {
+				res.DriftStatus = string(r.DriftInformation.StackResourceDriftStatus)
+			}
+			resources = append(resources, res)
+		}
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].LogicalID < resources[j].LogicalID })
+	return resources, nil
+}
+
+// ListEvents returns a stack's events newest first. With an empty after it
+// returns the most recent page; otherwise it returns the events newer than
+// the event with ID after, reading pages until it is found.
+func (c *Client) ListEvents(ctx context.Context, stack, after string) ([]Event, error) {
+	var events []Event
+	var token *string
+	for range maxEventPages {
+		out, err := c.api.DescribeStackEvents(ctx, &awscfn.DescribeStackEventsInput{
+			StackName: aws.String(stack),
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeStackEvents: %w", err)
+		}
+		for _, e := range out.StackEvents {
+			if after != "" && aws.ToString(e.EventId) == after {
+				return events, nil
+			}
+			events = append(events, Event{
+				ID:           aws.ToString(e.EventId),
+				Time:         aws.ToTime(e.Timestamp),
+				LogicalID:    aws.ToString(e.LogicalResourceId),
+				PhysicalID:   aws.ToString(e.PhysicalResourceId),
+				ResourceType: aws.ToString(e.ResourceType),
+				Status:       string(e.ResourceStatus),
+				StatusReason: aws.ToString(e.ResourceStatusReason),
+			})
+		}
+		if after == "" || out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+	return events, nil
+}
+
+// GetTemplate returns a stack's template body, JSON or YAML, as it was
+// submitted.
+func (c *Client) GetTemplate(ctx context.Context, stack string) (string, error) {
+	out, err := c.api.GetTemplate(ctx, &awscfn.GetTemplateInput{
+		StackName:     aws.String(stack),
+		TemplateStage: cfntypes.TemplateStageOriginal,
+	})
+	if err != nil {
+		return "", fmt.Errorf("GetTemplate: %w", err)
+	}
+	return aws.ToString(out.TemplateBody), nil
+}
+
+func toStack(s cfntypes.Stack) Stack {
+	stack := Stack{
+		Name:                  aws.ToString(s.StackName),
+		ID:                    aws.ToString(s.StackId),
+		Status:                string(s.StackStatus),
+		StatusReason:          aws.ToString(s.StackStatusReason),
+		Description:           strings.TrimSpace(aws.ToString(s.Description)),
+		CreatedAt:             aws.ToTime(s.CreationTime),
+		UpdatedAt:             aws.ToTime(s.LastUpdatedTime),
+		DriftStatus:           string(cfntypes.StackDriftStatusNotChecked),
+		TerminationProtection: aws.ToBool(s.EnableTerminationProtection),
+		RoleARN:               aws.ToString(s.RoleARN),
+		ParentID:              aws.ToString(s.ParentId),
+	}
+	if d := s.DriftInformation; d != nil && d.StackDriftStatus != "" {
+		stack.DriftStatus = string(d.StackDriftStatus)
+		stack.DriftCheckedAt = aws.ToTime(d.LastCheckTimestamp)
+	}
+	for _, c := range s.Capabilities {
+		stack.Capabilities = append(stack.Capabilities, string(c))
+	}
+	for _, p := range s.Parameters {
+		stack.Parameters = append(stack.Parameters, Parameter{
+			Key:           aws.ToString(p.ParameterKey),
+			Value:         aws.ToString(p.ParameterValue),
+			ResolvedValue: aws.ToString(p.ResolvedValue),
+		})
+	}
+	sort.Slice(stack.Parameters, func(i, j int) bool { return stack.Parameters[i].Key < stack.Parameters[j].Key })
+	for _, o := range s.Outputs {
+		stack.Outputs = append(stack.Outputs, Output{
+			Key:         aws.ToString(o.OutputKey),
+			Value:       aws.ToString(o.OutputValue),
+			Description: aws.ToString(o.Description),
+			ExportName:  aws.ToString(o.ExportName),
+		})
+	}
+	sort.Slice(stack.Outputs, func(i, j int) bool { return stack.Outputs[i].Key < stack.Outputs[j].Key })
+	if len(s.Tags) > 0 {
+		stack.Tags = make(map[string]string, len(s.Tags))
+		for _, t := range s.Tags {
+			stack.Tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+		}
+	}
+	return stack
+}
@@ -0,0 +1,172 @@
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCloudFormationAPI struct {
+	describeStacksFunc      func(ctx context.Context, params *awscfn.DescribeStacksInput, optFns ...func(*awscfn.Options)) (*awscfn.DescribeStacksOutput, error)
+	listStackResourcesFunc  func(ctx context.Context, params *awscfn.ListStackResourcesInput, optFns ...func(*awscfn.Options)) (*awscfn.ListStackResourcesOutput, error)
+	describeStackEventsFunc func(ctx context.Context, params *awscfn.DescribeStackEventsInput, optFns ...func(*awscfn.Options)) (*awscfn.DescribeStackEventsOutput, error)
+	getTemplateFunc         func(ctx context.Context, params *awscfn.GetTemplateInput, optFns ...func(*awscfn.Options)) (*awscfn.GetTemplateOutput, error)
+}
+
+func (m *mockCloudFormationAPI) DescribeStacks(ctx context.Context, params *awscfn.DescribeStacksInput, optFns ...func(*awscfn.Options)) (*awscfn.DescribeStacksOutput, error) {
+	return m.describeStacksFunc(ctx, params, optFns...)
+}
+
+func (m *mockCloudFormationAPI) ListStackResources(ctx context.Context, params *awscfn.ListStackResourcesInput, optFns ...func(*awscfn.Options)) (*awscfn.ListStackResourcesOutput, error) {
+	return m.listStackResourcesFunc(ctx, params, optFns...)
+}
+
+func (m *mockCloudFormationAPI) DescribeStackEvents(ctx context.Context, params *awscfn.DescribeStackEventsInput, optFns ...func(*awscfn.Options)) (*awscfn.DescribeStackEventsOutput, error) {
+	return m.describeStackEventsFunc(ctx, params, optFns...)
+}
+
+func (m *mockCloudFormationAPI) GetTemplate(ctx context.Context, params *awscfn.GetTemplateInput, optFns ...func(*awscfn.Options)) (*awscfn.GetTemplateOutput, error) {
+	return m.getTemplateFunc(ctx, params, optFns...)
+}
+
+func TestListStacks(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mock := &mockCloudFormationAPI{
+		describeStacksFunc: func(_ context.Context, params *awscfn.DescribeStacksInput, _ ...func(*awscfn.Options)) (*awscfn.DescribeStacksOutput, error) {
+			if params.NextToken == nil {
+				return &awscfn.DescribeStacksOutput{
+					Stacks: []cfntypes.Stack{{
+						StackName:    aws.String("web"),
+						StackId:      aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/web/1"),
+						StackStatus:  cfntypes.StackStatusUpdateComplete,
+						CreationTime: &created,
+						DriftInformation: &cfntypes.StackDriftInformation{
+							StackDriftStatus:   cfntypes.StackDriftStatusDrifted,
+							LastCheckTimestamp: &created,
+						},
+						Parameters: []cfntypes.Parameter{
+							{ParameterKey: aws.String("Env"), ParameterValue: aws.String("prod")},
+							{ParameterKey: aws.String("Ami"), ParameterValue: aws.String("/aws/ami"), ResolvedValue: aws.String("ami-1")},
+						},
+						Outputs: []cfntypes.Output{{OutputKey: aws.String("Url"), OutputValue: aws.String("https://web"), ExportName: aws.String("web-url")}},
+						Tags:    []cfntypes.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+					}},
+					NextToken: aws.String("page2"),
+				}, nil
+			}
+			return &awscfn.DescribeStacksOutput{Stacks: []cfntypes.Stack{{
+				StackName:   aws.String("api"),
+				StackStatus: cfntypes.StackStatusCreateComplete,
+			}}}, nil
+		},
+	}
+
+	stacks, err := NewClient(mock).ListStacks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, stacks, 2)
+	assert.Equal(t, "api", stacks[0].Name)
+	assert.Equal(t, "NOT_CHECKED", stacks[0].DriftStatus)
+
+	web := stacks[1]
+	assert.Equal(t, "UPDATE_COMPLETE", web.Status)
+	assert.Equal(t, "DRIFTED", web.DriftStatus)
+	assert.Equal(t, created, web.DriftCheckedAt)
+	assert.Equal(t, []Parameter{{Key: "Ami", Value: "/aws/ami", ResolvedValue: "ami-1"}, {Key: "Env", Value: "prod"}}, web.Parameters)
+	assert.Equal(t, []Output{{Key: "Url", Value: "https://web", ExportName: "web-url"}}, web.Outputs)
+	assert.Equal(t, map[string]string{"team": "platform"}, web.Tags)
+}
+
+func TestGetStack_NotFound(t *testing.T) {
+	mock := &mockCloudFormationAPI{
+		describeStacksFunc: func(_ context.Context, _ *awscfn.DescribeStacksInput, _ ...func(*awscfn.Options)) (*awscfn.DescribeStacksOutput, error) {
+			return nil, errors.New("Stack with id web does not exist")
+		},
+	}
+	_, err := NewClient(mock).GetStack(context.Background(), "web")
+	assert.EqualError(t, err, "DescribeStacks: Stack with id web does not exist")
+}
+
+func TestListResources(t *testing.T) {
+	mock := &mockCloudFormationAPI{
+		listStackResourcesFunc: func(_ context.Context, params *awscfn.ListStackResourcesInput, _ ...func(*awscfn.Options)) (*awscfn.ListStackResourcesOutput, error) {
+			assert.Equal(t, "web", aws.ToString(params.StackName))
+			return &awscfn.ListStackResourcesOutput{StackResourceSummaries: []cfntypes.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("WebService"),
+					PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/prod/web"),
+					ResourceType:       aws.String("AWS::ECS::Service"),
+					ResourceStatus:     cfntypes.ResourceStatusUpdateComplete,
+					DriftInformation:   &cfntypes.StackResourceDriftInformationSummary{StackResourceDriftStatus: cfntypes.StackResourceDriftStatusModified},
+				},
+				{
+					LogicalResourceId:  aws.String("Bucket"),
+					PhysicalResourceId: aws.String("web-assets"),
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+					ResourceStatus:     cfntypes.ResourceStatusCreateComplete,
+				},
+			}}, nil
+		},
+	}
+
+	resources, err := NewClient(mock).ListResources(context.Background(), "web")
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "Bucket", resources[0].LogicalID)
+	assert.Equal(t, "MODIFIED", resources[1].DriftStatus)
+	assert.Equal(t, "AWS::ECS::Service", resources[1].Type)
+}
+
+func TestListEvents(t *testing.T) {
+	event := func(id string) cfntypes.StackEvent {
+		return cfntypes.StackEvent{EventId: aws.String(id), LogicalResourceId: aws.String("web"), ResourceStatus: cfntypes.ResourceStatusUpdateInProgress}
+	}
+	var tokens []*string
+	mock := &mockCloudFormationAPI{
+		describeStackEventsFunc: func(_ context.Context, params *awscfn.DescribeStackEventsInput, _ ...func(*awscfn.Options)) (*awscfn.DescribeStackEventsOutput, error) {
+			tokens = append(tokens, params.NextToken)
+			if params.NextToken == nil {
+				return &awscfn.DescribeStackEventsOutput{StackEvents: []cfntypes.StackEvent{event("e5"), event("e4")}, NextToken: aws.String("p2")}, nil
+			}
+			return &awscfn.DescribeStackEventsOutput{StackEvents: []cfntypes.StackEvent{event("e3"), event("e2")}}, nil
+		},
+	}
+	client := NewClient(mock)
+
+	// Without a last seen event only the first page is read.
+	events, err := client.ListEvents(context.Background(), "web", "")
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Len(t, tokens, 1)
+
+	// Otherwise pages are read until the last seen event.
+	tokens = nil
+	events, err = client.ListEvents(context.Background(), "web", "e3")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "e5", events[0].ID)
+	assert.Equal(t, "UPDATE_IN_PROGRESS", events[0].Status)
+	assert.Len(t, tokens, 2)
+
+	events, err = client.ListEvents(context.Background(), "web", "e5")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestGetTemplate(t *testing.T) {
+	mock := &mockCloudFormationAPI{
+		getTemplateFunc: func(_ context.Context, params *awscfn.GetTemplateInput, _ ...func(*awscfn.Options)) (*awscfn.GetTemplateOutput, error) {
+			assert.Equal(t, cfntypes.TemplateStageOriginal, params.TemplateStage)
+			return &awscfn.GetTemplateOutput{TemplateBody: aws.String("Resources: {}\n")}, nil
+		},
+	}
+	body, err := NewClient(mock).GetTemplate(context.Background(), "web")
+	require.NoError(t, err)
+	assert.Equal(t, "Resources: {}\n", body)
+}
@@ -0,0 +1,62 @@
+package cloudformation
+
+import "time"
+
+// Stack is a CloudFormation stack with its parameters and outputs.
+type Stack struct {
+	Name                  string
+	ID                    string // the stack ARN
+	Status                string
+	StatusReason          string
+	Description           string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time // zero if never updated
+	DriftStatus           string    // "DRIFTED", "IN_SYNC", "NOT_CHECKED", …
+	DriftCheckedAt        time.Time
+	TerminationProtection bool
+	RoleARN               string
+	Capabilities          []string
+	ParentID              string // set for nested stacks
+	Parameters            []Parameter
+	Outputs               []Output
+	Tags                  map[string]string
+}
+
+// Parameter is a stack parameter. ResolvedValue is set for parameters
+// read from Systems Manager.
+type Parameter struct {
+	Key           string
+	Value         string
+	ResolvedValue string
+}
+
+// Output is a stack output, optionally exported for other stacks.
+type Output struct {
+	Key         string
+	Value       string
+	Description string
+	ExportName  string
+}
+
+// Resource is a resource of a stack.
+type Resource struct {
+	LogicalID    string
+	PhysicalID   string
+	Type         string
+	Status       string
+	StatusReason string
+	DriftStatus  string
+	UpdatedAt    time.Time
+}
+
+// Event is a stack event: a status change of the stack or one of its
+// resources.
+type Event struct {
+	ID           string
+	Time         time.Time
+	LogicalID    string
+	PhysicalID   string
+	ResourceType string
+	Status       string
+	StatusReason string
+}
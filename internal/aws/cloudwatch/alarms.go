@@ -0,0 +1,104 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// ListAlarms returns every metric alarm in the region.
+func (c *Client) ListAlarms(ctx context.Context) ([]Alarm, error) {
+	var alarms []Alarm
+	var nextToken *string
+
+	for {
+		out, err := c.api.DescribeAlarms(ctx, &cloudwatch.DescribeAlarmsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeAlarms: %w", err)
+		}
+
+		for _, a := range out.MetricAlarms {
+			alarms = append(alarms, toAlarm(a))
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return alarms, nil
+}
+
+// DescribeAlarm returns the metric alarm called name.
+func (c *Client) DescribeAlarm(ctx context.Context, name string) (Alarm, error) {
+	out, err := c.api.DescribeAlarms(ctx, &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+	})
+	if err != nil {
+		return Alarm{}, fmt.Errorf("DescribeAlarms: %w", err)
+	}
+	if len(out.MetricAlarms) == 0 {
+		return Alarm{}, fmt.Errorf("alarm %s not found", name)
+	}
+	return toAlarm(out.MetricAlarms[0]), nil
+}
+
+// AlarmHistory returns up to limit of the most recent history items of an
+// alarm, newest first.
+func (c *Client) AlarmHistory(ctx context.Context, name string, limit int) ([]AlarmHistoryItem, error) {
+	out, err := c.api.DescribeAlarmHistory(ctx, &cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:  aws.String(name),
+		MaxRecords: aws.Int32(int32(limit)),
+		ScanBy:     cwtypes.ScanByTimestampDescending,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DescribeAlarmHistory: %w", err)
+	}
+
+	items := make([]AlarmHistoryItem, 0, len(out.AlarmHistoryItems))
+	for _, h := range out.AlarmHistoryItems {
+		items = append(items, AlarmHistoryItem{
+			Timestamp: aws.ToTime(h.Timestamp),
+			Type:      string(h.HistoryItemType),
+			Summary:   aws.ToString(h.HistorySummary),
+		})
+	}
+	return items, nil
+}
+
+func toAlarm(a cwtypes.MetricAlarm) Alarm {
+	stat := string(a.Statistic)
+	if a.ExtendedStatistic != nil {
+		stat = aws.ToString(a.ExtendedStatistic)
+	}
+	dims := make([]Dimension, len(a.Dimensions))
+	for i, d := range a.Dimensions {
+		dims[i] = Dimension{Name: aws.ToString(d.Name), Value: aws.ToString(d.Value)}
+	}
+	return Alarm{
+		Name:              aws.ToString(a.AlarmName),
+		ARN:               aws.ToString(a.AlarmArn),
+		Description:       aws.ToString(a.AlarmDescription),
+		State:             string(a.StateValue),
+		StateReason:       aws.ToString(a.StateReason),
+		StateUpdated:      aws.ToTime(a.StateUpdatedTimestamp),
+		Namespace:         aws.ToString(a.Namespace),
+		Metric:            aws.ToString(a.MetricName),
+		Stat:              stat,
+		Dimensions:        dims,
+		Period:            time.Duration(aws.ToInt32(a.Period)) * time.Second,
+		EvaluationPeriods: int(aws.ToInt32(a.EvaluationPeriods)),
+		DatapointsToAlarm: int(aws.ToInt32(a.DatapointsToAlarm)),
+		Comparison:        string(a.ComparisonOperator),
+		Threshold:         aws.ToFloat64(a.Threshold),
+		ActionsEnabled:    aws.ToBool(a.ActionsEnabled),
+		Actions:           a.AlarmActions,
+	}
+}
@@ -0,0 +1,132 @@
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAlarms(t *testing.T) {
+	updated := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	var calls int
+	mock := &mockCloudWatchAPI{
+		describeAlarmsFunc: func(_ context.Context, params *cloudwatch.DescribeAlarmsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error) {
+			calls++
+			if params.NextToken == nil {
+				return &cloudwatch.DescribeAlarmsOutput{
+					MetricAlarms: []cwtypes.MetricAlarm{{
+						AlarmName:             awssdk.String("web-cpu-high"),
+						AlarmArn:              awssdk.String("arn:aws:cloudwatch:us-east-1:123456789012:alarm:web-cpu-high"),
+						StateValue:            cwtypes.StateValueAlarm,
+						StateReason:           awssdk.String("Threshold Crossed"),
+						StateUpdatedTimestamp: awssdk.Time(updated),
+						Namespace:             awssdk.String("AWS/EC2"),
+						MetricName:            awssdk.String("CPUUtilization"),
+						Statistic:             cwtypes.StatisticAverage,
+						Dimensions:            []cwtypes.Dimension{{Name: awssdk.String("InstanceId"), Value: awssdk.String("i-0abc")}},
+						Period:                awssdk.Int32(300),
+						EvaluationPeriods:     awssdk.Int32(3),
+						DatapointsToAlarm:     awssdk.Int32(2),
+						ComparisonOperator:    cwtypes.ComparisonOperatorGreaterThanThreshold,
+						Threshold:             awssdk.Float64(80),
+						ActionsEnabled:        awssdk.Bool(true),
+						AlarmActions:          []string{"arn:aws:sns:us-east-1:123456789012:oncall"},
+					}},
+					NextToken: awssdk.String("page2"),
+				}, nil
+			}
+			return &cloudwatch.DescribeAlarmsOutput{
+				MetricAlarms: []cwtypes.MetricAlarm{{
+					AlarmName:         awssdk.String("api-latency-p99"),
+					StateValue:        cwtypes.StateValueOk,
+					ExtendedStatistic: awssdk.String("p99"),
+				}},
+			}, nil
+		},
+	}
+
+	alarms, err := NewClient(mock).ListAlarms(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	require.Len(t, alarms, 2)
+
+	a := alarms[0]
+	assert.Equal(t, "web-cpu-high", a.Name)
+	assert.Equal(t, "ALARM", a.State)
+	assert.Equal(t, updated, a.StateUpdated)
+	assert.Equal(t, "Average", a.Stat)
+	assert.Equal(t, []Dimension{{Name: "InstanceId", Value: "i-0abc"}}, a.Dimensions)
+	assert.Equal(t, 5*time.Minute, a.Period)
+	assert.Equal(t, 3, a.EvaluationPeriods)
+	assert.Equal(t, 2, a.DatapointsToAlarm)
+	assert.Equal(t, "GreaterThanThreshold", a.Comparison)
+	assert.Equal(t, 80.0, a.Threshold)
+	assert.True(t, a.ActionsEnabled)
+	assert.Len(t, a.Actions, 1)
+
+	assert.Equal(t, "p99", alarms[1].Stat)
+	assert.Equal(t, "OK", alarms[1].State)
+}
+
+func TestDescribeAlarm(t *testing.T) {
+	mock := &mockCloudWatchAPI{
+		describeAlarmsFunc: func(_ context.Context, params *cloudwatch.DescribeAlarmsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error) {
+			if params.AlarmNames[0] != "web-cpu-high" {
+				return &cloudwatch.DescribeAlarmsOutput{}, nil
+			}
+			return &cloudwatch.DescribeAlarmsOutput{
+				MetricAlarms: []cwtypes.MetricAlarm{{AlarmName: awssdk.String("web-cpu-high"), StateValue: cwtypes.StateValueAlarm}},
+			}, nil
+		},
+	}
+	client := NewClient(mock)
+
+	a, err := client.DescribeAlarm(context.Background(), "web-cpu-high")
+	require.NoError(t, err)
+	assert.Equal(t, "ALARM", a.State)
+
+	_, err = client.DescribeAlarm(context.Background(), "missing")
+	assert.EqualError(t, err, "alarm missing not found")
+}
+
+func TestAlarmHistory(t *testing.T) {
+	at := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	var input *cloudwatch.DescribeAlarmHistoryInput
+	mock := &mockCloudWatchAPI{
+		describeAlarmHistoryFunc: func(_ context.Context, params *cloudwatch.DescribeAlarmHistoryInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmHistoryOutput, error) {
+			input = params
+			return &cloudwatch.DescribeAlarmHistoryOutput{
+				AlarmHistoryItems: []cwtypes.AlarmHistoryItem{{
+					Timestamp:       awssdk.Time(at),
+					HistoryItemType: cwtypes.HistoryItemTypeStateUpdate,
+					HistorySummary:  awssdk.String("Alarm updated from OK to ALARM"),
+				}},
+			}, nil
+		},
+	}
+
+	items, err := NewClient(mock).AlarmHistory(context.Background(), "web-cpu-high", 20)
+	require.NoError(t, err)
+	assert.Equal(t, "web-cpu-high", awssdk.ToString(input.AlarmName))
+	assert.Equal(t, int32(20), awssdk.ToInt32(input.MaxRecords))
+	assert.Equal(t, cwtypes.ScanByTimestampDescending, input.ScanBy)
+	assert.Equal(t, []AlarmHistoryItem{{Timestamp: at, Type: "StateUpdate", Summary: "Alarm updated from OK to ALARM"}}, items)
+}
+
+func TestListAlarms_Error(t *testing.T) {
+	mock := &mockCloudWatchAPI{
+		describeAlarmsFunc: func(_ context.Context, _ *cloudwatch.DescribeAlarmsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+
+	_, err := NewClient(mock).ListAlarms(context.Background())
+	assert.EqualError(t, err, "DescribeAlarms: AccessDenied")
+}
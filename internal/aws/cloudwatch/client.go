@@ -0,0 +1,94 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// CloudWatchAPI defines the subset of the CloudWatch API we use.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+	DescribeAlarms(ctx context.Context, params *cloudwatch.DescribeAlarmsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error)
+	DescribeAlarmHistory(ctx context.Context, params *cloudwatch.DescribeAlarmHistoryInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmHistoryOutput, error)
+}
+
+// Client wraps the CloudWatch API.
+type Client struct {
+	api CloudWatchAPI
+}
+
+// NewClient creates a new CloudWatch client.
+func NewClient(api CloudWatchAPI) *Client {
+	return &Client{api: api}
+}
+
+// GetMetrics returns one series per query covering the time range up to
+// end, in the order of the queries. A query without datapoints returns an
+// empty series.
+func (c *Client) GetMetrics(ctx context.Context, queries []MetricQuery, r TimeRange, end time.Time) ([]MetricSeries, error) {
+	period := int32(r.Period / time.Second)
+	dataQueries := make([]cwtypes.MetricDataQuery, len(queries))
+	for i, q := range queries {
+		dims := make([]cwtypes.Dimension, len(q.Dimensions))
+		for j, d := range q.Dimensions {
+			dims[j] = cwtypes.Dimension{Name: aws.String(d.Name), Value: aws.String(d.Value)}
+		}
+		dataQueries[i] = cwtypes.MetricDataQuery{
+			Id: aws.String(q.ID),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String(q.Namespace),
+					MetricName: aws.String(q.Metric),
+					Dimensions: dims,
+				},
+				Period: aws.Int32(period),
+				Stat:   aws.String(q.Stat),
+			},
+		}
+	}
+
+	series := make(map[string]*MetricSeries, len(queries))
+	for _, q := range queries {
+		series[q.ID] = &MetricSeries{ID: q.ID}
+	}
+
+	start := end.Add(-r.Duration)
+	var nextToken *string
+	for {
+		out, err := c.api.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+			MetricDataQueries: dataQueries,
+			StartTime:         aws.Time(start),
+			EndTime:           aws.Time(end),
+			ScanBy:            cwtypes.ScanByTimestampAscending,
+			NextToken:         nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("GetMetricData: %w", err)
+		}
+
+		for _, res := range out.MetricDataResults {
+			s, ok := series[aws.ToString(res.Id)]
+			if !ok {
+				continue
+			}
+			s.Timestamps = append(s.Timestamps, res.Timestamps...)
+			s.Values = append(s.Values, res.Values...)
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	result := make([]MetricSeries, len(queries))
+	for i, q := range queries {
+		result[i] = *series[q.ID]
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCloudWatchAPI struct {
+	getMetricDataFunc        func(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+	describeAlarmsFunc       func(ctx context.Context, params *cloudwatch.DescribeAlarmsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error)
+	describeAlarmHistoryFunc func(ctx context.Context, params *cloudwatch.DescribeAlarmHistoryInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmHistoryOutput, error)
+}
+
+func (m *mockCloudWatchAPI) GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	return m.getMetricDataFunc(ctx, params, optFns...)
+}
+
+func (m *mockCloudWatchAPI) DescribeAlarms(ctx context.Context, params *cloudwatch.DescribeAlarmsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error) {
+	return m.describeAlarmsFunc(ctx, params, optFns...)
+}
+
+func (m *mockCloudWatchAPI) DescribeAlarmHistory(ctx context.Context, params *cloudwatch.DescribeAlarmHistoryInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmHistoryOutput, error) {
+	return m.describeAlarmHistoryFunc(ctx, params, optFns...)
+}
+
+func TestGetMetrics(t *testing.T) {
+	end := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	t1, t2, t3 := end.Add(-3*time.Minute), end.Add(-2*time.Minute), end.Add(-time.Minute)
+
+	var inputs []*cloudwatch.GetMetricDataInput
+	mock := &mockCloudWatchAPI{
+		getMetricDataFunc: func(_ context.Context, params *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			inputs = append(inputs, params)
+			if params.NextToken == nil {
+				return &cloudwatch.GetMetricDataOutput{
+					MetricDataResults: []cwtypes.MetricDataResult{
+						{Id: awssdk.String("cpu"), Timestamps: []time.Time{t1, t2}, Values: []float64{12.5, 40}},
+						{Id: awssdk.String("net_in")},
+					},
+					NextToken: awssdk.String("page2"),
+				}, nil
+			}
+			return &cloudwatch.GetMetricDataOutput{
+				MetricDataResults: []cwtypes.MetricDataResult{
+					{Id: awssdk.String("cpu"), Timestamps: []time.Time{t3}, Values: []float64{7}},
+				},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	series, err := client.GetMetrics(context.Background(), []MetricQuery{
+		{ID: "cpu", Namespace: "AWS/EC2", Metric: "CPUUtilization", Stat: "Average", Dimensions: []Dimension{{Name: "InstanceId", Value: "i-0abc"}}},
+		{ID: "net_in", Namespace: "AWS/EC2", Metric: "NetworkIn", Stat: "Sum", Dimensions: []Dimension{{Name: "InstanceId", Value: "i-0abc"}}},
+	}, TimeRanges[0], end)
+	require.NoError(t, err)
+
+	require.Len(t, inputs, 2)
+	in := inputs[0]
+	assert.Equal(t, end.Add(-time.Hour), awssdk.ToTime(in.StartTime))
+	assert.Equal(t, end, awssdk.ToTime(in.EndTime))
+	assert.Equal(t, cwtypes.ScanByTimestampAscending, in.ScanBy)
+	require.Len(t, in.MetricDataQueries, 2)
+	stat := in.MetricDataQueries[0].MetricStat
+	assert.Equal(t, "CPUUtilization", awssdk.ToString(stat.Metric.MetricName))
+	assert.Equal(t, "AWS/EC2", awssdk.ToString(stat.Metric.Namespace))
+	assert.Equal(t, "InstanceId", awssdk.ToString(stat.Metric.Dimensions[0].Name))
+	assert.Equal(t, "i-0abc", awssdk.ToString(stat.Metric.Dimensions[0].Value))
+	assert.Equal(t, int32(60), awssdk.ToInt32(stat.Period))
+	assert.Equal(t, "Average", awssdk.ToString(stat.Stat))
+
+	require.Len(t, series, 2)
+	assert.Equal(t, "cpu", series[0].ID)
+	assert.Equal(t, []time.Time{t1, t2, t3}, series[0].Timestamps)
+	assert.Equal(t, []float64{12.5, 40, 7}, series[0].Values)
+	assert.Equal(t, "net_in", series[1].ID)
+	assert.Empty(t, series[1].Values)
+}
+
+func TestGetMetrics_Error(t *testing.T) {
+	mock := &mockCloudWatchAPI{
+		getMetricDataFunc: func(_ context.Context, _ *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+
+	_, err := NewClient(mock).GetMetrics(context.Background(), []MetricQuery{{ID: "cpu"}}, TimeRanges[3], time.Now())
+	assert.EqualError(t, err, "GetMetricData: AccessDenied")
+}
@@ -0,0 +1,72 @@
+package cloudwatch
+
+import "time"
+
+// Dimension narrows a metric to one resource, such as InstanceId=i-0abc.
+type Dimension struct {
+	Name  string
+	Value string
+}
+
+// MetricQuery selects one statistic of a metric. ID must be unique within a
+// request, start with a lowercase letter and contain only letters, digits
+// and underscores.
+type MetricQuery struct {
+	ID         string
+	Namespace  string
+	Metric     string
+	Dimensions []Dimension
+	Stat       string // e.g. Average, Sum, Maximum
+}
+
+// MetricSeries is the datapoints of a query, oldest first.
+type MetricSeries struct {
+	ID         string
+	Timestamps []time.Time
+	Values     []float64
+}
+
+// TimeRange is a window of recent metrics and the period each datapoint
+// covers.
+type TimeRange struct {
+	Label    string
+	Duration time.Duration
+	Period   time.Duration
+}
+
+// TimeRanges are the windows offered by metric charts, shortest first. Each
+// period keeps a range to under 200 datapoints.
+var TimeRanges = []TimeRange{
+	{Label: "1h", Duration: time.Hour, Period: time.Minute},
+	{Label: "6h", Duration: 6 * time.Hour, Period: 5 * time.Minute},
+	{Label: "24h", Duration: 24 * time.Hour, Period: 15 * time.Minute},
+	{Label: "7d", Duration: 7 * 24 * time.Hour, Period: time.Hour},
+}
+
+// Alarm is a CloudWatch metric alarm.
+type Alarm struct {
+	Name              string
+	ARN               string
+	Description       string
+	State             string // ALARM, INSUFFICIENT_DATA or OK
+	StateReason       string
+	StateUpdated      time.Time
+	Namespace         string
+	Metric            string // empty for a metric math alarm
+	Stat              string // e.g. Average or p99
+	Dimensions        []Dimension
+	Period            time.Duration
+	EvaluationPeriods int
+	DatapointsToAlarm int
+	Comparison        string // e.g. GreaterThanThreshold
+	Threshold         float64
+	ActionsEnabled    bool
+	Actions           []string // run on entering ALARM
+}
+
+// AlarmHistoryItem is one entry of an alarm's history.
+type AlarmHistoryItem struct {
+	Timestamp time.Time
+	Type      string // StateUpdate, ConfigurationUpdate or Action
+	Summary   string
+}
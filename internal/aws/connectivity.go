@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ErrOffline is returned by instrumented API calls while the app is offline.
+// ClassifyError reports it as ErrKindNetwork.
+var ErrOffline = errors.New("offline: AWS is unreachable")
+
+// Connectivity tracks whether AWS endpoints are reachable. API calls made
+// through an instrumented config switch it offline when they fail with a
+// network error, and fail fast with ErrOffline until it is set back online.
+// It also records when calls fail because credentials have expired.
+// It is safe for concurrent use.
+type Connectivity struct {
+	offline     atomic.Bool
+	authExpired atomic.Bool
+	dial        func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// NewConnectivity returns a Connectivity in online mode.
+func NewConnectivity() *Connectivity {
+	var d net.Dialer
+	return &Connectivity{dial: d.DialContext}
+}
+
+// Offline reports whether the last observed API failure was a network error
+// and connectivity has not been restored since. A nil Connectivity is
+// always online.
+func (c *Connectivity) Offline() bool {
+	return c != nil && c.offline.Load()
+}
+
+// AuthExpired reports whether an API call failed with ErrKindAuth since the
+// last ClearAuth.
+func (c *Connectivity) AuthExpired() bool {
+	return c != nil && c.authExpired.Load()
+}
+
+// ClearAuth resets AuthExpired, typically after the user has logged in again.
+func (c *Connectivity) ClearAuth() {
+	if c != nil {
+		c.authExpired.Store(false)
+	}
+}
+
+// Observe switches to offline mode when err is a network error and records
+// expired credentials when it is an auth error. Cancelled and timed-out calls
+// say nothing about connectivity and are ignored. It returns true if this
+// call changed the offline mode.
+func (c *Connectivity) Observe(err error) bool {
+	if c == nil || errors.Is(err, ErrOffline) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	switch ClassifyError(err) {
+	case ErrKindNetwork:
+		return c.offline.CompareAndSwap(false, true)
+	case ErrKindAuth:
+		c.authExpired.Store(true)
+	}
+	return false
+}
+
+// SetOnline switches back to live mode.
+func (c *Connectivity) SetOnline() {
+	if c != nil {
+		c.offline.Store(false)
+	}
+}
+
+// Instrument adds a middleware to cfg that observes every API error, except
+// those of calls whose context is done, and short-circuits calls with
+// ErrOffline while offline. Calls made inside Retry are sent once and leave
+// observing to Retry. Clients must be created from cfg after Instrument is
+// called.
+func (c *Connectivity) Instrument(cfg *aws.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("Connectivity",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				if c.Offline() {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, ErrOffline
+				}
+				out, md, err := next.HandleInitialize(ctx, in)
+				if err != nil && ctx.Err() == nil && ctx.Value(unobservedKey{}) == nil {
+					if scope, ok := ctx.Value(attemptScopeKey{}).(*attemptScope); ok {
+						scope.hold(c, err)
+					} else {
+						c.Observe(err)
+					}
+				}
+				return out, md, err
+			}), middleware.Before)
+		if err != nil {
+			return err
+		}
+		// Added after the SDK's retry middleware, this sees every attempt
+		// and stops the SDK from retrying calls that Retry retries.
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("SingleAttempt",
+			func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				out, md, err := next.HandleFinalize(ctx, in)
+				if err != nil && ctx.Value(attemptScopeKey{}) != nil {
+					err = &noRetryError{err: err}
+				}
+				return out, md, err
+			}), middleware.After)
+	})
+}
+
+type unobservedKey struct{}
+
+// Unobserved returns a context whose API errors instrumented clients do not
+// pass to Observe. Calls fanned out across regions use it, so one unreachable
+// or disabled region neither switches the app offline nor prompts for a
+// login. Offline mode still short-circuits these calls.
+func Unobserved(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unobservedKey{}, true)
+}
+
+// Probe checks whether the STS endpoint for region accepts connections. It
+// does not call any API, so it works without valid credentials.
+func (c *Connectivity) Probe(ctx context.Context, region string) error {
+	if region == "" {
+		region = "us-east-1"
+	}
+	conn, err := c.dial(ctx, "tcp", "sts."+region+".amazonaws.com:443")
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
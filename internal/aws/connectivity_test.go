@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingHTTPClient fails every request with a dial error.
+type failingHTTPClient struct {
+	calls int
+}
+
+func (c *failingHTTPClient) Do(*http.Request) (*http.Response, error) {
+	c.calls++
+	return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+}
+
+func TestConnectivity_Observe(t *testing.T) {
+	c := NewConnectivity()
+	require.False(t, c.Offline())
+
+	assert.False(t, c.Observe(errors.New("AccessDenied: nope")))
+	assert.False(t, c.Offline())
+
+	assert.True(t, c.Observe(&net.DNSError{Err: "no such host", Name: "sts.amazonaws.com"}))
+	assert.True(t, c.Offline())
+	assert.False(t, c.Observe(&net.DNSError{Err: "no such host", Name: "sts.amazonaws.com"}), "already offline")
+
+	c.SetOnline()
+	assert.False(t, c.Offline())
+	assert.False(t, c.Observe(ErrOffline), "fail-fast errors do not switch modes")
+
+	var nilConn *Connectivity
+	assert.False(t, nilConn.Offline())
+	assert.False(t, nilConn.AuthExpired())
+}
+
+func TestConnectivity_ObserveAuth(t *testing.T) {
+	c := NewConnectivity()
+	require.False(t, c.AuthExpired())
+
+	assert.False(t, c.Observe(&mockAPIError{code: "ExpiredTokenException", message: "expired"}))
+	assert.True(t, c.AuthExpired())
+	assert.False(t, c.Offline())
+
+	c.ClearAuth()
+	assert.False(t, c.AuthExpired())
+}
+
+func TestConnectivity_Instrument(t *testing.T) {
+	httpClient := &failingHTTPClient{}
+	cfg := aws.Config{
+		Region:           "us-east-1",
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:       httpClient,
+		RetryMaxAttempts: 1,
+	}
+	c := NewConnectivity()
+	c.Instrument(&cfg)
+	client := sts.NewFromConfig(cfg)
+
+	_, err := client.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	require.Error(t, err)
+	assert.True(t, c.Offline())
+	assert.Equal(t, 1, httpClient.calls)
+
+	_, err = client.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	assert.ErrorIs(t, err, ErrOffline)
+	assert.Equal(t, 1, httpClient.calls, "offline calls must not reach the network")
+}
+
+func TestConnectivity_InstrumentUnobserved(t *testing.T) {
+	httpClient := &failingHTTPClient{}
+	cfg := aws.Config{
+		Region:           "us-east-1",
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:       httpClient,
+		RetryMaxAttempts: 1,
+	}
+	c := NewConnectivity()
+	c.Instrument(&cfg)
+	client := sts.NewFromConfig(cfg)
+
+	_, err := client.GetCallerIdentity(Unobserved(context.Background()), &sts.GetCallerIdentityInput{})
+	require.Error(t, err)
+	assert.False(t, c.Offline(), "unobserved errors must not switch offline")
+	assert.Equal(t, 1, httpClient.calls)
+}
+
+// cancellingHTTPClient cancels the call's context and fails the request
+// the way a dial interrupted by the cancellation does.
+type cancellingHTTPClient struct {
+	cancel context.CancelFunc
+}
+
+func (c *cancellingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.cancel()
+	return nil, &net.OpError{Op: "dial", Net: "tcp", Err: req.Context().Err()}
+}
+
+func TestConnectivity_InstrumentCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg := aws.Config{
+		Region:           "us-east-1",
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:       &cancellingHTTPClient{cancel: cancel},
+		RetryMaxAttempts: 1,
+	}
+	c := NewConnectivity()
+	c.Instrument(&cfg)
+	client := sts.NewFromConfig(cfg)
+
+	_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	require.Error(t, err)
+	assert.False(t, c.Offline(), "cancelled calls must not switch offline")
+
+	assert.False(t, c.Observe(&net.OpError{Op: "dial", Net: "tcp", Err: context.Canceled}))
+	assert.False(t, c.Observe(context.DeadlineExceeded))
+	assert.False(t, c.Offline())
+}
+
+// flakyHTTPClient fails the first failures requests with a dial error and
+// answers the rest with a GetCallerIdentity response.
+type flakyHTTPClient struct {
+	failures int
+	calls    int
+}
+
+func (c *flakyHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	body := `<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestConnectivity_InstrumentRetry(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    int
+		wantErr     bool
+		wantCalls   int
+		wantOffline bool
+	}{
+		{name: "recovered network error stays online", failures: 1, wantCalls: 2},
+		{name: "offline once attempts are used up", failures: 10, wantErr: true, wantCalls: 3, wantOffline: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := &flakyHTTPClient{failures: tc.failures}
+			cfg := aws.Config{
+				Region:      "us-east-1",
+				Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+				HTTPClient:  httpClient,
+			}
+			c := NewConnectivity()
+			c.Instrument(&cfg)
+			client := sts.NewFromConfig(cfg)
+
+			var retries int
+			err := Retry(context.Background(), testRetryPolicy, func(ctx context.Context) error {
+				_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+				if err == nil {
+					assert.False(t, c.Offline())
+				}
+				return err
+			}, func(int, error) {
+				retries++
+				assert.False(t, c.Offline(), "errors are held back while retrying")
+			})
+
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantCalls, httpClient.calls, "the SDK must not retry on top of Retry")
+			assert.Equal(t, tc.wantCalls-1, retries)
+			assert.Equal(t, tc.wantOffline, c.Offline())
+		})
+	}
+}
+
+func TestConnectivity_Probe(t *testing.T) {
+	c := NewConnectivity()
+	var addr string
+	c.dial = func(_ context.Context, _, a string) (net.Conn, error) {
+		addr = a
+		return nil, errors.New("connection refused")
+	}
+
+	assert.Error(t, c.Probe(context.Background(), "eu-west-1"))
+	assert.Equal(t, "sts.eu-west-1.amazonaws.com:443", addr)
+}
@@ -0,0 +1,399 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsddb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// DynamoDBAPI defines the subset of the DynamoDB API we use.
+type DynamoDBAPI interface {
+	ListTables(ctx context.Context, params *awsddb.ListTablesInput, optFns ...func(*awsddb.Options)) (*awsddb.ListTablesOutput, error)
+	DescribeTable(ctx context.Context, params *awsddb.DescribeTableInput, optFns ...func(*awsddb.Options)) (*awsddb.DescribeTableOutput, error)
+	DescribeTimeToLive(ctx context.Context, params *awsddb.DescribeTimeToLiveInput, optFns ...func(*awsddb.Options)) (*awsddb.DescribeTimeToLiveOutput, error)
+	Query(ctx context.Context, params *awsddb.QueryInput, optFns ...func(*awsddb.Options)) (*awsddb.QueryOutput, error)
+	Scan(ctx context.Context, params *awsddb.ScanInput, optFns ...func(*awsddb.Options)) (*awsddb.ScanOutput, error)
+}
+
+// Client wraps the DynamoDB API.
+type Client struct {
+	api DynamoDBAPI
+}
+
+// NewClient creates a new DynamoDB client.
+func NewClient(api DynamoDBAPI) *Client {
+	return &Client{api: api}
+}
+
+// pageLimit is the number of items a single Query or Scan request
+// evaluates.
+const pageLimit = 100
+
+// ListTables returns every table in the region, described, sorted by name.
+func (c *Client) ListTables(ctx context.Context) ([]Table, error) {
+	var names []string
+	var start *string
+	for {
+		out, err := c.api.ListTables(ctx, &awsddb.ListTablesInput{ExclusiveStartTableName: start})
+		if err != nil {
+			return nil, fmt.Errorf("ListTables: %w", err)
+		}
+		names = append(names, out.TableNames...)
+		if out.LastEvaluatedTableName == nil {
+			break
+		}
+		start = out.LastEvaluatedTableName
+	}
+
+	// Describe tables concurrently, bounded to 8 goroutines.
+	tables := make([]Table, len(names))
+	sem := make(chan struct{}, 8)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			t, err := c.GetTable(ctx, name)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			tables[i] = t
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables, nil
+}
+
+// GetTable describes a table and its time to live setting.
+func (c *Client) GetTable(ctx context.Context, name string) (Table, error) {
+	out, err := c.api.DescribeTable(ctx, &awsddb.DescribeTableInput{TableName: aws.String(name)})
+	if err != nil {
+		return Table{}, fmt.Errorf("DescribeTable(%s): %w", name, err)
+	}
+	if out.Table == nil {
+		return Table{}, fmt.Errorf("table %s not found", name)
+	}
+	t := toTable(*out.Table)
+
+	ttl, err := c.api.DescribeTimeToLive(ctx, &awsddb.DescribeTimeToLiveInput{TableName: aws.String(name)})
+	if err != nil {
+		return Table{}, fmt.Errorf("DescribeTimeToLive(%s): %w", name, err)
+	}
+	if d := ttl.TimeToLiveDescription; d != nil {
+		t.TTLAttribute = aws.ToString(d.AttributeName)
+		t.TTLStatus = string(d.TimeToLiveStatus)
+	}
+	return t, nil
+}
+
+// ScanPage scans a page of a table after token, keeping the items that
+// match filter, an expression in the form ParseExpression accepts. An empty
+// filter keeps every item. It returns the token for the next page, or nil
+// once the scan is complete.
+func (c *Client) ScanPage(ctx context.Context, table, filter string, token *string) ([]Item, *string, error) {
+	in := &awsddb.ScanInput{TableName: aws.String(table), Limit: aws.Int32(pageLimit)}
+	if filter != "" {
+		expr, err := ParseExpression(filter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("filter: %w", err)
+		}
+		in.FilterExpression = aws.String(expr.Text)
+		in.ExpressionAttributeNames = expr.Names
+		in.ExpressionAttributeValues = expr.Values
+	}
+	start, err := decodeKey(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	in.ExclusiveStartKey = start
+
+	out, err := c.api.Scan(ctx, in)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Scan: %w", err)
+	}
+	return toItems(out.Items), encodeKey(out.LastEvaluatedKey), nil
+}
+
+// QueryPage reads a page of the items q selects after token. It returns
+// the token for the next page, or nil once the query is complete.
+func (c *Client) QueryPage(ctx context.Context, table string, q Query, token *string) ([]Item, *string, error) {
+	names := map[string]string{"#pk": q.PartitionKey.Name}
+	pk, err := keyValue(q.PartitionKey, q.PartitionValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	values := map[string]ddbtypes.AttributeValue{":pk": pk}
+	cond := "#pk = :pk"
+
+	if q.SortOp != "" {
+		want := 1
+		if q.SortOp == "between" {
+			want = 2
+		}
+		if len(q.SortValues) != want {
+			return nil, nil, fmt.Errorf("%s needs %d sort key values", q.SortOp, want)
+		}
+		names["#sk"] = q.SortKey.Name
+		for i, v := range q.SortValues {
+			av, err := keyValue(q.SortKey, v)
+			if err != nil {
+				return nil, nil, err
+			}
+			values[fmt.Sprintf(":sk%d", i)] = av
+		}
+		switch q.SortOp {
+		case "=", "<", "<=", ">", ">=":
+			cond += " AND #sk " + q.SortOp + " :sk0"
+		case "begins_with":
+			cond += " AND begins_with(#sk, :sk0)"
+		case "between":
+			cond += " AND #sk BETWEEN :sk0 AND :sk1"
+		default:
+			return nil, nil, fmt.Errorf("unknown sort key condition %q", q.SortOp)
+		}
+	}
+
+	in := &awsddb.QueryInput{
+		TableName:                 aws.String(table),
+		KeyConditionExpression:    aws.String(cond),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+		Limit:                     aws.Int32(pageLimit),
+	}
+	if q.Index != "" {
+		in.IndexName = aws.String(q.Index)
+	}
+	start, err := decodeKey(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	in.ExclusiveStartKey = start
+
+	out, err := c.api.Query(ctx, in)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Query: %w", err)
+	}
+	return toItems(out.Items), encodeKey(out.LastEvaluatedKey), nil
+}
+
+// keyValue converts text typed for a key attribute to a value of the key's
+// type. Binary keys are written in base64.
+func keyValue(k Key, v string) (ddbtypes.AttributeValue, error) {
+	switch k.Type {
+	case "N":
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("%s is a number, not %q", k.Name, v)
+		}
+		return &ddbtypes.AttributeValueMemberN{Value: v}, nil
+	case "B":
+		b, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s is binary; write it in base64", k.Name)
+		}
+		return &ddbtypes.AttributeValueMemberB{Value: b}, nil
+	}
+	return &ddbtypes.AttributeValueMemberS{Value: v}, nil
+}
+
+// encodeKey encodes the LastEvaluatedKey of a page as a page token. Key
+// attributes are scalars, so each is stored with its type.
+func encodeKey(key map[string]ddbtypes.AttributeValue) *string {
+	if len(key) == 0 {
+		return nil
+	}
+	typed := make(map[string]map[string]string, len(key))
+	for name, av := range key {
+		switch v := av.(type) {
+		case *ddbtypes.AttributeValueMemberS:
+			typed[name] = map[string]string{"S": v.Value}
+		case *ddbtypes.AttributeValueMemberN:
+			typed[name] = map[string]string{"N": v.Value}
+		case *ddbtypes.AttributeValueMemberB:
+			typed[name] = map[string]string{"B": base64.StdEncoding.EncodeToString(v.Value)}
+		}
+	}
+	b, _ := json.Marshal(typed)
+	return aws.String(string(b))
+}
+
+// decodeKey decodes a page token made by encodeKey.
+func decodeKey(token *string) (map[string]ddbtypes.AttributeValue, error) {
+	if token == nil {
+		return nil, nil
+	}
+	var typed map[string]map[string]string
+	if err := json.Unmarshal([]byte(*token), &typed); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	key := make(map[string]ddbtypes.AttributeValue, len(typed))
+	for name, tv := range typed {
+		for t, v := range tv {
+			av, err := keyValue(Key{Name: name, Type: t}, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid page token: %w", err)
+			}
+			key[name] = av
+		}
+	}
+	return key, nil
+}
+
+func toItems(raw []map[string]ddbtypes.AttributeValue) []Item {
+	items := make([]Item, len(raw))
+	for i, r := range raw {
+		item := make(Item, len(r))
+		for k, v := range r {
+			item[k] = toValue(v)
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// toValue decodes an attribute value. Numbers stay json.Number so large
+// and precise values survive.
+func toValue(av ddbtypes.AttributeValue) any {
+	switch v := av.(type) {
+	case *ddbtypes.AttributeValueMemberS:
+		return v.Value
+	case *ddbtypes.AttributeValueMemberN:
+		return json.Number(v.Value)
+	case *ddbtypes.AttributeValueMemberB:
+		return v.Value
+	case *ddbtypes.AttributeValueMemberBOOL:
+		return v.Value
+	case *ddbtypes.AttributeValueMemberNULL:
+		return nil
+	case *ddbtypes.AttributeValueMemberSS:
+		return v.Value
+	case *ddbtypes.AttributeValueMemberNS:
+		ns := make([]json.Number, len(v.Value))
+		for i, n := range v.Value {
+			ns[i] = json.Number(n)
+		}
+		return ns
+	case *ddbtypes.AttributeValueMemberBS:
+		return v.Value
+	case *ddbtypes.AttributeValueMemberL:
+		l := make([]any, len(v.Value))
+		for i, e := range v.Value {
+			l[i] = toValue(e)
+		}
+		return l
+	case *ddbtypes.AttributeValueMemberM:
+		m := make(map[string]any, len(v.Value))
+		for k, e := range v.Value {
+			m[k] = toValue(e)
+		}
+		return m
+	}
+	return nil
+}
+
+func toTable(d ddbtypes.TableDescription) Table {
+	types := make(map[string]string, len(d.AttributeDefinitions))
+	for _, a := range d.AttributeDefinitions {
+		types[aws.ToString(a.AttributeName)] = string(a.AttributeType)
+	}
+
+	t := Table{
+		Name:               aws.ToString(d.TableName),
+		ARN:                aws.ToString(d.TableArn),
+		Status:             string(d.TableStatus),
+		ItemCount:          aws.ToInt64(d.ItemCount),
+		SizeBytes:          aws.ToInt64(d.TableSizeBytes),
+		BillingMode:        string(ddbtypes.BillingModeProvisioned),
+		StreamARN:          aws.ToString(d.LatestStreamArn),
+		DeletionProtection: aws.ToBool(d.DeletionProtectionEnabled),
+		CreatedAt:          aws.ToTime(d.CreationDateTime),
+	}
+	t.PartitionKey, t.SortKey = keySchema(d.KeySchema, types)
+	// Only tables that are or have been on demand report a billing mode;
+	// the others are provisioned.
+	if d.BillingModeSummary != nil && d.BillingModeSummary.BillingMode != "" {
+		t.BillingMode = string(d.BillingModeSummary.BillingMode)
+	}
+	if p := d.ProvisionedThroughput; p != nil {
+		t.ReadCapacity = aws.ToInt64(p.ReadCapacityUnits)
+		t.WriteCapacity = aws.ToInt64(p.WriteCapacityUnits)
+	}
+	if d.TableClassSummary != nil {
+		t.TableClass = string(d.TableClassSummary.TableClass)
+	}
+	if s := d.StreamSpecification; s != nil && aws.ToBool(s.StreamEnabled) {
+		t.StreamEnabled = true
+		t.StreamViewType = string(s.StreamViewType)
+	}
+	for _, g := range d.GlobalSecondaryIndexes {
+		idx := Index{
+			Name:      aws.ToString(g.IndexName),
+			Status:    string(g.IndexStatus),
+			ItemCount: aws.ToInt64(g.ItemCount),
+			SizeBytes: aws.ToInt64(g.IndexSizeBytes),
+		}
+		idx.PartitionKey, idx.SortKey = keySchema(g.KeySchema, types)
+		if g.Projection != nil {
+			idx.Projection = string(g.Projection.ProjectionType)
+		}
+		t.GSIs = append(t.GSIs, idx)
+	}
+	for _, l := range d.LocalSecondaryIndexes {
+		idx := Index{
+			Name:      aws.ToString(l.IndexName),
+			ItemCount: aws.ToInt64(l.ItemCount),
+			SizeBytes: aws.ToInt64(l.IndexSizeBytes),
+		}
+		idx.PartitionKey, idx.SortKey = keySchema(l.KeySchema, types)
+		if l.Projection != nil {
+			idx.Projection = string(l.Projection.ProjectionType)
+		}
+		t.LSIs = append(t.LSIs, idx)
+	}
+	return t
+}
+
+// keySchema returns the partition (HASH) and sort (RANGE) keys of a key
+// schema, typed from the table's attribute definitions.
+func keySchema(schema []ddbtypes.KeySchemaElement, types map[string]string) (pk, sk Key) {
+	for _, e := range schema {
+		k := Key{Name: aws.ToString(e.AttributeName)}
+		k.Type = types[k.Name]
+		switch e.KeyType {
+		case ddbtypes.KeyTypeHash:
+			pk = k
+		case ddbtypes.KeyTypeRange:
+			sk = k
+		}
+	}
+	return pk, sk
+}
@@ -0,0 +1,219 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awsddb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDynamoDBAPI struct {
+	listTablesFunc         func(ctx context.Context, params *awsddb.ListTablesInput, optFns ...func(*awsddb.Options)) (*awsddb.ListTablesOutput, error)
+	describeTableFunc      func(ctx context.Context, params *awsddb.DescribeTableInput, optFns ...func(*awsddb.Options)) (*awsddb.DescribeTableOutput, error)
+	describeTimeToLiveFunc func(ctx context.Context, params *awsddb.DescribeTimeToLiveInput, optFns ...func(*awsddb.Options)) (*awsddb.DescribeTimeToLiveOutput, error)
+	queryFunc              func(ctx context.Context, params *awsddb.QueryInput, optFns ...func(*awsddb.Options)) (*awsddb.QueryOutput, error)
+	scanFunc               func(ctx context.Context, params *awsddb.ScanInput, optFns ...func(*awsddb.Options)) (*awsddb.ScanOutput, error)
+}
+
+func (m *mockDynamoDBAPI) ListTables(ctx context.Context, params *awsddb.ListTablesInput, optFns ...func(*awsddb.Options)) (*awsddb.ListTablesOutput, error) {
+	return m.listTablesFunc(ctx, params, optFns...)
+}
+
+func (m *mockDynamoDBAPI) DescribeTable(ctx context.Context, params *awsddb.DescribeTableInput, optFns ...func(*awsddb.Options)) (*awsddb.DescribeTableOutput, error) {
+	return m.describeTableFunc(ctx, params, optFns...)
+}
+
+func (m *mockDynamoDBAPI) DescribeTimeToLive(ctx context.Context, params *awsddb.DescribeTimeToLiveInput, optFns ...func(*awsddb.Options)) (*awsddb.DescribeTimeToLiveOutput, error) {
+	return m.describeTimeToLiveFunc(ctx, params, optFns...)
+}
+
+func (m *mockDynamoDBAPI) Query(ctx context.Context, params *awsddb.QueryInput, optFns ...func(*awsddb.Options)) (*awsddb.QueryOutput, error) {
+	return m.queryFunc(ctx, params, optFns...)
+}
+
+func (m *mockDynamoDBAPI) Scan(ctx context.Context, params *awsddb.ScanInput, optFns ...func(*awsddb.Options)) (*awsddb.ScanOutput, error) {
+	return m.scanFunc(ctx, params, optFns...)
+}
+
+func ordersTable(name string) *ddbtypes.TableDescription {
+	return &ddbtypes.TableDescription{
+		TableName:   awssdk.String(name),
+		TableStatus: ddbtypes.TableStatusActive,
+		ItemCount:   awssdk.Int64(1200),
+		AttributeDefinitions: []ddbtypes.AttributeDefinition{
+			{AttributeName: awssdk.String("customerId"), AttributeType: ddbtypes.ScalarAttributeTypeS},
+			{AttributeName: awssdk.String("createdAt"), AttributeType: ddbtypes.ScalarAttributeTypeN},
+			{AttributeName: awssdk.String("status"), AttributeType: ddbtypes.ScalarAttributeTypeS},
+		},
+		KeySchema: []ddbtypes.KeySchemaElement{
+			{AttributeName: awssdk.String("customerId"), KeyType: ddbtypes.KeyTypeHash},
+			{AttributeName: awssdk.String("createdAt"), KeyType: ddbtypes.KeyTypeRange},
+		},
+		BillingModeSummary: &ddbtypes.BillingModeSummary{BillingMode: ddbtypes.BillingModePayPerRequest},
+		GlobalSecondaryIndexes: []ddbtypes.GlobalSecondaryIndexDescription{{
+			IndexName:   awssdk.String("by-status"),
+			IndexStatus: ddbtypes.IndexStatusActive,
+			KeySchema:   []ddbtypes.KeySchemaElement{{AttributeName: awssdk.String("status"), KeyType: ddbtypes.KeyTypeHash}},
+			Projection:  &ddbtypes.Projection{ProjectionType: ddbtypes.ProjectionTypeKeysOnly},
+		}},
+		StreamSpecification: &ddbtypes.StreamSpecification{StreamEnabled: awssdk.Bool(true), StreamViewType: ddbtypes.StreamViewTypeNewAndOldImages},
+	}
+}
+
+func TestListTables(t *testing.T) {
+	mock := &mockDynamoDBAPI{
+		listTablesFunc: func(_ context.Context, params *awsddb.ListTablesInput, _ ...func(*awsddb.Options)) (*awsddb.ListTablesOutput, error) {
+			if params.ExclusiveStartTableName == nil {
+				return &awsddb.ListTablesOutput{TableNames: []string{"orders"}, LastEvaluatedTableName: awssdk.String("orders")}, nil
+			}
+			return &awsddb.ListTablesOutput{TableNames: []string{"carts"}}, nil
+		},
+		describeTableFunc: func(_ context.Context, params *awsddb.DescribeTableInput, _ ...func(*awsddb.Options)) (*awsddb.DescribeTableOutput, error) {
+			if awssdk.ToString(params.TableName) == "carts" {
+				return &awsddb.DescribeTableOutput{Table: &ddbtypes.TableDescription{
+					TableName:             awssdk.String("carts"),
+					TableStatus:           ddbtypes.TableStatusActive,
+					ProvisionedThroughput: &ddbtypes.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(5), WriteCapacityUnits: awssdk.Int64(2)},
+				}}, nil
+			}
+			return &awsddb.DescribeTableOutput{Table: ordersTable("orders")}, nil
+		},
+		describeTimeToLiveFunc: func(_ context.Context, params *awsddb.DescribeTimeToLiveInput, _ ...func(*awsddb.Options)) (*awsddb.DescribeTimeToLiveOutput, error) {
+			if awssdk.ToString(params.TableName) == "carts" {
+				return &awsddb.DescribeTimeToLiveOutput{TimeToLiveDescription: &ddbtypes.TimeToLiveDescription{
+					AttributeName:    awssdk.String("expiresAt"),
+					TimeToLiveStatus: ddbtypes.TimeToLiveStatusEnabled,
+				}}, nil
+			}
+			return &awsddb.DescribeTimeToLiveOutput{TimeToLiveDescription: &ddbtypes.TimeToLiveDescription{TimeToLiveStatus: ddbtypes.TimeToLiveStatusDisabled}}, nil
+		},
+	}
+
+	tables, err := NewClient(mock).ListTables(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+
+	carts := tables[0]
+	assert.Equal(t, "carts", carts.Name)
+	assert.Equal(t, "PROVISIONED", carts.BillingMode)
+	assert.Equal(t, int64(5), carts.ReadCapacity)
+	assert.Equal(t, "expiresAt", carts.TTLAttribute)
+	assert.Equal(t, "ENABLED", carts.TTLStatus)
+
+	orders := tables[1]
+	assert.Equal(t, "PAY_PER_REQUEST", orders.BillingMode)
+	assert.Equal(t, Key{Name: "customerId", Type: "S"}, orders.PartitionKey)
+	assert.Equal(t, Key{Name: "createdAt", Type: "N"}, orders.SortKey)
+	require.Len(t, orders.GSIs, 1)
+	assert.Equal(t, Index{Name: "by-status", Status: "ACTIVE", PartitionKey: Key{Name: "status", Type: "S"}, Projection: "KEYS_ONLY"}, orders.GSIs[0])
+	assert.True(t, orders.StreamEnabled)
+	assert.Equal(t, "NEW_AND_OLD_IMAGES", orders.StreamViewType)
+	assert.Equal(t, "DISABLED", orders.TTLStatus)
+}
+
+func TestListTables_Error(t *testing.T) {
+	mock := &mockDynamoDBAPI{
+		listTablesFunc: func(_ context.Context, _ *awsddb.ListTablesInput, _ ...func(*awsddb.Options)) (*awsddb.ListTablesOutput, error) {
+			return &awsddb.ListTablesOutput{TableNames: []string{"orders"}}, nil
+		},
+		describeTableFunc: func(_ context.Context, _ *awsddb.DescribeTableInput, _ ...func(*awsddb.Options)) (*awsddb.DescribeTableOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+	_, err := NewClient(mock).ListTables(context.Background())
+	assert.EqualError(t, err, "DescribeTable(orders): AccessDenied")
+}
+
+func TestScanPage(t *testing.T) {
+	last := map[string]ddbtypes.AttributeValue{
+		"customerId": &ddbtypes.AttributeValueMemberS{Value: "c-1"},
+		"createdAt":  &ddbtypes.AttributeValueMemberN{Value: "1714567890"},
+	}
+	var inputs []*awsddb.ScanInput
+	mock := &mockDynamoDBAPI{
+		scanFunc: func(_ context.Context, params *awsddb.ScanInput, _ ...func(*awsddb.Options)) (*awsddb.ScanOutput, error) {
+			inputs = append(inputs, params)
+			if params.ExclusiveStartKey != nil {
+				return &awsddb.ScanOutput{}, nil
+			}
+			return &awsddb.ScanOutput{
+				Items: []map[string]ddbtypes.AttributeValue{{
+					"customerId": &ddbtypes.AttributeValueMemberS{Value: "c-1"},
+					"total":      &ddbtypes.AttributeValueMemberN{Value: "12.50"},
+					"lines": &ddbtypes.AttributeValueMemberL{Value: []ddbtypes.AttributeValue{
+						&ddbtypes.AttributeValueMemberM{Value: map[string]ddbtypes.AttributeValue{
+							"sku":  &ddbtypes.AttributeValueMemberS{Value: "A1"},
+							"gift": &ddbtypes.AttributeValueMemberBOOL{Value: true},
+						}},
+					}},
+					"tags": &ddbtypes.AttributeValueMemberSS{Value: []string{"vip"}},
+					"note": &ddbtypes.AttributeValueMemberNULL{Value: true},
+				}},
+				LastEvaluatedKey: last,
+			}, nil
+		},
+	}
+	client := NewClient(mock)
+
+	items, next, err := client.ScanPage(context.Background(), "orders", `total > 10`, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	b, err := json.Marshal(items[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"customerId":"c-1","total":12.50,"lines":[{"sku":"A1","gift":true}],"tags":["vip"],"note":null}`, string(b))
+	assert.Equal(t, "#n0 > :v0", awssdk.ToString(inputs[0].FilterExpression))
+	assert.Equal(t, map[string]string{"#n0": "total"}, inputs[0].ExpressionAttributeNames)
+	require.NotNil(t, next)
+
+	// The token resumes the scan from the last evaluated key.
+	_, next, err = client.ScanPage(context.Background(), "orders", "", next)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+	assert.Equal(t, last, inputs[1].ExclusiveStartKey)
+	assert.Nil(t, inputs[1].FilterExpression)
+
+	_, _, err = client.ScanPage(context.Background(), "orders", `total > :x`, nil)
+	assert.ErrorContains(t, err, "filter:")
+}
+
+func TestQueryPage(t *testing.T) {
+	var got *awsddb.QueryInput
+	mock := &mockDynamoDBAPI{
+		queryFunc: func(_ context.Context, params *awsddb.QueryInput, _ ...func(*awsddb.Options)) (*awsddb.QueryOutput, error) {
+			got = params
+			return &awsddb.QueryOutput{}, nil
+		},
+	}
+	client := NewClient(mock)
+	q := Query{
+		PartitionKey:   Key{Name: "customerId", Type: "S"},
+		PartitionValue: "c-1",
+		SortKey:        Key{Name: "createdAt", Type: "N"},
+		SortOp:         "between",
+		SortValues:     []string{"100", "200"},
+	}
+
+	_, next, err := client.QueryPage(context.Background(), "orders", q, nil)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+	assert.Equal(t, "#pk = :pk AND #sk BETWEEN :sk0 AND :sk1", awssdk.ToString(got.KeyConditionExpression))
+	assert.Equal(t, map[string]string{"#pk": "customerId", "#sk": "createdAt"}, got.ExpressionAttributeNames)
+	assert.Equal(t, &ddbtypes.AttributeValueMemberN{Value: "200"}, got.ExpressionAttributeValues[":sk1"])
+	assert.Nil(t, got.IndexName)
+
+	q = Query{Index: "by-status", PartitionKey: Key{Name: "status", Type: "S"}, PartitionValue: "shipped"}
+	_, _, err = client.QueryPage(context.Background(), "orders", q, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "#pk = :pk", awssdk.ToString(got.KeyConditionExpression))
+	assert.Equal(t, "by-status", awssdk.ToString(got.IndexName))
+
+	q = Query{PartitionKey: Key{Name: "id", Type: "N"}, PartitionValue: "abc"}
+	_, _, err = client.QueryPage(context.Background(), "orders", q, nil)
+	assert.EqualError(t, err, `id is a number, not "abc"`)
+}
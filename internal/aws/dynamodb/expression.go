@@ -0,0 +1,235 @@
+package dynamodb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Expression is a condition expression with its attribute names and values
+// moved into placeholders, ready to send to DynamoDB.
+type Expression struct {
+	Text   string
+	Names  map[string]string
+	Values map[string]ddbtypes.AttributeValue
+}
+
+// expressionKeywords are the words of the condition expression grammar that
+// are not attribute names.
+var expressionKeywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "BETWEEN": true, "IN": true,
+}
+
+// expressionFunctions are the functions a condition expression may call.
+var expressionFunctions = map[string]bool{
+	"attribute_exists": true, "attribute_not_exists": true, "attribute_type": true,
+	"begins_with": true, "contains": true, "size": true,
+}
+
+// ParseExpression turns a condition written with literal values, such as
+//
+//	status = "active" AND size(tags) > 2 AND begins_with(sk, 'order#')
+//
+// into an Expression. Attribute names, including reserved words, become
+// #n placeholders and literals become :v placeholders: quoted strings are
+// strings, numbers are numbers and true/false are booleans.
+func ParseExpression(expr string) (Expression, error) {
+	e := Expression{Names: map[string]string{}, Values: map[string]ddbtypes.AttributeValue{}}
+	nameRefs := map[string]string{}
+	var b strings.Builder
+	// operand records whether the previous token ends an operand; a "-"
+	// only starts a negative number where an operand may begin.
+	operand := false
+	// call records whether the previous token is a function name, which its
+	// "(" follows without a space.
+	call := false
+
+	addValue := func(v ddbtypes.AttributeValue) {
+		ref := fmt.Sprintf(":v%d", len(e.Values))
+		e.Values[ref] = v
+		b.WriteString(ref)
+	}
+	addName := func(name string) {
+		ref, ok := nameRefs[name]
+		if !ok {
+			ref = fmt.Sprintf("#n%d", len(nameRefs))
+			nameRefs[name] = ref
+			e.Names[ref] = name
+		}
+		b.WriteString(ref)
+	}
+
+	rs := []rune(expr)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+
+		case r == '"' || r == '\'':
+			s, n, err := readString(rs[i:])
+			if err != nil {
+				return Expression{}, err
+			}
+			i += n
+			spaceBefore(&b)
+			addValue(&ddbtypes.AttributeValueMemberS{Value: s})
+			operand = true
+
+		case unicode.IsDigit(r) || (r == '-' && !operand && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			j := i + 1
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.' || rs[j] == 'e' || rs[j] == 'E') {
+				j++
+			}
+			num := string(rs[i:j])
+			if _, err := strconv.ParseFloat(num, 64); err != nil {
+				return Expression{}, fmt.Errorf("invalid number %q", num)
+			}
+			i = j
+			spaceBefore(&b)
+			addValue(&ddbtypes.AttributeValueMemberN{Value: num})
+			operand = true
+
+		case r == '_' || unicode.IsLetter(r):
+			j := i
+			for j < len(rs) && isNameRune(rs[j]) {
+				j++
+			}
+			word := string(rs[i:j])
+			spaceBefore(&b)
+			switch {
+			case expressionKeywords[strings.ToUpper(word)]:
+				b.WriteString(strings.ToUpper(word))
+				i, operand = j, false
+				continue
+			case strings.EqualFold(word, "true") || strings.EqualFold(word, "false"):
+				addValue(&ddbtypes.AttributeValueMemberBOOL{Value: strings.EqualFold(word, "true")})
+				i, operand = j, true
+				continue
+			case expressionFunctions[word] && nextNonSpace(rs, j) == '(':
+				b.WriteString(word)
+				i, operand, call = j, false, true
+				continue
+			}
+			// An attribute path: names separated by "." with optional
+			// list indexes, e.g. address.lines[0].
+			addName(word)
+			for j < len(rs) {
+				if rs[j] == '.' && j+1 < len(rs) && isNameStart(rs[j+1]) {
+					k := j + 1
+					for k < len(rs) && isNameRune(rs[k]) {
+						k++
+					}
+					b.WriteByte('.')
+					addName(string(rs[j+1 : k]))
+					j = k
+					continue
+				}
+				if rs[j] == '[' {
+					k := j + 1
+					for k < len(rs) && unicode.IsDigit(rs[k]) {
+						k++
+					}
+					if k == j+1 || k >= len(rs) || rs[k] != ']' {
+						return Expression{}, fmt.Errorf("invalid list index in %q", string(rs[i:min(k+1, len(rs))]))
+					}
+					b.WriteString(string(rs[j : k+1]))
+					j = k + 1
+					continue
+				}
+				break
+			}
+			i, operand = j, true
+
+		case r == '(':
+			if !call {
+				spaceBefore(&b)
+			}
+			b.WriteRune(r)
+			i++
+			operand, call = false, false
+
+		case r == ',':
+			b.WriteString(", ")
+			i++
+			operand = false
+
+		case r == ')':
+			b.WriteRune(r)
+			i++
+			operand = true
+
+		case r == '=' || r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(rs) && (rs[i+1] == '=' || (r == '<' && rs[i+1] == '>')) {
+				op += string(rs[i+1])
+			}
+			i += len(op)
+			spaceBefore(&b)
+			b.WriteString(op)
+			operand = false
+
+		case r == '#' || r == ':':
+			return Expression{}, fmt.Errorf("write values and names directly instead of %c placeholders", r)
+
+		default:
+			return Expression{}, fmt.Errorf("unexpected %q", r)
+		}
+	}
+
+	e.Text = b.String()
+	if e.Text == "" {
+		return Expression{}, fmt.Errorf("empty expression")
+	}
+	return e, nil
+}
+
+// readString reads a quoted string starting at rs[0], returning its value
+// and the number of runes consumed. A backslash escapes the next rune.
+func readString(rs []rune) (string, int, error) {
+	quote := rs[0]
+	var s strings.Builder
+	for i := 1; i < len(rs); i++ {
+		switch rs[i] {
+		case '\\':
+			if i+1 < len(rs) {
+				i++
+				s.WriteRune(rs[i])
+			}
+		case quote:
+			return s.String(), i + 1, nil
+		default:
+			s.WriteRune(rs[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string %s", string(rs))
+}
+
+// spaceBefore separates a word or operator from the token before it.
+func spaceBefore(b *strings.Builder) {
+	s := b.String()
+	if s != "" && !strings.HasSuffix(s, " ") && !strings.HasSuffix(s, "(") {
+		b.WriteByte(' ')
+	}
+}
+
+func nextNonSpace(rs []rune, i int) rune {
+	for ; i < len(rs); i++ {
+		if !unicode.IsSpace(rs[i]) {
+			return rs[i]
+		}
+	}
+	return 0
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,43 @@
+package dynamodb
+
+import (
+	"testing"
+
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	e, err := ParseExpression(`status = "active" and size(tags)>2 AND begins_with(sk, 'order#') AND NOT attribute_exists(deleted)`)
+	require.NoError(t, err)
+	assert.Equal(t, "#n0 = :v0 AND size(#n1) > :v1 AND begins_with(#n2, :v2) AND NOT attribute_exists(#n3)", e.Text)
+	assert.Equal(t, map[string]string{"#n0": "status", "#n1": "tags", "#n2": "sk", "#n3": "deleted"}, e.Names)
+	assert.Equal(t, map[string]ddbtypes.AttributeValue{
+		":v0": &ddbtypes.AttributeValueMemberS{Value: "active"},
+		":v1": &ddbtypes.AttributeValueMemberN{Value: "2"},
+		":v2": &ddbtypes.AttributeValueMemberS{Value: "order#"},
+	}, e.Values)
+}
+
+func TestParseExpression_PathsAndLists(t *testing.T) {
+	e, err := ParseExpression(`address.lines[0] <> 'x' OR qty IN (-1, 2.5) OR active = true OR qty BETWEEN 1 AND 9`)
+	require.NoError(t, err)
+	assert.Equal(t, "#n0.#n1[0] <> :v0 OR #n2 IN (:v1, :v2) OR #n3 = :v3 OR #n2 BETWEEN :v4 AND :v5", e.Text)
+	assert.Equal(t, &ddbtypes.AttributeValueMemberN{Value: "-1"}, e.Values[":v1"])
+	assert.Equal(t, &ddbtypes.AttributeValueMemberBOOL{Value: true}, e.Values[":v3"])
+}
+
+func TestParseExpression_Errors(t *testing.T) {
+	for expr, msg := range map[string]string{
+		``:                 "empty expression",
+		`name = "unclosed`: "unterminated string",
+		`name = :v`:        "placeholders",
+		`lines[x] = 1`:     "invalid list index",
+		`a ~ 1`:            "unexpected",
+	} {
+		_, err := ParseExpression(expr)
+		require.Error(t, err, expr)
+		assert.Contains(t, err.Error(), msg, expr)
+	}
+}
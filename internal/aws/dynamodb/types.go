@@ -0,0 +1,60 @@
+package dynamodb
+
+import "time"
+
+// Table is a DynamoDB table with its key schema, indexes, TTL and stream
+// settings.
+type Table struct {
+	Name               string
+	ARN                string
+	Status             string
+	ItemCount          int64 // approximate; DynamoDB refreshes it about every six hours
+	SizeBytes          int64
+	BillingMode        string // "PAY_PER_REQUEST" or "PROVISIONED"
+	ReadCapacity       int64
+	WriteCapacity      int64
+	TableClass         string
+	PartitionKey       Key
+	SortKey            Key // zero when the table has no sort key
+	GSIs               []Index
+	LSIs               []Index
+	TTLAttribute       string
+	TTLStatus          string // "ENABLED", "DISABLED", …
+	StreamEnabled      bool
+	StreamViewType     string
+	StreamARN          string
+	DeletionProtection bool
+	CreatedAt          time.Time
+}
+
+// Key is a key attribute with its scalar type: "S", "N" or "B".
+type Key struct {
+	Name string
+	Type string
+}
+
+// Index is a global or local secondary index.
+type Index struct {
+	Name         string
+	Status       string // empty for local indexes
+	PartitionKey Key
+	SortKey      Key
+	Projection   string // "ALL", "KEYS_ONLY" or "INCLUDE"
+	ItemCount    int64
+	SizeBytes    int64
+}
+
+// Item is an item decoded to JSON-ready values: strings, json.Number,
+// bools, nil, []byte for binary, and slices and maps of those.
+type Item map[string]any
+
+// Query selects items by partition key and an optional sort key condition,
+// from the table or one of its indexes.
+type Query struct {
+	Index          string // empty for the table itself
+	PartitionKey   Key
+	PartitionValue string
+	SortKey        Key
+	SortOp         string   // "", "=", "<", "<=", ">", ">=", "begins_with" or "between"
+	SortValues     []string // one value, or two for "between"
+}
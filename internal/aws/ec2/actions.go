@@ -0,0 +1,71 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// StartInstances starts the given stopped instances.
+func (c *Client) StartInstances(ctx context.Context, ids []string) error {
+	if _, err := c.api.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: ids}); err != nil {
+		return fmt.Errorf("StartInstances: %w", err)
+	}
+	return nil
+}
+
+// StopInstances stops the given running instances. With hibernate set the
+// instances save their memory to the root volume and resume from it when
+// started again; EC2 rejects the call for instances not enabled for it.
+func (c *Client) StopInstances(ctx context.Context, ids []string, hibernate bool) error {
+	input := &awsec2.StopInstancesInput{InstanceIds: ids}
+	if hibernate {
+		input.Hibernate = aws.Bool(true)
+	}
+	if _, err := c.api.StopInstances(ctx, input); err != nil {
+		return fmt.Errorf("StopInstances: %w", err)
+	}
+	return nil
+}
+
+// RebootInstances reboots the given running instances.
+func (c *Client) RebootInstances(ctx context.Context, ids []string) error {
+	if _, err := c.api.RebootInstances(ctx, &awsec2.RebootInstancesInput{InstanceIds: ids}); err != nil {
+		return fmt.Errorf("RebootInstances: %w", err)
+	}
+	return nil
+}
+
+// TerminateInstances terminates the given instances.
+func (c *Client) TerminateInstances(ctx context.Context, ids []string) error {
+	if _, err := c.api.TerminateInstances(ctx, &awsec2.TerminateInstancesInput{InstanceIds: ids}); err != nil {
+		return fmt.Errorf("TerminateInstances: %w", err)
+	}
+	return nil
+}
+
+// InstanceStates returns the state name of each of the given instances,
+// keyed by instance ID.
+func (c *Client) InstanceStates(ctx context.Context, ids []string) (map[string]string, error) {
+	states := make(map[string]string, len(ids))
+	input := &awsec2.DescribeInstancesInput{InstanceIds: ids}
+	for {
+		out, err := c.api.DescribeInstances(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("DescribeInstances: %w", err)
+		}
+		for _, reservation := range out.Reservations {
+			for _, inst := range reservation.Instances {
+				if inst.State != nil {
+					states[aws.ToString(inst.InstanceId)] = string(inst.State.Name)
+				}
+			}
+		}
+		if out.NextToken == nil {
+			return states, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
@@ -0,0 +1,103 @@
+package ec2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+)
+
+func TestStopInstances(t *testing.T) {
+	tt := []struct {
+		name          string
+		hibernate     bool
+		wantHibernate *bool
+	}{
+		{name: "stop", hibernate: false, wantHibernate: nil},
+		{name: "hibernate", hibernate: true, wantHibernate: awssdk.Bool(true)},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *awsec2.StopInstancesInput
+			mock := &mockEC2API{
+				stopInstancesFunc: func(ctx context.Context, params *awsec2.StopInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+					got = params
+					return &awsec2.StopInstancesOutput{}, nil
+				},
+			}
+			client := NewClient(mock)
+			if err := client.StopInstances(context.Background(), []string{"i-abc", "i-def"}, tc.hibernate); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got.InstanceIds, []string{"i-abc", "i-def"}) {
+				t.Errorf("InstanceIds = %v, want [i-abc i-def]", got.InstanceIds)
+			}
+			if !reflect.DeepEqual(got.Hibernate, tc.wantHibernate) {
+				t.Errorf("Hibernate = %v, want %v", got.Hibernate, tc.wantHibernate)
+			}
+		})
+	}
+}
+
+func TestInstanceActions(t *testing.T) {
+	var calls []string
+	mock := &mockEC2API{
+		startInstancesFunc: func(ctx context.Context, params *awsec2.StartInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+			calls = append(calls, "start "+params.InstanceIds[0])
+			return &awsec2.StartInstancesOutput{}, nil
+		},
+		rebootInstancesFunc: func(ctx context.Context, params *awsec2.RebootInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.RebootInstancesOutput, error) {
+			calls = append(calls, "reboot "+params.InstanceIds[0])
+			return &awsec2.RebootInstancesOutput{}, nil
+		},
+		terminateInstancesFunc: func(ctx context.Context, params *awsec2.TerminateInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.TerminateInstancesOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: "OperationNotPermitted", Message: "termination protection"}
+		},
+	}
+
+	client := NewClient(mock)
+	ctx := context.Background()
+	if err := client.StartInstances(ctx, []string{"i-abc"}); err != nil {
+		t.Fatalf("StartInstances: %v", err)
+	}
+	if err := client.RebootInstances(ctx, []string{"i-def"}); err != nil {
+		t.Fatalf("RebootInstances: %v", err)
+	}
+	if !reflect.DeepEqual(calls, []string{"start i-abc", "reboot i-def"}) {
+		t.Errorf("calls = %v, want [start i-abc reboot i-def]", calls)
+	}
+	if err := client.TerminateInstances(ctx, []string{"i-abc"}); err == nil {
+		t.Error("TerminateInstances: expected error")
+	}
+}
+
+func TestInstanceStates(t *testing.T) {
+	mock := &mockEC2API{
+		describeInstancesFunc: func(ctx context.Context, params *awsec2.DescribeInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+			if len(params.InstanceIds) != 2 {
+				t.Errorf("InstanceIds = %v, want 2 IDs", params.InstanceIds)
+			}
+			return &awsec2.DescribeInstancesOutput{
+				Reservations: []types.Reservation{{Instances: []types.Instance{
+					{InstanceId: awssdk.String("i-abc"), State: &types.InstanceState{Name: types.InstanceStateNameStopping}},
+					{InstanceId: awssdk.String("i-def"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+				}}},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	states, err := client.InstanceStates(context.Background(), []string{"i-abc", "i-def"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"i-abc": "stopping", "i-def": "running"}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("states = %v, want %v", states, want)
+	}
+}
@@ -8,11 +8,18 @@ import (
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	internalaws "tasnim.dev/aws-tui/internal/aws"
 )
 
 type mockEC2API struct {
-	describeInstancesFunc func(ctx context.Context, params *awsec2.DescribeInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error)
-	describeVolumesFunc   func(ctx context.Context, params *awsec2.DescribeVolumesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error)
+	describeInstancesFunc  func(ctx context.Context, params *awsec2.DescribeInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error)
+	describeVolumesFunc    func(ctx context.Context, params *awsec2.DescribeVolumesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error)
+	startInstancesFunc     func(ctx context.Context, params *awsec2.StartInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error)
+	stopInstancesFunc      func(ctx context.Context, params *awsec2.StopInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error)
+	rebootInstancesFunc    func(ctx context.Context, params *awsec2.RebootInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.RebootInstancesOutput, error)
+	terminateInstancesFunc func(ctx context.Context, params *awsec2.TerminateInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.TerminateInstancesOutput, error)
 }
 
 func (m *mockEC2API) DescribeInstances(ctx context.Context, params *awsec2.DescribeInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
@@ -23,6 +30,22 @@ func (m *mockEC2API) DescribeVolumes(ctx context.Context, params *awsec2.Describ
 	return m.describeVolumesFunc(ctx, params, optFns...)
 }
 
+func (m *mockEC2API) StartInstances(ctx context.Context, params *awsec2.StartInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+	return m.startInstancesFunc(ctx, params, optFns...)
+}
+
+func (m *mockEC2API) StopInstances(ctx context.Context, params *awsec2.StopInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+	return m.stopInstancesFunc(ctx, params, optFns...)
+}
+
+func (m *mockEC2API) RebootInstances(ctx context.Context, params *awsec2.RebootInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.RebootInstancesOutput, error) {
+	return m.rebootInstancesFunc(ctx, params, optFns...)
+}
+
+func (m *mockEC2API) TerminateInstances(ctx context.Context, params *awsec2.TerminateInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.TerminateInstancesOutput, error) {
+	return m.terminateInstancesFunc(ctx, params, optFns...)
+}
+
 func TestListInstances(t *testing.T) {
 	launchTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
 	deleteOnTerm := true
@@ -347,3 +370,69 @@ func TestListInstancesPage(t *testing.T) {
 		})
 	}
 }
+
+func TestListInstances_RetriesThrottling(t *testing.T) {
+	calls := 0
+	mock := &mockEC2API{
+		describeInstancesFunc: func(ctx context.Context, params *awsec2.DescribeInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+			calls++
+			if calls < 3 {
+				return nil, &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+			}
+			return &awsec2.DescribeInstancesOutput{
+				Reservations: []types.Reservation{{
+					Instances: []types.Instance{{
+						InstanceId: awssdk.String("i-abc123"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+					}},
+				}},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	policy := internalaws.RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	var attempts []int
+	var instances []EC2Instance
+	err := internalaws.Retry(context.Background(), policy, func(ctx context.Context) (err error) {
+		instances, _, err = client.ListInstances(ctx)
+		return err
+	}, func(attempt int, _ error) {
+		attempts = append(attempts, attempt)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("DescribeInstances calls = %d, want 3", calls)
+	}
+	if len(attempts) != 2 || attempts[0] != 2 || attempts[1] != 3 {
+		t.Errorf("retry attempts = %v, want [2 3]", attempts)
+	}
+	if len(instances) != 1 || instances[0].InstanceID != "i-abc123" {
+		t.Errorf("instances = %+v, want i-abc123", instances)
+	}
+}
+
+func TestListInstances_DoesNotRetryAccessDenied(t *testing.T) {
+	calls := 0
+	mock := &mockEC2API{
+		describeInstancesFunc: func(ctx context.Context, params *awsec2.DescribeInstancesInput, optFns ...func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+			calls++
+			return nil, &smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "You are not authorized"}
+		},
+	}
+
+	client := NewClient(mock)
+	policy := internalaws.RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	err := internalaws.Retry(context.Background(), policy, func(ctx context.Context) error {
+		_, _, err := client.ListInstances(ctx)
+		return err
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Errorf("DescribeInstances calls = %d, want 1", calls)
+	}
+}
@@ -0,0 +1,59 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// SetDesiredCount changes how many tasks a service keeps running.
+func (c *Client) SetDesiredCount(ctx context.Context, clusterName, serviceName string, count int) error {
+	return c.updateService(ctx, &awsecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterName),
+		Service:      aws.String(serviceName),
+		DesiredCount: aws.Int32(int32(count)),
+	})
+}
+
+// ForceNewDeployment replaces a service's tasks with new ones running the
+// same task definition, picking up newly pushed images.
+func (c *Client) ForceNewDeployment(ctx context.Context, clusterName, serviceName string) error {
+	return c.updateService(ctx, &awsecs.UpdateServiceInput{
+		Cluster:            aws.String(clusterName),
+		Service:            aws.String(serviceName),
+		ForceNewDeployment: true,
+	})
+}
+
+// SetTaskDefinition deploys a service at the given task definition,
+// "family:revision" or a full ARN, such as to roll back to an earlier
+// revision.
+func (c *Client) SetTaskDefinition(ctx context.Context, clusterName, serviceName, taskDef string) error {
+	return c.updateService(ctx, &awsecs.UpdateServiceInput{
+		Cluster:        aws.String(clusterName),
+		Service:        aws.String(serviceName),
+		TaskDefinition: aws.String(taskDef),
+	})
+}
+
+func (c *Client) updateService(ctx context.Context, input *awsecs.UpdateServiceInput) error {
+	if _, err := c.api.UpdateService(ctx, input); err != nil {
+		return fmt.Errorf("UpdateService: %w", err)
+	}
+	return nil
+}
+
+// StopTask stops a running task, recording reason in its stopped reason.
+func (c *Client) StopTask(ctx context.Context, clusterName, taskARN, reason string) error {
+	_, err := c.api.StopTask(ctx, &awsecs.StopTaskInput{
+		Cluster: aws.String(clusterName),
+		Task:    aws.String(taskARN),
+		Reason:  aws.String(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("StopTask: %w", err)
+	}
+	return nil
+}
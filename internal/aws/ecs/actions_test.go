@@ -0,0 +1,72 @@
+package ecs
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+func TestUpdateService(t *testing.T) {
+	var inputs []*awsecs.UpdateServiceInput
+	mock := &mockECSAPI{
+		updateServiceFunc: func(ctx context.Context, params *awsecs.UpdateServiceInput, optFns ...func(*awsecs.Options)) (*awsecs.UpdateServiceOutput, error) {
+			inputs = append(inputs, params)
+			return &awsecs.UpdateServiceOutput{}, nil
+		},
+	}
+	client := NewClient(mock)
+	ctx := context.Background()
+
+	if err := client.SetDesiredCount(ctx, "prod", "web", 4); err != nil {
+		t.Fatalf("SetDesiredCount: %v", err)
+	}
+	if err := client.ForceNewDeployment(ctx, "prod", "web"); err != nil {
+		t.Fatalf("ForceNewDeployment: %v", err)
+	}
+	if err := client.SetTaskDefinition(ctx, "prod", "web", "web:11"); err != nil {
+		t.Fatalf("SetTaskDefinition: %v", err)
+	}
+
+	if len(inputs) != 3 {
+		t.Fatalf("UpdateService calls = %d, want 3", len(inputs))
+	}
+	for _, in := range inputs {
+		if awssdk.ToString(in.Cluster) != "prod" || awssdk.ToString(in.Service) != "web" {
+			t.Errorf("cluster/service = %s/%s, want prod/web", awssdk.ToString(in.Cluster), awssdk.ToString(in.Service))
+		}
+	}
+	if got := awssdk.ToInt32(inputs[0].DesiredCount); got != 4 {
+		t.Errorf("DesiredCount = %d, want 4", got)
+	}
+	if inputs[0].ForceNewDeployment || inputs[0].TaskDefinition != nil {
+		t.Errorf("scaling input also set %+v", inputs[0])
+	}
+	if !inputs[1].ForceNewDeployment || inputs[1].DesiredCount != nil {
+		t.Errorf("force input = %+v, want only ForceNewDeployment", inputs[1])
+	}
+	if got := awssdk.ToString(inputs[2].TaskDefinition); got != "web:11" {
+		t.Errorf("TaskDefinition = %s, want web:11", got)
+	}
+}
+
+func TestStopTask(t *testing.T) {
+	var got *awsecs.StopTaskInput
+	mock := &mockECSAPI{
+		stopTaskFunc: func(ctx context.Context, params *awsecs.StopTaskInput, optFns ...func(*awsecs.Options)) (*awsecs.StopTaskOutput, error) {
+			got = params
+			return &awsecs.StopTaskOutput{}, nil
+		},
+	}
+	client := NewClient(mock)
+	if err := client.StopTask(context.Background(), "prod", "arn:aws:ecs:us-east-1:123456:task/prod/abc", "stuck"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if awssdk.ToString(got.Cluster) != "prod" || awssdk.ToString(got.Task) != "arn:aws:ecs:us-east-1:123456:task/prod/abc" {
+		t.Errorf("input = %+v, want the prod task", got)
+	}
+	if awssdk.ToString(got.Reason) != "stuck" {
+		t.Errorf("Reason = %s, want stuck", awssdk.ToString(got.Reason))
+	}
+}
@@ -18,6 +18,8 @@ type mockECSAPI struct {
 	listTasksFunc              func(ctx context.Context, params *awsecs.ListTasksInput, optFns ...func(*awsecs.Options)) (*awsecs.ListTasksOutput, error)
 	describeTasksFunc          func(ctx context.Context, params *awsecs.DescribeTasksInput, optFns ...func(*awsecs.Options)) (*awsecs.DescribeTasksOutput, error)
 	describeTaskDefinitionFunc func(ctx context.Context, params *awsecs.DescribeTaskDefinitionInput, optFns ...func(*awsecs.Options)) (*awsecs.DescribeTaskDefinitionOutput, error)
+	updateServiceFunc          func(ctx context.Context, params *awsecs.UpdateServiceInput, optFns ...func(*awsecs.Options)) (*awsecs.UpdateServiceOutput, error)
+	stopTaskFunc               func(ctx context.Context, params *awsecs.StopTaskInput, optFns ...func(*awsecs.Options)) (*awsecs.StopTaskOutput, error)
 }
 
 func (m *mockECSAPI) ListClusters(ctx context.Context, params *awsecs.ListClustersInput, optFns ...func(*awsecs.Options)) (*awsecs.ListClustersOutput, error) {
@@ -41,6 +43,12 @@ func (m *mockECSAPI) DescribeTasks(ctx context.Context, params *awsecs.DescribeT
 func (m *mockECSAPI) DescribeTaskDefinition(ctx context.Context, params *awsecs.DescribeTaskDefinitionInput, optFns ...func(*awsecs.Options)) (*awsecs.DescribeTaskDefinitionOutput, error) {
 	return m.describeTaskDefinitionFunc(ctx, params, optFns...)
 }
+func (m *mockECSAPI) UpdateService(ctx context.Context, params *awsecs.UpdateServiceInput, optFns ...func(*awsecs.Options)) (*awsecs.UpdateServiceOutput, error) {
+	return m.updateServiceFunc(ctx, params, optFns...)
+}
+func (m *mockECSAPI) StopTask(ctx context.Context, params *awsecs.StopTaskInput, optFns ...func(*awsecs.Options)) (*awsecs.StopTaskOutput, error) {
+	return m.stopTaskFunc(ctx, params, optFns...)
+}
 
 func TestListClusters(t *testing.T) {
 	mock := &mockECSAPI{
@@ -0,0 +1,29 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// ScaleNodeGroup changes the scaling config of a managed node group. The
+// group's Auto Scaling group keeps between minSize and maxSize nodes, starting
+// at desiredSize.
+func (c *Client) ScaleNodeGroup(ctx context.Context, clusterName, nodeGroup string, minSize, maxSize, desiredSize int) error {
+	_, err := c.api.UpdateNodegroupConfig(ctx, &awseks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodeGroup),
+		ScalingConfig: &ekstypes.NodegroupScalingConfig{
+			MinSize:     aws.Int32(int32(minSize)),
+			MaxSize:     aws.Int32(int32(maxSize)),
+			DesiredSize: aws.Int32(int32(desiredSize)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("UpdateNodegroupConfig(%s/%s): %w", clusterName, nodeGroup, err)
+	}
+	return nil
+}
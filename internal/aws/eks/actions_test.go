@@ -0,0 +1,52 @@
+package eks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+)
+
+func TestScaleNodeGroup(t *testing.T) {
+	var got *awseks.UpdateNodegroupConfigInput
+	mock := &mockEKSAPI{
+		updateNodegroupConfigFunc: func(ctx context.Context, params *awseks.UpdateNodegroupConfigInput, optFns ...func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error) {
+			got = params
+			return &awseks.UpdateNodegroupConfigOutput{}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	if err := client.ScaleNodeGroup(context.Background(), "my-cluster", "ng-1", 1, 6, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if awssdk.ToString(got.ClusterName) != "my-cluster" || awssdk.ToString(got.NodegroupName) != "ng-1" {
+		t.Errorf("node group = %s/%s, want my-cluster/ng-1", awssdk.ToString(got.ClusterName), awssdk.ToString(got.NodegroupName))
+	}
+	sc := got.ScalingConfig
+	if sc == nil {
+		t.Fatal("ScalingConfig is nil")
+	}
+	if awssdk.ToInt32(sc.MinSize) != 1 || awssdk.ToInt32(sc.MaxSize) != 6 || awssdk.ToInt32(sc.DesiredSize) != 4 {
+		t.Errorf("ScalingConfig = %d/%d/%d, want 1/6/4", awssdk.ToInt32(sc.MinSize), awssdk.ToInt32(sc.MaxSize), awssdk.ToInt32(sc.DesiredSize))
+	}
+}
+
+func TestScaleNodeGroup_Error(t *testing.T) {
+	mock := &mockEKSAPI{
+		updateNodegroupConfigFunc: func(ctx context.Context, params *awseks.UpdateNodegroupConfigInput, optFns ...func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error) {
+			return nil, errors.New("InvalidParameterException")
+		},
+	}
+
+	client := NewClient(mock)
+	err := client.ScaleNodeGroup(context.Background(), "my-cluster", "ng-1", 5, 2, 3)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if want := "UpdateNodegroupConfig(my-cluster/ng-1): InvalidParameterException"; err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
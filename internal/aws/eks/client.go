@@ -20,6 +20,10 @@ type EKSAPI interface {
 	DescribeFargateProfile(ctx context.Context, params *awseks.DescribeFargateProfileInput, optFns ...func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error)
 	ListAccessEntries(ctx context.Context, params *awseks.ListAccessEntriesInput, optFns ...func(*awseks.Options)) (*awseks.ListAccessEntriesOutput, error)
 	DescribeAccessEntry(ctx context.Context, params *awseks.DescribeAccessEntryInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error)
+	UpdateNodegroupConfig(ctx context.Context, params *awseks.UpdateNodegroupConfigInput, optFns ...func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error)
+	DescribeAddonVersions(ctx context.Context, params *awseks.DescribeAddonVersionsInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAddonVersionsOutput, error)
+	ListInsights(ctx context.Context, params *awseks.ListInsightsInput, optFns ...func(*awseks.Options)) (*awseks.ListInsightsOutput, error)
+	DescribeInsight(ctx context.Context, params *awseks.DescribeInsightInput, optFns ...func(*awseks.Options)) (*awseks.DescribeInsightOutput, error)
 }
 
 type Client struct {
@@ -170,6 +174,8 @@ func (c *Client) ListNodeGroups(ctx context.Context, clusterName string) ([]EKSN
 			Name:           aws.ToString(ng.NodegroupName),
 			ARN:            aws.ToString(ng.NodegroupArn),
 			Status:         string(ng.Status),
+			Version:        aws.ToString(ng.Version),
+			ReleaseVersion: aws.ToString(ng.ReleaseVersion),
 			InstanceTypes:  ng.InstanceTypes,
 			AMIType:        string(ng.AmiType),
 			MinSize:        minSize,
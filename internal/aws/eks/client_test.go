@@ -21,6 +21,10 @@ type mockEKSAPI struct {
 	describeFargateProfileFunc func(ctx context.Context, params *awseks.DescribeFargateProfileInput, optFns ...func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error)
 	listAccessEntriesFunc      func(ctx context.Context, params *awseks.ListAccessEntriesInput, optFns ...func(*awseks.Options)) (*awseks.ListAccessEntriesOutput, error)
 	describeAccessEntryFunc    func(ctx context.Context, params *awseks.DescribeAccessEntryInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error)
+	updateNodegroupConfigFunc  func(ctx context.Context, params *awseks.UpdateNodegroupConfigInput, optFns ...func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error)
+	describeAddonVersionsFunc  func(ctx context.Context, params *awseks.DescribeAddonVersionsInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAddonVersionsOutput, error)
+	listInsightsFunc           func(ctx context.Context, params *awseks.ListInsightsInput, optFns ...func(*awseks.Options)) (*awseks.ListInsightsOutput, error)
+	describeInsightFunc        func(ctx context.Context, params *awseks.DescribeInsightInput, optFns ...func(*awseks.Options)) (*awseks.DescribeInsightOutput, error)
 }
 
 func (m *mockEKSAPI) ListClusters(ctx context.Context, params *awseks.ListClustersInput, optFns ...func(*awseks.Options)) (*awseks.ListClustersOutput, error) {
@@ -63,6 +67,22 @@ func (m *mockEKSAPI) DescribeAccessEntry(ctx context.Context, params *awseks.Des
 	return m.describeAccessEntryFunc(ctx, params, optFns...)
 }
 
+func (m *mockEKSAPI) UpdateNodegroupConfig(ctx context.Context, params *awseks.UpdateNodegroupConfigInput, optFns ...func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error) {
+	return m.updateNodegroupConfigFunc(ctx, params, optFns...)
+}
+
+func (m *mockEKSAPI) DescribeAddonVersions(ctx context.Context, params *awseks.DescribeAddonVersionsInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAddonVersionsOutput, error) {
+	return m.describeAddonVersionsFunc(ctx, params, optFns...)
+}
+
+func (m *mockEKSAPI) ListInsights(ctx context.Context, params *awseks.ListInsightsInput, optFns ...func(*awseks.Options)) (*awseks.ListInsightsOutput, error) {
+	return m.listInsightsFunc(ctx, params, optFns...)
+}
+
+func (m *mockEKSAPI) DescribeInsight(ctx context.Context, params *awseks.DescribeInsightInput, optFns ...func(*awseks.Options)) (*awseks.DescribeInsightOutput, error) {
+	return m.describeInsightFunc(ctx, params, optFns...)
+}
+
 func TestListClusters(t *testing.T) {
 	created := time.Date(2025, 6, 15, 10, 30, 0, 0, time.UTC)
 
@@ -216,11 +236,13 @@ func TestListNodeGroups(t *testing.T) {
 			}
 			return &awseks.DescribeNodegroupOutput{
 				Nodegroup: &ekstypes.Nodegroup{
-					NodegroupName: awssdk.String("ng-1"),
-					NodegroupArn:  awssdk.String("arn:aws:eks:us-east-1:123456789012:nodegroup/my-cluster/ng-1/abc"),
-					Status:        ekstypes.NodegroupStatusActive,
-					InstanceTypes: []string{"m5.large", "m5.xlarge"},
-					AmiType:       ekstypes.AMITypesAl2X8664,
+					NodegroupName:  awssdk.String("ng-1"),
+					NodegroupArn:   awssdk.String("arn:aws:eks:us-east-1:123456789012:nodegroup/my-cluster/ng-1/abc"),
+					Status:         ekstypes.NodegroupStatusActive,
+					Version:        awssdk.String("1.29"),
+					ReleaseVersion: awssdk.String("1.29.3-20240531"),
+					InstanceTypes:  []string{"m5.large", "m5.xlarge"},
+					AmiType:        ekstypes.AMITypesAl2X8664,
 					ScalingConfig: &ekstypes.NodegroupScalingConfig{
 						MinSize:     awssdk.Int32(2),
 						MaxSize:     awssdk.Int32(10),
@@ -262,6 +284,9 @@ func TestListNodeGroups(t *testing.T) {
 	if ng.Status != "ACTIVE" {
 		t.Errorf("Status = %s, want ACTIVE", ng.Status)
 	}
+	if ng.Version != "1.29" || ng.ReleaseVersion != "1.29.3-20240531" {
+		t.Errorf("Version = %s/%s, want 1.29/1.29.3-20240531", ng.Version, ng.ReleaseVersion)
+	}
 	if len(ng.InstanceTypes) != 2 || ng.InstanceTypes[0] != "m5.large" || ng.InstanceTypes[1] != "m5.xlarge" {
 		t.Errorf("InstanceTypes = %v, want [m5.large m5.xlarge]", ng.InstanceTypes)
 	}
@@ -0,0 +1,98 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListWarningEvents returns the Warning events in namespace, or in every
+// namespace if it is empty, newest first.
+func (c *K8sClient) ListWarningEvents(ctx context.Context, namespace string) ([]K8sEvent, error) {
+	out, err := c.api.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "type=" + corev1.EventTypeWarning,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+	events := make([]K8sEvent, 0, len(out.Items))
+	for i := range out.Items {
+		events = append(events, toK8sEvent(&out.Items[i]))
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].LastSeen.After(events[j].LastSeen) })
+	return events, nil
+}
+
+// toK8sEvent reads an event's count and last-seen time from whichever of the
+// legacy and series fields its reporter filled in.
+func toK8sEvent(e *corev1.Event) K8sEvent {
+	count := int(e.Count)
+	last := e.LastTimestamp.Time
+	if e.Series != nil {
+		count = int(e.Series.Count)
+		last = e.Series.LastObservedTime.Time
+	}
+	if last.IsZero() {
+		last = e.EventTime.Time
+	}
+	if last.IsZero() {
+		last = e.CreationTimestamp.Time
+	}
+	return K8sEvent{
+		Namespace: e.InvolvedObject.Namespace,
+		Kind:      e.InvolvedObject.Kind,
+		Name:      e.InvolvedObject.Name,
+		Type:      e.Type,
+		Reason:    e.Reason,
+		Message:   e.Message,
+		Count:     max(count, 1),
+		LastSeen:  last,
+	}
+}
+
+// GroupEvents groups events by the object they involve. Groups are ordered
+// by when they were last seen, newest first, and each keeps the message of
+// its newest event.
+func GroupEvents(events []K8sEvent) []K8sEventGroup {
+	var groups []K8sEventGroup
+	index := make(map[string]int)
+	for _, e := range events {
+		key := e.Kind + "/" + e.Namespace + "/" + e.Name
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, K8sEventGroup{Kind: e.Kind, Namespace: e.Namespace, Name: e.Name})
+		}
+		g := &groups[i]
+		g.Count += e.Count
+		if !slices.Contains(g.Reasons, e.Reason) {
+			g.Reasons = append(g.Reasons, e.Reason)
+		}
+		if !ok || e.LastSeen.After(g.LastSeen) {
+			g.LastSeen = e.LastSeen
+			g.Message = e.Message
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].LastSeen.After(groups[j].LastSeen) })
+	return groups
+}
+
+// HasTroubleEvents reports whether any event since the given time shows pods
+// that cannot be scheduled, containers crash-looping or nodes not ready.
+func HasTroubleEvents(events []K8sEvent, since time.Time) bool {
+	for _, e := range events {
+		switch e.Reason {
+		case "FailedScheduling", "BackOff", "NodeNotReady":
+			if e.LastSeen.After(since) {
+				return true
+			}
+		}
+	}
+	return false
+}
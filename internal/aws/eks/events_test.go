@@ -0,0 +1,78 @@
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestListWarningEvents(t *testing.T) {
+	older := metav1.NewTime(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	newer := metav1.NewMicroTime(time.Date(2024, 5, 1, 12, 5, 0, 0, time.UTC))
+	api := fake.NewClientset(
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "web.1", Namespace: "prod"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "prod", Name: "web-abc"},
+			Type:           corev1.EventTypeWarning, Reason: "BackOff", Message: "Back-off restarting failed container",
+			Count: 7, LastTimestamp: older,
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "node.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Node", Name: "ip-10-0-1-5"},
+			Type:           corev1.EventTypeWarning, Reason: "NodeNotReady",
+			Series: &corev1.EventSeries{Count: 3, LastObservedTime: newer},
+		},
+	)
+	c := &K8sClient{api: api}
+
+	events, err := c.ListWarningEvents(context.Background(), "")
+	if err != nil {
+		t.Fatalf("ListWarningEvents: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("len(events) = %d, want 2", len(events))
+	}
+	if e := events[0]; e.Reason != "NodeNotReady" || e.Count != 3 || !e.LastSeen.Equal(newer.Time) {
+		t.Errorf("events[0] = %+v, want the NodeNotReady series seen 3 times", e)
+	}
+	if e := events[1]; e.Kind != "Pod" || e.Name != "web-abc" || e.Count != 7 || !e.LastSeen.Equal(older.Time) {
+		t.Errorf("events[1] = %+v, want the pod BackOff seen 7 times", e)
+	}
+}
+
+func TestGroupEvents(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	groups := GroupEvents([]K8sEvent{
+		{Kind: "Pod", Namespace: "prod", Name: "web-abc", Reason: "BackOff", Message: "old", Count: 2, LastSeen: at},
+		{Kind: "Node", Name: "ip-10-0-1-5", Reason: "NodeNotReady", Count: 1, LastSeen: at.Add(time.Minute)},
+		{Kind: "Pod", Namespace: "prod", Name: "web-abc", Reason: "Unhealthy", Message: "new", Count: 3, LastSeen: at.Add(2 * time.Minute)},
+	})
+	if len(groups) != 2 {
+		t.Fatalf("len(groups) = %d, want 2", len(groups))
+	}
+	g := groups[0]
+	if g.Name != "web-abc" || g.Count != 5 || g.Message != "new" || len(g.Reasons) != 2 {
+		t.Errorf("groups[0] = %+v, want web-abc with 5 events and the newest message", g)
+	}
+	if groups[1].Kind != "Node" {
+		t.Errorf("groups[1].Kind = %q, want Node", groups[1].Kind)
+	}
+}
+
+func TestHasTroubleEvents(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	events := []K8sEvent{
+		{Reason: "Unhealthy", LastSeen: now},
+		{Reason: "FailedScheduling", LastSeen: now.Add(-time.Hour)},
+	}
+	if HasTroubleEvents(events, now.Add(-15*time.Minute)) {
+		t.Error("HasTroubleEvents = true for an old FailedScheduling event")
+	}
+	if !HasTroubleEvents(events, now.Add(-2*time.Hour)) {
+		t.Error("HasTroubleEvents = false for a recent FailedScheduling event")
+	}
+}
@@ -0,0 +1,103 @@
+package eks
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// terminalPollInterval is how often an exec session checks whether the local
+// terminal has been resized.
+const terminalPollInterval = 250 * time.Millisecond
+
+// PodExec is an interactive command in a pod's container, run over the
+// Kubernetes SPDY exec protocol with a TTY. It needs no kubectl. PodExec
+// satisfies tea.ExecCommand, so the TUI can hand it the terminal.
+type PodExec struct {
+	config *rest.Config
+	url    *url.URL
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+// Exec prepares command to run in container of pod. Nothing is sent until
+// Run.
+func (c *K8sClient) Exec(namespace, pod, container string, command []string) *PodExec {
+	req := c.api.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+	return &PodExec{config: c.Config, url: req.URL(), stdin: os.Stdin, stdout: os.Stdout}
+}
+
+func (e *PodExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *PodExec) SetStdout(w io.Writer) { e.stdout = w }
+
+// SetStderr is a no-op: with a TTY the container's stderr arrives on stdout.
+func (e *PodExec) SetStderr(io.Writer) {}
+
+// Run streams the session until the command exits. When stdin is a terminal
+// it is put in raw mode and its size is kept in sync with the container's.
+func (e *PodExec) Run() error {
+	executor, err := remotecommand.NewSPDYExecutor(e.config, "POST", e.url)
+	if err != nil {
+		return err
+	}
+
+	opts := remotecommand.StreamOptions{Stdin: e.stdin, Stdout: e.stdout, Tty: true}
+	if f, ok := e.stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fd := int(f.Fd())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		defer term.Restore(fd, state)
+
+		sizes := &terminalSizeQueue{fd: fd, done: make(chan struct{})}
+		defer close(sizes.done)
+		opts.TerminalSizeQueue = sizes
+	}
+	return executor.StreamWithContext(context.Background(), opts)
+}
+
+// terminalSizeQueue reports the local terminal's size when it changes.
+type terminalSizeQueue struct {
+	fd   int
+	last remotecommand.TerminalSize
+	done chan struct{}
+}
+
+// Next blocks until the terminal's size differs from the last one reported,
+// and returns nil once the session has ended.
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	for {
+		if w, h, err := term.GetSize(q.fd); err == nil {
+			size := remotecommand.TerminalSize{Width: uint16(w), Height: uint16(h)}
+			if size != q.last {
+				q.last = size
+				return &size
+			}
+		}
+		select {
+		case <-q.done:
+			return nil
+		case <-time.After(terminalPollInterval):
+		}
+	}
+}
@@ -14,6 +14,8 @@ type K8sClient struct {
 	Token       *TokenProvider
 	Config      *rest.Config
 	ClusterName string
+
+	api kubernetes.Interface // Clientset, or a fake in tests
 }
 
 // NewK8sClient creates a K8s client from EKS cluster details.
@@ -40,5 +42,6 @@ func NewK8sClient(endpoint, caData string, tokenProvider *TokenProvider) (*K8sCl
 		Clientset: clientset,
 		Token:     tokenProvider,
 		Config:    config,
+		api:       clientset,
 	}, nil
 }
@@ -0,0 +1,58 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodLogOptions selects the part of a container's log StreamPodLogs returns.
+type PodLogOptions struct {
+	Container string
+	Follow    bool      // keep the stream open for new lines
+	Previous  bool      // the previous, terminated instance of the container
+	Since     time.Time // zero for no lower bound
+	TailLines int       // 0 for every line
+}
+
+// StreamPodLogs opens a container's log. Each line is prefixed with its
+// RFC 3339 timestamp; split it with ParsePodLogLine. The caller closes the
+// stream, or cancels ctx to end a followed one.
+func (c *K8sClient) StreamPodLogs(ctx context.Context, namespace, pod string, opts PodLogOptions) (io.ReadCloser, error) {
+	o := &corev1.PodLogOptions{
+		Container:  opts.Container,
+		Follow:     opts.Follow,
+		Previous:   opts.Previous,
+		Timestamps: true,
+	}
+	if !opts.Since.IsZero() {
+		since := metav1.NewTime(opts.Since)
+		o.SinceTime = &since
+	}
+	if opts.TailLines > 0 {
+		tail := int64(opts.TailLines)
+		o.TailLines = &tail
+	}
+	stream, err := c.api.CoreV1().Pods(namespace).GetLogs(pod, o).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("stream pod logs: %w", err)
+	}
+	return stream, nil
+}
+
+// ParsePodLogLine splits a timestamped log line into its time and message.
+// A line without a timestamp is returned whole with a zero time.
+func ParsePodLogLine(line string) (time.Time, string) {
+	ts, msg, ok := strings.Cut(line, " ")
+	if ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			return t, msg
+		}
+	}
+	return time.Time{}, line
+}
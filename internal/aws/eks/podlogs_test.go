@@ -0,0 +1,53 @@
+package eks
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStreamPodLogs(t *testing.T) {
+	api := fake.NewClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "prod"}})
+	c := &K8sClient{api: api}
+
+	stream, err := c.StreamPodLogs(context.Background(), "prod", "web-abc", PodLogOptions{
+		Container: "app",
+		Previous:  true,
+		Since:     time.Now().Add(-time.Hour),
+		TailLines: 100,
+	})
+	if err != nil {
+		t.Fatalf("StreamPodLogs: %v", err)
+	}
+	defer stream.Close()
+	body, err := io.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if len(body) == 0 {
+		t.Error("stream is empty")
+	}
+}
+
+func TestParsePodLogLine(t *testing.T) {
+	at, msg := ParsePodLogLine("2024-05-01T12:00:01.123456789Z GET /healthz 200")
+	if want := time.Date(2024, 5, 1, 12, 0, 1, 123456789, time.UTC); !at.Equal(want) {
+		t.Errorf("time = %v, want %v", at, want)
+	}
+	if msg != "GET /healthz 200" {
+		t.Errorf("message = %q, want %q", msg, "GET /healthz 200")
+	}
+
+	at, msg = ParsePodLogLine("no timestamp here")
+	if !at.IsZero() {
+		t.Errorf("time = %v, want zero", at)
+	}
+	if msg != "no timestamp here" {
+		t.Errorf("message = %q, want the whole line", msg)
+	}
+}
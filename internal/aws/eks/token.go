@@ -72,6 +72,13 @@ func (tp *TokenProvider) GetToken() (string, error) {
 	return tp.token, nil
 }
 
+// Invalidate drops the cached token so the next request generates a new one.
+func (tp *TokenProvider) Invalidate() {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.token = ""
+}
+
 // generateToken creates a presigned STS GetCallerIdentity URL and encodes it
 // as an EKS bearer token. This implements the same mechanism as `aws eks get-token`.
 //
@@ -147,7 +154,13 @@ func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req = req.Clone(req.Context())
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	return t.base.RoundTrip(req)
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		// The API server rejected the token, e.g. because of clock skew or a
+		// changed session; the next request presigns a fresh one.
+		t.provider.Invalidate()
+	}
+	return resp, err
 }
 
 // tokenHasPrefix checks if a token has the expected k8s-aws-v1. prefix.
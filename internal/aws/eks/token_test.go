@@ -202,6 +202,39 @@ func TestWrapTransport(t *testing.T) {
 	}
 }
 
+func TestWrapTransport_UnauthorizedRegeneratesToken(t *testing.T) {
+	callCount := 0
+	tp := &TokenProvider{
+		generate: func() (string, time.Time, error) {
+			callCount++
+			return fmt.Sprintf("k8s-aws-v1.token-%d", callCount), time.Now().Add(15 * time.Minute), nil
+		},
+	}
+
+	var auth []string
+	status := http.StatusUnauthorized
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		auth = append(auth, req.Header.Get("Authorization"))
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+	wrapped := tp.WrapTransport(base)
+
+	for range 2 {
+		req, _ := http.NewRequest("GET", "https://k8s-api.example.com/api/v1/pods", nil)
+		resp, err := wrapped.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip error: %v", err)
+		}
+		resp.Body.Close()
+		status = http.StatusOK
+	}
+
+	want := []string{"Bearer k8s-aws-v1.token-1", "Bearer k8s-aws-v1.token-2"}
+	if len(auth) != 2 || auth[0] != want[0] || auth[1] != want[1] {
+		t.Errorf("Authorization headers = %v, want %v", auth, want)
+	}
+}
+
 func TestTokenHasPrefix(t *testing.T) {
 	tests := []struct {
 		token string
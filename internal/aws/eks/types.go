@@ -21,6 +21,8 @@ type EKSNodeGroup struct {
 	Name           string
 	ARN            string
 	Status         string
+	Version        string // Kubernetes version of the nodes
+	ReleaseVersion string // AMI release version
 	InstanceTypes  []string
 	AMIType        string
 	MinSize        int
@@ -48,6 +50,29 @@ type EKSAddon struct {
 	ConfigurationValues string
 }
 
+// EKSAddonVersions is the versions of an addon compatible with a Kubernetes
+// version, in the order EKS lists them.
+type EKSAddonVersions struct {
+	Name              string
+	KubernetesVersion string
+	Versions          []string
+	Default           string // installed when no version is given
+}
+
+// EKSInsight is an upgrade readiness insight: a check of the cluster against
+// a later Kubernetes version.
+type EKSInsight struct {
+	ID                string
+	Name              string
+	KubernetesVersion string
+	Status            string // PASSING, WARNING, ERROR or UNKNOWN
+	Reason            string
+	Description       string
+	Recommendation    string // only for insights that are not passing
+	Resources         int    // resources affected, only for insights that are not passing
+	LastRefreshed     time.Time
+}
+
 type EKSFargateProfile struct {
 	Name             string
 	ARN              string
@@ -69,3 +94,101 @@ type EKSAccessEntry struct {
 	Groups       []string
 	CreatedAt    time.Time
 }
+
+// K8sNamespace is a Kubernetes namespace inside an EKS cluster.
+type K8sNamespace struct {
+	Name      string
+	Status    string
+	CreatedAt time.Time
+}
+
+// K8sPod is a pod with its status summarised the way kubectl shows it.
+type K8sPod struct {
+	Name       string
+	Namespace  string
+	Status     string // phase, or the reason a container is not running
+	Ready      int    // ready containers
+	Total      int    // containers
+	Restarts   int
+	Node       string
+	IP         string
+	QoS        string
+	Owner      string // kind/name of the controlling owner
+	Labels     map[string]string
+	Containers []K8sContainer
+	CreatedAt  time.Time
+}
+
+// K8sContainer is one container of a pod.
+type K8sContainer struct {
+	Name     string
+	Image    string
+	State    string // Running, Waiting or Terminated
+	Reason   string
+	Ready    bool
+	Restarts int
+}
+
+// K8sWorkload is a deployment, statefulset or daemonset.
+type K8sWorkload struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Desired   int
+	Ready     int
+	UpToDate  int
+	Available int
+	Selector  string // label selector matching the workload's pods
+	Images    []string
+	CreatedAt time.Time
+}
+
+// K8sService is a Kubernetes service.
+type K8sService struct {
+	Name       string
+	Namespace  string
+	Type       string
+	ClusterIP  string
+	ExternalIP string
+	Ports      []string // port/protocol, with the node port if any
+	Selector   string   // label selector matching the service's pods
+	CreatedAt  time.Time
+}
+
+// K8sNode is a cluster node.
+type K8sNode struct {
+	Name         string
+	Status       string
+	Roles        []string
+	Version      string
+	InstanceType string
+	Zone         string
+	NodeGroup    string
+	InternalIP   string
+	CPU          string // allocatable
+	Memory       string // allocatable
+	CreatedAt    time.Time
+}
+
+// K8sEvent is a Kubernetes event about an object in the cluster.
+type K8sEvent struct {
+	Namespace string
+	Kind      string // kind of the involved object
+	Name      string // name of the involved object
+	Type      string // Normal or Warning
+	Reason    string
+	Message   string
+	Count     int
+	LastSeen  time.Time
+}
+
+// K8sEventGroup is the events about one object.
+type K8sEventGroup struct {
+	Namespace string
+	Kind      string
+	Name      string
+	Reasons   []string
+	Message   string // of the newest event
+	Count     int
+	LastSeen  time.Time
+}
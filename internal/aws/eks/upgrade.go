@@ -0,0 +1,117 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// AddonVersions lists the versions of an addon compatible with a Kubernetes
+// version.
+func (c *Client) AddonVersions(ctx context.Context, addonName, kubernetesVersion string) (EKSAddonVersions, error) {
+	result := EKSAddonVersions{Name: addonName, KubernetesVersion: kubernetesVersion}
+	var nextToken *string
+
+	for {
+		out, err := c.api.DescribeAddonVersions(ctx, &awseks.DescribeAddonVersionsInput{
+			AddonName:         aws.String(addonName),
+			KubernetesVersion: aws.String(kubernetesVersion),
+			NextToken:         nextToken,
+		})
+		if err != nil {
+			return EKSAddonVersions{}, fmt.Errorf("DescribeAddonVersions(%s, %s): %w", addonName, kubernetesVersion, err)
+		}
+
+		for _, info := range out.Addons {
+			if aws.ToString(info.AddonName) != addonName {
+				continue
+			}
+			for _, v := range info.AddonVersions {
+				version := aws.ToString(v.AddonVersion)
+				for _, compat := range v.Compatibilities {
+					if aws.ToString(compat.ClusterVersion) != kubernetesVersion {
+						continue
+					}
+					result.Versions = append(result.Versions, version)
+					if compat.DefaultVersion {
+						result.Default = version
+					}
+					break
+				}
+			}
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return result, nil
+}
+
+// ListUpgradeInsights lists the cluster's upgrade readiness insights.
+// Insights that are not passing are described to include their
+// recommendation and the resources they affect.
+func (c *Client) ListUpgradeInsights(ctx context.Context, clusterName string) ([]EKSInsight, error) {
+	var summaries []ekstypes.InsightSummary
+	var nextToken *string
+
+	for {
+		out, err := c.api.ListInsights(ctx, &awseks.ListInsightsInput{
+			ClusterName: aws.String(clusterName),
+			Filter: &ekstypes.InsightsFilter{
+				Categories: []ekstypes.Category{ekstypes.CategoryUpgradeReadiness},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListInsights(%s): %w", clusterName, err)
+		}
+
+		summaries = append(summaries, out.Insights...)
+
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	insights := make([]EKSInsight, 0, len(summaries))
+	for _, s := range summaries {
+		insight := EKSInsight{
+			ID:                aws.ToString(s.Id),
+			Name:              aws.ToString(s.Name),
+			KubernetesVersion: aws.ToString(s.KubernetesVersion),
+			Description:       aws.ToString(s.Description),
+		}
+		if s.InsightStatus != nil {
+			insight.Status = string(s.InsightStatus.Status)
+			insight.Reason = aws.ToString(s.InsightStatus.Reason)
+		}
+		if s.LastRefreshTime != nil {
+			insight.LastRefreshed = *s.LastRefreshTime
+		}
+
+		if insight.Status != string(ekstypes.InsightStatusValuePassing) {
+			out, err := c.api.DescribeInsight(ctx, &awseks.DescribeInsightInput{
+				ClusterName: aws.String(clusterName),
+				Id:          s.Id,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("DescribeInsight(%s/%s): %w", clusterName, insight.ID, err)
+			}
+			if out.Insight != nil {
+				insight.Recommendation = aws.ToString(out.Insight.Recommendation)
+				insight.Resources = len(out.Insight.Resources)
+			}
+		}
+
+		insights = append(insights, insight)
+	}
+
+	return insights, nil
+}
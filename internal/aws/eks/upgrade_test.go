@@ -0,0 +1,149 @@
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+func TestAddonVersions(t *testing.T) {
+	calls := 0
+	mock := &mockEKSAPI{
+		describeAddonVersionsFunc: func(ctx context.Context, params *awseks.DescribeAddonVersionsInput, optFns ...func(*awseks.Options)) (*awseks.DescribeAddonVersionsOutput, error) {
+			calls++
+			if awssdk.ToString(params.AddonName) != "vpc-cni" || awssdk.ToString(params.KubernetesVersion) != "1.30" {
+				t.Errorf("input = %s/%s, want vpc-cni/1.30", awssdk.ToString(params.AddonName), awssdk.ToString(params.KubernetesVersion))
+			}
+			if params.NextToken == nil {
+				return &awseks.DescribeAddonVersionsOutput{
+					Addons: []ekstypes.AddonInfo{{
+						AddonName: awssdk.String("vpc-cni"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{
+								AddonVersion: awssdk.String("v1.18.3-eksbuild.1"),
+								Compatibilities: []ekstypes.Compatibility{
+									{ClusterVersion: awssdk.String("1.29")},
+									{ClusterVersion: awssdk.String("1.30")},
+								},
+							},
+							{
+								AddonVersion: awssdk.String("v1.18.1-eksbuild.3"),
+								Compatibilities: []ekstypes.Compatibility{
+									{ClusterVersion: awssdk.String("1.30"), DefaultVersion: true},
+								},
+							},
+							{
+								AddonVersion:    awssdk.String("v1.15.0-eksbuild.2"),
+								Compatibilities: []ekstypes.Compatibility{{ClusterVersion: awssdk.String("1.28")}},
+							},
+						},
+					}},
+					NextToken: awssdk.String("page2"),
+				}, nil
+			}
+			return &awseks.DescribeAddonVersionsOutput{
+				Addons: []ekstypes.AddonInfo{{
+					AddonName: awssdk.String("vpc-cni"),
+					AddonVersions: []ekstypes.AddonVersionInfo{{
+						AddonVersion:    awssdk.String("v1.17.1-eksbuild.1"),
+						Compatibilities: []ekstypes.Compatibility{{ClusterVersion: awssdk.String("1.30")}},
+					}},
+				}},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	got, err := client.AddonVersions(context.Background(), "vpc-cni", "1.30")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("DescribeAddonVersions calls = %d, want 2", calls)
+	}
+	want := []string{"v1.18.3-eksbuild.1", "v1.18.1-eksbuild.3", "v1.17.1-eksbuild.1"}
+	if len(got.Versions) != len(want) {
+		t.Fatalf("Versions = %v, want %v", got.Versions, want)
+	}
+	for i := range want {
+		if got.Versions[i] != want[i] {
+			t.Errorf("Versions[%d] = %s, want %s", i, got.Versions[i], want[i])
+		}
+	}
+	if got.Default != "v1.18.1-eksbuild.3" {
+		t.Errorf("Default = %s, want v1.18.1-eksbuild.3", got.Default)
+	}
+	if got.Name != "vpc-cni" || got.KubernetesVersion != "1.30" {
+		t.Errorf("Name/KubernetesVersion = %s/%s, want vpc-cni/1.30", got.Name, got.KubernetesVersion)
+	}
+}
+
+func TestListUpgradeInsights(t *testing.T) {
+	refreshed := time.Date(2025, 6, 15, 10, 30, 0, 0, time.UTC)
+	var described []string
+	mock := &mockEKSAPI{
+		listInsightsFunc: func(ctx context.Context, params *awseks.ListInsightsInput, optFns ...func(*awseks.Options)) (*awseks.ListInsightsOutput, error) {
+			if params.Filter == nil || len(params.Filter.Categories) != 1 || params.Filter.Categories[0] != ekstypes.CategoryUpgradeReadiness {
+				t.Errorf("Filter = %+v, want the UPGRADE_READINESS category", params.Filter)
+			}
+			return &awseks.ListInsightsOutput{
+				Insights: []ekstypes.InsightSummary{
+					{
+						Id:                awssdk.String("ins-1"),
+						Name:              awssdk.String("Deprecated APIs removed in Kubernetes v1.30"),
+						KubernetesVersion: awssdk.String("1.30"),
+						InsightStatus: &ekstypes.InsightStatus{
+							Status: ekstypes.InsightStatusValueError,
+							Reason: awssdk.String("Deprecated API usage detected within last 30 days"),
+						},
+						LastRefreshTime: &refreshed,
+					},
+					{
+						Id:            awssdk.String("ins-2"),
+						Name:          awssdk.String("Cluster health issues"),
+						InsightStatus: &ekstypes.InsightStatus{Status: ekstypes.InsightStatusValuePassing},
+					},
+				},
+			}, nil
+		},
+		describeInsightFunc: func(ctx context.Context, params *awseks.DescribeInsightInput, optFns ...func(*awseks.Options)) (*awseks.DescribeInsightOutput, error) {
+			described = append(described, awssdk.ToString(params.Id))
+			return &awseks.DescribeInsightOutput{
+				Insight: &ekstypes.Insight{
+					Recommendation: awssdk.String("Update manifests to use the replacement APIs"),
+					Resources:      []ekstypes.InsightResourceDetail{{}, {}},
+				},
+			}, nil
+		},
+	}
+
+	client := NewClient(mock)
+	insights, err := client.ListUpgradeInsights(context.Background(), "my-cluster")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(insights) != 2 {
+		t.Fatalf("expected 2 insights, got %d", len(insights))
+	}
+	if len(described) != 1 || described[0] != "ins-1" {
+		t.Errorf("described = %v, want only the failing insight", described)
+	}
+
+	ins := insights[0]
+	if ins.Status != "ERROR" || ins.Reason != "Deprecated API usage detected within last 30 days" {
+		t.Errorf("Status/Reason = %s/%s", ins.Status, ins.Reason)
+	}
+	if ins.KubernetesVersion != "1.30" || !ins.LastRefreshed.Equal(refreshed) {
+		t.Errorf("KubernetesVersion/LastRefreshed = %s/%v", ins.KubernetesVersion, ins.LastRefreshed)
+	}
+	if ins.Recommendation != "Update manifests to use the replacement APIs" || ins.Resources != 2 {
+		t.Errorf("Recommendation/Resources = %s/%d", ins.Recommendation, ins.Resources)
+	}
+	if insights[1].Status != "PASSING" || insights[1].Recommendation != "" {
+		t.Errorf("passing insight = %+v", insights[1])
+	}
+}
@@ -0,0 +1,307 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// PodFilter narrows ListPods to the pods of a workload, service or node.
+type PodFilter struct {
+	LabelSelector string
+	FieldSelector string
+}
+
+// ListNamespaces returns the cluster's namespaces sorted by name.
+func (c *K8sClient) ListNamespaces(ctx context.Context) ([]K8sNamespace, error) {
+	out, err := c.api.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+	namespaces := make([]K8sNamespace, 0, len(out.Items))
+	for _, ns := range out.Items {
+		namespaces = append(namespaces, K8sNamespace{
+			Name:      ns.Name,
+			Status:    string(ns.Status.Phase),
+			CreatedAt: ns.CreationTimestamp.Time,
+		})
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+	return namespaces, nil
+}
+
+// ListPods returns the pods in namespace, or in every namespace if it is
+// empty, that match filter.
+func (c *K8sClient) ListPods(ctx context.Context, namespace string, filter PodFilter) ([]K8sPod, error) {
+	out, err := c.api.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: filter.LabelSelector,
+		FieldSelector: filter.FieldSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+	pods := make([]K8sPod, 0, len(out.Items))
+	for i := range out.Items {
+		pods = append(pods, toK8sPod(&out.Items[i]))
+	}
+	return pods, nil
+}
+
+// GetPod returns a single pod.
+func (c *K8sClient) GetPod(ctx context.Context, namespace, name string) (K8sPod, error) {
+	pod, err := c.api.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return K8sPod{}, fmt.Errorf("get pod: %w", err)
+	}
+	return toK8sPod(pod), nil
+}
+
+// ListDeployments returns the deployments in namespace, or in every
+// namespace if it is empty.
+func (c *K8sClient) ListDeployments(ctx context.Context, namespace string) ([]K8sWorkload, error) {
+	out, err := c.api.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list deployments: %w", err)
+	}
+	workloads := make([]K8sWorkload, 0, len(out.Items))
+	for _, d := range out.Items {
+		desired := 1
+		if d.Spec.Replicas != nil {
+			desired = int(*d.Spec.Replicas)
+		}
+		workloads = append(workloads, K8sWorkload{
+			Kind:      "Deployment",
+			Name:      d.Name,
+			Namespace: d.Namespace,
+			Desired:   desired,
+			Ready:     int(d.Status.ReadyReplicas),
+			UpToDate:  int(d.Status.UpdatedReplicas),
+			Available: int(d.Status.AvailableReplicas),
+			Selector:  selectorString(d.Spec.Selector),
+			Images:    images(d.Spec.Template.Spec),
+			CreatedAt: d.CreationTimestamp.Time,
+		})
+	}
+	return workloads, nil
+}
+
+// ListStatefulSets returns the statefulsets in namespace, or in every
+// namespace if it is empty.
+func (c *K8sClient) ListStatefulSets(ctx context.Context, namespace string) ([]K8sWorkload, error) {
+	out, err := c.api.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list statefulsets: %w", err)
+	}
+	workloads := make([]K8sWorkload, 0, len(out.Items))
+	for _, s := range out.Items {
+		desired := 1
+		if s.Spec.Replicas != nil {
+			desired = int(*s.Spec.Replicas)
+		}
+		workloads = append(workloads, K8sWorkload{
+			Kind:      "StatefulSet",
+			Name:      s.Name,
+			Namespace: s.Namespace,
+			Desired:   desired,
+			Ready:     int(s.Status.ReadyReplicas),
+			UpToDate:  int(s.Status.UpdatedReplicas),
+			Available: int(s.Status.AvailableReplicas),
+			Selector:  selectorString(s.Spec.Selector),
+			Images:    images(s.Spec.Template.Spec),
+			CreatedAt: s.CreationTimestamp.Time,
+		})
+	}
+	return workloads, nil
+}
+
+// ListDaemonSets returns the daemonsets in namespace, or in every namespace
+// if it is empty.
+func (c *K8sClient) ListDaemonSets(ctx context.Context, namespace string) ([]K8sWorkload, error) {
+	out, err := c.api.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list daemonsets: %w", err)
+	}
+	workloads := make([]K8sWorkload, 0, len(out.Items))
+	for _, d := range out.Items {
+		workloads = append(workloads, K8sWorkload{
+			Kind:      "DaemonSet",
+			Name:      d.Name,
+			Namespace: d.Namespace,
+			Desired:   int(d.Status.DesiredNumberScheduled),
+			Ready:     int(d.Status.NumberReady),
+			UpToDate:  int(d.Status.UpdatedNumberScheduled),
+			Available: int(d.Status.NumberAvailable),
+			Selector:  selectorString(d.Spec.Selector),
+			Images:    images(d.Spec.Template.Spec),
+			CreatedAt: d.CreationTimestamp.Time,
+		})
+	}
+	return workloads, nil
+}
+
+// ListServices returns the services in namespace, or in every namespace if
+// it is empty.
+func (c *K8sClient) ListServices(ctx context.Context, namespace string) ([]K8sService, error) {
+	out, err := c.api.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list services: %w", err)
+	}
+	services := make([]K8sService, 0, len(out.Items))
+	for _, s := range out.Items {
+		svc := K8sService{
+			Name:      s.Name,
+			Namespace: s.Namespace,
+			Type:      string(s.Spec.Type),
+			ClusterIP: s.Spec.ClusterIP,
+			CreatedAt: s.CreationTimestamp.Time,
+		}
+		if len(s.Spec.Selector) > 0 {
+			svc.Selector = labels.SelectorFromSet(s.Spec.Selector).String()
+		}
+		var external []string
+		for _, ing := range s.Status.LoadBalancer.Ingress {
+			if ing.Hostname != "" {
+				external = append(external, ing.Hostname)
+			} else if ing.IP != "" {
+				external = append(external, ing.IP)
+			}
+		}
+		external = append(external, s.Spec.ExternalIPs...)
+		svc.ExternalIP = strings.Join(external, ",")
+		for _, p := range s.Spec.Ports {
+			port := fmt.Sprintf("%d/%s", p.Port, p.Protocol)
+			if p.NodePort != 0 {
+				port = fmt.Sprintf("%d:%d/%s", p.Port, p.NodePort, p.Protocol)
+			}
+			svc.Ports = append(svc.Ports, port)
+		}
+		services = append(services, svc)
+	}
+	return services, nil
+}
+
+// ListNodes returns the cluster's nodes sorted by name.
+func (c *K8sClient) ListNodes(ctx context.Context) ([]K8sNode, error) {
+	out, err := c.api.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+	nodes := make([]K8sNode, 0, len(out.Items))
+	for _, n := range out.Items {
+		node := K8sNode{
+			Name:         n.Name,
+			Status:       "NotReady",
+			Version:      n.Status.NodeInfo.KubeletVersion,
+			InstanceType: n.Labels["node.kubernetes.io/instance-type"],
+			Zone:         n.Labels["topology.kubernetes.io/zone"],
+			NodeGroup:    n.Labels["eks.amazonaws.com/nodegroup"],
+			CPU:          n.Status.Allocatable.Cpu().String(),
+			Memory:       n.Status.Allocatable.Memory().String(),
+			CreatedAt:    n.CreationTimestamp.Time,
+		}
+		for _, cond := range n.Status.Conditions {
+			if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
+				node.Status = "Ready"
+			}
+		}
+		if n.Spec.Unschedulable {
+			node.Status += ",SchedulingDisabled"
+		}
+		for label := range n.Labels {
+			if role, ok := strings.CutPrefix(label, "node-role.kubernetes.io/"); ok {
+				node.Roles = append(node.Roles, role)
+			}
+		}
+		sort.Strings(node.Roles)
+		for _, addr := range n.Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP {
+				node.InternalIP = addr.Address
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes, nil
+}
+
+// toK8sPod summarises pod, deriving its status as kubectl get pods does: a
+// waiting or terminated container's reason takes precedence over the phase.
+func toK8sPod(pod *corev1.Pod) K8sPod {
+	p := K8sPod{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Status:    string(pod.Status.Phase),
+		Total:     len(pod.Spec.Containers),
+		Node:      pod.Spec.NodeName,
+		IP:        pod.Status.PodIP,
+		QoS:       string(pod.Status.QOSClass),
+		Labels:    pod.Labels,
+		CreatedAt: pod.CreationTimestamp.Time,
+	}
+	if pod.Status.Reason != "" {
+		p.Status = pod.Status.Reason
+	}
+	for _, ref := range pod.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			p.Owner = ref.Kind + "/" + ref.Name
+		}
+	}
+
+	statuses := make(map[string]corev1.ContainerStatus, len(pod.Status.ContainerStatuses))
+	for _, cs := range pod.Status.ContainerStatuses {
+		statuses[cs.Name] = cs
+	}
+	for _, spec := range pod.Spec.Containers {
+		c := K8sContainer{Name: spec.Name, Image: spec.Image, State: "Waiting"}
+		if cs, ok := statuses[spec.Name]; ok {
+			c.Ready = cs.Ready
+			c.Restarts = int(cs.RestartCount)
+			switch {
+			case cs.State.Running != nil:
+				c.State = "Running"
+			case cs.State.Waiting != nil:
+				c.Reason = cs.State.Waiting.Reason
+			case cs.State.Terminated != nil:
+				c.State = "Terminated"
+				c.Reason = cs.State.Terminated.Reason
+			}
+		}
+		if c.Ready {
+			p.Ready++
+		}
+		p.Restarts += c.Restarts
+		if c.Reason != "" && c.Reason != "Completed" {
+			p.Status = c.Reason
+		}
+		p.Containers = append(p.Containers, c)
+	}
+
+	if pod.DeletionTimestamp != nil {
+		p.Status = "Terminating"
+	}
+	return p
+}
+
+func selectorString(sel *metav1.LabelSelector) string {
+	if sel == nil {
+		return ""
+	}
+	s, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return ""
+	}
+	return s.String()
+}
+
+func images(spec corev1.PodSpec) []string {
+	out := make([]string, 0, len(spec.Containers))
+	for _, c := range spec.Containers {
+		out = append(out, c.Image)
+	}
+	return out
+}
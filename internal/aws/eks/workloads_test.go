@@ -0,0 +1,270 @@
+package eks
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestListPods(t *testing.T) {
+	controller := true
+	created := metav1.NewTime(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	api := fake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "web-abc", Namespace: "prod", CreationTimestamp: created,
+				Labels:          map[string]string{"app": "web"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-7d9", Controller: &controller}},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   "ip-10-0-1-5",
+				Containers: []corev1.Container{{Name: "app", Image: "web:1"}, {Name: "proxy", Image: "envoy:1"}},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				PodIP: "10.0.1.20",
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", RestartCount: 4, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+					{Name: "proxy", Ready: true, RestartCount: 1, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Namespace: "batch", Labels: map[string]string{"app": "worker"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "worker"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+	)
+	client := &K8sClient{api: api}
+
+	pods, err := client.ListPods(context.Background(), "", PodFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 2 {
+		t.Errorf("expected 2 pods across all namespaces, got %d", len(pods))
+	}
+
+	pods, err = client.ListPods(context.Background(), "prod", PodFilter{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 1 {
+		t.Fatalf("expected 1 pod, got %d", len(pods))
+	}
+	p := pods[0]
+	if p.Status != "CrashLoopBackOff" {
+		t.Errorf("Status = %s, want CrashLoopBackOff", p.Status)
+	}
+	if p.Ready != 1 {
+		t.Errorf("Ready = %d, want 1", p.Ready)
+	}
+	if p.Total != 2 {
+		t.Errorf("Total = %d, want 2", p.Total)
+	}
+	if p.Restarts != 5 {
+		t.Errorf("Restarts = %d, want 5", p.Restarts)
+	}
+	if p.Node != "ip-10-0-1-5" {
+		t.Errorf("Node = %s, want ip-10-0-1-5", p.Node)
+	}
+	if p.IP != "10.0.1.20" {
+		t.Errorf("IP = %s, want 10.0.1.20", p.IP)
+	}
+	if p.Owner != "ReplicaSet/web-7d9" {
+		t.Errorf("Owner = %s, want ReplicaSet/web-7d9", p.Owner)
+	}
+	if !p.CreatedAt.Equal(created.Time) {
+		t.Errorf("CreatedAt = %v, want %v", p.CreatedAt, created.Time)
+	}
+	if len(p.Containers) != 2 {
+		t.Fatalf("expected 2 containers, got %d", len(p.Containers))
+	}
+	wantApp := K8sContainer{Name: "app", Image: "web:1", State: "Waiting", Reason: "CrashLoopBackOff", Restarts: 4}
+	if !reflect.DeepEqual(p.Containers[0], wantApp) {
+		t.Errorf("Containers[0] = %+v, want %+v", p.Containers[0], wantApp)
+	}
+	if p.Containers[1].State != "Running" {
+		t.Errorf("State = %s, want Running", p.Containers[1].State)
+	}
+
+	pods, err = client.ListPods(context.Background(), "batch", PodFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 1 {
+		t.Fatalf("expected 1 pod, got %d", len(pods))
+	}
+	if pods[0].Status != "Pending" {
+		t.Errorf("pods[0].Status = %s, want Pending", pods[0].Status)
+	}
+}
+
+func TestListWorkloads(t *testing.T) {
+	replicas := int32(3)
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	api := fake.NewClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: selector,
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Image: "web:2"}}}},
+			},
+			Status: appsv1.DeploymentStatus{ReadyReplicas: 2, UpdatedReplicas: 3, AvailableReplicas: 2},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: &replicas, Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 3},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: "logging"},
+			Spec:       appsv1.DaemonSetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fluent-bit"}}},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 4, NumberReady: 4},
+		},
+	)
+	client := &K8sClient{api: api}
+	ctx := context.Background()
+
+	deployments, err := client.ListDeployments(ctx, "prod")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deployments) != 1 {
+		t.Fatalf("expected 1 deployment, got %d", len(deployments))
+	}
+	want := K8sWorkload{Kind: "Deployment", Name: "web", Namespace: "prod", Desired: 3, Ready: 2, UpToDate: 3, Available: 2, Selector: "app=web", Images: []string{"web:2"}}
+	if !reflect.DeepEqual(deployments[0], want) {
+		t.Errorf("deployments[0] = %+v, want %+v", deployments[0], want)
+	}
+
+	statefulsets, err := client.ListStatefulSets(ctx, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(statefulsets) != 1 {
+		t.Fatalf("expected 1 statefulset, got %d", len(statefulsets))
+	}
+	if statefulsets[0].Selector != "app=db" {
+		t.Errorf("statefulsets[0].Selector = %s, want app=db", statefulsets[0].Selector)
+	}
+	if statefulsets[0].Ready != 3 {
+		t.Errorf("statefulsets[0].Ready = %d, want 3", statefulsets[0].Ready)
+	}
+
+	daemonsets, err := client.ListDaemonSets(ctx, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(daemonsets) != 1 {
+		t.Fatalf("expected 1 daemonset, got %d", len(daemonsets))
+	}
+	if daemonsets[0].Desired != 4 {
+		t.Errorf("daemonsets[0].Desired = %d, want 4", daemonsets[0].Desired)
+	}
+}
+
+func TestListServicesAndNodes(t *testing.T) {
+	api := fake.NewClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod"},
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeLoadBalancer,
+				ClusterIP: "172.20.0.10",
+				Selector:  map[string]string{"app": "web"},
+				Ports:     []corev1.ServicePort{{Port: 80, NodePort: 30080, Protocol: corev1.ProtocolTCP}},
+			},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{Hostname: "web.elb.amazonaws.com"}},
+			}},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "ip-10-0-1-5", Labels: map[string]string{
+				"node.kubernetes.io/instance-type": "m5.large",
+				"topology.kubernetes.io/zone":      "us-east-1a",
+				"eks.amazonaws.com/nodegroup":      "default",
+				"node-role.kubernetes.io/worker":   "",
+			}},
+			Spec: corev1.NodeSpec{Unschedulable: true},
+			Status: corev1.NodeStatus{
+				Conditions:  []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				Addresses:   []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.1.5"}},
+				NodeInfo:    corev1.NodeSystemInfo{KubeletVersion: "v1.30.2-eks"},
+				Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1930m"), corev1.ResourceMemory: resource.MustParse("7Gi")},
+			},
+		},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}},
+	)
+	client := &K8sClient{api: api}
+	ctx := context.Background()
+
+	services, err := client.ListServices(ctx, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(services) != 1 {
+		t.Fatalf("expected 1 service, got %d", len(services))
+	}
+	if services[0].ExternalIP != "web.elb.amazonaws.com" {
+		t.Errorf("services[0].ExternalIP = %s, want web.elb.amazonaws.com", services[0].ExternalIP)
+	}
+	if !reflect.DeepEqual(services[0].Ports, []string{"80:30080/TCP"}) {
+		t.Errorf("Ports = %v, want [80:30080/TCP]", services[0].Ports)
+	}
+	if services[0].Selector != "app=web" {
+		t.Errorf("services[0].Selector = %s, want app=web", services[0].Selector)
+	}
+
+	nodes, err := client.ListNodes(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(nodes) != 1 {
+		t.Fatalf("expected 1 node, got %d", len(nodes))
+	}
+	n := nodes[0]
+	if n.Status != "Ready,SchedulingDisabled" {
+		t.Errorf("Status = %s, want Ready,SchedulingDisabled", n.Status)
+	}
+	if !reflect.DeepEqual(n.Roles, []string{"worker"}) {
+		t.Errorf("Roles = %v, want [worker]", n.Roles)
+	}
+	if n.InstanceType != "m5.large" {
+		t.Errorf("InstanceType = %s, want m5.large", n.InstanceType)
+	}
+	if n.Zone != "us-east-1a" {
+		t.Errorf("Zone = %s, want us-east-1a", n.Zone)
+	}
+	if n.NodeGroup != "default" {
+		t.Errorf("NodeGroup = %s, want default", n.NodeGroup)
+	}
+	if n.InternalIP != "10.0.1.5" {
+		t.Errorf("InternalIP = %s, want 10.0.1.5", n.InternalIP)
+	}
+	if n.CPU != "1930m" {
+		t.Errorf("CPU = %s, want 1930m", n.CPU)
+	}
+
+	namespaces, err := client.ListNamespaces(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(namespaces) != 2 {
+		t.Fatalf("expected 2 namespaces, got %d", len(namespaces))
+	}
+	if namespaces[0].Name != "default" {
+		t.Errorf("namespaces[0].Name = %s, want default", namespaces[0].Name)
+	}
+	if namespaces[0].Status != "Active" {
+		t.Errorf("namespaces[0].Status = %s, want Active", namespaces[0].Status)
+	}
+}
@@ -74,6 +74,9 @@ func ClassifyError(err error) ErrKind {
 	if err == nil {
 		return ErrKindUnknown
 	}
+	if errors.Is(err, ErrOffline) {
+		return ErrKindNetwork
+	}
 
 	// Check for smithy API errors first (most AWS SDK errors).
 	var apiErr smithy.APIError
@@ -175,13 +178,23 @@ func FormatError(err error) string {
 	}
 }
 
+// SSOLoginCommand returns the `aws sso login --profile <profile>` command
+// without starting it, for callers that hand the terminal over themselves
+// (e.g. tea.ExecProcess).
+func SSOLoginCommand(profile string) *exec.Cmd {
+	if profile == "" {
+		profile = "default"
+	}
+	return exec.Command("aws", "sso", "login", "--profile", profile)
+}
+
 // StartSSOLogin spawns `aws sso login --profile <profile>` as a subprocess.
 // It blocks until the login process completes and returns any error encountered.
 func StartSSOLogin(profile string) error {
 	if profile == "" {
 		profile = "default"
 	}
-	cmd := exec.Command("aws", "sso", "login", "--profile", profile)
+	cmd := SSOLoginCommand(profile)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -80,6 +80,7 @@ func TestClassifyError(t *testing.T) {
 		// Wrapped errors
 		{name: "wrapped auth error", err: fmt.Errorf("operation failed: %w", &mockAPIError{code: "ExpiredToken", message: "expired"}), want: ErrKindAuth},
 		{name: "wrapped net error", err: fmt.Errorf("request: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("refused")}), want: ErrKindNetwork},
+		{name: "wrapped offline error", err: fmt.Errorf("list: %w", ErrOffline), want: ErrKindNetwork},
 
 		// Unknown
 		{name: "unknown error", err: errors.New("something unexpected"), want: ErrKindUnknown},
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"context"
+	"sync"
+)
+
+// RegionResult is the outcome of a FanOut call in one region.
+type RegionResult[T any] struct {
+	Region string
+	Value  T
+	Err    error
+}
+
+// FanOut calls fn once per region with at most limit calls in flight and
+// returns the results in the order of regions. A failure in one region does
+// not stop the others. Regions not yet started when ctx is cancelled report
+// ctx's error.
+func FanOut[T any](ctx context.Context, regions []string, limit int, fn func(ctx context.Context, region string) (T, error)) []RegionResult[T] {
+	results := make([]RegionResult[T], len(regions))
+	sem := make(chan struct{}, max(limit, 1))
+
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		results[i].Region = region
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i].Value, results[i].Err = fn(ctx, region)
+		})
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanOut(t *testing.T) {
+	regions := []string{"us-east-1", "eu-west-1", "eu-north-1", "ap-south-1", "sa-east-1"}
+
+	var inFlight, peak atomic.Int32
+	results := FanOut(context.Background(), regions, 2, func(_ context.Context, region string) (string, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if region == "eu-west-1" {
+			return "", errors.New("access denied")
+		}
+		return "vpc-" + region, nil
+	})
+
+	require.Len(t, results, len(regions))
+	for i, r := range results {
+		assert.Equal(t, regions[i], r.Region, "results keep the order of regions")
+	}
+	assert.EqualError(t, results[1].Err, "access denied")
+	assert.Equal(t, "vpc-eu-north-1", results[2].Value, "one failed region does not hide the others")
+	assert.NoError(t, results[2].Err)
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestFanOut_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	results := FanOut(ctx, []string{"us-east-1", "us-west-2"}, 0, func(context.Context, string) (int, error) {
+		calls.Add(1)
+		return 1, nil
+	})
+	require.Len(t, results, 2)
+	for _, r := range results {
+		assert.ErrorIs(t, r.Err, context.Canceled)
+	}
+	assert.Zero(t, calls.Load())
+}
@@ -0,0 +1,364 @@
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+// LambdaAPI defines the subset of the Lambda API we use.
+type LambdaAPI interface {
+	ListFunctions(ctx context.Context, params *awslambda.ListFunctionsInput, optFns ...func(*awslambda.Options)) (*awslambda.ListFunctionsOutput, error)
+	GetFunction(ctx context.Context, params *awslambda.GetFunctionInput, optFns ...func(*awslambda.Options)) (*awslambda.GetFunctionOutput, error)
+	ListVersionsByFunction(ctx context.Context, params *awslambda.ListVersionsByFunctionInput, optFns ...func(*awslambda.Options)) (*awslambda.ListVersionsByFunctionOutput, error)
+	ListAliases(ctx context.Context, params *awslambda.ListAliasesInput, optFns ...func(*awslambda.Options)) (*awslambda.ListAliasesOutput, error)
+	ListEventSourceMappings(ctx context.Context, params *awslambda.ListEventSourceMappingsInput, optFns ...func(*awslambda.Options)) (*awslambda.ListEventSourceMappingsOutput, error)
+	GetPolicy(ctx context.Context, params *awslambda.GetPolicyInput, optFns ...func(*awslambda.Options)) (*awslambda.GetPolicyOutput, error)
+	Invoke(ctx context.Context, params *awslambda.InvokeInput, optFns ...func(*awslambda.Options)) (*awslambda.InvokeOutput, error)
+}
+
+// Client wraps the Lambda API.
+type Client struct {
+	api LambdaAPI
+}
+
+// NewClient creates a new Lambda client.
+func NewClient(api LambdaAPI) *Client {
+	return &Client{api: api}
+}
+
+// lastModifiedLayout is the layout of the LastModified timestamps Lambda
+// returns, such as 2024-05-01T14:05:09.123+0000.
+const lastModifiedLayout = "2006-01-02T15:04:05.000-0700"
+
+// ListFunctions returns every function in the region, sorted by name.
+func (c *Client) ListFunctions(ctx context.Context) ([]Function, error) {
+	var fns []Function
+	var marker *string
+	for {
+		out, err := c.api.ListFunctions(ctx, &awslambda.ListFunctionsInput{Marker: marker})
+		if err != nil {
+			return nil, fmt.Errorf("ListFunctions: %w", err)
+		}
+		for _, f := range out.Functions {
+			fns = append(fns, toFunction(f))
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	sort.Slice(fns, func(i, j int) bool { return fns[i].Name < fns[j].Name })
+	return fns, nil
+}
+
+// GetFunction returns the configuration and tags of a function.
+func (c *Client) GetFunction(ctx context.Context, name string) (FunctionDetail, error) {
+	out, err := c.api.GetFunction(ctx, &awslambda.GetFunctionInput{FunctionName: aws.String(name)})
+	if err != nil {
+		return FunctionDetail{}, fmt.Errorf("GetFunction: %w", err)
+	}
+	if out.Configuration == nil {
+		return FunctionDetail{}, fmt.Errorf("function %s not found", name)
+	}
+	cfg := out.Configuration
+	d := FunctionDetail{
+		Function:         toFunction(*cfg),
+		Handler:          aws.ToString(cfg.Handler),
+		Role:             aws.ToString(cfg.Role),
+		State:            string(cfg.State),
+		StateReason:      aws.ToString(cfg.StateReason),
+		LastUpdateStatus: string(cfg.LastUpdateStatus),
+		Tags:             out.Tags,
+	}
+	for _, a := range cfg.Architectures {
+		d.Architectures = append(d.Architectures, string(a))
+	}
+	if cfg.EphemeralStorage != nil {
+		d.EphemeralStorageMB = int(aws.ToInt32(cfg.EphemeralStorage.Size))
+	}
+	if cfg.TracingConfig != nil {
+		d.Tracing = string(cfg.TracingConfig.Mode)
+	}
+	if out.Concurrency != nil && out.Concurrency.ReservedConcurrentExecutions != nil {
+		n := int(*out.Concurrency.ReservedConcurrentExecutions)
+		d.ReservedConcurrency = &n
+	}
+	for _, l := range cfg.Layers {
+		d.Layers = append(d.Layers, aws.ToString(l.Arn))
+	}
+	if v := cfg.VpcConfig; v != nil {
+		d.VPCID = aws.ToString(v.VpcId)
+		d.Subnets = v.SubnetIds
+		d.SecurityGroups = v.SecurityGroupIds
+	}
+	if cfg.DeadLetterConfig != nil {
+		d.DeadLetterTarget = aws.ToString(cfg.DeadLetterConfig.TargetArn)
+	}
+	d.LogGroup = "/aws/lambda/" + d.Name
+	if cfg.LoggingConfig != nil && aws.ToString(cfg.LoggingConfig.LogGroup) != "" {
+		d.LogGroup = aws.ToString(cfg.LoggingConfig.LogGroup)
+	}
+	if env := cfg.Environment; env != nil {
+		d.Environment = env.Variables
+		if env.Error != nil {
+			d.EnvironmentError = aws.ToString(env.Error.Message)
+		}
+	}
+	return d, nil
+}
+
+// ListVersions returns the published versions of a function, newest first.
+// $LATEST is left out.
+func (c *Client) ListVersions(ctx context.Context, name string) ([]Version, error) {
+	var versions []Version
+	var marker *string
+	for {
+		out, err := c.api.ListVersionsByFunction(ctx, &awslambda.ListVersionsByFunctionInput{
+			FunctionName: aws.String(name),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListVersionsByFunction: %w", err)
+		}
+		for _, v := range out.Versions {
+			if aws.ToString(v.Version) == "$LATEST" {
+				continue
+			}
+			versions = append(versions, Version{
+				Version:      aws.ToString(v.Version),
+				Description:  aws.ToString(v.Description),
+				CodeSHA256:   aws.ToString(v.CodeSha256),
+				LastModified: parseLastModified(v.LastModified),
+			})
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versionNumber(versions[i].Version) > versionNumber(versions[j].Version)
+	})
+	return versions, nil
+}
+
+// ListAliases returns the aliases of a function, sorted by name.
+func (c *Client) ListAliases(ctx context.Context, name string) ([]Alias, error) {
+	var aliases []Alias
+	var marker *string
+	for {
+		out, err := c.api.ListAliases(ctx, &awslambda.ListAliasesInput{
+			FunctionName: aws.String(name),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListAliases: %w", err)
+		}
+		for _, a := range out.Aliases {
+			alias := Alias{
+				Name:        aws.ToString(a.Name),
+				Version:     aws.ToString(a.FunctionVersion),
+				Description: aws.ToString(a.Description),
+			}
+			if a.RoutingConfig != nil {
+				alias.Weights = a.RoutingConfig.AdditionalVersionWeights
+			}
+			aliases = append(aliases, alias)
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+	return aliases, nil
+}
+
+// ListEventSourceMappings returns the event source mappings that invoke a
+// function.
+func (c *Client) ListEventSourceMappings(ctx context.Context, name string) ([]EventSourceMapping, error) {
+	var mappings []EventSourceMapping
+	var marker *string
+	for {
+		out, err := c.api.ListEventSourceMappings(ctx, &awslambda.ListEventSourceMappingsInput{
+			FunctionName: aws.String(name),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListEventSourceMappings: %w", err)
+		}
+		for _, m := range out.EventSourceMappings {
+			source := aws.ToString(m.EventSourceArn)
+			if source == "" && m.SelfManagedEventSource != nil {
+				source = strings.Join(m.SelfManagedEventSource.Endpoints["KAFKA_BOOTSTRAP_SERVERS"], ",")
+			}
+			mapping := EventSourceMapping{
+				UUID:           aws.ToString(m.UUID),
+				Source:         source,
+				State:          aws.ToString(m.State),
+				BatchSize:      int(aws.ToInt32(m.BatchSize)),
+				LastProcessing: aws.ToString(m.LastProcessingResult),
+			}
+			if m.LastModified != nil {
+				mapping.LastModified = *m.LastModified
+			}
+			mappings = append(mappings, mapping)
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	return mappings, nil
+}
+
+// ListPermissions returns the statements of a function's resource policy.
+// A function without a policy has none.
+func (c *Client) ListPermissions(ctx context.Context, name string) ([]Permission, error) {
+	out, err := c.api.GetPolicy(ctx, &awslambda.GetPolicyInput{FunctionName: aws.String(name)})
+	if err != nil {
+		var notFound *lambdatypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("GetPolicy: %w", err)
+	}
+	return parsePolicy(aws.ToString(out.Policy))
+}
+
+// Invoke runs a function synchronously with payload and returns its
+// response together with the tail of its log.
+func (c *Client) Invoke(ctx context.Context, name string, payload []byte) (InvokeResult, error) {
+	out, err := c.api.Invoke(ctx, &awslambda.InvokeInput{
+		FunctionName: aws.String(name),
+		Payload:      payload,
+		LogType:      lambdatypes.LogTypeTail,
+	})
+	if err != nil {
+		return InvokeResult{}, fmt.Errorf("Invoke: %w", err)
+	}
+	res := InvokeResult{
+		StatusCode:      int(out.StatusCode),
+		FunctionError:   aws.ToString(out.FunctionError),
+		ExecutedVersion: aws.ToString(out.ExecutedVersion),
+		Payload:         out.Payload,
+	}
+	if out.LogResult != nil {
+		if log, err := base64.StdEncoding.DecodeString(*out.LogResult); err == nil {
+			res.Log = string(log)
+		}
+	}
+	return res, nil
+}
+
+func toFunction(f lambdatypes.FunctionConfiguration) Function {
+	return Function{
+		Name:         aws.ToString(f.FunctionName),
+		ARN:          aws.ToString(f.FunctionArn),
+		Runtime:      string(f.Runtime),
+		PackageType:  string(f.PackageType),
+		MemoryMB:     int(aws.ToInt32(f.MemorySize)),
+		Timeout:      time.Duration(aws.ToInt32(f.Timeout)) * time.Second,
+		CodeSize:     f.CodeSize,
+		LastModified: parseLastModified(f.LastModified),
+		Description:  aws.ToString(f.Description),
+	}
+}
+
+// parseLastModified parses a LastModified timestamp, returning the zero
+// time if it is missing or malformed.
+func parseLastModified(s *string) time.Time {
+	t, err := time.Parse(lastModifiedLayout, aws.ToString(s))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// versionNumber returns the number of a published version, or -1.
+func versionNumber(v string) int {
+	var n int
+	if _, err := fmt.Sscanf(v, "%d", &n); err != nil {
+		return -1
+	}
+	return n
+}
+
+// policyDocument is the part of a resource policy ListPermissions reads.
+// Principal and Action may be a string or a list, and Principal also an
+// object keyed by principal type.
+type policyDocument struct {
+	Statement []struct {
+		Sid       string
+		Effect    string
+		Principal json.RawMessage
+		Action    json.RawMessage
+		Condition map[string]map[string]json.RawMessage
+	}
+}
+
+func parsePolicy(policy string) ([]Permission, error) {
+	if policy == "" {
+		return nil, nil
+	}
+	var doc policyDocument
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("parsing resource policy: %w", err)
+	}
+	var perms []Permission
+	for _, st := range doc.Statement {
+		if st.Effect != "Allow" {
+			continue
+		}
+		p := Permission{
+			Sid:       st.Sid,
+			Principal: strings.Join(principals(st.Principal), ", "),
+			Action:    strings.Join(stringList(st.Action), ", "),
+		}
+		for _, op := range []string{"ArnLike", "ArnEquals"} {
+			if arn, ok := st.Condition[op]["AWS:SourceArn"]; ok {
+				p.SourceARN = strings.Join(stringList(arn), ", ")
+			}
+		}
+		perms = append(perms, p)
+	}
+	return perms, nil
+}
+
+// principals returns the principals of a statement, such as
+// s3.amazonaws.com or an account ARN.
+func principals(raw json.RawMessage) []string {
+	var byType map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byType); err != nil {
+		return stringList(raw)
+	}
+	var out []string
+	for _, typ := range []string{"Service", "AWS", "Federated"} {
+		out = append(out, stringList(byType[typ])...)
+	}
+	return out
+}
+
+// stringList decodes a policy value that is a string or a list of strings.
+func stringList(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return []string{s}
+	}
+	var list []string
+	_ = json.Unmarshal(raw, &list)
+	return list
+}
@@ -0,0 +1,222 @@
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLambdaAPI struct {
+	listFunctionsFunc           func(ctx context.Context, params *awslambda.ListFunctionsInput, optFns ...func(*awslambda.Options)) (*awslambda.ListFunctionsOutput, error)
+	getFunctionFunc             func(ctx context.Context, params *awslambda.GetFunctionInput, optFns ...func(*awslambda.Options)) (*awslambda.GetFunctionOutput, error)
+	listVersionsByFunctionFunc  func(ctx context.Context, params *awslambda.ListVersionsByFunctionInput, optFns ...func(*awslambda.Options)) (*awslambda.ListVersionsByFunctionOutput, error)
+	listAliasesFunc             func(ctx context.Context, params *awslambda.ListAliasesInput, optFns ...func(*awslambda.Options)) (*awslambda.ListAliasesOutput, error)
+	listEventSourceMappingsFunc func(ctx context.Context, params *awslambda.ListEventSourceMappingsInput, optFns ...func(*awslambda.Options)) (*awslambda.ListEventSourceMappingsOutput, error)
+	getPolicyFunc               func(ctx context.Context, params *awslambda.GetPolicyInput, optFns ...func(*awslambda.Options)) (*awslambda.GetPolicyOutput, error)
+	invokeFunc                  func(ctx context.Context, params *awslambda.InvokeInput, optFns ...func(*awslambda.Options)) (*awslambda.InvokeOutput, error)
+}
+
+func (m *mockLambdaAPI) ListFunctions(ctx context.Context, params *awslambda.ListFunctionsInput, optFns ...func(*awslambda.Options)) (*awslambda.ListFunctionsOutput, error) {
+	return m.listFunctionsFunc(ctx, params, optFns...)
+}
+
+func (m *mockLambdaAPI) GetFunction(ctx context.Context, params *awslambda.GetFunctionInput, optFns ...func(*awslambda.Options)) (*awslambda.GetFunctionOutput, error) {
+	return m.getFunctionFunc(ctx, params, optFns...)
+}
+
+func (m *mockLambdaAPI) ListVersionsByFunction(ctx context.Context, params *awslambda.ListVersionsByFunctionInput, optFns ...func(*awslambda.Options)) (*awslambda.ListVersionsByFunctionOutput, error) {
+	return m.listVersionsByFunctionFunc(ctx, params, optFns...)
+}
+
+func (m *mockLambdaAPI) ListAliases(ctx context.Context, params *awslambda.ListAliasesInput, optFns ...func(*awslambda.Options)) (*awslambda.ListAliasesOutput, error) {
+	return m.listAliasesFunc(ctx, params, optFns...)
+}
+
+func (m *mockLambdaAPI) ListEventSourceMappings(ctx context.Context, params *awslambda.ListEventSourceMappingsInput, optFns ...func(*awslambda.Options)) (*awslambda.ListEventSourceMappingsOutput, error) {
+	return m.listEventSourceMappingsFunc(ctx, params, optFns...)
+}
+
+func (m *mockLambdaAPI) GetPolicy(ctx context.Context, params *awslambda.GetPolicyInput, optFns ...func(*awslambda.Options)) (*awslambda.GetPolicyOutput, error) {
+	return m.getPolicyFunc(ctx, params, optFns...)
+}
+
+func (m *mockLambdaAPI) Invoke(ctx context.Context, params *awslambda.InvokeInput, optFns ...func(*awslambda.Options)) (*awslambda.InvokeOutput, error) {
+	return m.invokeFunc(ctx, params, optFns...)
+}
+
+func TestListFunctions(t *testing.T) {
+	mock := &mockLambdaAPI{
+		listFunctionsFunc: func(_ context.Context, params *awslambda.ListFunctionsInput, _ ...func(*awslambda.Options)) (*awslambda.ListFunctionsOutput, error) {
+			if params.Marker == nil {
+				return &awslambda.ListFunctionsOutput{
+					Functions: []lambdatypes.FunctionConfiguration{{
+						FunctionName: awssdk.String("orders-api"),
+						Runtime:      lambdatypes.RuntimePython312,
+						PackageType:  lambdatypes.PackageTypeZip,
+						MemorySize:   awssdk.Int32(512),
+						Timeout:      awssdk.Int32(30),
+						CodeSize:     2048,
+						LastModified: awssdk.String("2024-05-01T14:05:09.123+0000"),
+					}},
+					NextMarker: awssdk.String("page2"),
+				}, nil
+			}
+			return &awslambda.ListFunctionsOutput{
+				Functions: []lambdatypes.FunctionConfiguration{{
+					FunctionName: awssdk.String("billing-worker"),
+					PackageType:  lambdatypes.PackageTypeImage,
+				}},
+			}, nil
+		},
+	}
+
+	fns, err := NewClient(mock).ListFunctions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, fns, 2)
+	assert.Equal(t, "billing-worker", fns[0].Name)
+	assert.Equal(t, "Image", fns[0].PackageType)
+	assert.True(t, fns[0].LastModified.IsZero())
+
+	f := fns[1]
+	assert.Equal(t, "orders-api", f.Name)
+	assert.Equal(t, "python3.12", f.Runtime)
+	assert.Equal(t, 512, f.MemoryMB)
+	assert.Equal(t, 30*time.Second, f.Timeout)
+	assert.Equal(t, int64(2048), f.CodeSize)
+	assert.Equal(t, time.Date(2024, 5, 1, 14, 5, 9, 123e6, time.UTC), f.LastModified.UTC())
+}
+
+func TestListFunctions_Error(t *testing.T) {
+	mock := &mockLambdaAPI{
+		listFunctionsFunc: func(_ context.Context, _ *awslambda.ListFunctionsInput, _ ...func(*awslambda.Options)) (*awslambda.ListFunctionsOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+	_, err := NewClient(mock).ListFunctions(context.Background())
+	assert.EqualError(t, err, "ListFunctions: AccessDenied")
+}
+
+func TestGetFunction(t *testing.T) {
+	mock := &mockLambdaAPI{
+		getFunctionFunc: func(_ context.Context, params *awslambda.GetFunctionInput, _ ...func(*awslambda.Options)) (*awslambda.GetFunctionOutput, error) {
+			assert.Equal(t, "orders-api", awssdk.ToString(params.FunctionName))
+			return &awslambda.GetFunctionOutput{
+				Configuration: &lambdatypes.FunctionConfiguration{
+					FunctionName:  awssdk.String("orders-api"),
+					Handler:       awssdk.String("app.handler"),
+					Architectures: []lambdatypes.Architecture{lambdatypes.ArchitectureArm64},
+					State:         lambdatypes.StateActive,
+					Environment:   &lambdatypes.EnvironmentResponse{Variables: map[string]string{"DB_PASSWORD": "hunter2"}},
+					VpcConfig:     &lambdatypes.VpcConfigResponse{VpcId: awssdk.String("vpc-1"), SubnetIds: []string{"subnet-a"}},
+				},
+				Concurrency: &lambdatypes.Concurrency{ReservedConcurrentExecutions: awssdk.Int32(5)},
+				Tags:        map[string]string{"team": "orders"},
+			}, nil
+		},
+	}
+
+	d, err := NewClient(mock).GetFunction(context.Background(), "orders-api")
+	require.NoError(t, err)
+	assert.Equal(t, "app.handler", d.Handler)
+	assert.Equal(t, []string{"arm64"}, d.Architectures)
+	assert.Equal(t, "Active", d.State)
+	assert.Equal(t, "hunter2", d.Environment["DB_PASSWORD"])
+	assert.Equal(t, "vpc-1", d.VPCID)
+	require.NotNil(t, d.ReservedConcurrency)
+	assert.Equal(t, 5, *d.ReservedConcurrency)
+	assert.Equal(t, "/aws/lambda/orders-api", d.LogGroup)
+	assert.Equal(t, "orders", d.Tags["team"])
+}
+
+func TestListVersionsAndAliases(t *testing.T) {
+	mock := &mockLambdaAPI{
+		listVersionsByFunctionFunc: func(_ context.Context, _ *awslambda.ListVersionsByFunctionInput, _ ...func(*awslambda.Options)) (*awslambda.ListVersionsByFunctionOutput, error) {
+			return &awslambda.ListVersionsByFunctionOutput{Versions: []lambdatypes.FunctionConfiguration{
+				{Version: awssdk.String("$LATEST")},
+				{Version: awssdk.String("2")},
+				{Version: awssdk.String("10")},
+			}}, nil
+		},
+		listAliasesFunc: func(_ context.Context, _ *awslambda.ListAliasesInput, _ ...func(*awslambda.Options)) (*awslambda.ListAliasesOutput, error) {
+			return &awslambda.ListAliasesOutput{Aliases: []lambdatypes.AliasConfiguration{
+				{Name: awssdk.String("prod"), FunctionVersion: awssdk.String("2"), RoutingConfig: &lambdatypes.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{"10": 0.1},
+				}},
+				{Name: awssdk.String("dev"), FunctionVersion: awssdk.String("$LATEST")},
+			}}, nil
+		},
+	}
+	client := NewClient(mock)
+
+	versions, err := client.ListVersions(context.Background(), "orders-api")
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "10", versions[0].Version)
+	assert.Equal(t, "2", versions[1].Version)
+
+	aliases, err := client.ListAliases(context.Background(), "orders-api")
+	require.NoError(t, err)
+	require.Len(t, aliases, 2)
+	assert.Equal(t, "dev", aliases[0].Name)
+	assert.Equal(t, map[string]float64{"10": 0.1}, aliases[1].Weights)
+}
+
+func TestListPermissions(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[
+		{"Sid":"s3","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction",
+		 "Condition":{"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::uploads"}}},
+		{"Sid":"any","Effect":"Allow","Principal":"*","Action":["lambda:InvokeFunctionUrl"]},
+		{"Sid":"deny","Effect":"Deny","Principal":"*","Action":"lambda:InvokeFunction"}]}`
+	mock := &mockLambdaAPI{
+		getPolicyFunc: func(_ context.Context, _ *awslambda.GetPolicyInput, _ ...func(*awslambda.Options)) (*awslambda.GetPolicyOutput, error) {
+			return &awslambda.GetPolicyOutput{Policy: awssdk.String(policy)}, nil
+		},
+	}
+
+	perms, err := NewClient(mock).ListPermissions(context.Background(), "orders-api")
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{
+		{Sid: "s3", Principal: "s3.amazonaws.com", SourceARN: "arn:aws:s3:::uploads", Action: "lambda:InvokeFunction"},
+		{Sid: "any", Principal: "*", Action: "lambda:InvokeFunctionUrl"},
+	}, perms)
+
+	// A function without a resource policy has no permissions.
+	mock.getPolicyFunc = func(_ context.Context, _ *awslambda.GetPolicyInput, _ ...func(*awslambda.Options)) (*awslambda.GetPolicyOutput, error) {
+		return nil, &lambdatypes.ResourceNotFoundException{Message: awssdk.String("no policy")}
+	}
+	perms, err = NewClient(mock).ListPermissions(context.Background(), "orders-api")
+	require.NoError(t, err)
+	assert.Empty(t, perms)
+}
+
+func TestInvoke(t *testing.T) {
+	mock := &mockLambdaAPI{
+		invokeFunc: func(_ context.Context, params *awslambda.InvokeInput, _ ...func(*awslambda.Options)) (*awslambda.InvokeOutput, error) {
+			assert.Equal(t, `{"id":1}`, string(params.Payload))
+			assert.Equal(t, lambdatypes.LogTypeTail, params.LogType)
+			return &awslambda.InvokeOutput{
+				StatusCode:      200,
+				FunctionError:   awssdk.String("Unhandled"),
+				ExecutedVersion: awssdk.String("$LATEST"),
+				Payload:         []byte(`{"errorMessage":"boom"}`),
+				LogResult:       awssdk.String(base64.StdEncoding.EncodeToString([]byte("START RequestId: 1\n"))),
+			}, nil
+		},
+	}
+
+	res, err := NewClient(mock).Invoke(context.Background(), "orders-api", []byte(`{"id":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, "Unhandled", res.FunctionError)
+	assert.Equal(t, "$LATEST", res.ExecutedVersion)
+	assert.Equal(t, `{"errorMessage":"boom"}`, string(res.Payload))
+	assert.Equal(t, "START RequestId: 1\n", res.Log)
+}
@@ -0,0 +1,86 @@
+package lambda
+
+import "time"
+
+// Function is a Lambda function as listed.
+type Function struct {
+	Name         string
+	ARN          string
+	Runtime      string // empty for container images
+	PackageType  string // "Zip" or "Image"
+	MemoryMB     int
+	Timeout      time.Duration
+	CodeSize     int64
+	LastModified time.Time
+	Description  string
+}
+
+// FunctionDetail is the configuration of a function's $LATEST version.
+type FunctionDetail struct {
+	Function
+	Handler             string
+	Role                string
+	Architectures       []string
+	State               string
+	StateReason         string
+	LastUpdateStatus    string
+	EphemeralStorageMB  int
+	Tracing             string
+	ReservedConcurrency *int // nil when unreserved
+	Layers              []string
+	VPCID               string
+	Subnets             []string
+	SecurityGroups      []string
+	DeadLetterTarget    string
+	LogGroup            string
+	Environment         map[string]string
+	EnvironmentError    string
+	Tags                map[string]string
+}
+
+// Version is a published version of a function.
+type Version struct {
+	Version      string
+	Description  string
+	CodeSHA256   string
+	LastModified time.Time
+}
+
+// Alias points a name at a version, optionally shifting a share of
+// invocations to a second version.
+type Alias struct {
+	Name        string
+	Version     string
+	Description string
+	Weights     map[string]float64 // additional version → share of invocations
+}
+
+// EventSourceMapping is a poller that invokes a function with records read
+// from a queue or stream.
+type EventSourceMapping struct {
+	UUID           string
+	Source         string // event source ARN, or the Kafka/MQ source
+	State          string
+	BatchSize      int
+	LastModified   time.Time
+	LastProcessing string
+}
+
+// Permission is a statement of a function's resource policy, which lets a
+// service or account invoke it, such as an S3 bucket notification or an API
+// Gateway route.
+type Permission struct {
+	Sid       string
+	Principal string
+	SourceARN string
+	Action    string
+}
+
+// InvokeResult is the outcome of a synchronous invocation.
+type InvokeResult struct {
+	StatusCode      int
+	FunctionError   string // "Unhandled" or "Handled" when the function failed
+	ExecutedVersion string
+	Payload         []byte
+	Log             string // the last 4 KB of the invocation's log
+}
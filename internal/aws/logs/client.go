@@ -12,8 +12,13 @@ import (
 // CloudWatchLogsAPI defines the subset of CloudWatch Logs API we use.
 type CloudWatchLogsAPI interface {
 	GetLogEvents(ctx context.Context, params *cloudwatchlogs.GetLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error)
 }
 
+// groupPageLimit caps the pages read from a log group, so a busy group
+// cannot keep a read paging indefinitely.
+const groupPageLimit = 20
+
 // Client wraps the CloudWatch Logs API.
 type Client struct {
 	api CloudWatchLogsAPI
@@ -79,3 +84,93 @@ func (c *Client) GetLogEventsSince(ctx context.Context, logGroup, logStream, for
 
 	return events, token, nil
 }
+
+// GetLogEventsFrom retrieves up to limit log events logged at or after start,
+// oldest first, along with a forward token for GetLogEventsSince.
+func (c *Client) GetLogEventsFrom(ctx context.Context, logGroup, logStream string, start time.Time, limit int) ([]LogEvent, string, error) {
+	out, err := c.api.GetLogEvents(ctx, &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String(logGroup),
+		LogStreamName: aws.String(logStream),
+		StartTime:     aws.Int64(start.UnixMilli()),
+		Limit:         aws.Int32(int32(limit)),
+		StartFromHead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("GetLogEvents: %w", err)
+	}
+
+	events := make([]LogEvent, len(out.Events))
+	for i, e := range out.Events {
+		events[i] = LogEvent{
+			Timestamp: time.UnixMilli(aws.ToInt64(e.Timestamp)),
+			Message:   aws.ToString(e.Message),
+		}
+	}
+
+	var token string
+	if out.NextForwardToken != nil {
+		token = *out.NextForwardToken
+	}
+
+	return events, token, nil
+}
+
+// GetGroupEventsFrom retrieves up to limit events logged to any stream of a
+// log group at or after start, oldest first.
+func (c *Client) GetGroupEventsFrom(ctx context.Context, logGroup string, start time.Time, limit int) ([]LogEvent, error) {
+	var events []LogEvent
+	var nextToken *string
+	for range groupPageLimit {
+		out, err := c.api.FilterLogEvents(ctx, &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName: aws.String(logGroup),
+			StartTime:    aws.Int64(start.UnixMilli()),
+			Limit:        aws.Int32(int32(limit - len(events))),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("FilterLogEvents: %w", err)
+		}
+		for _, e := range out.Events {
+			events = append(events, LogEvent{
+				Timestamp: time.UnixMilli(aws.ToInt64(e.Timestamp)),
+				Message:   aws.ToString(e.Message),
+			})
+		}
+		if out.NextToken == nil || len(events) >= limit {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return events, nil
+}
+
+// GetRecentGroupEvents retrieves the newest of the events logged to any
+// stream of a log group since start, at most limit of them, oldest first.
+func (c *Client) GetRecentGroupEvents(ctx context.Context, logGroup string, since time.Time, limit int) ([]LogEvent, error) {
+	var events []LogEvent
+	var nextToken *string
+	for range groupPageLimit {
+		out, err := c.api.FilterLogEvents(ctx, &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName: aws.String(logGroup),
+			StartTime:    aws.Int64(since.UnixMilli()),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("FilterLogEvents: %w", err)
+		}
+		for _, e := range out.Events {
+			events = append(events, LogEvent{
+				Timestamp: time.UnixMilli(aws.ToInt64(e.Timestamp)),
+				Message:   aws.ToString(e.Message),
+			})
+		}
+		if over := len(events) - limit; over > 0 {
+			events = events[over:]
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return events, nil
+}
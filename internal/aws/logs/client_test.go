@@ -3,6 +3,7 @@ package logs
 import (
 	"context"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
@@ -12,13 +13,18 @@ import (
 )
 
 type mockLogsAPI struct {
-	getLogEventsFunc func(ctx context.Context, params *cloudwatchlogs.GetLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetLogEventsOutput, error)
+	getLogEventsFunc    func(ctx context.Context, params *cloudwatchlogs.GetLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetLogEventsOutput, error)
+	filterLogEventsFunc func(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error)
 }
 
 func (m *mockLogsAPI) GetLogEvents(ctx context.Context, params *cloudwatchlogs.GetLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetLogEventsOutput, error) {
 	return m.getLogEventsFunc(ctx, params, optFns...)
 }
 
+func (m *mockLogsAPI) FilterLogEvents(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	return m.filterLogEventsFunc(ctx, params, optFns...)
+}
+
 func TestGetLatestLogEvents(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -150,3 +156,82 @@ func TestGetLogEventsSince(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLogEventsFrom(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	mock := &mockLogsAPI{
+		getLogEventsFunc: func(ctx context.Context, params *cloudwatchlogs.GetLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetLogEventsOutput, error) {
+			assert.Equal(t, int64(1700000000000), *params.StartTime)
+			assert.Equal(t, int32(100), *params.Limit)
+			assert.Equal(t, true, *params.StartFromHead)
+			assert.Nil(t, params.NextToken)
+			return &cloudwatchlogs.GetLogEventsOutput{
+				Events: []cwltypes.OutputLogEvent{
+					{Timestamp: awssdk.Int64(1700000000500), Message: awssdk.String("first")},
+					{Timestamp: awssdk.Int64(1700000001000), Message: awssdk.String("second")},
+				},
+				NextForwardToken: awssdk.String("fwd-token-3"),
+			}, nil
+		},
+	}
+	client := NewClient(mock)
+	events, token, err := client.GetLogEventsFrom(context.Background(), "/ecs/my-app", "ecs/web/abc123", start, 100)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "first", events[0].Message)
+	assert.Equal(t, time.UnixMilli(1700000000500), events[0].Timestamp)
+	assert.Equal(t, "fwd-token-3", token)
+}
+
+func TestGetRecentGroupEvents(t *testing.T) {
+	since := time.UnixMilli(1700000000000)
+	var pages int
+	mock := &mockLogsAPI{
+		filterLogEventsFunc: func(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+			assert.Equal(t, "/aws/lambda/api", *params.LogGroupName)
+			assert.Equal(t, int64(1700000000000), *params.StartTime)
+			pages++
+			if params.NextToken == nil {
+				return &cloudwatchlogs.FilterLogEventsOutput{
+					Events: []cwltypes.FilteredLogEvent{
+						{Timestamp: awssdk.Int64(1700000000100), Message: awssdk.String("START")},
+						{Timestamp: awssdk.Int64(1700000000200), Message: awssdk.String("hello")},
+					},
+					NextToken: awssdk.String("page2"),
+				}, nil
+			}
+			return &cloudwatchlogs.FilterLogEventsOutput{
+				Events: []cwltypes.FilteredLogEvent{
+					{Timestamp: awssdk.Int64(1700000000300), Message: awssdk.String("END")},
+				},
+			}, nil
+		},
+	}
+	events, err := NewClient(mock).GetRecentGroupEvents(context.Background(), "/aws/lambda/api", since, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pages)
+	require.Len(t, events, 2)
+	assert.Equal(t, "hello", events[0].Message)
+	assert.Equal(t, "END", events[1].Message)
+}
+
+func TestGetGroupEventsFrom(t *testing.T) {
+	mock := &mockLogsAPI{}
+	// A token is returned while the time range is still being searched, so
+	// paging stops only once limit events are read.
+	mock.filterLogEventsFunc = func(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+		if params.NextToken != nil {
+			return &cloudwatchlogs.FilterLogEventsOutput{}, nil
+		}
+		assert.Equal(t, int32(50), *params.Limit)
+		return &cloudwatchlogs.FilterLogEventsOutput{
+			Events:    []cwltypes.FilteredLogEvent{{Timestamp: awssdk.Int64(1700000000100), Message: awssdk.String("first")}},
+			NextToken: awssdk.String("more"),
+		}, nil
+	}
+	events, err := NewClient(mock).GetGroupEventsFrom(context.Background(), "/aws/lambda/api", time.UnixMilli(1700000000000), 50)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "first", events[0].Message)
+	assert.Equal(t, time.UnixMilli(1700000000100), events[0].Timestamp)
+}
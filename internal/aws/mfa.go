@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+)
+
+// ErrMFACanceled is returned by an MFAPrompt when the user declines to enter
+// a code.
+var ErrMFACanceled = errors.New("MFA code not entered")
+
+// MFAPrompt asks the user for the current code of the MFA device serial. It
+// is called on the goroutine retrieving credentials and blocks until the
+// user answers or ctx, the context of the retrieval, is done.
+type MFAPrompt func(ctx context.Context, serial string) (string, error)
+
+// MFA supplies codes to profiles that assume a role with mfa_serial and keeps
+// their credentials for their lifetime, so that new sessions for the same
+// profile, such as after a region switch, do not prompt again. It is safe
+// for concurrent use.
+type MFA struct {
+	mu     sync.Mutex
+	prompt MFAPrompt
+	creds  map[string]aws.CredentialsProvider
+	used   map[string]bool            // profiles that asked for a code
+	ctxs   map[string]context.Context // retrievals waiting on a code, by profile
+}
+
+// NewMFA returns an MFA that asks prompt for codes.
+func NewMFA(prompt MFAPrompt) *MFA {
+	return &MFA{
+		prompt: prompt,
+		creds:  make(map[string]aws.CredentialsProvider),
+		used:   make(map[string]bool),
+		ctxs:   make(map[string]context.Context),
+	}
+}
+
+// SetPrompt replaces the prompt, typically once the UI is able to ask.
+func (m *MFA) SetPrompt(prompt MFAPrompt) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prompt = prompt
+}
+
+// loadOption sets the token provider the SDK calls when profile's role
+// requires MFA.
+func (m *MFA) loadOption(profile string) func(*config.LoadOptions) error {
+	return config.WithAssumeRoleCredentialOptions(func(o *stscreds.AssumeRoleOptions) {
+		serial := aws.ToString(o.SerialNumber)
+		o.TokenProvider = func() (string, error) {
+			m.mu.Lock()
+			m.used[profile] = true
+			prompt := m.prompt
+			ctx := m.ctxs[profile]
+			m.mu.Unlock()
+			if prompt == nil {
+				return "", ErrMFACanceled
+			}
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			return prompt(ctx, serial)
+		}
+	})
+}
+
+// credentials returns the credentials kept for profile if they were obtained
+// with an MFA code, and otherwise keeps fresh for the next session. The
+// result passes the context of each retrieval on to the prompt.
+func (m *MFA) credentials(profile string, fresh aws.CredentialsProvider) aws.CredentialsProvider {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.creds[profile]
+	if !ok || !m.used[profile] {
+		c = fresh
+		m.creds[profile] = fresh
+	}
+	return &mfaCredentials{mfa: m, profile: profile, provider: c}
+}
+
+// bind makes ctx the context prompts for profile are made with until the
+// returned function is called. The SDK's credentials cache hands providers
+// a context that is never done; such a context does not replace one that
+// can be. bind is a no-op on a nil MFA.
+func (m *MFA) bind(ctx context.Context, profile string) func() {
+	if m == nil || ctx.Done() == nil {
+		return func() {}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ctxs[profile] = ctx
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.ctxs[profile] == ctx {
+			delete(m.ctxs, profile)
+		}
+	}
+}
+
+// mfaCredentials binds the context of each retrieval for the prompt, so
+// that a code is no longer waited for once the caller has given up.
+type mfaCredentials struct {
+	mfa      *MFA
+	profile  string
+	provider aws.CredentialsProvider
+}
+
+func (c *mfaCredentials) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	defer c.mfa.bind(ctx, c.profile)()
+	return c.provider.Retrieve(ctx)
+}
+
+// Forget drops the credentials kept for profile, so the next session asks
+// for a new code.
+func (m *MFA) Forget(profile string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.creds, profile)
+	delete(m.used, profile)
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMFA(t *testing.T) {
+	var asked []string
+	m := NewMFA(func(_ context.Context, serial string) (string, error) {
+		asked = append(asked, serial)
+		return "123456", nil
+	})
+
+	// Credentials are not kept for profiles that never asked for a code.
+	first := credentials.NewStaticCredentialsProvider("a", "b", "")
+	second := credentials.NewStaticCredentialsProvider("c", "d", "")
+	assert.Equal(t, aws.CredentialsProvider(first), kept(m.credentials("mfa", first)))
+	assert.Equal(t, aws.CredentialsProvider(second), kept(m.credentials("mfa", second)))
+
+	// Once the SDK has asked for a code, later sessions reuse them.
+	var opts config.LoadOptions
+	require.NoError(t, m.loadOption("mfa")(&opts))
+	ro := stscreds.AssumeRoleOptions{SerialNumber: aws.String("arn:aws:iam::111111111111:mfa/dev")}
+	opts.AssumeRoleCredentialOptions(&ro)
+	code, err := ro.TokenProvider()
+	require.NoError(t, err)
+	assert.Equal(t, "123456", code)
+	assert.Equal(t, []string{"arn:aws:iam::111111111111:mfa/dev"}, asked)
+	assert.Equal(t, aws.CredentialsProvider(second), kept(m.credentials("mfa", first)))
+
+	m.Forget("mfa")
+	assert.Equal(t, aws.CredentialsProvider(first), kept(m.credentials("mfa", first)))
+}
+
+// kept returns the provider MFA.credentials wrapped.
+func kept(p aws.CredentialsProvider) aws.CredentialsProvider {
+	return p.(*mfaCredentials).provider
+}
+
+// tokenProvider is a credentials provider that asks opts' token provider for
+// a code, as an assume-role provider with mfa_serial does.
+type tokenProvider struct {
+	opts *stscreds.AssumeRoleOptions
+}
+
+func (p tokenProvider) Retrieve(context.Context) (aws.Credentials, error) {
+	if _, err := p.opts.TokenProvider(); err != nil {
+		return aws.Credentials{}, err
+	}
+	return aws.Credentials{AccessKeyID: "a", SecretAccessKey: "b"}, nil
+}
+
+func TestMFA_PromptStopsWithRetrieval(t *testing.T) {
+	asked := make(chan struct{})
+	stopped := make(chan error, 1)
+	m := NewMFA(func(ctx context.Context, _ string) (string, error) {
+		close(asked)
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return "", ctx.Err()
+	})
+	var opts config.LoadOptions
+	require.NoError(t, m.loadOption("mfa")(&opts))
+	ro := stscreds.AssumeRoleOptions{SerialNumber: aws.String("arn:aws:iam::111111111111:mfa/dev")}
+	opts.AssumeRoleCredentialOptions(&ro)
+
+	// The SDK's credentials cache hides the caller's context from the
+	// provider, yet the prompt still sees it.
+	creds := m.credentials("mfa", aws.NewCredentialsCache(tokenProvider{opts: &ro}))
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-asked
+		cancel()
+	}()
+	_, err := creds.Retrieve(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	select {
+	case err := <-stopped:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the prompt kept waiting after the retrieval was cancelled")
+	}
+}
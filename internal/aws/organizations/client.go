@@ -0,0 +1,62 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsorg "github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+type OrganizationsAPI interface {
+	ListAccounts(ctx context.Context, params *awsorg.ListAccountsInput, optFns ...func(*awsorg.Options)) (*awsorg.ListAccountsOutput, error)
+}
+
+type Client struct {
+	api OrganizationsAPI
+}
+
+func NewClient(api OrganizationsAPI) *Client {
+	return &Client{api: api}
+}
+
+// ListActiveAccounts returns the organization's active member accounts
+// sorted by name. Suspended and closed accounts cannot be entered and are
+// left out.
+func (c *Client) ListActiveAccounts(ctx context.Context) ([]OrgAccount, error) {
+	var accounts []OrgAccount
+	var nextToken *string
+
+	for {
+		out, err := c.api.ListAccounts(ctx, &awsorg.ListAccountsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListAccounts: %w", err)
+		}
+
+		for _, a := range out.Accounts {
+			if a.State != orgtypes.AccountStateActive {
+				continue
+			}
+			accounts = append(accounts, OrgAccount{
+				ID:    aws.ToString(a.Id),
+				Name:  aws.ToString(a.Name),
+				Email: aws.ToString(a.Email),
+				State: string(a.State),
+			})
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Name < accounts[j].Name
+	})
+	return accounts, nil
+}
@@ -0,0 +1,58 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awsorg "github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOrganizationsAPI struct {
+	pages [][]orgtypes.Account
+	err   error
+	calls int
+}
+
+func (m *mockOrganizationsAPI) ListAccounts(_ context.Context, params *awsorg.ListAccountsInput, _ ...func(*awsorg.Options)) (*awsorg.ListAccountsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	page := m.calls
+	m.calls++
+	out := &awsorg.ListAccountsOutput{Accounts: m.pages[page]}
+	if page < len(m.pages)-1 {
+		out.NextToken = awssdk.String("next")
+	}
+	return out, nil
+}
+
+func account(id, name string, state orgtypes.AccountState) orgtypes.Account {
+	return orgtypes.Account{Id: awssdk.String(id), Name: awssdk.String(name), State: state}
+}
+
+func TestListActiveAccounts(t *testing.T) {
+	api := &mockOrganizationsAPI{pages: [][]orgtypes.Account{
+		{account("111", "prod", orgtypes.AccountStateActive), account("222", "legacy", orgtypes.AccountStateSuspended)},
+		{account("333", "dev", orgtypes.AccountStateActive)},
+	}}
+
+	accounts, err := NewClient(api).ListActiveAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, api.calls)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, OrgAccount{ID: "333", Name: "dev", State: "ACTIVE"}, accounts[0])
+	assert.Equal(t, "prod", accounts[1].Name)
+}
+
+func TestListActiveAccounts_Error(t *testing.T) {
+	api := &mockOrganizationsAPI{err: errors.New("AccessDeniedException")}
+
+	_, err := NewClient(api).ListActiveAccounts(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ListAccounts")
+}
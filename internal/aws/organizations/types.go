@@ -0,0 +1,9 @@
+package organizations
+
+// OrgAccount is a member account of an AWS Organization.
+type OrgAccount struct {
+	ID    string
+	Name  string
+	Email string
+	State string
+}
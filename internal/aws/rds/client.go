@@ -0,0 +1,366 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// RDSAPI defines the subset of the RDS API we use.
+type RDSAPI interface {
+	DescribeDBInstances(ctx context.Context, params *awsrds.DescribeDBInstancesInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error)
+	DescribeDBClusters(ctx context.Context, params *awsrds.DescribeDBClustersInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBClustersOutput, error)
+	DescribeDBSubnetGroups(ctx context.Context, params *awsrds.DescribeDBSubnetGroupsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBSubnetGroupsOutput, error)
+	DescribeDBSnapshots(ctx context.Context, params *awsrds.DescribeDBSnapshotsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBSnapshotsOutput, error)
+	DescribeDBClusterSnapshots(ctx context.Context, params *awsrds.DescribeDBClusterSnapshotsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBClusterSnapshotsOutput, error)
+	DescribePendingMaintenanceActions(ctx context.Context, params *awsrds.DescribePendingMaintenanceActionsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribePendingMaintenanceActionsOutput, error)
+}
+
+// Client wraps the RDS API.
+type Client struct {
+	api RDSAPI
+}
+
+// NewClient creates a new RDS client.
+func NewClient(api RDSAPI) *Client {
+	return &Client{api: api}
+}
+
+// ListInstances returns every DB instance in the region, sorted by
+// identifier.
+func (c *Client) ListInstances(ctx context.Context) ([]Instance, error) {
+	return c.describeInstances(ctx, nil)
+}
+
+// GetInstance returns the DB instance called id.
+func (c *Client) GetInstance(ctx context.Context, id string) (Instance, error) {
+	instances, err := c.describeInstances(ctx, aws.String(id))
+	if err != nil {
+		return Instance{}, err
+	}
+	if len(instances) == 0 {
+		return Instance{}, fmt.Errorf("DB instance %s not found", id)
+	}
+	return instances[0], nil
+}
+
+func (c *Client) describeInstances(ctx context.Context, id *string) ([]Instance, error) {
+	var instances []Instance
+	var marker *string
+	for {
+		out, err := c.api.DescribeDBInstances(ctx, &awsrds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: id,
+			Marker:               marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeDBInstances: %w", err)
+		}
+		for _, db := range out.DBInstances {
+			instances = append(instances, toInstance(db))
+		}
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	sort.Slice(instances, func(i, j int) bool { return instances[i].ID < instances[j].ID })
+	return instances, nil
+}
+
+// ListClusters returns every DB cluster in the region, sorted by identifier.
+// Cluster subnets are not resolved; use GetCluster for those.
+func (c *Client) ListClusters(ctx context.Context) ([]Cluster, error) {
+	return c.describeClusters(ctx, nil)
+}
+
+// GetCluster returns the DB cluster called id, with the VPC and subnets of
+// its subnet group.
+func (c *Client) GetCluster(ctx context.Context, id string) (Cluster, error) {
+	clusters, err := c.describeClusters(ctx, aws.String(id))
+	if err != nil {
+		return Cluster{}, err
+	}
+	if len(clusters) == 0 {
+		return Cluster{}, fmt.Errorf("DB cluster %s not found", id)
+	}
+	cl := clusters[0]
+	if cl.SubnetGroup == "" {
+		return cl, nil
+	}
+	out, err := c.api.DescribeDBSubnetGroups(ctx, &awsrds.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: aws.String(cl.SubnetGroup),
+	})
+	if err != nil {
+		return Cluster{}, fmt.Errorf("DescribeDBSubnetGroups: %w", err)
+	}
+	if len(out.DBSubnetGroups) > 0 {
+		cl.VPCID, cl.Subnets = subnetGroup(&out.DBSubnetGroups[0])
+	}
+	return cl, nil
+}
+
+func (c *Client) describeClusters(ctx context.Context, id *string) ([]Cluster, error) {
+	var clusters []Cluster
+	var marker *string
+	for {
+		out, err := c.api.DescribeDBClusters(ctx, &awsrds.DescribeDBClustersInput{
+			DBClusterIdentifier: id,
+			Marker:              marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeDBClusters: %w", err)
+		}
+		for _, cl := range out.DBClusters {
+			clusters = append(clusters, toCluster(cl))
+		}
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].ID < clusters[j].ID })
+	return clusters, nil
+}
+
+// ListPendingMaintenance returns the maintenance actions pending on the
+// instance or cluster with the given ARN.
+func (c *Client) ListPendingMaintenance(ctx context.Context, arn string) ([]MaintenanceAction, error) {
+	var actions []MaintenanceAction
+	var marker *string
+	for {
+		out, err := c.api.DescribePendingMaintenanceActions(ctx, &awsrds.DescribePendingMaintenanceActionsInput{
+			ResourceIdentifier: aws.String(arn),
+			Marker:             marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribePendingMaintenanceActions: %w", err)
+		}
+		for _, r := range out.PendingMaintenanceActions {
+			for _, a := range r.PendingMaintenanceActionDetails {
+				actions = append(actions, MaintenanceAction{
+					Action:           aws.ToString(a.Action),
+					Description:      aws.ToString(a.Description),
+					OptIn:            aws.ToString(a.OptInStatus),
+					AutoAppliedAfter: aws.ToTime(a.AutoAppliedAfterDate),
+					ForcedApply:      aws.ToTime(a.ForcedApplyDate),
+					CurrentApply:     aws.ToTime(a.CurrentApplyDate),
+				})
+			}
+		}
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	return actions, nil
+}
+
+// ListSnapshots returns the automated and manual snapshots of a DB
+// instance, newest first.
+func (c *Client) ListSnapshots(ctx context.Context, instanceID string) ([]Snapshot, error) {
+	var snaps []Snapshot
+	var marker *string
+	for {
+		out, err := c.api.DescribeDBSnapshots(ctx, &awsrds.DescribeDBSnapshotsInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			Marker:               marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeDBSnapshots: %w", err)
+		}
+		for _, s := range out.DBSnapshots {
+			snaps = append(snaps, Snapshot{
+				ID:        aws.ToString(s.DBSnapshotIdentifier),
+				Type:      aws.ToString(s.SnapshotType),
+				Status:    aws.ToString(s.Status),
+				StorageGB: int(aws.ToInt32(s.AllocatedStorage)),
+				Encrypted: aws.ToBool(s.Encrypted),
+				CreatedAt: aws.ToTime(s.SnapshotCreateTime),
+			})
+		}
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	sortSnapshots(snaps)
+	return snaps, nil
+}
+
+// ListClusterSnapshots returns the automated and manual snapshots of a DB
+// cluster, newest first.
+func (c *Client) ListClusterSnapshots(ctx context.Context, clusterID string) ([]Snapshot, error) {
+	var snaps []Snapshot
+	var marker *string
+	for {
+		out, err := c.api.DescribeDBClusterSnapshots(ctx, &awsrds.DescribeDBClusterSnapshotsInput{
+			DBClusterIdentifier: aws.String(clusterID),
+			Marker:              marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("DescribeDBClusterSnapshots: %w", err)
+		}
+		for _, s := range out.DBClusterSnapshots {
+			snaps = append(snaps, Snapshot{
+				ID:        aws.ToString(s.DBClusterSnapshotIdentifier),
+				Type:      aws.ToString(s.SnapshotType),
+				Status:    aws.ToString(s.Status),
+				StorageGB: int(aws.ToInt32(s.AllocatedStorage)),
+				Encrypted: aws.ToBool(s.StorageEncrypted),
+				CreatedAt: aws.ToTime(s.SnapshotCreateTime),
+			})
+		}
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	sortSnapshots(snaps)
+	return snaps, nil
+}
+
+func sortSnapshots(snaps []Snapshot) {
+	sort.SliceStable(snaps, func(i, j int) bool { return snaps[i].CreatedAt.After(snaps[j].CreatedAt) })
+}
+
+func toInstance(db rdstypes.DBInstance) Instance {
+	inst := Instance{
+		ID:                 aws.ToString(db.DBInstanceIdentifier),
+		ARN:                aws.ToString(db.DBInstanceArn),
+		Status:             aws.ToString(db.DBInstanceStatus),
+		Engine:             aws.ToString(db.Engine),
+		EngineVersion:      aws.ToString(db.EngineVersion),
+		Class:              aws.ToString(db.DBInstanceClass),
+		MultiAZ:            aws.ToBool(db.MultiAZ),
+		AZ:                 aws.ToString(db.AvailabilityZone),
+		StorageGB:          int(aws.ToInt32(db.AllocatedStorage)),
+		StorageType:        aws.ToString(db.StorageType),
+		IOPS:               int(aws.ToInt32(db.Iops)),
+		Encrypted:          aws.ToBool(db.StorageEncrypted),
+		ClusterID:          aws.ToString(db.DBClusterIdentifier),
+		SecurityGroups:     securityGroups(db.VpcSecurityGroups),
+		PubliclyAccessible: aws.ToBool(db.PubliclyAccessible),
+		DeletionProtection: aws.ToBool(db.DeletionProtection),
+		BackupRetention:    int(aws.ToInt32(db.BackupRetentionPeriod)),
+		BackupWindow:       aws.ToString(db.PreferredBackupWindow),
+		MaintenanceWindow:  aws.ToString(db.PreferredMaintenanceWindow),
+		PendingChanges:     pendingChanges(db.PendingModifiedValues),
+		CreatedAt:          aws.ToTime(db.InstanceCreateTime),
+	}
+	if db.Endpoint != nil && db.Endpoint.Address != nil {
+		inst.Endpoint = aws.ToString(db.Endpoint.Address) + ":" + strconv.Itoa(int(aws.ToInt32(db.Endpoint.Port)))
+	}
+	for _, pg := range db.DBParameterGroups {
+		inst.ParameterGroups = append(inst.ParameterGroups, ParameterGroup{
+			Name:   aws.ToString(pg.DBParameterGroupName),
+			Status: aws.ToString(pg.ParameterApplyStatus),
+		})
+	}
+	inst.VPCID, inst.Subnets = subnetGroup(db.DBSubnetGroup)
+	return inst
+}
+
+func toCluster(cl rdstypes.DBCluster) Cluster {
+	c := Cluster{
+		ID:                 aws.ToString(cl.DBClusterIdentifier),
+		ARN:                aws.ToString(cl.DBClusterArn),
+		Status:             aws.ToString(cl.Status),
+		Engine:             aws.ToString(cl.Engine),
+		EngineVersion:      aws.ToString(cl.EngineVersion),
+		EngineMode:         aws.ToString(cl.EngineMode),
+		MultiAZ:            aws.ToBool(cl.MultiAZ),
+		StorageGB:          int(aws.ToInt32(cl.AllocatedStorage)),
+		StorageType:        aws.ToString(cl.StorageType),
+		Encrypted:          aws.ToBool(cl.StorageEncrypted),
+		ParameterGroup:     aws.ToString(cl.DBClusterParameterGroup),
+		SubnetGroup:        aws.ToString(cl.DBSubnetGroup),
+		SecurityGroups:     securityGroups(cl.VpcSecurityGroups),
+		DeletionProtection: aws.ToBool(cl.DeletionProtection),
+		BackupRetention:    int(aws.ToInt32(cl.BackupRetentionPeriod)),
+		BackupWindow:       aws.ToString(cl.PreferredBackupWindow),
+		MaintenanceWindow:  aws.ToString(cl.PreferredMaintenanceWindow),
+		CreatedAt:          aws.ToTime(cl.ClusterCreateTime),
+	}
+	port := ":" + strconv.Itoa(int(aws.ToInt32(cl.Port)))
+	if cl.Endpoint != nil {
+		c.Endpoint = aws.ToString(cl.Endpoint) + port
+	}
+	if cl.ReaderEndpoint != nil {
+		c.ReaderEndpoint = aws.ToString(cl.ReaderEndpoint) + port
+	}
+	if sc := cl.ServerlessV2ScalingConfiguration; sc != nil {
+		c.Capacity = fmt.Sprintf("%g–%g ACU", aws.ToFloat64(sc.MinCapacity), aws.ToFloat64(sc.MaxCapacity))
+	}
+	for _, m := range cl.DBClusterMembers {
+		c.Members = append(c.Members, ClusterMember{
+			InstanceID: aws.ToString(m.DBInstanceIdentifier),
+			Writer:     aws.ToBool(m.IsClusterWriter),
+		})
+	}
+	// Writers first, then readers by name.
+	sort.Slice(c.Members, func(i, j int) bool {
+		if c.Members[i].Writer != c.Members[j].Writer {
+			return c.Members[i].Writer
+		}
+		return c.Members[i].InstanceID < c.Members[j].InstanceID
+	})
+	return c
+}
+
+func subnetGroup(g *rdstypes.DBSubnetGroup) (vpcID string, subnets []string) {
+	if g == nil {
+		return "", nil
+	}
+	for _, s := range g.Subnets {
+		subnets = append(subnets, aws.ToString(s.SubnetIdentifier))
+	}
+	sort.Strings(subnets)
+	return aws.ToString(g.VpcId), subnets
+}
+
+func securityGroups(memberships []rdstypes.VpcSecurityGroupMembership) []string {
+	var ids []string
+	for _, m := range memberships {
+		ids = append(ids, aws.ToString(m.VpcSecurityGroupId))
+	}
+	return ids
+}
+
+// pendingChanges describes the modifications of an instance that wait for
+// its next maintenance window, e.g. "class → db.r6g.large".
+func pendingChanges(p *rdstypes.PendingModifiedValues) []string {
+	if p == nil {
+		return nil
+	}
+	var changes []string
+	if p.DBInstanceClass != nil {
+		changes = append(changes, "class → "+*p.DBInstanceClass)
+	}
+	if p.EngineVersion != nil {
+		changes = append(changes, "engine version → "+*p.EngineVersion)
+	}
+	if p.AllocatedStorage != nil {
+		changes = append(changes, fmt.Sprintf("storage → %d GB", *p.AllocatedStorage))
+	}
+	if p.StorageType != nil {
+		changes = append(changes, "storage type → "+*p.StorageType)
+	}
+	if p.Iops != nil {
+		changes = append(changes, fmt.Sprintf("IOPS → %d", *p.Iops))
+	}
+	if p.MultiAZ != nil {
+		changes = append(changes, fmt.Sprintf("Multi-AZ → %t", *p.MultiAZ))
+	}
+	if p.BackupRetentionPeriod != nil {
+		changes = append(changes, fmt.Sprintf("backup retention → %d days", *p.BackupRetentionPeriod))
+	}
+	if p.CACertificateIdentifier != nil {
+		changes = append(changes, "CA certificate → "+*p.CACertificateIdentifier)
+	}
+	return changes
+}
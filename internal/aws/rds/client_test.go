@@ -0,0 +1,206 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRDSAPI struct {
+	describeDBInstancesFunc               func(ctx context.Context, params *awsrds.DescribeDBInstancesInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error)
+	describeDBClustersFunc                func(ctx context.Context, params *awsrds.DescribeDBClustersInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBClustersOutput, error)
+	describeDBSubnetGroupsFunc            func(ctx context.Context, params *awsrds.DescribeDBSubnetGroupsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBSubnetGroupsOutput, error)
+	describeDBSnapshotsFunc               func(ctx context.Context, params *awsrds.DescribeDBSnapshotsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBSnapshotsOutput, error)
+	describeDBClusterSnapshotsFunc        func(ctx context.Context, params *awsrds.DescribeDBClusterSnapshotsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBClusterSnapshotsOutput, error)
+	describePendingMaintenanceActionsFunc func(ctx context.Context, params *awsrds.DescribePendingMaintenanceActionsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribePendingMaintenanceActionsOutput, error)
+}
+
+func (m *mockRDSAPI) DescribeDBInstances(ctx context.Context, params *awsrds.DescribeDBInstancesInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+	return m.describeDBInstancesFunc(ctx, params, optFns...)
+}
+
+func (m *mockRDSAPI) DescribeDBClusters(ctx context.Context, params *awsrds.DescribeDBClustersInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBClustersOutput, error) {
+	return m.describeDBClustersFunc(ctx, params, optFns...)
+}
+
+func (m *mockRDSAPI) DescribeDBSubnetGroups(ctx context.Context, params *awsrds.DescribeDBSubnetGroupsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBSubnetGroupsOutput, error) {
+	return m.describeDBSubnetGroupsFunc(ctx, params, optFns...)
+}
+
+func (m *mockRDSAPI) DescribeDBSnapshots(ctx context.Context, params *awsrds.DescribeDBSnapshotsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBSnapshotsOutput, error) {
+	return m.describeDBSnapshotsFunc(ctx, params, optFns...)
+}
+
+func (m *mockRDSAPI) DescribeDBClusterSnapshots(ctx context.Context, params *awsrds.DescribeDBClusterSnapshotsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribeDBClusterSnapshotsOutput, error) {
+	return m.describeDBClusterSnapshotsFunc(ctx, params, optFns...)
+}
+
+func (m *mockRDSAPI) DescribePendingMaintenanceActions(ctx context.Context, params *awsrds.DescribePendingMaintenanceActionsInput, optFns ...func(*awsrds.Options)) (*awsrds.DescribePendingMaintenanceActionsOutput, error) {
+	return m.describePendingMaintenanceActionsFunc(ctx, params, optFns...)
+}
+
+func TestListInstances(t *testing.T) {
+	mock := &mockRDSAPI{
+		describeDBInstancesFunc: func(_ context.Context, params *awsrds.DescribeDBInstancesInput, _ ...func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+			if params.Marker == nil {
+				return &awsrds.DescribeDBInstancesOutput{
+					DBInstances: []rdstypes.DBInstance{{
+						DBInstanceIdentifier: awssdk.String("orders-db"),
+						DBInstanceStatus:     awssdk.String("available"),
+						Engine:               awssdk.String("postgres"),
+						EngineVersion:        awssdk.String("16.3"),
+						DBInstanceClass:      awssdk.String("db.t4g.medium"),
+						MultiAZ:              awssdk.Bool(true),
+						AllocatedStorage:     awssdk.Int32(100),
+						StorageType:          awssdk.String("gp3"),
+						Endpoint:             &rdstypes.Endpoint{Address: awssdk.String("orders-db.abc.rds.amazonaws.com"), Port: awssdk.Int32(5432)},
+						DBParameterGroups: []rdstypes.DBParameterGroupStatus{
+							{DBParameterGroupName: awssdk.String("pg16-tuned"), ParameterApplyStatus: awssdk.String("pending-reboot")},
+						},
+						DBSubnetGroup: &rdstypes.DBSubnetGroup{
+							VpcId: awssdk.String("vpc-1"),
+							Subnets: []rdstypes.Subnet{
+								{SubnetIdentifier: awssdk.String("subnet-b")},
+								{SubnetIdentifier: awssdk.String("subnet-a")},
+							},
+						},
+						VpcSecurityGroups:     []rdstypes.VpcSecurityGroupMembership{{VpcSecurityGroupId: awssdk.String("sg-1")}},
+						PendingModifiedValues: &rdstypes.PendingModifiedValues{DBInstanceClass: awssdk.String("db.r6g.large")},
+					}},
+					Marker: awssdk.String("page2"),
+				}, nil
+			}
+			return &awsrds.DescribeDBInstancesOutput{
+				DBInstances: []rdstypes.DBInstance{{
+					DBInstanceIdentifier: awssdk.String("analytics-1"),
+					DBInstanceStatus:     awssdk.String("creating"),
+					DBClusterIdentifier:  awssdk.String("analytics"),
+				}},
+			}, nil
+		},
+	}
+
+	instances, err := NewClient(mock).ListInstances(context.Background())
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+	assert.Equal(t, "analytics-1", instances[0].ID)
+	assert.Equal(t, "analytics", instances[0].ClusterID)
+	assert.Empty(t, instances[0].Endpoint)
+
+	db := instances[1]
+	assert.Equal(t, "orders-db", db.ID)
+	assert.Equal(t, "db.t4g.medium", db.Class)
+	assert.True(t, db.MultiAZ)
+	assert.Equal(t, 100, db.StorageGB)
+	assert.Equal(t, "orders-db.abc.rds.amazonaws.com:5432", db.Endpoint)
+	assert.Equal(t, []ParameterGroup{{Name: "pg16-tuned", Status: "pending-reboot"}}, db.ParameterGroups)
+	assert.Equal(t, "vpc-1", db.VPCID)
+	assert.Equal(t, []string{"subnet-a", "subnet-b"}, db.Subnets)
+	assert.Equal(t, []string{"sg-1"}, db.SecurityGroups)
+	assert.Equal(t, []string{"class → db.r6g.large"}, db.PendingChanges)
+}
+
+func TestListInstances_Error(t *testing.T) {
+	mock := &mockRDSAPI{
+		describeDBInstancesFunc: func(_ context.Context, _ *awsrds.DescribeDBInstancesInput, _ ...func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+	_, err := NewClient(mock).ListInstances(context.Background())
+	assert.EqualError(t, err, "DescribeDBInstances: AccessDenied")
+}
+
+func TestGetCluster(t *testing.T) {
+	mock := &mockRDSAPI{
+		describeDBClustersFunc: func(_ context.Context, params *awsrds.DescribeDBClustersInput, _ ...func(*awsrds.Options)) (*awsrds.DescribeDBClustersOutput, error) {
+			assert.Equal(t, "analytics", awssdk.ToString(params.DBClusterIdentifier))
+			return &awsrds.DescribeDBClustersOutput{DBClusters: []rdstypes.DBCluster{{
+				DBClusterIdentifier: awssdk.String("analytics"),
+				Engine:              awssdk.String("aurora-postgresql"),
+				Endpoint:            awssdk.String("analytics.cluster-abc.rds.amazonaws.com"),
+				ReaderEndpoint:      awssdk.String("analytics.cluster-ro-abc.rds.amazonaws.com"),
+				Port:                awssdk.Int32(5432),
+				DBSubnetGroup:       awssdk.String("private"),
+				ServerlessV2ScalingConfiguration: &rdstypes.ServerlessV2ScalingConfigurationInfo{
+					MinCapacity: awssdk.Float64(0.5),
+					MaxCapacity: awssdk.Float64(16),
+				},
+				DBClusterMembers: []rdstypes.DBClusterMember{
+					{DBInstanceIdentifier: awssdk.String("analytics-2")},
+					{DBInstanceIdentifier: awssdk.String("analytics-3"), IsClusterWriter: awssdk.Bool(true)},
+					{DBInstanceIdentifier: awssdk.String("analytics-1")},
+				},
+			}}}, nil
+		},
+		describeDBSubnetGroupsFunc: func(_ context.Context, params *awsrds.DescribeDBSubnetGroupsInput, _ ...func(*awsrds.Options)) (*awsrds.DescribeDBSubnetGroupsOutput, error) {
+			assert.Equal(t, "private", awssdk.ToString(params.DBSubnetGroupName))
+			return &awsrds.DescribeDBSubnetGroupsOutput{DBSubnetGroups: []rdstypes.DBSubnetGroup{{
+				VpcId:   awssdk.String("vpc-1"),
+				Subnets: []rdstypes.Subnet{{SubnetIdentifier: awssdk.String("subnet-a")}},
+			}}}, nil
+		},
+	}
+
+	cl, err := NewClient(mock).GetCluster(context.Background(), "analytics")
+	require.NoError(t, err)
+	assert.Equal(t, "analytics.cluster-abc.rds.amazonaws.com:5432", cl.Endpoint)
+	assert.Equal(t, "analytics.cluster-ro-abc.rds.amazonaws.com:5432", cl.ReaderEndpoint)
+	assert.Equal(t, "0.5–16 ACU", cl.Capacity)
+	assert.Equal(t, []ClusterMember{
+		{InstanceID: "analytics-3", Writer: true},
+		{InstanceID: "analytics-1"},
+		{InstanceID: "analytics-2"},
+	}, cl.Members)
+	assert.Equal(t, "vpc-1", cl.VPCID)
+	assert.Equal(t, []string{"subnet-a"}, cl.Subnets)
+}
+
+func TestListSnapshots(t *testing.T) {
+	older := time.Date(2024, 5, 1, 3, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+	mock := &mockRDSAPI{
+		describeDBSnapshotsFunc: func(_ context.Context, params *awsrds.DescribeDBSnapshotsInput, _ ...func(*awsrds.Options)) (*awsrds.DescribeDBSnapshotsOutput, error) {
+			assert.Equal(t, "orders-db", awssdk.ToString(params.DBInstanceIdentifier))
+			return &awsrds.DescribeDBSnapshotsOutput{DBSnapshots: []rdstypes.DBSnapshot{
+				{DBSnapshotIdentifier: awssdk.String("pre-upgrade"), SnapshotType: awssdk.String("manual"), SnapshotCreateTime: &older},
+				{DBSnapshotIdentifier: awssdk.String("rds:orders-db-2024-05-02"), SnapshotType: awssdk.String("automated"), SnapshotCreateTime: &newer},
+			}}, nil
+		},
+	}
+
+	snaps, err := NewClient(mock).ListSnapshots(context.Background(), "orders-db")
+	require.NoError(t, err)
+	require.Len(t, snaps, 2)
+	assert.Equal(t, "automated", snaps[0].Type)
+	assert.Equal(t, "pre-upgrade", snaps[1].ID)
+}
+
+func TestListPendingMaintenance(t *testing.T) {
+	apply := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock := &mockRDSAPI{
+		describePendingMaintenanceActionsFunc: func(_ context.Context, params *awsrds.DescribePendingMaintenanceActionsInput, _ ...func(*awsrds.Options)) (*awsrds.DescribePendingMaintenanceActionsOutput, error) {
+			assert.Equal(t, "arn:aws:rds:us-east-1:123456789012:db:orders-db", awssdk.ToString(params.ResourceIdentifier))
+			return &awsrds.DescribePendingMaintenanceActionsOutput{PendingMaintenanceActions: []rdstypes.ResourcePendingMaintenanceActions{{
+				PendingMaintenanceActionDetails: []rdstypes.PendingMaintenanceAction{{
+					Action:           awssdk.String("system-update"),
+					Description:      awssdk.String("New Operating System update is available"),
+					CurrentApplyDate: &apply,
+				}},
+			}}}, nil
+		},
+	}
+
+	actions, err := NewClient(mock).ListPendingMaintenance(context.Background(), "arn:aws:rds:us-east-1:123456789012:db:orders-db")
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "system-update", actions[0].Action)
+	assert.Equal(t, apply, actions[0].CurrentApply)
+	assert.True(t, actions[0].ForcedApply.IsZero())
+}
@@ -0,0 +1,95 @@
+package rds
+
+import "time"
+
+// Instance is an RDS DB instance, including the instances of Aurora
+// clusters.
+type Instance struct {
+	ID                 string
+	ARN                string
+	Status             string
+	Engine             string
+	EngineVersion      string
+	Class              string
+	MultiAZ            bool
+	AZ                 string
+	StorageGB          int
+	StorageType        string
+	IOPS               int
+	Encrypted          bool
+	Endpoint           string // host:port, empty while the instance is being created
+	ClusterID          string // empty unless the instance belongs to an Aurora cluster
+	ParameterGroups    []ParameterGroup
+	VPCID              string
+	Subnets            []string
+	SecurityGroups     []string
+	PubliclyAccessible bool
+	DeletionProtection bool
+	BackupRetention    int // days; 0 when automated backups are disabled
+	BackupWindow       string
+	MaintenanceWindow  string
+	PendingChanges     []string // modifications waiting for the next maintenance window
+	CreatedAt          time.Time
+}
+
+// Cluster is an Aurora (or Multi-AZ) DB cluster.
+type Cluster struct {
+	ID                 string
+	ARN                string
+	Status             string
+	Engine             string
+	EngineVersion      string
+	EngineMode         string // "provisioned", "serverless", …
+	Capacity           string // Serverless v2 ACU range, e.g. "0.5–16 ACU"
+	MultiAZ            bool
+	StorageGB          int
+	StorageType        string
+	Encrypted          bool
+	Endpoint           string
+	ReaderEndpoint     string
+	Members            []ClusterMember
+	ParameterGroup     string
+	SubnetGroup        string
+	VPCID              string
+	Subnets            []string
+	SecurityGroups     []string
+	DeletionProtection bool
+	BackupRetention    int
+	BackupWindow       string
+	MaintenanceWindow  string
+	CreatedAt          time.Time
+}
+
+// ClusterMember is an instance of a cluster.
+type ClusterMember struct {
+	InstanceID string
+	Writer     bool
+}
+
+// ParameterGroup is a parameter group applied to an instance, with whether
+// its latest changes have been applied.
+type ParameterGroup struct {
+	Name   string
+	Status string // "in-sync", "pending-reboot", …
+}
+
+// MaintenanceAction is a maintenance action pending on an instance or
+// cluster.
+type MaintenanceAction struct {
+	Action           string
+	Description      string
+	OptIn            string
+	AutoAppliedAfter time.Time
+	ForcedApply      time.Time
+	CurrentApply     time.Time
+}
+
+// Snapshot is a DB or cluster snapshot.
+type Snapshot struct {
+	ID        string
+	Type      string // "automated", "manual", …
+	Status    string
+	StorageGB int
+	Encrypted bool
+	CreatedAt time.Time
+}
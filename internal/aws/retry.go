@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// RetryPolicy controls how Retry backs off between attempts.
+type RetryPolicy struct {
+	MaxAttempts int           // total attempts, including the first
+	BaseDelay   time.Duration // delay before the second attempt
+	MaxDelay    time.Duration // cap on the exponential delay
+}
+
+// DefaultRetryPolicy is used by service views for list and detail fetches.
+// It takes the place of the SDK's standard retryer for those calls, so it
+// allows as many attempts and the same maximum backoff.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: retry.DefaultMaxAttempts,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    retry.DefaultMaxBackoff,
+}
+
+// sdkRetryables are the checks of the SDK's standard retryer, other than
+// the one that honours an error's own RetryableError method: Retry marks
+// every error of its attempts as not retryable by the SDK.
+var sdkRetryables = retry.IsErrorRetryables{
+	retry.NoRetryCanceledError{},
+	retry.RetryableConnectionError{},
+	retry.RetryableHTTPStatusCode{Codes: retry.DefaultRetryableHTTPStatusCodes},
+	retry.RetryableErrorCode{Codes: retry.DefaultRetryableErrorCodes},
+	retry.RetryableErrorCode{Codes: retry.DefaultThrottleErrorCodes},
+}
+
+// retryable reports whether Retry tries err again: throttled and network
+// errors, and whatever else the SDK's standard retryer would retry.
+func retryable(err error) bool {
+	if IsRetryable(ClassifyError(err)) {
+		return true
+	}
+	return sdkRetryables.IsErrorRetryable(err).Bool()
+}
+
+// Retry calls fn until it succeeds, fails with an error that is not
+// retryable, ctx is cancelled or the policy's attempts are used up. Before
+// each retry it calls onRetry, if set, with the upcoming attempt number and
+// the error that caused it, then waits an exponentially growing, jittered
+// delay.
+//
+// Retry replaces the SDK's retryer rather than adding to it: instrumented
+// calls made with the context passed to fn are sent once, and their errors
+// only reach Connectivity.Observe once Retry has given up, so a network error
+// that a retry recovers from does not switch the app offline.
+//
+// ErrOffline is never retried: once the app has switched to offline mode the
+// connectivity probe, not the caller, decides when to try again.
+func Retry(ctx context.Context, p RetryPolicy, fn func(ctx context.Context) error, onRetry func(attempt int, err error)) error {
+	attempts := max(p.MaxAttempts, 1)
+	scope := &attemptScope{}
+	ctx = context.WithValue(ctx, attemptScopeKey{}, scope)
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		scope.reset()
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+		if attempt >= attempts || errors.Is(err, ErrOffline) || !retryable(err) {
+			scope.observe()
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return err
+		}
+
+		if onRetry != nil {
+			onRetry(attempt+1, err)
+		}
+
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+type attemptScopeKey struct{}
+
+// attemptScope holds back the last observable error of an attempt made by
+// Retry, along with the Connectivity that would have observed it.
+type attemptScope struct {
+	mu   sync.Mutex
+	conn *Connectivity
+	err  error
+}
+
+func (s *attemptScope) hold(c *Connectivity, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conn, s.err = c, err
+}
+
+func (s *attemptScope) reset() {
+	s.hold(nil, nil)
+}
+
+// observe passes the held error, if any, to its Connectivity.
+func (s *attemptScope) observe() {
+	s.mu.Lock()
+	c, err := s.conn, s.err
+	s.mu.Unlock()
+	if err != nil {
+		c.Observe(err)
+	}
+}
+
+// noRetryError marks an error as not retryable for the SDK's retryer, which
+// checks RetryableError before anything else about the error.
+type noRetryError struct {
+	err error
+}
+
+func (e *noRetryError) Error() string        { return e.err.Error() }
+func (e *noRetryError) Unwrap() error        { return e.err }
+func (e *noRetryError) RetryableError() bool { return false }
+
+// backoff returns the delay after the given failed attempt: BaseDelay doubled
+// per attempt, capped at MaxDelay, with the upper half randomised so that
+// views retrying together spread out.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + rand.N(d-half)
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond}
+
+func TestRetry(t *testing.T) {
+	throttled := &mockAPIError{code: "Throttling", message: "slow down"}
+	denied := &mockAPIError{code: "AccessDenied", message: "no"}
+
+	tests := []struct {
+		name        string
+		errs        []error // returned by successive calls; nil after the end
+		wantErr     error
+		wantCalls   int
+		wantRetries []int
+	}{
+		{name: "success on first attempt", errs: nil, wantCalls: 1},
+		{name: "throttled then success", errs: []error{throttled}, wantCalls: 2, wantRetries: []int{2}},
+		{name: "network error then success", errs: []error{errors.New("connection refused")}, wantCalls: 2, wantRetries: []int{2}},
+		{name: "gives up after max attempts", errs: []error{throttled, throttled, throttled, throttled}, wantErr: throttled, wantCalls: 3, wantRetries: []int{2, 3}},
+		{name: "non-retryable error is returned at once", errs: []error{denied}, wantErr: denied, wantCalls: 1},
+		{name: "offline is not retried", errs: []error{ErrOffline}, wantErr: ErrOffline, wantCalls: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			var retries []int
+			err := Retry(context.Background(), testRetryPolicy, func(context.Context) error {
+				calls++
+				if calls <= len(tc.errs) {
+					return tc.errs[calls-1]
+				}
+				return nil
+			}, func(attempt int, _ error) {
+				retries = append(retries, attempt)
+			})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantCalls, calls)
+			assert.Equal(t, tc.wantRetries, retries)
+		})
+	}
+}
+
+func TestRetry_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+	throttled := &mockAPIError{code: "Throttling", message: "slow down"}
+
+	calls := 0
+	done := make(chan error, 1)
+	go func() {
+		done <- Retry(ctx, p, func(context.Context) error {
+			calls++
+			return throttled
+		}, func(int, error) { cancel() })
+	}()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, throttled)
+		assert.Equal(t, 1, calls)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Retry did not stop when the context was cancelled")
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	for range 20 {
+		d := p.backoff(1)
+		require.GreaterOrEqual(t, d, 50*time.Millisecond)
+		require.Less(t, d, 100*time.Millisecond)
+
+		d = p.backoff(2)
+		require.GreaterOrEqual(t, d, 100*time.Millisecond)
+		require.Less(t, d, 200*time.Millisecond)
+
+		// Capped at MaxDelay.
+		d = p.backoff(10)
+		require.GreaterOrEqual(t, d, 150*time.Millisecond)
+		require.Less(t, d, 300*time.Millisecond)
+	}
+}
@@ -7,23 +7,88 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
 // Session holds the loaded AWS configuration along with the region and profile
-// that were used to create it.
+// that were used to create it. For a session in a named account, Profile is
+// the source profile and Role the role assumed from it.
 type Session struct {
 	Config  aws.Config
 	Region  string
 	Profile string
+	Account string // name of the configured account, if any
+	Role    *Role
+}
+
+// Role is an IAM role assumed with the credentials of a source profile.
+type Role struct {
+	ARN         string
+	ExternalID  string
+	SessionName string
+}
+
+// defaultRoleSessionName is used when a Role has no SessionName.
+const defaultRoleSessionName = "aws-tui"
+
+// RoleCredentials caches assumed-role credentials per source profile and role
+// until they expire, so switching back to an account does not call
+// AssumeRole again. It is safe for concurrent use.
+type RoleCredentials struct {
+	mu     sync.Mutex
+	caches map[string]*aws.CredentialsCache
+}
+
+// NewRoleCredentials returns an empty RoleCredentials.
+func NewRoleCredentials() *RoleCredentials {
+	return &RoleCredentials{caches: make(map[string]*aws.CredentialsCache)}
+}
+
+func roleKey(profile string, role Role) string {
+	return profile + "|" + role.ARN + "|" + role.ExternalID + "|" + role.SessionName
+}
+
+// provider returns the cached credentials for role, creating a provider that
+// assumes it with src's credentials if there is none yet.
+func (r *RoleCredentials) provider(src aws.Config, profile string, role Role) *aws.CredentialsCache {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := roleKey(profile, role)
+	if c, ok := r.caches[key]; ok {
+		return c
+	}
+	c := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(src), role.ARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = role.SessionName
+		if o.RoleSessionName == "" {
+			o.RoleSessionName = defaultRoleSessionName
+		}
+		if role.ExternalID != "" {
+			o.ExternalID = aws.String(role.ExternalID)
+		}
+	}))
+	r.caches[key] = c
+	return c
+}
+
+// Forget drops the cached credentials for role, typically after the source
+// profile's credentials have been renewed.
+func (r *RoleCredentials) Forget(profile string, role Role) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.caches, roleKey(profile, role))
 }
 
 // NewSession loads an AWS config with optional region and profile overrides and
-// returns a Session wrapping the result.
-func NewSession(ctx context.Context, region, profile string) (*Session, error) {
+// returns a Session wrapping the result. mfa, which may be nil, supplies codes
+// for profiles with mfa_serial; without it such profiles fail to load.
+func NewSession(ctx context.Context, region, profile string, mfa *MFA) (*Session, error) {
 	opts := []func(*config.LoadOptions) error{}
 	if profile != "" {
 		opts = append(opts, config.WithSharedConfigProfile(profile))
@@ -31,11 +96,17 @@ func NewSession(ctx context.Context, region, profile string) (*Session, error) {
 	if region != "" {
 		opts = append(opts, config.WithRegion(region))
 	}
+	if mfa != nil {
+		opts = append(opts, mfa.loadOption(profile))
+	}
 
 	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("loading AWS config: %w", err)
 	}
+	if mfa != nil {
+		cfg.Credentials = mfa.credentials(profile, cfg.Credentials)
+	}
 
 	return &Session{
 		Config:  cfg,
@@ -44,6 +115,40 @@ func NewSession(ctx context.Context, region, profile string) (*Session, error) {
 	}, nil
 }
 
+// NewRoleSession loads the config of the source profile and replaces its
+// credentials with those of role, taken from creds. The role is assumed
+// before returning so that a denied AssumeRole fails the switch rather than
+// the first API call.
+func NewRoleSession(ctx context.Context, region, profile, account string, role Role, creds *RoleCredentials, mfa *MFA) (*Session, error) {
+	sess, err := NewSession(ctx, region, profile, mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	sess.Config.Credentials = creds.provider(sess.Config, profile, role)
+	// The source credentials are retrieved through the role's credentials
+	// cache, which hides ctx from them; bind it so an MFA prompt stops
+	// waiting when ctx is done.
+	release := mfa.bind(ctx, profile)
+	defer release()
+	if _, err := sess.Config.Credentials.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("assuming role %s: %w", role.ARN, err)
+	}
+	sess.Account = account
+	sess.Role = &role
+	return sess, nil
+}
+
+// CacheProfile returns the name cached resources are stored under: the
+// profile, or the role ARN for a session in a named account, so accounts
+// reached through the same source profile do not share cached rows.
+func (s *Session) CacheProfile() string {
+	if s.Role != nil {
+		return s.Role.ARN
+	}
+	return s.Profile
+}
+
 // Identity holds the caller's AWS identity from STS.
 type Identity struct {
 	Account string
@@ -64,6 +169,16 @@ func (s *Session) CallerIdentity(ctx context.Context) (Identity, error) {
 	}, nil
 }
 
+// AccountAlias returns the account's IAM alias, or an empty string if it
+// has none or the caller may not list it.
+func (s *Session) AccountAlias(ctx context.Context) string {
+	out, err := iam.NewFromConfig(s.Config).ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
+	if err != nil || len(out.AccountAliases) == 0 {
+		return ""
+	}
+	return out.AccountAliases[0]
+}
+
 // AccountID calls STS GetCallerIdentity and returns the AWS account ID.
 // Returns an empty string on error (non-fatal).
 func (s *Session) AccountID(ctx context.Context) string {
@@ -104,6 +219,20 @@ func ListProfiles() []string {
 	return profiles
 }
 
+// UsesSSO reports whether profile signs in through IAM Identity Center,
+// that is whether it sets sso_session or sso_start_url, so that aws sso login
+// can renew its credentials. optFns select other shared config files.
+func UsesSSO(ctx context.Context, profile string, optFns ...func(*config.LoadSharedConfigOptions)) bool {
+	if profile == "" {
+		profile = "default"
+	}
+	sc, err := config.LoadSharedConfigProfile(ctx, profile, optFns...)
+	if err != nil {
+		return false
+	}
+	return sc.SSOSessionName != "" || sc.SSOStartURL != ""
+}
+
 // ListRegions returns a hardcoded list of common AWS regions.
 func ListRegions() []string {
 	return []string{
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleCredentials(t *testing.T) {
+	creds := NewRoleCredentials()
+	prod := Role{ARN: "arn:aws:iam::111111111111:role/ReadOnly"}
+	dev := Role{ARN: "arn:aws:iam::222222222222:role/ReadOnly"}
+
+	c := creds.provider(aws.Config{}, "sso", prod)
+	assert.Same(t, c, creds.provider(aws.Config{}, "sso", prod), "credentials are reused while cached")
+	assert.NotSame(t, c, creds.provider(aws.Config{}, "sso", dev))
+	assert.NotSame(t, c, creds.provider(aws.Config{}, "other", prod), "source profiles are kept apart")
+
+	creds.Forget("sso", prod)
+	assert.NotSame(t, c, creds.provider(aws.Config{}, "sso", prod))
+}
+
+func TestUsesSSO(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(`[profile keys]
+region = us-east-1
+
+[profile legacy]
+sso_start_url = https://example.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 111111111111
+sso_role_name = ReadOnly
+
+[profile modern]
+sso_session = corp
+sso_account_id = 111111111111
+sso_role_name = ReadOnly
+
+[sso-session corp]
+sso_start_url = https://example.awsapps.com/start
+sso_region = us-east-1
+`), 0o600))
+	files := func(o *config.LoadSharedConfigOptions) {
+		o.ConfigFiles = []string{path}
+		o.CredentialsFiles = []string{filepath.Join(t.TempDir(), "credentials")}
+	}
+
+	assert.False(t, UsesSSO(context.Background(), "keys", files))
+	assert.True(t, UsesSSO(context.Background(), "legacy", files))
+	assert.True(t, UsesSSO(context.Background(), "modern", files))
+	assert.False(t, UsesSSO(context.Background(), "missing", files))
+}
@@ -0,0 +1,147 @@
+package sns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+// SNSAPI defines the subset of the SNS API we use.
+type SNSAPI interface {
+	ListTopics(ctx context.Context, params *awssns.ListTopicsInput, optFns ...func(*awssns.Options)) (*awssns.ListTopicsOutput, error)
+	GetTopicAttributes(ctx context.Context, params *awssns.GetTopicAttributesInput, optFns ...func(*awssns.Options)) (*awssns.GetTopicAttributesOutput, error)
+	ListSubscriptionsByTopic(ctx context.Context, params *awssns.ListSubscriptionsByTopicInput, optFns ...func(*awssns.Options)) (*awssns.ListSubscriptionsByTopicOutput, error)
+	Publish(ctx context.Context, params *awssns.PublishInput, optFns ...func(*awssns.Options)) (*awssns.PublishOutput, error)
+}
+
+// Client wraps the SNS API.
+type Client struct {
+	api SNSAPI
+}
+
+// NewClient creates a new SNS client.
+func NewClient(api SNSAPI) *Client {
+	return &Client{api: api}
+}
+
+// ListTopics returns every topic in the region with its attributes, sorted
+// by name.
+func (c *Client) ListTopics(ctx context.Context) ([]Topic, error) {
+	var arns []string
+	var token *string
+	for {
+		out, err := c.api.ListTopics(ctx, &awssns.ListTopicsInput{NextToken: token})
+		if err != nil {
+			return nil, fmt.Errorf("ListTopics: %w", err)
+		}
+		for _, t := range out.Topics {
+			arns = append(arns, aws.ToString(t.TopicArn))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+
+	topics := make([]Topic, 0, len(arns))
+	for _, arn := range arns {
+		t, err := c.GetTopic(ctx, arn)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, t)
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Name < topics[j].Name })
+	return topics, nil
+}
+
+// GetTopic returns the topic with the given ARN.
+func (c *Client) GetTopic(ctx context.Context, arn string) (Topic, error) {
+	out, err := c.api.GetTopicAttributes(ctx, &awssns.GetTopicAttributesInput{TopicArn: aws.String(arn)})
+	if err != nil {
+		return Topic{}, fmt.Errorf("GetTopicAttributes: %w", err)
+	}
+	attrs := out.Attributes
+	count := func(name string) int {
+		n, _ := strconv.Atoi(attrs[name])
+		return n
+	}
+	return Topic{
+		Name:                NameFromARN(arn),
+		ARN:                 arn,
+		DisplayName:         attrs["DisplayName"],
+		FIFO:                attrs["FifoTopic"] == "true",
+		ContentDeduplicated: attrs["ContentBasedDeduplication"] == "true",
+		KMSKey:              attrs["KmsMasterKeyId"],
+		Confirmed:           count("SubscriptionsConfirmed"),
+		Pending:             count("SubscriptionsPending"),
+		Deleted:             count("SubscriptionsDeleted"),
+	}, nil
+}
+
+// ListSubscriptions returns the subscriptions to a topic, sorted by protocol
+// and endpoint.
+func (c *Client) ListSubscriptions(ctx context.Context, topicARN string) ([]Subscription, error) {
+	var subs []Subscription
+	var token *string
+	for {
+		out, err := c.api.ListSubscriptionsByTopic(ctx, &awssns.ListSubscriptionsByTopicInput{
+			TopicArn:  aws.String(topicARN),
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListSubscriptionsByTopic: %w", err)
+		}
+		for _, s := range out.Subscriptions {
+			subs = append(subs, Subscription{
+				ARN:      aws.ToString(s.SubscriptionArn),
+				Protocol: aws.ToString(s.Protocol),
+				Endpoint: aws.ToString(s.Endpoint),
+				Owner:    aws.ToString(s.Owner),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		if subs[i].Protocol != subs[j].Protocol {
+			return subs[i].Protocol < subs[j].Protocol
+		}
+		return subs[i].Endpoint < subs[j].Endpoint
+	})
+	return subs, nil
+}
+
+// Publish publishes message to the topic and returns the message ID.
+// Messages to a FIFO topic are published in groupID, with a deduplication ID
+// unless the topic deduplicates by content.
+func (c *Client) Publish(ctx context.Context, t Topic, message, groupID string) (string, error) {
+	in := &awssns.PublishInput{
+		TopicArn: aws.String(t.ARN),
+		Message:  aws.String(message),
+	}
+	if t.FIFO {
+		in.MessageGroupId = aws.String(groupID)
+		if !t.ContentDeduplicated {
+			in.MessageDeduplicationId = aws.String(strconv.FormatInt(time.Now().UnixNano(), 10))
+		}
+	}
+	out, err := c.api.Publish(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("Publish: %w", err)
+	}
+	return aws.ToString(out.MessageId), nil
+}
+
+// NameFromARN returns the topic name at the end of a topic ARN.
+func NameFromARN(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
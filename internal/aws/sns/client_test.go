@@ -0,0 +1,113 @@
+package sns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSNSAPI struct {
+	listTopicsFunc               func(ctx context.Context, params *awssns.ListTopicsInput, optFns ...func(*awssns.Options)) (*awssns.ListTopicsOutput, error)
+	getTopicAttributesFunc       func(ctx context.Context, params *awssns.GetTopicAttributesInput, optFns ...func(*awssns.Options)) (*awssns.GetTopicAttributesOutput, error)
+	listSubscriptionsByTopicFunc func(ctx context.Context, params *awssns.ListSubscriptionsByTopicInput, optFns ...func(*awssns.Options)) (*awssns.ListSubscriptionsByTopicOutput, error)
+	publishFunc                  func(ctx context.Context, params *awssns.PublishInput, optFns ...func(*awssns.Options)) (*awssns.PublishOutput, error)
+}
+
+func (m *mockSNSAPI) ListTopics(ctx context.Context, params *awssns.ListTopicsInput, optFns ...func(*awssns.Options)) (*awssns.ListTopicsOutput, error) {
+	return m.listTopicsFunc(ctx, params, optFns...)
+}
+
+func (m *mockSNSAPI) GetTopicAttributes(ctx context.Context, params *awssns.GetTopicAttributesInput, optFns ...func(*awssns.Options)) (*awssns.GetTopicAttributesOutput, error) {
+	return m.getTopicAttributesFunc(ctx, params, optFns...)
+}
+
+func (m *mockSNSAPI) ListSubscriptionsByTopic(ctx context.Context, params *awssns.ListSubscriptionsByTopicInput, optFns ...func(*awssns.Options)) (*awssns.ListSubscriptionsByTopicOutput, error) {
+	return m.listSubscriptionsByTopicFunc(ctx, params, optFns...)
+}
+
+func (m *mockSNSAPI) Publish(ctx context.Context, params *awssns.PublishInput, optFns ...func(*awssns.Options)) (*awssns.PublishOutput, error) {
+	return m.publishFunc(ctx, params, optFns...)
+}
+
+const topicARN = "arn:aws:sns:us-east-1:123456789012:"
+
+func TestListTopics(t *testing.T) {
+	mock := &mockSNSAPI{
+		listTopicsFunc: func(_ context.Context, params *awssns.ListTopicsInput, _ ...func(*awssns.Options)) (*awssns.ListTopicsOutput, error) {
+			if params.NextToken == nil {
+				return &awssns.ListTopicsOutput{Topics: []snstypes.Topic{{TopicArn: awssdk.String(topicARN + "orders.fifo")}}, NextToken: awssdk.String("p2")}, nil
+			}
+			return &awssns.ListTopicsOutput{Topics: []snstypes.Topic{{TopicArn: awssdk.String(topicARN + "alerts")}}}, nil
+		},
+		getTopicAttributesFunc: func(_ context.Context, params *awssns.GetTopicAttributesInput, _ ...func(*awssns.Options)) (*awssns.GetTopicAttributesOutput, error) {
+			if *params.TopicArn == topicARN+"alerts" {
+				return &awssns.GetTopicAttributesOutput{Attributes: map[string]string{
+					"DisplayName":            "Alerts",
+					"SubscriptionsConfirmed": "2",
+					"SubscriptionsPending":   "1",
+				}}, nil
+			}
+			return &awssns.GetTopicAttributesOutput{Attributes: map[string]string{"FifoTopic": "true"}}, nil
+		},
+	}
+
+	topics, err := NewClient(mock).ListTopics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, topics, 2)
+	assert.Equal(t, "alerts", topics[0].Name)
+	assert.Equal(t, "Alerts", topics[0].DisplayName)
+	assert.Equal(t, 2, topics[0].Confirmed)
+	assert.Equal(t, 1, topics[0].Pending)
+	assert.Equal(t, "orders.fifo", topics[1].Name)
+	assert.True(t, topics[1].FIFO)
+}
+
+func TestListTopics_Error(t *testing.T) {
+	mock := &mockSNSAPI{
+		listTopicsFunc: func(_ context.Context, _ *awssns.ListTopicsInput, _ ...func(*awssns.Options)) (*awssns.ListTopicsOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+	_, err := NewClient(mock).ListTopics(context.Background())
+	assert.ErrorContains(t, err, "ListTopics: AccessDenied")
+}
+
+func TestListSubscriptions(t *testing.T) {
+	mock := &mockSNSAPI{
+		listSubscriptionsByTopicFunc: func(_ context.Context, params *awssns.ListSubscriptionsByTopicInput, _ ...func(*awssns.Options)) (*awssns.ListSubscriptionsByTopicOutput, error) {
+			assert.Equal(t, topicARN+"alerts", *params.TopicArn)
+			return &awssns.ListSubscriptionsByTopicOutput{Subscriptions: []snstypes.Subscription{
+				{SubscriptionArn: awssdk.String(topicARN + "alerts:1"), Protocol: awssdk.String("sqs"), Endpoint: awssdk.String("arn:aws:sqs:us-east-1:123456789012:alerts")},
+				{SubscriptionArn: awssdk.String("PendingConfirmation"), Protocol: awssdk.String("email"), Endpoint: awssdk.String("ops@example.com")},
+			}}, nil
+		},
+	}
+	subs, err := NewClient(mock).ListSubscriptions(context.Background(), topicARN+"alerts")
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	assert.Equal(t, "email", subs[0].Protocol)
+	assert.True(t, subs[0].Pending())
+	assert.Equal(t, "sqs", subs[1].Protocol)
+	assert.False(t, subs[1].Pending())
+}
+
+func TestPublish(t *testing.T) {
+	var got *awssns.PublishInput
+	mock := &mockSNSAPI{
+		publishFunc: func(_ context.Context, params *awssns.PublishInput, _ ...func(*awssns.Options)) (*awssns.PublishOutput, error) {
+			got = params
+			return &awssns.PublishOutput{MessageId: awssdk.String("m1")}, nil
+		},
+	}
+	id, err := NewClient(mock).Publish(context.Background(), Topic{ARN: topicARN + "alerts"}, "hello", "test")
+	require.NoError(t, err)
+	assert.Equal(t, "m1", id)
+	assert.Equal(t, "hello", *got.Message)
+	assert.Nil(t, got.MessageGroupId, "standard topics have no message groups")
+}
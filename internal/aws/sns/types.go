@@ -0,0 +1,27 @@
+package sns
+
+// Topic is an SNS topic with its subscription counts.
+type Topic struct {
+	Name                string
+	ARN                 string
+	DisplayName         string
+	FIFO                bool
+	ContentDeduplicated bool // FIFO topics only
+	KMSKey              string
+	Confirmed           int
+	Pending             int
+	Deleted             int
+}
+
+// Subscription is an endpoint subscribed to a topic.
+type Subscription struct {
+	ARN      string // "PendingConfirmation" until the endpoint confirms
+	Protocol string // e.g. sqs, lambda, https, email
+	Endpoint string
+	Owner    string
+}
+
+// Pending reports whether the endpoint has yet to confirm the subscription.
+func (s Subscription) Pending() bool {
+	return s.ARN == "PendingConfirmation"
+}
@@ -0,0 +1,384 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// SQSAPI defines the subset of the SQS API we use.
+type SQSAPI interface {
+	ListQueues(ctx context.Context, params *awssqs.ListQueuesInput, optFns ...func(*awssqs.Options)) (*awssqs.ListQueuesOutput, error)
+	GetQueueAttributes(ctx context.Context, params *awssqs.GetQueueAttributesInput, optFns ...func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error)
+	ListDeadLetterSourceQueues(ctx context.Context, params *awssqs.ListDeadLetterSourceQueuesInput, optFns ...func(*awssqs.Options)) (*awssqs.ListDeadLetterSourceQueuesOutput, error)
+	ReceiveMessage(ctx context.Context, params *awssqs.ReceiveMessageInput, optFns ...func(*awssqs.Options)) (*awssqs.ReceiveMessageOutput, error)
+	ChangeMessageVisibilityBatch(ctx context.Context, params *awssqs.ChangeMessageVisibilityBatchInput, optFns ...func(*awssqs.Options)) (*awssqs.ChangeMessageVisibilityBatchOutput, error)
+	SendMessage(ctx context.Context, params *awssqs.SendMessageInput, optFns ...func(*awssqs.Options)) (*awssqs.SendMessageOutput, error)
+	PurgeQueue(ctx context.Context, params *awssqs.PurgeQueueInput, optFns ...func(*awssqs.Options)) (*awssqs.PurgeQueueOutput, error)
+	StartMessageMoveTask(ctx context.Context, params *awssqs.StartMessageMoveTaskInput, optFns ...func(*awssqs.Options)) (*awssqs.StartMessageMoveTaskOutput, error)
+	ListMessageMoveTasks(ctx context.Context, params *awssqs.ListMessageMoveTasksInput, optFns ...func(*awssqs.Options)) (*awssqs.ListMessageMoveTasksOutput, error)
+}
+
+// receiveBatch is the most messages a single ReceiveMessage returns.
+const receiveBatch = 10
+
+// peekHold is how long peeked messages stay invisible while further
+// batches are read, so that no message is returned twice. A visibility
+// timeout of zero cannot be requested: the SDK leaves it out of the request,
+// and the queue's own timeout applies instead.
+const peekHold = 30
+
+// releaseTimeout bounds making peeked messages visible again, which outlives
+// the caller's context.
+const releaseTimeout = 10 * time.Second
+
+// Client wraps the SQS API.
+type Client struct {
+	api SQSAPI
+}
+
+// NewClient creates a new SQS client.
+func NewClient(api SQSAPI) *Client {
+	return &Client{api: api}
+}
+
+// ListQueues returns every queue in the region with its attributes, sorted
+// by name. Queues that other queues use as their DLQ have their Sources set.
+func (c *Client) ListQueues(ctx context.Context) ([]Queue, error) {
+	var urls []string
+	var token *string
+	for {
+		out, err := c.api.ListQueues(ctx, &awssqs.ListQueuesInput{
+			MaxResults: aws.Int32(1000),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ListQueues: %w", err)
+		}
+		urls = append(urls, out.QueueUrls...)
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+
+	queues := make([]Queue, 0, len(urls))
+	for _, url := range urls {
+		q, err := c.queueAttributes(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, q)
+	}
+
+	byARN := make(map[string]int, len(queues))
+	for i, q := range queues {
+		byARN[q.ARN] = i
+	}
+	for _, q := range queues {
+		if q.Redrive == nil {
+			continue
+		}
+		if i, ok := byARN[q.Redrive.DLQARN]; ok {
+			queues[i].Sources = append(queues[i].Sources, q.Name)
+		}
+	}
+	for i := range queues {
+		sort.Strings(queues[i].Sources)
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+	return queues, nil
+}
+
+// GetQueue returns the queue at url with its attributes and the queues that
+// use it as their DLQ.
+func (c *Client) GetQueue(ctx context.Context, url string) (Queue, error) {
+	q, err := c.queueAttributes(ctx, url)
+	if err != nil {
+		return Queue{}, err
+	}
+	var token *string
+	for {
+		out, err := c.api.ListDeadLetterSourceQueues(ctx, &awssqs.ListDeadLetterSourceQueuesInput{
+			QueueUrl:   aws.String(url),
+			MaxResults: aws.Int32(1000),
+			NextToken:  token,
+		})
+		if err != nil {
+			return Queue{}, fmt.Errorf("ListDeadLetterSourceQueues: %w", err)
+		}
+		for _, u := range out.QueueUrls {
+			q.Sources = append(q.Sources, NameFromURL(u))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+	sort.Strings(q.Sources)
+	return q, nil
+}
+
+func (c *Client) queueAttributes(ctx context.Context, url string) (Queue, error) {
+	out, err := c.api.GetQueueAttributes(ctx, &awssqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(url),
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		return Queue{}, fmt.Errorf("GetQueueAttributes: %w", err)
+	}
+	return toQueue(url, out.Attributes), nil
+}
+
+func toQueue(url string, attrs map[string]string) Queue {
+	num := func(name string) int64 {
+		n, _ := strconv.ParseInt(attrs[name], 10, 64)
+		return n
+	}
+	seconds := func(name string) time.Duration {
+		return time.Duration(num(name)) * time.Second
+	}
+	timestamp := func(name string) time.Time {
+		if n := num(name); n > 0 {
+			return time.Unix(n, 0)
+		}
+		return time.Time{}
+	}
+
+	q := Queue{
+		Name:                NameFromURL(url),
+		URL:                 url,
+		ARN:                 attrs["QueueArn"],
+		FIFO:                attrs["FifoQueue"] == "true",
+		Visible:             num("ApproximateNumberOfMessages"),
+		InFlight:            num("ApproximateNumberOfMessagesNotVisible"),
+		Delayed:             num("ApproximateNumberOfMessagesDelayed"),
+		VisibilityTimeout:   seconds("VisibilityTimeout"),
+		Retention:           seconds("MessageRetentionPeriod"),
+		Delay:               seconds("DelaySeconds"),
+		ReceiveWait:         seconds("ReceiveMessageWaitTimeSeconds"),
+		MaxMessageSize:      num("MaximumMessageSize"),
+		KMSKey:              attrs["KmsMasterKeyId"],
+		ContentDeduplicated: attrs["ContentBasedDeduplication"] == "true",
+		CreatedAt:           timestamp("CreatedTimestamp"),
+		ModifiedAt:          timestamp("LastModifiedTimestamp"),
+	}
+	switch {
+	case q.KMSKey != "":
+		q.Encryption = "SSE-KMS"
+	case attrs["SqsManagedSseEnabled"] == "true":
+		q.Encryption = "SSE-SQS"
+	}
+	if policy := attrs["RedrivePolicy"]; policy != "" {
+		var p struct {
+			DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+			MaxReceiveCount     any    `json:"maxReceiveCount"` // a number or a string
+		}
+		if json.Unmarshal([]byte(policy), &p) == nil && p.DeadLetterTargetArn != "" {
+			count, _ := strconv.Atoi(fmt.Sprint(p.MaxReceiveCount))
+			q.Redrive = &RedrivePolicy{DLQARN: p.DeadLetterTargetArn, MaxReceiveCount: count}
+		}
+	}
+	return q
+}
+
+// PeekMessages reads up to limit messages without deleting them. Messages
+// are held invisible while the batches are read, so none is returned twice,
+// and are then made visible again straight away. Each peek counts as a
+// receive towards the queue's redrive policy.
+func (c *Client) PeekMessages(ctx context.Context, url string, limit int) ([]Message, error) {
+	var messages []Message
+	var handles []string
+	seen := make(map[string]bool)
+	var err error
+	for len(messages) < limit {
+		var out *awssqs.ReceiveMessageOutput
+		out, err = c.api.ReceiveMessage(ctx, &awssqs.ReceiveMessageInput{
+			QueueUrl:                    aws.String(url),
+			MaxNumberOfMessages:         int32(min(receiveBatch, limit-len(messages))),
+			VisibilityTimeout:           peekHold,
+			MessageAttributeNames:       []string{"All"},
+			MessageSystemAttributeNames: []sqstypes.MessageSystemAttributeName{sqstypes.MessageSystemAttributeNameAll},
+		})
+		if err != nil {
+			err = fmt.Errorf("ReceiveMessage: %w", err)
+			break
+		}
+		if len(out.Messages) == 0 {
+			break
+		}
+		for _, m := range out.Messages {
+			handles = append(handles, aws.ToString(m.ReceiptHandle))
+			if id := aws.ToString(m.MessageId); !seen[id] {
+				seen[id] = true
+				messages = append(messages, toMessage(m))
+			}
+		}
+	}
+
+	// Release what was received even if a later batch failed.
+	if rerr := c.release(ctx, url, handles); err == nil {
+		err = rerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// release makes received messages visible again. It runs even if ctx has
+// been cancelled, for example because the view that peeked was closed, so
+// that consumers do not wait out peekHold.
+func (c *Client) release(ctx context.Context, url string, handles []string) error {
+	if len(handles) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+	for start := 0; start < len(handles); start += receiveBatch {
+		batch := handles[start:min(start+receiveBatch, len(handles))]
+		entries := make([]sqstypes.ChangeMessageVisibilityBatchRequestEntry, len(batch))
+		for i, h := range batch {
+			entries[i] = sqstypes.ChangeMessageVisibilityBatchRequestEntry{
+				Id:                aws.String(strconv.Itoa(i)),
+				ReceiptHandle:     aws.String(h),
+				VisibilityTimeout: 0,
+			}
+		}
+		out, err := c.api.ChangeMessageVisibilityBatch(ctx, &awssqs.ChangeMessageVisibilityBatchInput{
+			QueueUrl: aws.String(url),
+			Entries:  entries,
+		})
+		if err != nil {
+			return fmt.Errorf("ChangeMessageVisibilityBatch: %w", err)
+		}
+		if len(out.Failed) > 0 {
+			return fmt.Errorf("ChangeMessageVisibilityBatch: %d messages stay invisible for up to %ds: %s",
+				len(out.Failed), peekHold, aws.ToString(out.Failed[0].Message))
+		}
+	}
+	return nil
+}
+
+func toMessage(m sqstypes.Message) Message {
+	msg := Message{
+		ID:      aws.ToString(m.MessageId),
+		Body:    aws.ToString(m.Body),
+		GroupID: m.Attributes["MessageGroupId"],
+	}
+	if ms, err := strconv.ParseInt(m.Attributes["SentTimestamp"], 10, 64); err == nil {
+		msg.SentAt = time.UnixMilli(ms)
+	}
+	msg.ReceiveCount, _ = strconv.Atoi(m.Attributes["ApproximateReceiveCount"])
+	if len(m.MessageAttributes) > 0 {
+		msg.Attributes = make(map[string]string, len(m.MessageAttributes))
+		for name, v := range m.MessageAttributes {
+			if v.StringValue != nil {
+				msg.Attributes[name] = *v.StringValue
+			} else {
+				msg.Attributes[name] = fmt.Sprintf("(%s, %d bytes)", aws.ToString(v.DataType), len(v.BinaryValue))
+			}
+		}
+	}
+	return msg
+}
+
+// SendMessage sends body to the queue and returns the message ID. Messages
+// to a FIFO queue are sent in groupID, with a deduplication ID unless the
+// queue deduplicates by content.
+func (c *Client) SendMessage(ctx context.Context, q Queue, body, groupID string) (string, error) {
+	in := &awssqs.SendMessageInput{
+		QueueUrl:    aws.String(q.URL),
+		MessageBody: aws.String(body),
+	}
+	if q.FIFO {
+		in.MessageGroupId = aws.String(groupID)
+		if !q.ContentDeduplicated {
+			in.MessageDeduplicationId = aws.String(strconv.FormatInt(time.Now().UnixNano(), 10))
+		}
+	}
+	out, err := c.api.SendMessage(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("SendMessage: %w", err)
+	}
+	return aws.ToString(out.MessageId), nil
+}
+
+// PurgeQueue deletes every message in the queue.
+func (c *Client) PurgeQueue(ctx context.Context, url string) error {
+	if _, err := c.api.PurgeQueue(ctx, &awssqs.PurgeQueueInput{QueueUrl: aws.String(url)}); err != nil {
+		return fmt.Errorf("PurgeQueue: %w", err)
+	}
+	return nil
+}
+
+// StartRedrive moves the messages of the dead-letter queue dlqARN back to
+// the queues they came from and returns the task handle.
+func (c *Client) StartRedrive(ctx context.Context, dlqARN string) (string, error) {
+	out, err := c.api.StartMessageMoveTask(ctx, &awssqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(dlqARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("StartMessageMoveTask: %w", err)
+	}
+	return aws.ToString(out.TaskHandle), nil
+}
+
+// ListRedrives returns the recent redrives out of the dead-letter queue
+// dlqARN, newest first.
+func (c *Client) ListRedrives(ctx context.Context, dlqARN string) ([]MoveTask, error) {
+	out, err := c.api.ListMessageMoveTasks(ctx, &awssqs.ListMessageMoveTasksInput{
+		SourceArn:  aws.String(dlqARN),
+		MaxResults: aws.Int32(10),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListMessageMoveTasks: %w", err)
+	}
+	tasks := make([]MoveTask, len(out.Results))
+	for i, r := range out.Results {
+		tasks[i] = MoveTask{
+			Handle:      aws.ToString(r.TaskHandle),
+			Status:      aws.ToString(r.Status),
+			SourceARN:   aws.ToString(r.SourceArn),
+			Destination: aws.ToString(r.DestinationArn),
+			Moved:       r.ApproximateNumberOfMessagesMoved,
+			ToMove:      aws.ToInt64(r.ApproximateNumberOfMessagesToMove),
+			StartedAt:   time.UnixMilli(r.StartedTimestamp),
+			Failure:     aws.ToString(r.FailureReason),
+		}
+	}
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].StartedAt.After(tasks[j].StartedAt) })
+	return tasks, nil
+}
+
+// NameFromURL returns the queue name at the end of a queue URL.
+func NameFromURL(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+// NameFromARN returns the queue name at the end of a queue ARN.
+func NameFromARN(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+// URLFromARN returns the URL of the queue with the given ARN,
+// arn:<partition>:sqs:<region>:<account>:<name>.
+func URLFromARN(arn string) (string, bool) {
+	parts := strings.Split(arn, ":")
+	if len(parts) != 6 || parts[2] != "sqs" {
+		return "", false
+	}
+	host := "sqs." + parts[3] + ".amazonaws.com"
+	if strings.HasPrefix(parts[3], "cn-") {
+		host += ".cn"
+	}
+	return "https://" + host + "/" + parts[4] + "/" + parts[5], true
+}
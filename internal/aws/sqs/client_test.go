@@ -0,0 +1,307 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSQSAPI struct {
+	listQueuesFunc                   func(ctx context.Context, params *awssqs.ListQueuesInput, optFns ...func(*awssqs.Options)) (*awssqs.ListQueuesOutput, error)
+	getQueueAttributesFunc           func(ctx context.Context, params *awssqs.GetQueueAttributesInput, optFns ...func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error)
+	listDeadLetterSourceQueuesFunc   func(ctx context.Context, params *awssqs.ListDeadLetterSourceQueuesInput, optFns ...func(*awssqs.Options)) (*awssqs.ListDeadLetterSourceQueuesOutput, error)
+	receiveMessageFunc               func(ctx context.Context, params *awssqs.ReceiveMessageInput, optFns ...func(*awssqs.Options)) (*awssqs.ReceiveMessageOutput, error)
+	changeMessageVisibilityBatchFunc func(ctx context.Context, params *awssqs.ChangeMessageVisibilityBatchInput, optFns ...func(*awssqs.Options)) (*awssqs.ChangeMessageVisibilityBatchOutput, error)
+	sendMessageFunc                  func(ctx context.Context, params *awssqs.SendMessageInput, optFns ...func(*awssqs.Options)) (*awssqs.SendMessageOutput, error)
+	purgeQueueFunc                   func(ctx context.Context, params *awssqs.PurgeQueueInput, optFns ...func(*awssqs.Options)) (*awssqs.PurgeQueueOutput, error)
+	startMessageMoveTaskFunc         func(ctx context.Context, params *awssqs.StartMessageMoveTaskInput, optFns ...func(*awssqs.Options)) (*awssqs.StartMessageMoveTaskOutput, error)
+	listMessageMoveTasksFunc         func(ctx context.Context, params *awssqs.ListMessageMoveTasksInput, optFns ...func(*awssqs.Options)) (*awssqs.ListMessageMoveTasksOutput, error)
+}
+
+func (m *mockSQSAPI) ListQueues(ctx context.Context, params *awssqs.ListQueuesInput, optFns ...func(*awssqs.Options)) (*awssqs.ListQueuesOutput, error) {
+	return m.listQueuesFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) GetQueueAttributes(ctx context.Context, params *awssqs.GetQueueAttributesInput, optFns ...func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error) {
+	return m.getQueueAttributesFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) ListDeadLetterSourceQueues(ctx context.Context, params *awssqs.ListDeadLetterSourceQueuesInput, optFns ...func(*awssqs.Options)) (*awssqs.ListDeadLetterSourceQueuesOutput, error) {
+	return m.listDeadLetterSourceQueuesFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) ReceiveMessage(ctx context.Context, params *awssqs.ReceiveMessageInput, optFns ...func(*awssqs.Options)) (*awssqs.ReceiveMessageOutput, error) {
+	return m.receiveMessageFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) ChangeMessageVisibilityBatch(ctx context.Context, params *awssqs.ChangeMessageVisibilityBatchInput, optFns ...func(*awssqs.Options)) (*awssqs.ChangeMessageVisibilityBatchOutput, error) {
+	return m.changeMessageVisibilityBatchFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) SendMessage(ctx context.Context, params *awssqs.SendMessageInput, optFns ...func(*awssqs.Options)) (*awssqs.SendMessageOutput, error) {
+	return m.sendMessageFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) PurgeQueue(ctx context.Context, params *awssqs.PurgeQueueInput, optFns ...func(*awssqs.Options)) (*awssqs.PurgeQueueOutput, error) {
+	return m.purgeQueueFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) StartMessageMoveTask(ctx context.Context, params *awssqs.StartMessageMoveTaskInput, optFns ...func(*awssqs.Options)) (*awssqs.StartMessageMoveTaskOutput, error) {
+	return m.startMessageMoveTaskFunc(ctx, params, optFns...)
+}
+
+func (m *mockSQSAPI) ListMessageMoveTasks(ctx context.Context, params *awssqs.ListMessageMoveTasksInput, optFns ...func(*awssqs.Options)) (*awssqs.ListMessageMoveTasksOutput, error) {
+	return m.listMessageMoveTasksFunc(ctx, params, optFns...)
+}
+
+const queueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/"
+
+func TestListQueues(t *testing.T) {
+	attrs := map[string]map[string]string{
+		"orders": {
+			"QueueArn":                              "arn:aws:sqs:us-east-1:123456789012:orders",
+			"ApproximateNumberOfMessages":           "12",
+			"ApproximateNumberOfMessagesNotVisible": "3",
+			"ApproximateNumberOfMessagesDelayed":    "1",
+			"VisibilityTimeout":                     "30",
+			"MessageRetentionPeriod":                "345600",
+			"SqsManagedSseEnabled":                  "true",
+			"CreatedTimestamp":                      "1700000000",
+			"RedrivePolicy":                         `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:orders-dlq","maxReceiveCount":5}`,
+		},
+		"orders-dlq": {
+			"QueueArn":                    "arn:aws:sqs:us-east-1:123456789012:orders-dlq",
+			"ApproximateNumberOfMessages": "2",
+		},
+	}
+	mock := &mockSQSAPI{
+		listQueuesFunc: func(_ context.Context, params *awssqs.ListQueuesInput, _ ...func(*awssqs.Options)) (*awssqs.ListQueuesOutput, error) {
+			if params.NextToken == nil {
+				return &awssqs.ListQueuesOutput{QueueUrls: []string{queueURL + "orders-dlq"}, NextToken: awssdk.String("p2")}, nil
+			}
+			return &awssqs.ListQueuesOutput{QueueUrls: []string{queueURL + "orders"}}, nil
+		},
+		getQueueAttributesFunc: func(_ context.Context, params *awssqs.GetQueueAttributesInput, _ ...func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error) {
+			assert.Equal(t, []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll}, params.AttributeNames)
+			return &awssqs.GetQueueAttributesOutput{Attributes: attrs[NameFromURL(*params.QueueUrl)]}, nil
+		},
+	}
+
+	queues, err := NewClient(mock).ListQueues(context.Background())
+	require.NoError(t, err)
+	require.Len(t, queues, 2)
+
+	orders := queues[0]
+	assert.Equal(t, "orders", orders.Name)
+	assert.Equal(t, queueURL+"orders", orders.URL)
+	assert.Equal(t, int64(12), orders.Visible)
+	assert.Equal(t, int64(3), orders.InFlight)
+	assert.Equal(t, int64(1), orders.Delayed)
+	assert.Equal(t, 30*time.Second, orders.VisibilityTimeout)
+	assert.Equal(t, 96*time.Hour, orders.Retention)
+	assert.Equal(t, "SSE-SQS", orders.Encryption)
+	assert.Equal(t, time.Unix(1700000000, 0), orders.CreatedAt)
+	require.NotNil(t, orders.Redrive)
+	assert.Equal(t, "orders-dlq", orders.Redrive.DLQName())
+	assert.Equal(t, 5, orders.Redrive.MaxReceiveCount)
+	assert.False(t, orders.DLQ())
+
+	dlq := queues[1]
+	assert.Equal(t, "orders-dlq", dlq.Name)
+	assert.Nil(t, dlq.Redrive)
+	assert.True(t, dlq.DLQ())
+	assert.Equal(t, []string{"orders"}, dlq.Sources)
+}
+
+func TestListQueues_Error(t *testing.T) {
+	mock := &mockSQSAPI{
+		listQueuesFunc: func(_ context.Context, _ *awssqs.ListQueuesInput, _ ...func(*awssqs.Options)) (*awssqs.ListQueuesOutput, error) {
+			return nil, errors.New("AccessDenied")
+		},
+	}
+	_, err := NewClient(mock).ListQueues(context.Background())
+	assert.ErrorContains(t, err, "ListQueues: AccessDenied")
+}
+
+func TestGetQueue(t *testing.T) {
+	mock := &mockSQSAPI{
+		getQueueAttributesFunc: func(_ context.Context, _ *awssqs.GetQueueAttributesInput, _ ...func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error) {
+			return &awssqs.GetQueueAttributesOutput{Attributes: map[string]string{
+				"QueueArn":      "arn:aws:sqs:us-east-1:123456789012:orders-dlq",
+				"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:other","maxReceiveCount":"3"}`,
+			}}, nil
+		},
+		listDeadLetterSourceQueuesFunc: func(_ context.Context, _ *awssqs.ListDeadLetterSourceQueuesInput, _ ...func(*awssqs.Options)) (*awssqs.ListDeadLetterSourceQueuesOutput, error) {
+			return &awssqs.ListDeadLetterSourceQueuesOutput{QueueUrls: []string{queueURL + "payments", queueURL + "orders"}}, nil
+		},
+	}
+	q, err := NewClient(mock).GetQueue(context.Background(), queueURL+"orders-dlq")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders", "payments"}, q.Sources)
+	require.NotNil(t, q.Redrive)
+	assert.Equal(t, 3, q.Redrive.MaxReceiveCount)
+}
+
+func TestPeekMessages(t *testing.T) {
+	received := 0
+	var released []string
+	mock := &mockSQSAPI{
+		receiveMessageFunc: func(_ context.Context, params *awssqs.ReceiveMessageInput, _ ...func(*awssqs.Options)) (*awssqs.ReceiveMessageOutput, error) {
+			assert.Equal(t, int32(peekHold), params.VisibilityTimeout)
+			received++
+			switch received {
+			case 1:
+				return &awssqs.ReceiveMessageOutput{Messages: []sqstypes.Message{
+					{
+						MessageId:     awssdk.String("m1"),
+						ReceiptHandle: awssdk.String("h1"),
+						Body:          awssdk.String(`{"id":1}`),
+						Attributes:    map[string]string{"SentTimestamp": "1700000000000", "ApproximateReceiveCount": "2"},
+						MessageAttributes: map[string]sqstypes.MessageAttributeValue{
+							"source": {DataType: awssdk.String("String"), StringValue: awssdk.String("api")},
+						},
+					},
+					{MessageId: awssdk.String("m2"), ReceiptHandle: awssdk.String("h2"), Body: awssdk.String("two")},
+				}}, nil
+			case 2:
+				// A message received twice is only shown once.
+				return &awssqs.ReceiveMessageOutput{Messages: []sqstypes.Message{
+					{MessageId: awssdk.String("m2"), ReceiptHandle: awssdk.String("h3"), Body: awssdk.String("two")},
+				}}, nil
+			}
+			return &awssqs.ReceiveMessageOutput{}, nil
+		},
+		changeMessageVisibilityBatchFunc: func(_ context.Context, params *awssqs.ChangeMessageVisibilityBatchInput, _ ...func(*awssqs.Options)) (*awssqs.ChangeMessageVisibilityBatchOutput, error) {
+			for _, e := range params.Entries {
+				assert.Equal(t, int32(0), e.VisibilityTimeout)
+				released = append(released, *e.ReceiptHandle)
+			}
+			return &awssqs.ChangeMessageVisibilityBatchOutput{}, nil
+		},
+	}
+
+	msgs, err := NewClient(mock).PeekMessages(context.Background(), queueURL+"orders", 50)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "m1", msgs[0].ID)
+	assert.Equal(t, `{"id":1}`, msgs[0].Body)
+	assert.Equal(t, time.UnixMilli(1700000000000), msgs[0].SentAt)
+	assert.Equal(t, 2, msgs[0].ReceiveCount)
+	assert.Equal(t, map[string]string{"source": "api"}, msgs[0].Attributes)
+	assert.Equal(t, 3, received)
+	assert.Equal(t, []string{"h1", "h2", "h3"}, released)
+}
+
+func TestPeekMessages_ReleasesOnError(t *testing.T) {
+	received := 0
+	var released []string
+	mock := &mockSQSAPI{
+		receiveMessageFunc: func(_ context.Context, _ *awssqs.ReceiveMessageInput, _ ...func(*awssqs.Options)) (*awssqs.ReceiveMessageOutput, error) {
+			received++
+			if received > 1 {
+				return nil, errors.New("throttled")
+			}
+			return &awssqs.ReceiveMessageOutput{Messages: []sqstypes.Message{
+				{MessageId: awssdk.String("m1"), ReceiptHandle: awssdk.String("h1")},
+			}}, nil
+		},
+		changeMessageVisibilityBatchFunc: func(_ context.Context, params *awssqs.ChangeMessageVisibilityBatchInput, _ ...func(*awssqs.Options)) (*awssqs.ChangeMessageVisibilityBatchOutput, error) {
+			for _, e := range params.Entries {
+				released = append(released, *e.ReceiptHandle)
+			}
+			return &awssqs.ChangeMessageVisibilityBatchOutput{}, nil
+		},
+	}
+	_, err := NewClient(mock).PeekMessages(context.Background(), queueURL+"orders", 50)
+	assert.ErrorContains(t, err, "ReceiveMessage: throttled")
+	assert.Equal(t, []string{"h1"}, released)
+}
+
+func TestPeekMessages_ReleasesAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var releaseErr error
+	var released []string
+	mock := &mockSQSAPI{
+		receiveMessageFunc: func(_ context.Context, _ *awssqs.ReceiveMessageInput, _ ...func(*awssqs.Options)) (*awssqs.ReceiveMessageOutput, error) {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// The view is closed while the first batch is being read.
+			cancel()
+			return &awssqs.ReceiveMessageOutput{Messages: []sqstypes.Message{
+				{MessageId: awssdk.String("m1"), ReceiptHandle: awssdk.String("h1")},
+			}}, nil
+		},
+		changeMessageVisibilityBatchFunc: func(ctx context.Context, params *awssqs.ChangeMessageVisibilityBatchInput, _ ...func(*awssqs.Options)) (*awssqs.ChangeMessageVisibilityBatchOutput, error) {
+			releaseErr = ctx.Err()
+			for _, e := range params.Entries {
+				released = append(released, *e.ReceiptHandle)
+			}
+			return &awssqs.ChangeMessageVisibilityBatchOutput{}, nil
+		},
+	}
+	_, err := NewClient(mock).PeekMessages(ctx, queueURL+"orders", 50)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NoError(t, releaseErr, "release must not use the cancelled context")
+	assert.Equal(t, []string{"h1"}, released)
+}
+
+func TestSendMessage_FIFO(t *testing.T) {
+	var got *awssqs.SendMessageInput
+	mock := &mockSQSAPI{
+		sendMessageFunc: func(_ context.Context, params *awssqs.SendMessageInput, _ ...func(*awssqs.Options)) (*awssqs.SendMessageOutput, error) {
+			got = params
+			return &awssqs.SendMessageOutput{MessageId: awssdk.String("m9")}, nil
+		},
+	}
+	q := Queue{URL: queueURL + "jobs.fifo", FIFO: true}
+	id, err := NewClient(mock).SendMessage(context.Background(), q, "hello", "test")
+	require.NoError(t, err)
+	assert.Equal(t, "m9", id)
+	assert.Equal(t, "hello", *got.MessageBody)
+	assert.Equal(t, "test", *got.MessageGroupId)
+	assert.NotEmpty(t, *got.MessageDeduplicationId)
+}
+
+func TestStartRedrive(t *testing.T) {
+	mock := &mockSQSAPI{
+		startMessageMoveTaskFunc: func(_ context.Context, params *awssqs.StartMessageMoveTaskInput, _ ...func(*awssqs.Options)) (*awssqs.StartMessageMoveTaskOutput, error) {
+			assert.Equal(t, "arn:aws:sqs:us-east-1:123456789012:orders-dlq", *params.SourceArn)
+			assert.Nil(t, params.DestinationArn, "messages go back to their source queues")
+			return &awssqs.StartMessageMoveTaskOutput{TaskHandle: awssdk.String("task-1")}, nil
+		},
+		listMessageMoveTasksFunc: func(_ context.Context, _ *awssqs.ListMessageMoveTasksInput, _ ...func(*awssqs.Options)) (*awssqs.ListMessageMoveTasksOutput, error) {
+			return &awssqs.ListMessageMoveTasksOutput{Results: []sqstypes.ListMessageMoveTasksResultEntry{
+				{TaskHandle: awssdk.String("task-0"), Status: awssdk.String("COMPLETED"), StartedTimestamp: 1700000000000, ApproximateNumberOfMessagesMoved: 4},
+				{TaskHandle: awssdk.String("task-1"), Status: awssdk.String("RUNNING"), StartedTimestamp: 1700000600000, ApproximateNumberOfMessagesToMove: awssdk.Int64(9)},
+			}}, nil
+		},
+	}
+	c := NewClient(mock)
+	handle, err := c.StartRedrive(context.Background(), "arn:aws:sqs:us-east-1:123456789012:orders-dlq")
+	require.NoError(t, err)
+	assert.Equal(t, "task-1", handle)
+
+	tasks, err := c.ListRedrives(context.Background(), "arn:aws:sqs:us-east-1:123456789012:orders-dlq")
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "RUNNING", tasks[0].Status)
+	assert.Equal(t, int64(9), tasks[0].ToMove)
+	assert.Equal(t, int64(4), tasks[1].Moved)
+}
+
+func TestURLFromARN(t *testing.T) {
+	url, ok := URLFromARN("arn:aws:sqs:eu-west-1:123456789012:orders")
+	assert.True(t, ok)
+	assert.Equal(t, "https://sqs.eu-west-1.amazonaws.com/123456789012/orders", url)
+
+	_, ok = URLFromARN("arn:aws:sns:eu-west-1:123456789012:alerts")
+	assert.False(t, ok)
+}
@@ -0,0 +1,66 @@
+package sqs
+
+import "time"
+
+// Queue is an SQS queue with its attributes.
+type Queue struct {
+	Name                string
+	URL                 string
+	ARN                 string
+	FIFO                bool
+	Visible             int64 // approximate number of messages available to receive
+	InFlight            int64 // received but not yet deleted
+	Delayed             int64 // not yet available because of a delay
+	VisibilityTimeout   time.Duration
+	Retention           time.Duration
+	Delay               time.Duration
+	ReceiveWait         time.Duration
+	MaxMessageSize      int64
+	Encryption          string // "SSE-SQS", "SSE-KMS" or empty
+	KMSKey              string
+	ContentDeduplicated bool // FIFO queues only
+	Redrive             *RedrivePolicy
+	Sources             []string      // names of the queues that use this one as their DLQ
+	OldestAge           time.Duration // age of the oldest message, from CloudWatch; zero if unknown
+	CreatedAt           time.Time
+	ModifiedAt          time.Time
+}
+
+// DLQ reports whether another queue sends its failed messages here.
+func (q Queue) DLQ() bool {
+	return len(q.Sources) > 0
+}
+
+// RedrivePolicy sends messages to a dead-letter queue once they have been
+// received MaxReceiveCount times without being deleted.
+type RedrivePolicy struct {
+	DLQARN          string
+	MaxReceiveCount int
+}
+
+// DLQName returns the name of the dead-letter queue.
+func (p RedrivePolicy) DLQName() string {
+	return NameFromARN(p.DLQARN)
+}
+
+// Message is a message peeked from a queue.
+type Message struct {
+	ID           string
+	Body         string
+	SentAt       time.Time
+	ReceiveCount int
+	GroupID      string            // FIFO queues only
+	Attributes   map[string]string // message attributes; binary values are shown by size
+}
+
+// MoveTask is a redrive of messages out of a dead-letter queue.
+type MoveTask struct {
+	Handle      string
+	Status      string // RUNNING, COMPLETED, CANCELLING, CANCELLED or FAILED
+	SourceARN   string
+	Destination string // empty when messages go back to their source queues
+	Moved       int64
+	ToMove      int64
+	StartedAt   time.Time
+	Failure     string
+}
@@ -16,9 +16,13 @@ var schema string
 
 // Resource is a high-level representation of a cached resource.
 type Resource struct {
-	ID   string
-	Name string
-	Data string
+	// Service is the cache key the resource was stored under. It is only
+	// set by SearchResources, which spans services.
+	Service   string
+	ID        string
+	Name      string
+	Data      string
+	FetchedAt time.Time
 }
 
 // DB wraps a sql.DB and sqlc-generated Queries with a higher-level API.
@@ -103,20 +107,86 @@ func (db *DB) GetResources(ctx context.Context, service, region, profile string)
 	result := make([]Resource, 0, len(rows))
 	for _, r := range rows {
 		result = append(result, Resource{
-			ID:   r.ResourceID,
-			Name: r.Name,
-			Data: r.Data,
+			ID:        r.ResourceID,
+			Name:      r.Name,
+			Data:      r.Data,
+			FetchedAt: time.Unix(r.FetchedAt, 0),
 		})
 	}
 	return result, nil
 }
 
-// SearchResources performs a LIKE search on resource names.
+// GetResourcesStale returns resources for the given service, region, and
+// profile even if their TTL has expired. Callers use FetchedAt to decide
+// whether the rows are still fresh.
+func (db *DB) GetResourcesStale(ctx context.Context, service, region, profile string) ([]Resource, error) {
+	rows, err := db.queries.GetResourcesAll(ctx, sqlcgen.GetResourcesAllParams{
+		Service: service,
+		Region:  region,
+		Profile: profile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Resource, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, Resource{
+			ID:        r.ResourceID,
+			Name:      r.Name,
+			Data:      r.Data,
+			FetchedAt: time.Unix(r.FetchedAt, 0),
+		})
+	}
+	return result, nil
+}
+
+// ReplaceResources swaps the cached resources for a service with a new batch
+// within a transaction, so resources deleted upstream do not linger.
+func (db *DB) ReplaceResources(ctx context.Context, service, region, profile string, resources []Resource, ttlSeconds int) error {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := db.queries.WithTx(tx)
+	if err := q.DeleteResourcesForService(ctx, sqlcgen.DeleteResourcesForServiceParams{
+		Service: service,
+		Region:  region,
+		Profile: profile,
+	}); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	for _, r := range resources {
+		if err := q.UpsertResource(ctx, sqlcgen.UpsertResourceParams{
+			Service:    service,
+			ResourceID: r.ID,
+			Region:     region,
+			Profile:    profile,
+			Name:       r.Name,
+			Data:       r.Data,
+			FetchedAt:  now,
+			TtlSeconds: int64(ttlSeconds),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SearchResources performs a LIKE search on resource names and IDs across
+// all services.
 func (db *DB) SearchResources(ctx context.Context, profile, region, query string) ([]Resource, error) {
+	q := sql.NullString{String: query, Valid: true}
 	rows, err := db.queries.SearchResources(ctx, sqlcgen.SearchResourcesParams{
 		Profile: profile,
 		Region:  region,
-		Column3: sql.NullString{String: query, Valid: true},
+		Column3: q,
+		Column4: q,
 	})
 	if err != nil {
 		return nil, err
@@ -125,9 +195,11 @@ func (db *DB) SearchResources(ctx context.Context, profile, region, query string
 	result := make([]Resource, 0, len(rows))
 	for _, r := range rows {
 		result = append(result, Resource{
-			ID:   r.ResourceID,
-			Name: r.Name,
-			Data: r.Data,
+			Service:   r.Service,
+			ID:        r.ResourceID,
+			Name:      r.Name,
+			Data:      r.Data,
+			FetchedAt: time.Unix(r.FetchedAt, 0),
 		})
 	}
 	return result, nil
@@ -305,3 +305,83 @@ func TestPurgeExpired(t *testing.T) {
 	assert.Len(t, got, 1)
 	assert.Equal(t, "long-lived", got[0].Name)
 }
+
+func TestGetResourcesStale_IncludesExpired(t *testing.T) {
+	db, err := NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	resources := []Resource{{ID: "i-001", Name: "expired", Data: "{}"}}
+	err = db.UpsertResources(ctx, "ec2", "us-east-1", "default", resources, 1)
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Second)
+
+	fresh, err := db.GetResources(ctx, "ec2", "us-east-1", "default")
+	require.NoError(t, err)
+	assert.Empty(t, fresh)
+
+	stale, err := db.GetResourcesStale(ctx, "ec2", "us-east-1", "default")
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	assert.Equal(t, "expired", stale[0].Name)
+	assert.False(t, stale[0].FetchedAt.IsZero())
+}
+
+func TestReplaceResources_DropsMissing(t *testing.T) {
+	db, err := NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err = db.UpsertResources(ctx, "ec2", "us-east-1", "default", []Resource{
+		{ID: "i-001", Name: "kept", Data: "{}"},
+		{ID: "i-002", Name: "terminated", Data: "{}"},
+	}, 300)
+	require.NoError(t, err)
+	err = db.UpsertResources(ctx, "s3", "us-east-1", "default", []Resource{
+		{ID: "bucket", Name: "bucket", Data: "{}"},
+	}, 300)
+	require.NoError(t, err)
+
+	err = db.ReplaceResources(ctx, "ec2", "us-east-1", "default", []Resource{
+		{ID: "i-001", Name: "kept", Data: "{}"},
+	}, 300)
+	require.NoError(t, err)
+
+	got, err := db.GetResources(ctx, "ec2", "us-east-1", "default")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "i-001", got[0].ID)
+
+	// Other services are untouched.
+	other, err := db.GetResources(ctx, "s3", "us-east-1", "default")
+	require.NoError(t, err)
+	assert.Len(t, other, 1)
+}
+
+func TestSearchResources_MatchesIDAndService(t *testing.T) {
+	db, err := NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err = db.UpsertResources(ctx, "ec2", "us-east-1", "default", []Resource{
+		{ID: "i-0abc123", Name: "web", Data: "{}"},
+	}, 300)
+	require.NoError(t, err)
+	err = db.UpsertResources(ctx, "s3", "us-east-1", "default", []Resource{
+		{ID: "logs-bucket", Name: "logs-bucket", Data: "{}"},
+	}, 300)
+	require.NoError(t, err)
+
+	got, err := db.SearchResources(ctx, "default", "us-east-1", "0abc")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "ec2", got[0].Service)
+	assert.Equal(t, "i-0abc123", got[0].ID)
+}
@@ -202,7 +202,8 @@ func (q *Queries) PurgeSummaries(ctx context.Context, arg PurgeSummariesParams)
 
 const searchResources = `-- name: SearchResources :many
 SELECT service, resource_id, region, profile, name, data, fetched_at, ttl_seconds FROM resources
-WHERE profile = ? AND region = ? AND name LIKE '%' || ? || '%'
+WHERE profile = ? AND region = ?
+AND (name LIKE '%' || ? || '%' OR resource_id LIKE '%' || ? || '%')
 ORDER BY service, name
 LIMIT 50
 `
@@ -211,10 +212,16 @@ type SearchResourcesParams struct {
 	Profile string
 	Region  string
 	Column3 sql.NullString
+	Column4 sql.NullString
 }
 
 func (q *Queries) SearchResources(ctx context.Context, arg SearchResourcesParams) ([]Resource, error) {
-	rows, err := q.db.QueryContext(ctx, searchResources, arg.Profile, arg.Region, arg.Column3)
+	rows, err := q.db.QueryContext(ctx, searchResources,
+		arg.Profile,
+		arg.Region,
+		arg.Column3,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +35,8 @@ DO UPDATE SET data = excluded.data, fetched_at = excluded.fetched_at,
 
 -- name: SearchResources :many
 SELECT * FROM resources
-WHERE profile = ? AND region = ? AND name LIKE '%' || ? || '%'
+WHERE profile = ? AND region = ?
+AND (name LIKE '%' || ? || '%' OR resource_id LIKE '%' || ? || '%')
 ORDER BY service, name
 LIMIT 50;
 
@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// defaultTTL is used when a Scope has no TTL function.
+const defaultTTL = 300
+
+// Scope binds a DB to a single region and profile so service plugins can read
+// and write their own rows without knowing which session they belong to.
+// A nil *Scope is valid and behaves as an empty, write-discarding cache.
+type Scope struct {
+	db      *DB
+	region  string
+	profile string
+	ttl     func(service string) int
+}
+
+// NewScope returns a Scope for region and profile. ttl maps a service key to
+// its TTL in seconds. It returns nil when db is nil.
+func NewScope(db *DB, region, profile string, ttl func(service string) int) *Scope {
+	if db == nil {
+		return nil
+	}
+	return &Scope{db: db, region: region, profile: profile, ttl: ttl}
+}
+
+// DB returns the underlying database.
+func (s *Scope) DB() *DB {
+	if s == nil {
+		return nil
+	}
+	return s.db
+}
+
+// Region returns the region the scope is bound to.
+func (s *Scope) Region() string {
+	if s == nil {
+		return ""
+	}
+	return s.region
+}
+
+// Profile returns the profile the scope is bound to.
+func (s *Scope) Profile() string {
+	if s == nil {
+		return ""
+	}
+	return s.profile
+}
+
+// TTL returns the TTL in seconds for the given service key.
+func (s *Scope) TTL(service string) int {
+	if s == nil || s.ttl == nil {
+		return defaultTTL
+	}
+	if ttl := s.ttl(service); ttl > 0 {
+		return ttl
+	}
+	return defaultTTL
+}
+
+// Fresh reports whether data fetched at fetchedAt is still within the
+// service's TTL.
+func (s *Scope) Fresh(service string, fetchedAt time.Time) bool {
+	if fetchedAt.IsZero() {
+		return false
+	}
+	return time.Since(fetchedAt) < time.Duration(s.TTL(service))*time.Second
+}
+
+// Load decodes the cached items for service, ignoring TTL, and returns them
+// with the time of the oldest row. It returns no items for a nil Scope.
+func Load[T any](ctx context.Context, s *Scope, service string) ([]T, time.Time, error) {
+	if s == nil {
+		return nil, time.Time{}, nil
+	}
+
+	rows, err := s.db.GetResourcesStale(ctx, service, s.region, s.profile)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var fetchedAt time.Time
+	items := make([]T, 0, len(rows))
+	for _, r := range rows {
+		var item T
+		if err := json.Unmarshal([]byte(r.Data), &item); err != nil {
+			return nil, time.Time{}, err
+		}
+		items = append(items, item)
+		if fetchedAt.IsZero() || r.FetchedAt.Before(fetchedAt) {
+			fetchedAt = r.FetchedAt
+		}
+	}
+	return items, fetchedAt, nil
+}
+
+// Store replaces the cached items for service. key returns the resource ID
+// and display name of an item; the ID must be one the plugin's DetailView
+// accepts. Store is a no-op for a nil Scope.
+func Store[T any](ctx context.Context, s *Scope, service string, items []T, key func(T) (id, name string)) error {
+	if s == nil {
+		return nil
+	}
+
+	resources, err := toResources(items, key)
+	if err != nil {
+		return err
+	}
+	return s.db.ReplaceResources(ctx, service, s.region, s.profile, resources, s.TTL(service))
+}
+
+// Merge adds items to the cached items for service, overwriting rows with the
+// same ID and keeping the rest. List views use it for pages after the first,
+// which Store has already written. Merge is a no-op for a nil Scope.
+func Merge[T any](ctx context.Context, s *Scope, service string, items []T, key func(T) (id, name string)) error {
+	if s == nil {
+		return nil
+	}
+
+	resources, err := toResources(items, key)
+	if err != nil {
+		return err
+	}
+	return s.db.UpsertResources(ctx, service, s.region, s.profile, resources, s.TTL(service))
+}
+
+func toResources[T any](items []T, key func(T) (id, name string)) ([]Resource, error) {
+	resources := make([]Resource, 0, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		id, name := key(item)
+		resources = append(resources, Resource{ID: id, Name: name, Data: string(data)})
+	}
+	return resources, nil
+}
+
+// LoadSummary decodes the cached summary for service. found is false when
+// nothing is cached; fresh is false when the entry has outlived its TTL.
+func LoadSummary[T any](ctx context.Context, s *Scope, service string) (summary T, found, fresh bool, err error) {
+	if s == nil {
+		return summary, false, false, nil
+	}
+
+	data, err := s.db.GetSummary(ctx, service, s.region, s.profile)
+	if err != nil {
+		return summary, false, false, err
+	}
+	fresh = data != ""
+	if !fresh {
+		data, err = s.db.GetSummaryStale(ctx, service, s.region, s.profile)
+		if err != nil || data == "" {
+			return summary, false, false, err
+		}
+	}
+
+	if err := json.Unmarshal([]byte(data), &summary); err != nil {
+		return summary, false, false, err
+	}
+	return summary, true, fresh, nil
+}
+
+// StoreSummary caches summary for service. It is a no-op for a nil Scope.
+func StoreSummary[T any](ctx context.Context, s *Scope, service string, summary T) error {
+	if s == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	return s.db.UpsertSummary(ctx, service, s.region, s.profile, string(data), s.TTL(service))
+}
+
+// Search returns cached resources across all services whose name or ID
+// contains query. It returns nothing for a nil Scope.
+func (s *Scope) Search(ctx context.Context, query string) ([]Resource, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return s.db.SearchResources(ctx, s.profile, s.region, query)
+}
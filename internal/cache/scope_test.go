@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	ID    string
+	Name  string
+	Count int
+}
+
+func TestScope_StoreAndLoad(t *testing.T) {
+	db, err := NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	scope := NewScope(db, "us-east-1", "default", func(string) int { return 60 })
+
+	items := []testItem{
+		{ID: "a", Name: "alpha", Count: 1},
+		{ID: "b", Name: "beta", Count: 2},
+	}
+	err = Store(ctx, scope, "test", items, func(i testItem) (string, string) { return i.ID, i.Name })
+	require.NoError(t, err)
+
+	got, fetchedAt, err := Load[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, items, got)
+	assert.True(t, scope.Fresh("test", fetchedAt))
+
+	// Resources are searchable by the name returned from key.
+	hits, err := db.SearchResources(ctx, "default", "us-east-1", "alp")
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "a", hits[0].ID)
+}
+
+func TestScope_Merge(t *testing.T) {
+	db, err := NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	scope := NewScope(db, "us-east-1", "default", func(string) int { return 60 })
+	key := func(i testItem) (string, string) { return i.ID, i.Name }
+
+	require.NoError(t, Store(ctx, scope, "test", []testItem{{ID: "a", Name: "alpha"}, {ID: "b", Name: "beta"}}, key))
+	require.NoError(t, Merge(ctx, scope, "test", []testItem{{ID: "b", Name: "beta", Count: 2}, {ID: "c", Name: "gamma"}}, key))
+
+	got, _, err := Load[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []testItem{
+		{ID: "a", Name: "alpha"},
+		{ID: "b", Name: "beta", Count: 2},
+		{ID: "c", Name: "gamma"},
+	}, got)
+
+	// A later Store drops rows the merged pages added.
+	require.NoError(t, Store(ctx, scope, "test", []testItem{{ID: "a", Name: "alpha"}}, key))
+	got, _, err = Load[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.Equal(t, []testItem{{ID: "a", Name: "alpha"}}, got)
+}
+
+func TestScope_Nil(t *testing.T) {
+	ctx := context.Background()
+	var scope *Scope
+
+	assert.Nil(t, NewScope(nil, "us-east-1", "default", nil))
+
+	err := Store(ctx, scope, "test", []testItem{{ID: "a"}}, func(i testItem) (string, string) { return i.ID, i.Name })
+	require.NoError(t, err)
+	err = Merge(ctx, scope, "test", []testItem{{ID: "b"}}, func(i testItem) (string, string) { return i.ID, i.Name })
+	require.NoError(t, err)
+
+	got, fetchedAt, err := Load[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	assert.True(t, fetchedAt.IsZero())
+
+	_, found, _, err := LoadSummary[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestScope_Fresh(t *testing.T) {
+	scope := NewScope(&DB{}, "us-east-1", "default", func(service string) int {
+		if service == "short" {
+			return 10
+		}
+		return 0
+	})
+
+	tests := []struct {
+		name      string
+		service   string
+		fetchedAt time.Time
+		want      bool
+	}{
+		{"within ttl", "short", time.Now().Add(-5 * time.Second), true},
+		{"past ttl", "short", time.Now().Add(-20 * time.Second), false},
+		{"zero ttl falls back to default", "other", time.Now().Add(-time.Minute), true},
+		{"zero time is never fresh", "short", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, scope.Fresh(tt.service, tt.fetchedAt))
+		})
+	}
+}
+
+func TestScope_Summary(t *testing.T) {
+	db, err := NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	scope := NewScope(db, "us-east-1", "default", nil)
+
+	_, found, _, err := LoadSummary[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, StoreSummary(ctx, scope, "test", testItem{Name: "sum", Count: 3}))
+
+	got, found, fresh, err := LoadSummary[testItem](ctx, scope, "test")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, fresh)
+	assert.Equal(t, 3, got.Count)
+}
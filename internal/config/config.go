@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,10 +15,111 @@ type Config struct {
 	AutoRefreshInterval int    `yaml:"auto_refresh_interval"`
 	LastRegion          string `yaml:"last_region,omitempty"`
 	LastProfile         string `yaml:"last_profile,omitempty"`
+	// CacheTTL overrides the cache TTL, in seconds, per service ID.
+	CacheTTL map[string]int `yaml:"cache_ttl,omitempty"`
+	// Regions is the region set list views cover in all-regions scope.
+	Regions []string `yaml:"regions,omitempty"`
+	// RegionConcurrency caps how many regions are queried at once.
+	RegionConcurrency int `yaml:"region_concurrency,omitempty"`
+	// Accounts are the named accounts offered by the account picker.
+	Accounts []Account `yaml:"accounts,omitempty"`
+	// Organization, when set, adds the member accounts of an AWS
+	// Organization to the account picker.
+	Organization *Organization `yaml:"organization,omitempty"`
+	// LastAccount is the account in use when the app last switched, or nil
+	// if a plain profile was in use.
+	LastAccount *Account `yaml:"last_account,omitempty"`
+	// ReadOnly disables every action that changes AWS resources.
+	ReadOnly bool `yaml:"read_only,omitempty"`
 
 	path string `yaml:"-"`
 }
 
+// Account is an AWS account reached by assuming a role with the credentials
+// of a source profile.
+type Account struct {
+	Name          string `yaml:"name"`
+	SourceProfile string `yaml:"source_profile"`
+	RoleARN       string `yaml:"role_arn"`
+	ExternalID    string `yaml:"external_id,omitempty"`
+	SessionName   string `yaml:"session_name,omitempty"`
+}
+
+// Organization configures discovery of member accounts. The accounts are
+// listed with the credentials of SourceProfile, which must belong to the
+// management account or a delegated administrator, and are entered by
+// assuming RoleName in each of them.
+type Organization struct {
+	SourceProfile string `yaml:"source_profile"`
+	RoleName      string `yaml:"role_name,omitempty"`
+}
+
+// defaultOrgRoleName is the role AWS Organizations creates in new member
+// accounts.
+const defaultOrgRoleName = "OrganizationAccountAccessRole"
+
+// MemberAccount returns the Account for entering the member account id.
+func (o *Organization) MemberAccount(id, name string) Account {
+	role := o.RoleName
+	if role == "" {
+		role = defaultOrgRoleName
+	}
+	return Account{
+		Name:          name,
+		SourceProfile: o.SourceProfile,
+		RoleARN:       "arn:aws:iam::" + id + ":role/" + role,
+	}
+}
+
+// defaultCacheTTL holds the cache TTL, in seconds, for each service ID.
+// Slow-changing resources such as VPCs and IAM entities are kept longer than
+// instances and load balancers.
+var defaultCacheTTL = map[string]int{
+	"ec2":            60,
+	"ecs":            60,
+	"eks":            300,
+	"vpc":            900,
+	"s3":             900,
+	"iam":            1800,
+	"ecr":            300,
+	"elb":            120,
+	"alarms":         60,
+	"lambda":         300,
+	"rds":            300,
+	"dynamodb":       300,
+	"cloudformation": 300,
+	"messaging":      120,
+	"cost":           3600,
+}
+
+// defaultRegions are the regions enabled in every account. Opt-in regions
+// must be listed in the config to be included in all-regions scope.
+var defaultRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-northeast-3",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"eu-north-1",
+	"sa-east-1",
+}
+
+// defaultRegionConcurrency is used when RegionConcurrency is not set.
+const defaultRegionConcurrency = 4
+
+// fallbackCacheTTL is used for services without a default TTL.
+const fallbackCacheTTL = 300
+
 func Load(path string) (Config, error) {
 	cfg := Config{path: path}
 
@@ -48,6 +150,36 @@ func applyDefaults(cfg Config) Config {
 	return cfg
 }
 
+// CacheTTLFor returns the cache TTL in seconds for a cache key. Keys may carry
+// a sub-resource suffix ("iam:roles"); only the service ID before the colon is
+// used for the lookup.
+func (c *Config) CacheTTLFor(key string) int {
+	service, _, _ := strings.Cut(key, ":")
+	if ttl, ok := c.CacheTTL[service]; ok && ttl > 0 {
+		return ttl
+	}
+	if ttl, ok := defaultCacheTTL[service]; ok {
+		return ttl
+	}
+	return fallbackCacheTTL
+}
+
+// RegionSet returns the regions covered by all-regions scope.
+func (c *Config) RegionSet() []string {
+	if len(c.Regions) > 0 {
+		return c.Regions
+	}
+	return defaultRegions
+}
+
+// FanOutConcurrency returns how many regions may be queried at once.
+func (c *Config) FanOutConcurrency() int {
+	if c.RegionConcurrency > 0 {
+		return c.RegionConcurrency
+	}
+	return defaultRegionConcurrency
+}
+
 // Save writes the config back to disk.
 func (c *Config) Save() error {
 	if c.path == "" {
@@ -85,3 +85,64 @@ func TestLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheTTLFor(t *testing.T) {
+	cfg := Config{CacheTTL: map[string]int{"ec2": 10, "s3": 0}}
+
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"override wins", "ec2", 10},
+		{"zero override uses default", "s3", 900},
+		{"sub-resource key uses service id", "iam:roles", 1800},
+		{"unknown service uses fallback", "unknown", 300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cfg.CacheTTLFor(tt.key))
+		})
+	}
+}
+
+func TestRegionSet(t *testing.T) {
+	var cfg Config
+	assert.Contains(t, cfg.RegionSet(), "eu-north-1")
+	assert.NotContains(t, cfg.RegionSet(), "ap-east-1", "opt-in regions are not queried by default")
+	assert.Equal(t, defaultRegionConcurrency, cfg.FanOutConcurrency())
+
+	cfg = Config{Regions: []string{"eu-west-1", "ap-east-1"}, RegionConcurrency: 2}
+	assert.Equal(t, []string{"eu-west-1", "ap-east-1"}, cfg.RegionSet())
+	assert.Equal(t, 2, cfg.FanOutConcurrency())
+}
+
+func TestLoadAccounts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := []byte(`accounts:
+  - name: prod
+    source_profile: sso-admin
+    role_arn: arn:aws:iam::111111111111:role/ReadOnly
+    external_id: secret
+organization:
+  source_profile: sso-admin
+`)
+	require.NoError(t, os.WriteFile(path, content, 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.Accounts, 1)
+	assert.Equal(t, Account{
+		Name:          "prod",
+		SourceProfile: "sso-admin",
+		RoleARN:       "arn:aws:iam::111111111111:role/ReadOnly",
+		ExternalID:    "secret",
+	}, cfg.Accounts[0])
+
+	require.NotNil(t, cfg.Organization)
+	member := cfg.Organization.MemberAccount("222222222222", "staging")
+	assert.Equal(t, "arn:aws:iam::222222222222:role/OrganizationAccountAccessRole", member.RoleARN)
+	assert.Equal(t, "sso-admin", member.SourceProfile)
+	assert.Equal(t, "staging", member.Name)
+}
@@ -0,0 +1,148 @@
+package plugin
+
+import (
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"tasnim.dev/aws-tui/internal/cache"
+)
+
+// CacheHolder holds the cache scope of a service plugin. Plugins embed it to
+// receive the scope from the registry and hand it to their list views.
+type CacheHolder struct {
+	scope *cache.Scope
+}
+
+// SetCache sets the cache scope used by list views for stale-while-revalidate.
+func (h *CacheHolder) SetCache(scope *cache.Scope) { h.scope = scope }
+
+// Cache returns the cache scope, or nil when caching is disabled.
+func (h *CacheHolder) Cache() *cache.Scope { return h.scope }
+
+// CacheEntry describes rows read from the cache: when the oldest of them
+// was fetched and whether all of them are still within their TTL.
+type CacheEntry struct {
+	FetchedAt time.Time
+	Fresh     bool
+}
+
+// CachedItems is the message sent by LoadCachedItems.
+type CachedItems[T any] struct {
+	Items []T
+	CacheEntry
+}
+
+// CacheReader reads the rows of one view from a cache scope. See LoadCached.
+type CacheReader struct {
+	ctx   context.Context
+	scope *cache.Scope
+	entry CacheEntry
+	err   error
+}
+
+// ReadCached returns the items cached under key and folds their age into
+// the entry of r.
+func ReadCached[T any](r *CacheReader, key string) []T {
+	if r.err != nil {
+		return nil
+	}
+	items, fetchedAt, err := cache.Load[T](r.ctx, r.scope, key)
+	if err != nil {
+		r.err = err
+		return nil
+	}
+	if fetchedAt.IsZero() || !r.scope.Fresh(key, fetchedAt) {
+		r.entry.Fresh = false
+	}
+	if !fetchedAt.IsZero() && (r.entry.FetchedAt.IsZero() || fetchedAt.Before(r.entry.FetchedAt)) {
+		r.entry.FetchedAt = fetchedAt
+	}
+	return items
+}
+
+// Entry returns the age of the rows read so far.
+func (r *CacheReader) Entry() CacheEntry { return r.entry }
+
+// LoadCached returns a command that builds a message from the rows read
+// with read. When caching is disabled, nothing is cached or the cache cannot
+// be read, the command runs fetch instead.
+func LoadCached(scope *cache.Scope, fetch tea.Cmd, read func(r *CacheReader) tea.Msg) tea.Cmd {
+	if scope == nil {
+		return fetch
+	}
+	return func() tea.Msg {
+		r := &CacheReader{ctx: context.TODO(), scope: scope, entry: CacheEntry{Fresh: true}}
+		msg := read(r)
+		if r.err != nil || r.entry.FetchedAt.IsZero() {
+			return fetch()
+		}
+		return msg
+	}
+}
+
+// LoadCachedItems is LoadCached for a view that shows the items cached under
+// a single key. It sends a CachedItems[T].
+func LoadCachedItems[T any](scope *cache.Scope, key string, fetch tea.Cmd) tea.Cmd {
+	return LoadCached(scope, fetch, func(r *CacheReader) tea.Msg {
+		items := ReadCached[T](r, key)
+		return CachedItems[T]{Items: items, CacheEntry: r.Entry()}
+	})
+}
+
+// Revalidation tracks the freshness of the rows shown by a list view that
+// displays cached rows while it fetches them live. List views embed it to
+// implement Freshness.
+type Revalidation struct {
+	updated time.Time
+	stale   bool
+	pending int
+	failed  bool
+}
+
+// UpdatedAt returns when the displayed rows were fetched.
+func (r *Revalidation) UpdatedAt() time.Time { return r.updated }
+
+// Stale reports whether the displayed rows come from an expired cache entry
+// or a failed refresh.
+func (r *Revalidation) Stale() bool { return r.stale }
+
+// Expect records that n live fetches were started together. The rows count
+// as refreshed once all of them have succeeded, and a failure among them is
+// toasted once. Views that fetch their rows with a single call need not
+// call it.
+func (r *Revalidation) Expect(n int) { r.pending, r.failed = n, false }
+
+// FromCache records that the view shows rows read from the cache and reports
+// whether they should be revalidated with a live fetch.
+func (r *Revalidation) FromCache(router Router, entry CacheEntry) bool {
+	r.updated, r.stale = entry.FetchedAt, !entry.Fresh
+	return r.stale && !router.Offline()
+}
+
+// Fetched records the outcome of a live fetch. If it failed while rows are
+// shown, the last known rows are kept and marked stale, the error is toasted
+// and Fetched reports true. Otherwise err is left for the view to handle.
+func (r *Revalidation) Fetched(router Router, err error) bool {
+	r.pending--
+	kept := false
+	if err != nil {
+		if !r.updated.IsZero() {
+			if !r.failed {
+				router.Toast(ToastError, "Refresh failed: "+err.Error())
+			}
+			kept = true
+		}
+		r.failed = true
+	}
+	if r.pending <= 0 {
+		if r.failed {
+			r.stale = !r.updated.IsZero()
+		} else {
+			r.updated, r.stale = time.Now(), false
+		}
+		r.pending, r.failed = 0, false
+	}
+	return kept
+}
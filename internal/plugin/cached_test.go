@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tasnim.dev/aws-tui/internal/cache"
+)
+
+type fetchedMsg struct{}
+
+func fetchCmd() tea.Msg { return fetchedMsg{} }
+
+func TestLoadCached(t *testing.T) {
+	db, err := cache.NewTestDB()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	scope := cache.NewScope(db, "us-east-1", "default", func(string) int { return 300 })
+	key := func(s string) (string, string) { return s, s }
+
+	// Without caching the command is the live fetch itself.
+	assert.IsType(t, fetchedMsg{}, LoadCachedItems[string](nil, "a", fetchCmd)())
+
+	// Nothing cached falls back to the live fetch.
+	assert.IsType(t, fetchedMsg{}, LoadCachedItems[string](scope, "a", fetchCmd)())
+
+	require.NoError(t, cache.Store(context.Background(), scope, "a", []string{"x", "y"}, key))
+	msg := LoadCachedItems[string](scope, "a", fetchCmd)()
+	require.IsType(t, CachedItems[string]{}, msg)
+	got := msg.(CachedItems[string])
+	assert.Equal(t, []string{"x", "y"}, got.Items)
+	assert.False(t, got.FetchedAt.IsZero())
+	assert.True(t, got.Fresh)
+
+	// A key missing from a multi-key read makes the rows stale.
+	type pairMsg struct {
+		a, b []string
+		CacheEntry
+	}
+	msg = LoadCached(scope, fetchCmd, func(r *CacheReader) tea.Msg {
+		a := ReadCached[string](r, "a")
+		b := ReadCached[string](r, "b")
+		return pairMsg{a: a, b: b, CacheEntry: r.Entry()}
+	})()
+	require.IsType(t, pairMsg{}, msg)
+	pair := msg.(pairMsg)
+	assert.Equal(t, []string{"x", "y"}, pair.a)
+	assert.Empty(t, pair.b)
+	assert.Equal(t, got.FetchedAt, pair.FetchedAt)
+	assert.False(t, pair.Fresh)
+}
+
+func TestRevalidation(t *testing.T) {
+	router := &toastRouter{}
+	var r Revalidation
+
+	// A failure with nothing on screen is left to the view.
+	assert.False(t, r.Fetched(router, errors.New("boom")))
+	assert.True(t, r.UpdatedAt().IsZero())
+	assert.False(t, r.Stale())
+	assert.Empty(t, router.toasts)
+
+	fetchedAt := time.Now().Add(-time.Hour)
+	assert.True(t, r.FromCache(router, CacheEntry{FetchedAt: fetchedAt}))
+	assert.Equal(t, fetchedAt, r.UpdatedAt())
+	assert.True(t, r.Stale())
+
+	// Failures among fetches started together keep the rows and toast once.
+	r.Expect(2)
+	assert.True(t, r.Fetched(router, errors.New("boom")))
+	assert.True(t, r.Fetched(router, errors.New("boom")))
+	assert.Equal(t, fetchedAt, r.UpdatedAt())
+	assert.True(t, r.Stale())
+	assert.Equal(t, []string{"Refresh failed: boom"}, router.toasts)
+
+	// The rows count as refreshed once every fetch has succeeded.
+	r.Expect(2)
+	assert.False(t, r.Fetched(router, nil))
+	assert.True(t, r.Stale())
+	assert.False(t, r.Fetched(router, nil))
+	assert.False(t, r.Stale())
+	assert.WithinDuration(t, time.Now(), r.UpdatedAt(), time.Second)
+
+	// Fresh cached rows are not revalidated.
+	assert.False(t, r.FromCache(router, CacheEntry{FetchedAt: time.Now(), Fresh: true}))
+}
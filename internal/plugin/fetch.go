@@ -0,0 +1,59 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+)
+
+// fetchPolicy is the retry policy used by Fetch. Tests shorten its delays.
+var fetchPolicy = internalaws.DefaultRetryPolicy
+
+// Fetch runs fn with internalaws.Retry, retrying throttled, network and
+// transient service errors with jittered exponential backoff. Each retry is
+// reported to the user as a toast on router. ctx is normally
+// router.Context(view): once the view is closed the backoff is abandoned and
+// the last error returned.
+func Fetch(ctx context.Context, router Router, fn func(ctx context.Context) error) error {
+	return internalaws.Retry(ctx, fetchPolicy, fn, func(attempt int, err error) {
+		reason := "AWS service error"
+		switch internalaws.ClassifyError(err) {
+		case internalaws.ErrKindThrottled:
+			reason = "Throttled by AWS"
+		case internalaws.ErrKindNetwork:
+			reason = "Network error"
+		}
+		router.Toast(ToastWarning, fmt.Sprintf("%s, retrying (attempt %d of %d)", reason, attempt, fetchPolicy.MaxAttempts))
+	})
+}
+
+// FetchPages fetches pages with Fetch, starting after token, until at least
+// want items have been read or page returns no next token. want of zero
+// reads a single page. It returns the items and the token for the page
+// after them, or nil once the listing is complete.
+//
+// List views pass their loaded row count as want when refreshing, so a
+// refresh does not cut the table back to the first page.
+func FetchPages[T any](ctx context.Context, router Router, token *string, want int, page func(ctx context.Context, token *string) ([]T, *string, error)) ([]T, *string, error) {
+	var items []T
+	for {
+		var batch []T
+		var next *string
+		err := Fetch(ctx, router, func(ctx context.Context) (err error) {
+			batch, next, err = page(ctx, token)
+			return err
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, batch...)
+		if next != nil && *next == "" {
+			next = nil
+		}
+		if next == nil || len(items) >= want {
+			return items, next, nil
+		}
+		token = next
+	}
+}
@@ -0,0 +1,124 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+)
+
+// toastRouter records toasts; the other Router methods are no-ops.
+type toastRouter struct {
+	toasts []string
+}
+
+func (r *toastRouter) Push(View)                      {}
+func (r *toastRouter) Pop()                           {}
+func (r *toastRouter) Navigate(string)                {}
+func (r *toastRouter) NavigateDetail(string, string)  {}
+func (r *toastRouter) Toast(_ ToastLevel, msg string) { r.toasts = append(r.toasts, msg) }
+func (r *toastRouter) Offline() bool                  { return false }
+func (r *toastRouter) ReadOnly() bool                 { return false }
+func (r *toastRouter) Confirm(Action)                 {}
+func (r *toastRouter) Context(View) context.Context   { return context.Background() }
+
+func TestFetch(t *testing.T) {
+	orig := fetchPolicy
+	fetchPolicy = internalaws.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	t.Cleanup(func() { fetchPolicy = orig })
+
+	tests := []struct {
+		name       string
+		errs       []error
+		wantErr    bool
+		wantToasts []string
+	}{
+		{name: "success", errs: nil},
+		{
+			name:       "throttled then success",
+			errs:       []error{errors.New("Rate exceeded")},
+			wantToasts: []string{"Throttled by AWS, retrying (attempt 2 of 3)"},
+		},
+		{
+			name:    "network errors exhaust attempts",
+			errs:    []error{errors.New("connection refused"), errors.New("connection refused"), errors.New("connection refused")},
+			wantErr: true,
+			wantToasts: []string{
+				"Network error, retrying (attempt 2 of 3)",
+				"Network error, retrying (attempt 3 of 3)",
+			},
+		},
+		{name: "access denied is not retried", errs: []error{errors.New("access denied")}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			router := &toastRouter{}
+			calls := 0
+			err := Fetch(context.Background(), router, func(context.Context) error {
+				calls++
+				if calls <= len(tc.errs) {
+					return tc.errs[calls-1]
+				}
+				return nil
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantToasts, router.toasts)
+		})
+	}
+}
+
+func TestFetchPages(t *testing.T) {
+	// pages holds three pages of two items; tokens are page indexes.
+	pages := [][]int{{1, 2}, {3, 4}, {5, 6}}
+	page := func(_ context.Context, token *string) ([]int, *string, error) {
+		i := 0
+		if token != nil {
+			i = int((*token)[0] - '0')
+		}
+		var next *string
+		if i+1 < len(pages) {
+			s := string(rune('0' + i + 1))
+			next = &s
+		}
+		return pages[i], next, nil
+	}
+
+	tests := []struct {
+		name      string
+		token     *string
+		want      int
+		wantItems []int
+		wantNext  string
+	}{
+		{name: "single page", want: 0, wantItems: []int{1, 2}, wantNext: "1"},
+		{name: "enough pages to cover want", want: 3, wantItems: []int{1, 2, 3, 4}, wantNext: "2"},
+		{name: "stops at the last page", want: 10, wantItems: []int{1, 2, 3, 4, 5, 6}},
+		{name: "starts after token", token: ptr("2"), wantItems: []int{5, 6}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			items, next, err := FetchPages(context.Background(), &toastRouter{}, tc.token, tc.want, page)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantItems, items)
+			if tc.wantNext == "" {
+				assert.Nil(t, next)
+			} else {
+				require.NotNil(t, next)
+				assert.Equal(t, tc.wantNext, *next)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string { return &s }
@@ -54,12 +54,53 @@ type View interface {
 	KeyHints() []KeyHint
 }
 
+// Freshness is implemented by views that can show cached data. The app uses
+// it to render when the data was fetched and whether it is stale.
+type Freshness interface {
+	UpdatedAt() time.Time
+	Stale() bool
+}
+
+// InputCapturer is implemented by views that read text, such as a filter or
+// a search prompt. While CapturingInput reports true the app forwards every
+// key to the view instead of handling its global shortcuts.
+type InputCapturer interface {
+	CapturingInput() bool
+}
+
 type Router interface {
 	Push(view View)
 	Pop()
 	Navigate(pluginID string)
 	NavigateDetail(pluginID, id string)
 	Toast(level ToastLevel, msg string)
+	// Offline reports whether AWS is unreachable. Views serve cached data
+	// and refuse mutating or exec actions while offline.
+	Offline() bool
+	// ReadOnly reports whether mutating actions are disabled by config.
+	ReadOnly() bool
+	// Confirm asks the user to confirm action by typing its phrase, then
+	// runs it. It refuses, with a toast, while read-only.
+	Confirm(action Action)
+	// Context returns a context that is cancelled once view leaves the
+	// navigation stack, so its in-flight fetches and retries stop.
+	Context(view View) context.Context
+}
+
+// Action is a mutating operation, such as stopping an instance, that the app
+// confirms before running.
+type Action struct {
+	Title   string   // e.g. "Stop instance"
+	Targets []string // the resources affected, named in the prompt
+	Phrase  string   // what the user must type to confirm
+	// Region and Account are where the operation runs, shown in the prompt.
+	// Empty means the session's region or account; views that act through
+	// a regional client set Region.
+	Region  string
+	Account string
+	// Run starts the operation. Its messages are delivered to the current
+	// view.
+	Run func() tea.Cmd
 }
 
 type ServicePlugin interface {
@@ -72,3 +113,21 @@ type ServicePlugin interface {
 	Commands() []Command
 	PollConfig() PollConfig
 }
+
+// RegionScope selects the regions a list view covers. The zero value covers
+// the session region only.
+type RegionScope struct {
+	Regions     []string
+	Concurrency int // regions queried at once
+}
+
+// All reports whether the scope fans out across several regions.
+func (s RegionScope) All() bool {
+	return len(s.Regions) > 0
+}
+
+// MultiRegion is implemented by plugins whose list view can fan out across
+// regions. The app sets the scope when the user toggles all-regions mode.
+type MultiRegion interface {
+	SetRegionScope(scope RegionScope)
+}
@@ -0,0 +1,305 @@
+package alarms
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// historyLimit is how many history items the History tab shows.
+const historyLimit = 50
+
+// Tabs of the detail view.
+const (
+	overviewTab = 0
+	metricTab   = 1
+	historyTab  = 2
+)
+
+// detailLoadedMsg carries the result of loading an alarm and its history.
+type detailLoadedMsg struct {
+	alarm      cloudwatch.Alarm
+	history    []cloudwatch.AlarmHistoryItem
+	historyErr error
+	err        error
+}
+
+// DetailView shows an alarm's configuration, metric and history.
+type DetailView struct {
+	client     AlarmsClient
+	router     plugin.Router
+	name       string
+	alarm      *cloudwatch.Alarm
+	history    []cloudwatch.AlarmHistoryItem
+	historyErr error
+	metrics    *metrics.Panel
+	tabs       ui.TabController
+	loading    bool
+	err        error
+	width      int
+}
+
+// NewDetailView creates a DetailView for the alarm called name.
+func NewDetailView(client AlarmsClient, router plugin.Router, name string) *DetailView {
+	return &DetailView{
+		client:  client,
+		router:  router,
+		name:    name,
+		tabs:    ui.NewTabController([]string{"Overview", "Metric", "History"}),
+		loading: true,
+	}
+}
+
+func (dv *DetailView) loadAlarm() tea.Cmd {
+	client, router := dv.client, dv.router
+	name := dv.name
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		var alarm cloudwatch.Alarm
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			alarm, err = client.DescribeAlarm(ctx, name)
+			return err
+		})
+		if err != nil {
+			return detailLoadedMsg{err: err}
+		}
+		history, historyErr := client.AlarmHistory(ctx, name, historyLimit)
+		return detailLoadedMsg{alarm: alarm, history: history, historyErr: historyErr}
+	}
+}
+
+func (dv *DetailView) Init() tea.Cmd {
+	return dv.loadAlarm()
+}
+
+func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if dv.metrics != nil && dv.metrics.Update(msg) {
+		return dv, nil
+	}
+
+	switch msg := msg.(type) {
+	case detailLoadedMsg:
+		dv.loading = false
+		if msg.err != nil {
+			dv.err = msg.err
+			return dv, nil
+		}
+		dv.alarm = &msg.alarm
+		dv.history = msg.history
+		dv.historyErr = msg.historyErr
+		if dv.metrics == nil {
+			dv.metrics = metrics.NewPanel(dv.router, alarmCharts(msg.alarm))
+			dv.metrics.SetClient(dv.client)
+			dv.metrics.SetWidth(dv.width)
+		}
+		if dv.tabs.Active() == metricTab {
+			return dv, dv.metrics.LoadOnce(dv.router.Context(dv))
+		}
+		return dv, nil
+
+	case tea.WindowSizeMsg:
+		dv.width = msg.Width
+		if dv.metrics != nil {
+			dv.metrics.SetWidth(msg.Width)
+		}
+		return dv, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "esc", "backspace":
+			dv.router.Pop()
+			return dv, nil
+		case "enter":
+			if link, ok := dv.resource(); ok && dv.tabs.Active() == overviewTab {
+				dv.router.NavigateDetail(link.pluginID, link.id)
+			}
+			return dv, nil
+		}
+		if dv.tabs.Active() == metricTab && dv.metrics != nil {
+			if cmd, ok := dv.metrics.HandleKey(dv.router.Context(dv), msg.String()); ok {
+				return dv, cmd
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	dv.tabs, cmd = dv.tabs.Update(msg)
+	if dv.tabs.Active() == metricTab && dv.metrics != nil {
+		cmd = tea.Batch(cmd, dv.metrics.LoadOnce(dv.router.Context(dv)))
+	}
+	return dv, cmd
+}
+
+// resource returns the link to the resource the alarm watches, if it is one
+// that can be opened.
+func (dv *DetailView) resource() (resourceLink, bool) {
+	if dv.alarm == nil {
+		return resourceLink{}, false
+	}
+	return alarmResource(*dv.alarm)
+}
+
+func (dv *DetailView) View() tea.View {
+	if dv.loading {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if dv.err != nil {
+		return tea.NewView("Error: " + dv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(dv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch dv.tabs.Active() {
+	case overviewTab:
+		b.WriteString(dv.renderOverview())
+	case metricTab:
+		if dv.alarm.Metric == "" {
+			b.WriteString("This alarm evaluates a metric math expression, which cannot be charted here.")
+		} else {
+			b.WriteString(dv.metrics.View())
+		}
+	case historyTab:
+		b.WriteString(dv.renderHistory())
+	}
+
+	return tea.NewView(b.String())
+}
+
+func (dv *DetailView) renderOverview() string {
+	a := dv.alarm
+	dims := make([]string, len(a.Dimensions))
+	for i, d := range a.Dimensions {
+		dims[i] = d.Name + "=" + d.Value
+	}
+	actions := "disabled"
+	if a.ActionsEnabled {
+		actions = fmt.Sprintf("enabled, %d on alarm", len(a.Actions))
+	}
+	rows := []ui.KV{
+		{K: "Name", V: a.Name},
+		{K: "State", V: renderState(a.State)},
+		{K: "Reason", V: a.StateReason},
+		{K: "Updated", V: a.StateUpdated.Local().Format("2006-01-02 15:04:05")},
+		{K: "Description", V: a.Description},
+		{K: "Metric", V: strings.TrimPrefix(a.Namespace+" "+metricName(*a), " ")},
+		{K: "Dimensions", V: strings.Join(dims, ", ")},
+		{K: "Statistic", V: a.Stat},
+		{K: "Condition", V: evaluation(*a)},
+		{K: "Actions", V: actions},
+	}
+	if link, ok := alarmResource(*a); ok {
+		rows = append(rows, ui.KV{K: "Resource", V: link.label})
+	}
+	valWidth := dv.width - 22
+	if valWidth < 40 {
+		valWidth = 40
+	}
+	return ui.RenderKV(rows, 20, valWidth)
+}
+
+func (dv *DetailView) renderHistory() string {
+	if dv.historyErr != nil {
+		return "Error: " + dv.historyErr.Error()
+	}
+	if len(dv.history) == 0 {
+		return "No history."
+	}
+
+	var b strings.Builder
+	header := fmt.Sprintf("%-20s %-20s %s", "Time", "Type", "Summary")
+	b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Render(header))
+	b.WriteString("\n")
+	for _, h := range dv.history {
+		b.WriteString(fmt.Sprintf("%-20s %-20s %s\n", h.Timestamp.Local().Format("2006-01-02 15:04:05"), h.Type, h.Summary))
+	}
+	return b.String()
+}
+
+func (dv *DetailView) Title() string {
+	return dv.name
+}
+
+func (dv *DetailView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "1-3", Desc: "jump to tab"},
+	}
+	if link, ok := dv.resource(); ok && dv.tabs.Active() == overviewTab {
+		hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "open " + link.label})
+	}
+	if dv.tabs.Active() == metricTab && dv.metrics != nil {
+		hints = append(hints, dv.metrics.KeyHints()...)
+	}
+	return hints
+}
+
+// alarmCharts returns the chart of the metric an alarm watches, titled with
+// its threshold. A metric math alarm has none.
+func alarmCharts(a cloudwatch.Alarm) []metrics.Chart {
+	if a.Metric == "" {
+		return nil
+	}
+	return []metrics.Chart{{
+		Title: fmt.Sprintf("%s %s (threshold %s)", a.Metric, a.Stat, condition(a)),
+		Query: cloudwatch.MetricQuery{
+			Namespace:  a.Namespace,
+			Metric:     a.Metric,
+			Dimensions: a.Dimensions,
+			Stat:       a.Stat,
+		},
+		Format: metrics.Count,
+	}}
+}
+
+// metricName returns the metric an alarm watches.
+func metricName(a cloudwatch.Alarm) string {
+	if a.Metric == "" {
+		return "(metric math)"
+	}
+	return a.Metric
+}
+
+// comparisons maps comparison operators to symbols.
+var comparisons = map[string]string{
+	"GreaterThanOrEqualToThreshold":            ">=",
+	"GreaterThanThreshold":                     ">",
+	"LessThanThreshold":                        "<",
+	"LessThanOrEqualToThreshold":               "<=",
+	"LessThanLowerOrGreaterThanUpperThreshold": "outside band",
+	"LessThanLowerThreshold":                   "< band",
+	"GreaterThanUpperThreshold":                "> band",
+}
+
+// condition returns an alarm's comparison and threshold, e.g. "> 80".
+func condition(a cloudwatch.Alarm) string {
+	op, ok := comparisons[a.Comparison]
+	if !ok {
+		op = a.Comparison
+	}
+	if strings.HasSuffix(op, "band") {
+		return op // anomaly detection bands have no fixed threshold
+	}
+	return op + " " + strconv.FormatFloat(a.Threshold, 'f', -1, 64)
+}
+
+// evaluation returns when an alarm fires, e.g. "> 80 for 2 of 3 periods of 5m0s".
+func evaluation(a cloudwatch.Alarm) string {
+	points := a.DatapointsToAlarm
+	if points == 0 {
+		points = a.EvaluationPeriods
+	}
+	return fmt.Sprintf("%s for %d of %d periods of %s", condition(a), points, a.EvaluationPeriods, a.Period)
+}
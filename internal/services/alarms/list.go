@@ -0,0 +1,288 @@
+package alarms
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/cache"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// cacheKey is the cache service key for alarms.
+const cacheKey = "alarms"
+
+// Alarm states, in the order alarms are listed.
+const (
+	stateAlarm        = "ALARM"
+	stateInsufficient = "INSUFFICIENT_DATA"
+	stateOK           = "OK"
+)
+
+// states are the states the list can be narrowed to, "" showing every alarm.
+var states = []string{"", stateAlarm, stateInsufficient, stateOK}
+
+var (
+	stateAlarmStyle        = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("196"))
+	stateInsufficientStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	stateOKStyle           = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	countsStyle            = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+)
+
+// alarmsMsg carries the result of fetching alarms.
+type alarmsMsg struct {
+	alarms []cloudwatch.Alarm
+	err    error
+}
+
+// ListView displays alarms in a table, firing alarms first.
+type ListView struct {
+	plugin.Revalidation
+
+	client  AlarmsClient
+	router  plugin.Router
+	table   ui.TableView[cloudwatch.Alarm]
+	alarms  []cloudwatch.Alarm
+	state   int // index into states
+	loading bool
+	err     error
+	cache   *cache.Scope
+}
+
+// NewListView creates a new alarms ListView.
+func NewListView(client AlarmsClient, router plugin.Router) *ListView {
+	tv := ui.NewTableView(alarmColumns(), nil, func(a cloudwatch.Alarm) string {
+		return a.Name
+	})
+	return &ListView{
+		client:  client,
+		router:  router,
+		table:   tv,
+		loading: true,
+	}
+}
+
+func alarmColumns() []ui.Column[cloudwatch.Alarm] {
+	return []ui.Column[cloudwatch.Alarm]{
+		{Title: "State", Width: 18, Field: func(a cloudwatch.Alarm) string { return a.State }},
+		{Title: "Name", Width: 36, Field: func(a cloudwatch.Alarm) string { return a.Name }},
+		{Title: "Metric", Width: 32, Field: func(a cloudwatch.Alarm) string { return metricName(a) }},
+		{Title: "Condition", Width: 20, Field: func(a cloudwatch.Alarm) string { return condition(a) }},
+		{Title: "Updated", Width: 17, Field: func(a cloudwatch.Alarm) string {
+			if a.StateUpdated.IsZero() {
+				return "-"
+			}
+			return a.StateUpdated.Local().Format("2006-01-02 15:04")
+		}},
+	}
+}
+
+// sortAlarms orders alarms by state, firing first, then by name.
+func sortAlarms(alarms []cloudwatch.Alarm) {
+	sort.SliceStable(alarms, func(i, j int) bool {
+		if ri, rj := stateRank(alarms[i].State), stateRank(alarms[j].State); ri != rj {
+			return ri < rj
+		}
+		return alarms[i].Name < alarms[j].Name
+	})
+}
+
+func stateRank(state string) int {
+	switch state {
+	case stateAlarm:
+		return 0
+	case stateInsufficient:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// stateLabel returns how a state is named in the dashboard summary.
+func stateLabel(state string) string {
+	switch state {
+	case stateAlarm:
+		return "alarm"
+	case stateInsufficient:
+		return "insufficient data"
+	default:
+		return strings.ToLower(state)
+	}
+}
+
+// renderState returns the state coloured by severity.
+func renderState(state string) string {
+	switch state {
+	case stateAlarm:
+		return stateAlarmStyle.Render(state)
+	case stateInsufficient:
+		return stateInsufficientStyle.Render(state)
+	case stateOK:
+		return stateOKStyle.Render(state)
+	}
+	return state
+}
+
+func (lv *ListView) fetchAlarms() tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var alarms []cloudwatch.Alarm
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			alarms, err = client.ListAlarms(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, alarms, func(a cloudwatch.Alarm) (string, string) {
+				return a.Name, a.Name
+			})
+		}
+		return alarmsMsg{alarms: alarms, err: err}
+	}
+}
+
+// setAlarms replaces the alarms and shows those in the selected state.
+func (lv *ListView) setAlarms(alarms []cloudwatch.Alarm) {
+	sortAlarms(alarms)
+	lv.alarms = alarms
+	lv.applyState()
+}
+
+func (lv *ListView) applyState() {
+	state := states[lv.state]
+	if state == "" {
+		lv.table.SetItems(lv.alarms)
+		return
+	}
+	var shown []cloudwatch.Alarm
+	for _, a := range lv.alarms {
+		if a.State == state {
+			shown = append(shown, a)
+		}
+	}
+	lv.table.SetItems(shown)
+}
+
+func (lv *ListView) Init() tea.Cmd {
+	fetch := lv.fetchAlarms()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[cloudwatch.Alarm](lv.cache, cacheKey, fetch)
+	}
+	return fetch
+}
+
+func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case plugin.CachedItems[cloudwatch.Alarm]:
+		lv.loading = false
+		lv.setAlarms(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchAlarms()
+		}
+		return lv, nil
+
+	case alarmsMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.err = nil
+		lv.setAlarms(msg.alarms)
+		return lv, nil
+
+	case tea.KeyPressMsg:
+		if lv.loading {
+			return lv, nil
+		}
+		if lv.table.Filtering() {
+			break
+		}
+
+		switch msg.String() {
+		case "enter":
+			if id := lv.table.SelectedID(); id != "" {
+				view := NewDetailView(lv.client, lv.router, id)
+				lv.router.Push(view)
+				return lv, view.Init()
+			}
+			return lv, nil
+		case "esc", "backspace":
+			lv.router.Pop()
+			return lv, nil
+		case "r":
+			lv.loading = true
+			return lv, lv.fetchAlarms()
+		case "f":
+			lv.state = (lv.state + 1) % len(states)
+			lv.applyState()
+			return lv, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	lv.table, cmd = lv.table.Update(msg)
+	return lv, cmd
+}
+
+func (lv *ListView) View() tea.View {
+	if lv.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if lv.err != nil {
+		return tea.NewView("Error: " + lv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(lv.renderCounts())
+	b.WriteString("\n\n")
+	if lv.table.ItemCount() == 0 && states[lv.state] != "" {
+		b.WriteString(fmt.Sprintf("No alarms in %s.", states[lv.state]))
+	} else {
+		b.WriteString(lv.table.View())
+	}
+	return tea.NewView(b.String())
+}
+
+// renderCounts returns the number of alarms in each state, and the state
+// the list is narrowed to.
+func (lv *ListView) renderCounts() string {
+	counts := make(map[string]int)
+	for _, a := range lv.alarms {
+		counts[a.State]++
+	}
+	parts := make([]string, 0, 3)
+	for _, s := range states[1:] {
+		parts = append(parts, fmt.Sprintf("%s %d", renderState(s), counts[s]))
+	}
+	line := strings.Join(parts, "   ")
+	if s := states[lv.state]; s != "" {
+		line += countsStyle.Render("   showing " + s)
+	}
+	return line
+}
+
+func (lv *ListView) Title() string { return "CloudWatch Alarms" }
+
+// CapturingInput implements plugin.InputCapturer while the filter is open.
+func (lv *ListView) CapturingInput() bool { return lv.table.Filtering() }
+
+func (lv *ListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view alarm"},
+		{Key: "f", Desc: "filter by state"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+	}
+}
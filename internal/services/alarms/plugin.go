@@ -0,0 +1,99 @@
+package alarms
+
+import (
+	"context"
+	"time"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// AlarmsClient defines the subset of cloudwatch.Client methods used by the
+// plugin.
+type AlarmsClient interface {
+	ListAlarms(ctx context.Context) ([]cloudwatch.Alarm, error)
+	DescribeAlarm(ctx context.Context, name string) (cloudwatch.Alarm, error)
+	AlarmHistory(ctx context.Context, name string, limit int) ([]cloudwatch.AlarmHistoryItem, error)
+	GetMetrics(ctx context.Context, queries []cloudwatch.MetricQuery, r cloudwatch.TimeRange, end time.Time) ([]cloudwatch.MetricSeries, error)
+}
+
+// Plugin implements plugin.ServicePlugin for CloudWatch alarms.
+type Plugin struct {
+	plugin.CacheHolder
+
+	client AlarmsClient
+	alarms []cloudwatch.Alarm
+}
+
+// NewPlugin creates a new CloudWatch Alarms ServicePlugin.
+func NewPlugin(client AlarmsClient) *Plugin {
+	return &Plugin{client: client}
+}
+
+func (p *Plugin) ID() string   { return "alarms" }
+func (p *Plugin) Name() string { return "Alarms" }
+func (p *Plugin) Icon() string { return "\U000F009E" } // nf-mdi-bell-ring
+
+func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
+	alarms, err := p.client.ListAlarms(ctx)
+	if err != nil {
+		return plugin.ServiceSummary{}, err
+	}
+	p.alarms = alarms
+	return mapSummary(alarms), nil
+}
+
+// mapSummary counts alarms by state. Any alarm firing makes the service
+// critical.
+func mapSummary(alarms []cloudwatch.Alarm) plugin.ServiceSummary {
+	status := make(map[string]int)
+	for _, a := range alarms {
+		status[stateLabel(a.State)]++
+	}
+
+	health := plugin.HealthHealthy
+	if status[stateLabel(stateAlarm)] > 0 {
+		health = plugin.HealthCritical
+	}
+
+	return plugin.ServiceSummary{
+		Total:  len(alarms),
+		Status: status,
+		Health: health,
+		Label:  "alarms",
+	}
+}
+
+func (p *Plugin) ListView(router plugin.Router) plugin.View {
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
+}
+
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	return NewDetailView(p.client, router, id)
+}
+
+func (p *Plugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{
+			Title:    "CloudWatch Alarms",
+			Keywords: []string{"alarms", "cloudwatch", "alerts", "on-call", "firing"},
+		},
+	}
+}
+
+func (p *Plugin) PollConfig() plugin.PollConfig {
+	return plugin.PollConfig{
+		IdleInterval:   60 * time.Second,
+		ActiveInterval: 30 * time.Second,
+		IsActive: func() bool {
+			for _, a := range p.alarms {
+				if a.State == stateAlarm {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
@@ -0,0 +1,253 @@
+package alarms
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/plugin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const alarmARN = "arn:aws:cloudwatch:us-east-1:123456789012:alarm:"
+
+// mockClient implements AlarmsClient for testing.
+type mockClient struct {
+	alarms  []cloudwatch.Alarm
+	history []cloudwatch.AlarmHistoryItem
+	queries []cloudwatch.MetricQuery
+	err     error
+}
+
+func (m *mockClient) ListAlarms(_ context.Context) ([]cloudwatch.Alarm, error) {
+	return m.alarms, m.err
+}
+
+func (m *mockClient) DescribeAlarm(_ context.Context, name string) (cloudwatch.Alarm, error) {
+	for _, a := range m.alarms {
+		if a.Name == name {
+			return a, nil
+		}
+	}
+	return cloudwatch.Alarm{}, errors.New("alarm " + name + " not found")
+}
+
+func (m *mockClient) AlarmHistory(_ context.Context, _ string, _ int) ([]cloudwatch.AlarmHistoryItem, error) {
+	return m.history, nil
+}
+
+func (m *mockClient) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, _ cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries = queries
+	return []cloudwatch.MetricSeries{{Values: []float64{70, 92}}}, nil
+}
+
+type mockRouter struct {
+	detail []string // pluginID and id of NavigateDetail calls
+}
+
+func (m *mockRouter) Push(_ plugin.View)                    {}
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(pluginID, id string)    { m.detail = append(m.detail, pluginID, id) }
+func (m *mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	switch s {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+func cpuAlarm(state string) cloudwatch.Alarm {
+	return cloudwatch.Alarm{
+		Name:              "web-cpu-high",
+		ARN:               alarmARN + "web-cpu-high",
+		State:             state,
+		StateReason:       "Threshold Crossed: 2 out of the last 3 datapoints were greater than the threshold (80.0)",
+		Namespace:         "AWS/EC2",
+		Metric:            "CPUUtilization",
+		Stat:              "Average",
+		Dimensions:        []cloudwatch.Dimension{{Name: "InstanceId", Value: "i-0abc"}},
+		Period:            5 * time.Minute,
+		EvaluationPeriods: 3,
+		DatapointsToAlarm: 2,
+		Comparison:        "GreaterThanThreshold",
+		Threshold:         80,
+		ActionsEnabled:    true,
+		Actions:           []string{"arn:aws:sns:us-east-1:123456789012:oncall"},
+	}
+}
+
+func TestPluginMetadata(t *testing.T) {
+	p := NewPlugin(nil)
+	assert.Equal(t, "alarms", p.ID())
+	assert.Equal(t, "Alarms", p.Name())
+	assert.NotEmpty(t, p.Icon())
+	require.Len(t, p.Commands(), 1)
+	assert.Contains(t, p.Commands()[0].Keywords, "cloudwatch")
+}
+
+func TestSummary(t *testing.T) {
+	client := &mockClient{alarms: []cloudwatch.Alarm{
+		{Name: "a", State: "OK"},
+		{Name: "b", State: "OK"},
+		{Name: "c", State: "INSUFFICIENT_DATA"},
+	}}
+	p := NewPlugin(client)
+	summary, err := p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Total)
+	assert.Equal(t, map[string]int{"ok": 2, "insufficient data": 1}, summary.Status)
+	assert.Equal(t, plugin.HealthHealthy, summary.Health)
+	assert.Equal(t, "alarms", summary.Label)
+	assert.False(t, p.PollConfig().IsActive())
+
+	// A firing alarm makes the dashboard card critical and polls faster.
+	client.alarms = append(client.alarms, cpuAlarm("ALARM"))
+	summary, err = p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Status["alarm"])
+	assert.Equal(t, plugin.HealthCritical, summary.Health)
+	assert.True(t, p.PollConfig().IsActive())
+}
+
+func TestSummary_Error(t *testing.T) {
+	p := NewPlugin(&mockClient{err: assert.AnError})
+	_, err := p.Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestListView_OrdersAndFiltersByState(t *testing.T) {
+	client := &mockClient{alarms: []cloudwatch.Alarm{
+		{Name: "b-ok", State: "OK"},
+		{Name: "z-firing", State: "ALARM"},
+		{Name: "a-ok", State: "OK"},
+		{Name: "no-data", State: "INSUFFICIENT_DATA"},
+		{Name: "a-firing", State: "ALARM"},
+	}}
+	lv := NewPlugin(client).ListView(&mockRouter{}).(*ListView)
+	lv.Update(lv.Init()())
+
+	var names []string
+	for _, a := range lv.table.Items() {
+		names = append(names, a.Name)
+	}
+	assert.Equal(t, []string{"a-firing", "z-firing", "no-data", "a-ok", "b-ok"}, names)
+
+	// f narrows the list to firing alarms, then the next state.
+	lv.Update(key("f"))
+	assert.Equal(t, 2, lv.table.ItemCount())
+	assert.Contains(t, lv.View().Content, "showing ALARM")
+	lv.Update(key("f"))
+	assert.Equal(t, 1, lv.table.ItemCount())
+	lv.Update(key("f"))
+	lv.Update(key("f"))
+	assert.Equal(t, 5, lv.table.ItemCount())
+}
+
+func TestDetailView_OverviewLinksToInstance(t *testing.T) {
+	client := &mockClient{
+		alarms:  []cloudwatch.Alarm{cpuAlarm("ALARM")},
+		history: []cloudwatch.AlarmHistoryItem{{Timestamp: time.Now(), Type: "StateUpdate", Summary: "Alarm updated from OK to ALARM"}},
+	}
+	router := &mockRouter{}
+	dv := NewPlugin(client).DetailView(router, "web-cpu-high").(*DetailView)
+	dv.Update(dv.Init()())
+
+	out := dv.View().Content
+	assert.Contains(t, out, "AWS/EC2 CPUUtilization")
+	assert.Contains(t, out, "InstanceId=i-0abc")
+	assert.Contains(t, out, "> 80 for 2 of 3 periods of 5m0s")
+	assert.Contains(t, out, "enabled, 1 on alarm")
+	assert.Contains(t, dv.KeyHints(), plugin.KeyHint{Key: "enter", Desc: "open EC2 instance i-0abc"})
+
+	dv.Update(key("enter"))
+	assert.Equal(t, []string{"ec2", "i-0abc"}, router.detail)
+
+	// The Metric tab charts the alarm's metric against its threshold.
+	_, cmd := dv.Update(key("2"))
+	require.NotNil(t, cmd)
+	dv.Update(cmd())
+	require.Len(t, client.queries, 1)
+	assert.Equal(t, "CPUUtilization", client.queries[0].Metric)
+	assert.Equal(t, "Average", client.queries[0].Stat)
+	assert.Contains(t, dv.View().Content, "CPUUtilization Average (threshold > 80)")
+
+	dv.Update(key("3"))
+	assert.Contains(t, dv.View().Content, "Alarm updated from OK to ALARM")
+}
+
+func TestAlarmResource(t *testing.T) {
+	tests := []struct {
+		name  string
+		alarm cloudwatch.Alarm
+		want  resourceLink
+		ok    bool
+	}{
+		{
+			name:  "instance",
+			alarm: cpuAlarm("OK"),
+			want:  resourceLink{pluginID: "ec2", id: "i-0abc", label: "EC2 instance i-0abc"},
+			ok:    true,
+		},
+		{
+			name: "load balancer",
+			alarm: cloudwatch.Alarm{
+				ARN:        alarmARN + "web-5xx",
+				Namespace:  "AWS/ApplicationELB",
+				Dimensions: []cloudwatch.Dimension{{Name: "LoadBalancer", Value: "app/web/50dc6c495c0c9188"}},
+			},
+			want: resourceLink{
+				pluginID: "elb",
+				id:       "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188",
+				label:    "load balancer app/web/50dc6c495c0c9188",
+			},
+			ok: true,
+		},
+		{
+			name: "ecs service",
+			alarm: cloudwatch.Alarm{
+				ARN:       alarmARN + "api-memory",
+				Namespace: "AWS/ECS",
+				Dimensions: []cloudwatch.Dimension{
+					{Name: "ClusterName", Value: "prod"},
+					{Name: "ServiceName", Value: "api"},
+				},
+			},
+			want: resourceLink{pluginID: "ecs", id: "prod/api", label: "ECS service prod/api"},
+			ok:   true,
+		},
+		{
+			name: "other",
+			alarm: cloudwatch.Alarm{
+				Namespace:  "AWS/SQS",
+				Dimensions: []cloudwatch.Dimension{{Name: "QueueName", Value: "jobs"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := alarmResource(tt.alarm)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	a := cloudwatch.Alarm{Comparison: "LessThanOrEqualToThreshold", Threshold: 0.5}
+	assert.Equal(t, "<= 0.5", condition(a))
+	a.Comparison = "LessThanLowerOrGreaterThanUpperThreshold"
+	assert.Equal(t, "outside band", condition(a))
+}
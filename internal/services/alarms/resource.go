@@ -0,0 +1,48 @@
+package alarms
+
+import (
+	"strings"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+)
+
+// resourceLink names a resource an alarm watches and where to open it.
+type resourceLink struct {
+	pluginID string
+	id       string // the detail view ID within the plugin
+	label    string
+}
+
+// alarmResource returns the EC2 instance, load balancer or ECS service named
+// by an alarm's dimensions.
+func alarmResource(a cloudwatch.Alarm) (resourceLink, bool) {
+	dims := make(map[string]string, len(a.Dimensions))
+	for _, d := range a.Dimensions {
+		dims[d.Name] = d.Value
+	}
+
+	if id := dims["InstanceId"]; id != "" {
+		return resourceLink{pluginID: "ec2", id: id, label: "EC2 instance " + id}, true
+	}
+	if cluster, service := dims["ClusterName"], dims["ServiceName"]; cluster != "" && service != "" && strings.Contains(a.Namespace, "ECS") {
+		return resourceLink{pluginID: "ecs", id: cluster + "/" + service, label: "ECS service " + cluster + "/" + service}, true
+	}
+	if lb := dims["LoadBalancer"]; lb != "" {
+		if arn, ok := loadBalancerARN(a.ARN, lb); ok {
+			return resourceLink{pluginID: "elb", id: arn, label: "load balancer " + lb}, true
+		}
+	}
+	return resourceLink{}, false
+}
+
+// loadBalancerARN rebuilds a load balancer's ARN from the LoadBalancer
+// dimension, "app/<name>/<id>", and the partition, region and account of
+// the alarm's ARN.
+func loadBalancerARN(alarmARN, lb string) (string, bool) {
+	// arn:<partition>:cloudwatch:<region>:<account>:alarm:<name>
+	parts := strings.SplitN(alarmARN, ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" {
+		return "", false
+	}
+	return "arn:" + parts[1] + ":elasticloadbalancing:" + parts[3] + ":" + parts[4] + ":loadbalancer/" + lb, true
+}
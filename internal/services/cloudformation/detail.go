@@ -0,0 +1,451 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awscfn "tasnim.dev/aws-tui/internal/aws/cloudformation"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the detail view.
+const (
+	overviewTab   = 0
+	eventsTab     = 1
+	resourcesTab  = 2
+	outputsTab    = 3
+	parametersTab = 4
+	templateTab   = 5
+)
+
+// templateChromeHeight is the number of terminal rows around the template
+// body: the breadcrumb, status bar, tab bar and stack status line.
+const templateChromeHeight = 9
+
+var (
+	sectionStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	followingStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+)
+
+// stackLoadedMsg carries a stack with its events, resources and template.
+// Only a failure to read the stack itself is fatal.
+type stackLoadedMsg struct {
+	stack        awscfn.Stack
+	events       []awscfn.Event
+	eventsErr    error
+	resources    []awscfn.Resource
+	resourcesErr error
+	template     string
+	templateErr  error
+	err          error
+}
+
+// DetailView shows a stack's overview, events, resources, outputs,
+// parameters and template. While an operation is in progress it follows
+// the stack's events.
+type DetailView struct {
+	client       CloudFormationClient
+	router       plugin.Router
+	name         string
+	stack        *awscfn.Stack
+	resources    ui.TableView[awscfn.Resource]
+	resourcesErr error
+	tabs         ui.TabController
+	loading      bool
+	err          error
+	width        int
+	height       int
+
+	// Events tab state.
+	eventView   ui.LogView
+	eventsErr   error
+	eventGen    int // current tail chain; older results are dropped
+	tailing     bool
+	lastEventID string // newest event shown
+
+	// Template tab state.
+	templateLines  []string
+	templateErr    error
+	templateScroll int
+}
+
+// NewDetailView creates a DetailView for the stack called name.
+func NewDetailView(client CloudFormationClient, router plugin.Router, name string) *DetailView {
+	tv := ui.NewTableView(resourceColumns(), nil, func(r awscfn.Resource) string {
+		return r.LogicalID
+	})
+	return &DetailView{
+		client:    client,
+		router:    router,
+		name:      name,
+		resources: tv,
+		tabs:      ui.NewTabController([]string{"Overview", "Events", "Resources", "Outputs", "Parameters", "Template"}),
+		loading:   true,
+		eventView: ui.NewLogView(name),
+	}
+}
+
+func resourceColumns() []ui.Column[awscfn.Resource] {
+	return []ui.Column[awscfn.Resource]{
+		{Title: "Logical ID", Width: 32, Field: func(r awscfn.Resource) string { return r.LogicalID }},
+		{Title: "Type", Width: 36, Field: func(r awscfn.Resource) string { return r.Type }},
+		{Title: "Status", Width: 22, Field: func(r awscfn.Resource) string { return r.Status }},
+		{Title: "Physical ID", Width: 40, Field: func(r awscfn.Resource) string { return orDash(r.PhysicalID) }},
+		{Title: "Drift", Width: 12, Field: func(r awscfn.Resource) string { return orDash(r.DriftStatus) }},
+	}
+}
+
+func (dv *DetailView) loadStack() tea.Cmd {
+	client, router, name := dv.client, dv.router, dv.name
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		var msg stackLoadedMsg
+		msg.err = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.stack, err = client.GetStack(ctx, name)
+			return err
+		})
+		if msg.err != nil {
+			return msg
+		}
+		msg.eventsErr = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.events, err = client.ListEvents(ctx, name, "")
+			return err
+		})
+		msg.resourcesErr = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.resources, err = client.ListResources(ctx, name)
+			return err
+		})
+		msg.templateErr = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.template, err = client.GetTemplate(ctx, name)
+			return err
+		})
+		return msg
+	}
+}
+
+func (dv *DetailView) Init() tea.Cmd {
+	return dv.loadStack()
+}
+
+func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case stackLoadedMsg:
+		dv.loading = false
+		if msg.err != nil {
+			dv.err = msg.err
+			return dv, nil
+		}
+		dv.err = nil
+		dv.stack = &msg.stack
+		dv.resources.SetItems(msg.resources)
+		dv.resourcesErr = msg.resourcesErr
+		dv.setEvents(msg.events, msg.eventsErr)
+		dv.setTemplate(msg.template, msg.templateErr)
+		return dv, dv.startTail()
+
+	case stackEventsMsg, eventTickMsg, ui.LogSavedMsg:
+		return dv, dv.updateEvents(msg)
+
+	case tea.WindowSizeMsg:
+		dv.width, dv.height = msg.Width, msg.Height
+		dv.eventView.SetSize(msg.Width, msg.Height-eventsChromeHeight)
+		return dv, nil
+
+	case tea.KeyPressMsg:
+		if dv.CapturingInput() {
+			if dv.tabs.Active() == eventsTab {
+				return dv, dv.handleEventKey(msg)
+			}
+			var cmd tea.Cmd
+			dv.resources, cmd = dv.resources.Update(msg)
+			return dv, cmd
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			dv.router.Pop()
+			return dv, nil
+		case "r":
+			if !dv.loading {
+				dv.loading = true
+				dv.eventGen++
+				dv.tailing = false
+				return dv, dv.loadStack()
+			}
+			return dv, nil
+		case "enter":
+			if dv.tabs.Active() == resourcesTab {
+				if link, ok := dv.selectedLink(); ok {
+					dv.router.NavigateDetail(link.pluginID, link.id)
+				}
+				return dv, nil
+			}
+		}
+
+		prev := dv.tabs.Active()
+		var cmd tea.Cmd
+		dv.tabs, cmd = dv.tabs.Update(msg)
+		if dv.tabs.Active() != prev || dv.stack == nil {
+			return dv, cmd
+		}
+		switch dv.tabs.Active() {
+		case eventsTab:
+			return dv, dv.handleEventKey(msg)
+		case resourcesTab:
+			dv.resources, cmd = dv.resources.Update(msg)
+		case templateTab:
+			dv.scrollTemplate(msg.String())
+		}
+		return dv, cmd
+	}
+
+	return dv, nil
+}
+
+func (dv *DetailView) View() tea.View {
+	if dv.loading && dv.stack == nil {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if dv.err != nil {
+		return tea.NewView("Error: " + dv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(dv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch dv.tabs.Active() {
+	case overviewTab:
+		b.WriteString(dv.renderOverview())
+	case eventsTab:
+		b.WriteString(dv.renderEvents())
+	case resourcesTab:
+		b.WriteString(dv.renderResources())
+	case outputsTab:
+		b.WriteString(dv.renderOutputs())
+	case parametersTab:
+		b.WriteString(dv.renderParameters())
+	case templateTab:
+		b.WriteString(dv.renderTemplate())
+	}
+	return tea.NewView(b.String())
+}
+
+func (dv *DetailView) renderOverview() string {
+	s := dv.stack
+	drift := s.DriftStatus
+	if !s.DriftCheckedAt.IsZero() {
+		drift += " (checked " + ui.FormatTime(s.DriftCheckedAt) + ")"
+	}
+	protection := "disabled"
+	if s.TerminationProtection {
+		protection = "enabled"
+	}
+	rows := []ui.KV{
+		{K: "Name", V: s.Name},
+		{K: "ID", V: s.ID},
+		{K: "Status", V: s.Status},
+	}
+	if s.StatusReason != "" {
+		rows = append(rows, ui.KV{K: "Status Reason", V: s.StatusReason})
+	}
+	rows = append(rows,
+		ui.KV{K: "Description", V: orDash(s.Description)},
+		ui.KV{K: "Created", V: ui.FormatTime(s.CreatedAt)},
+		ui.KV{K: "Last Updated", V: ui.FormatTime(s.UpdatedAt)},
+		ui.KV{K: "Drift", V: drift},
+		ui.KV{K: "Termination Protection", V: protection},
+		ui.KV{K: "Role", V: orDash(s.RoleARN)},
+		ui.KV{K: "Capabilities", V: orDash(strings.Join(s.Capabilities, ", "))},
+	)
+	if s.ParentID != "" {
+		rows = append(rows, ui.KV{K: "Parent Stack", V: s.ParentID})
+	}
+
+	var b strings.Builder
+	b.WriteString(ui.RenderKV(rows, 24, ui.KVValueWidth(dv.width)))
+	if len(s.Tags) > 0 {
+		keys := make([]string, 0, len(s.Tags))
+		for k := range s.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		tags := make([]ui.KV, len(keys))
+		for i, k := range keys {
+			tags[i] = ui.KV{K: k, V: s.Tags[k]}
+		}
+		b.WriteString("\n\n")
+		b.WriteString(sectionStyle.Render("Tags"))
+		b.WriteString("\n")
+		b.WriteString(ui.RenderKV(tags, 24, ui.KVValueWidth(dv.width)))
+	}
+	return b.String()
+}
+
+func (dv *DetailView) renderResources() string {
+	switch {
+	case dv.resourcesErr != nil:
+		return fmt.Sprintf("Error: %v\nPress r to retry.", dv.resourcesErr)
+	case dv.resources.ItemCount() == 0:
+		return "No resources."
+	}
+	return dv.resources.View()
+}
+
+// selectedLink returns where to open the selected resource, if another
+// plugin shows it.
+func (dv *DetailView) selectedLink() (resourceLink, bool) {
+	if dv.resources.FilteredCount() == 0 {
+		return resourceLink{}, false
+	}
+	return resourceLinkFor(dv.resources.SelectedItem())
+}
+
+func (dv *DetailView) renderOutputs() string {
+	outputs := dv.stack.Outputs
+	if len(outputs) == 0 {
+		return "No outputs."
+	}
+	rows := make([]ui.KV, len(outputs))
+	for i, o := range outputs {
+		v := o.Value
+		if o.ExportName != "" {
+			v += "  (export: " + o.ExportName + ")"
+		}
+		if o.Description != "" {
+			v += " — " + o.Description
+		}
+		rows[i] = ui.KV{K: o.Key, V: v}
+	}
+	return ui.RenderKV(rows, 24, ui.KVValueWidth(dv.width))
+}
+
+func (dv *DetailView) renderParameters() string {
+	params := dv.stack.Parameters
+	if len(params) == 0 {
+		return "No parameters."
+	}
+	rows := make([]ui.KV, len(params))
+	for i, p := range params {
+		v := p.Value
+		if p.ResolvedValue != "" {
+			// SSM parameter types show the name and the value it resolved to.
+			v += " → " + p.ResolvedValue
+		}
+		rows[i] = ui.KV{K: p.Key, V: v}
+	}
+	return ui.RenderKV(rows, 24, ui.KVValueWidth(dv.width))
+}
+
+// setTemplate highlights a template body as JSON or YAML.
+func (dv *DetailView) setTemplate(body string, err error) {
+	dv.templateErr = err
+	if err != nil {
+		dv.templateLines = nil
+		return
+	}
+	filename := "template.yaml"
+	if strings.HasPrefix(strings.TrimSpace(body), "{") {
+		filename = "template.json"
+	}
+	dv.templateLines = strings.Split(ui.HighlightCode(filename, strings.TrimRight(body, "\n")), "\n")
+	dv.templateScroll = min(dv.templateScroll, max(len(dv.templateLines)-1, 0))
+}
+
+// templateHeight returns how many template lines fit on screen.
+func (dv *DetailView) templateHeight() int {
+	return max(dv.height-templateChromeHeight, 5)
+}
+
+func (dv *DetailView) scrollTemplate(key string) {
+	page := dv.templateHeight()
+	last := max(len(dv.templateLines)-page, 0)
+	switch key {
+	case "j", "down":
+		dv.templateScroll++
+	case "k", "up":
+		dv.templateScroll--
+	case "ctrl+d", "pgdown":
+		dv.templateScroll += page / 2
+	case "ctrl+u", "pgup":
+		dv.templateScroll -= page / 2
+	case "g", "home":
+		dv.templateScroll = 0
+	case "G", "end":
+		dv.templateScroll = last
+	}
+	dv.templateScroll = max(min(dv.templateScroll, last), 0)
+}
+
+func (dv *DetailView) renderTemplate() string {
+	if dv.templateErr != nil {
+		return fmt.Sprintf("Error: %v\nPress r to retry.", dv.templateErr)
+	}
+	if len(dv.templateLines) == 0 {
+		return "No template."
+	}
+	end := min(dv.templateScroll+dv.templateHeight(), len(dv.templateLines))
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Lines %d-%d of %d\n\n", dv.templateScroll+1, end, len(dv.templateLines)))
+	b.WriteString(strings.Join(dv.templateLines[dv.templateScroll:end], "\n"))
+	return b.String()
+}
+
+func (dv *DetailView) Title() string {
+	return dv.name
+}
+
+// CapturingInput implements plugin.InputCapturer while the event filter or
+// the resource filter is open.
+func (dv *DetailView) CapturingInput() bool {
+	switch dv.tabs.Active() {
+	case eventsTab:
+		return dv.eventView.Capturing()
+	case resourcesTab:
+		return dv.resources.Filtering()
+	}
+	return false
+}
+
+func (dv *DetailView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "1-6", Desc: "jump to tab"},
+	}
+	switch dv.tabs.Active() {
+	case eventsTab:
+		hints = append(hints,
+			plugin.KeyHint{Key: "p", Desc: "pause"},
+			plugin.KeyHint{Key: "/", Desc: "filter"},
+			plugin.KeyHint{Key: "w", Desc: "wrap"},
+			plugin.KeyHint{Key: "S", Desc: "save"},
+		)
+	case resourcesTab:
+		if link, ok := dv.selectedLink(); ok {
+			hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "open " + link.label})
+		}
+		hints = append(hints, plugin.KeyHint{Key: "/", Desc: "filter"})
+	case templateTab:
+		hints = append(hints,
+			plugin.KeyHint{Key: "j/k", Desc: "scroll"},
+			plugin.KeyHint{Key: "g/G", Desc: "top/bottom"},
+		)
+	}
+	return hints
+}
+
+// orDash returns s, or "-" if it is empty.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,196 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awscfn "tasnim.dev/aws-tui/internal/aws/cloudformation"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// eventPollInterval is how often a stack's events are polled while an
+// operation is in progress.
+const eventPollInterval = 5 * time.Second
+
+// eventsChromeHeight is the number of terminal rows around the event lines:
+// the breadcrumb, status bar, tab bar and stack status line.
+const eventsChromeHeight = 9
+
+// stackEventsMsg carries the stack and the events since the last poll. gen
+// identifies the tail chain that asked for them; results from an abandoned
+// chain are dropped.
+type stackEventsMsg struct {
+	gen    int
+	stack  awscfn.Stack
+	events []awscfn.Event // newest first
+	err    error
+}
+
+// eventTickMsg schedules the next poll of chain gen.
+type eventTickMsg struct{ gen int }
+
+// setEvents replaces the Events tab with events, which are newest first.
+func (dv *DetailView) setEvents(events []awscfn.Event, err error) {
+	dv.eventsErr = err
+	dv.lastEventID = ""
+	if len(events) > 0 {
+		dv.lastEventID = events[0].ID
+	}
+	dv.eventView.SetLines(eventLines(events), true)
+}
+
+// eventLines turns events, newest first, into log lines, oldest first.
+func eventLines(events []awscfn.Event) []ui.LogLine {
+	lines := make([]ui.LogLine, len(events))
+	for i, e := range events {
+		msg := fmt.Sprintf("%-28s %-32s %-36s", e.Status, e.LogicalID, e.ResourceType)
+		if e.StatusReason != "" {
+			msg += " " + e.StatusReason
+		}
+		lines[len(events)-1-i] = ui.LogLine{Time: e.Time, Message: strings.TrimRight(msg, " ")}
+	}
+	return lines
+}
+
+// shouldTail reports whether the stack has an operation in progress and
+// its events are being followed.
+func (dv *DetailView) shouldTail() bool {
+	if dv.stack == nil || !inProgress(dv.stack.Status) || dv.eventsErr != nil || dv.router.Offline() {
+		return false
+	}
+	return !dv.eventView.Paused()
+}
+
+// startTail starts a tail chain unless one is already running.
+func (dv *DetailView) startTail() tea.Cmd {
+	if dv.tailing || !dv.shouldTail() {
+		return nil
+	}
+	dv.eventGen++
+	dv.tailing = true
+	return dv.scheduleTail()
+}
+
+// scheduleTail schedules the next poll of the running chain, or ends it if
+// the events are no longer followed.
+func (dv *DetailView) scheduleTail() tea.Cmd {
+	if !dv.shouldTail() {
+		dv.tailing = false
+		return nil
+	}
+	gen := dv.eventGen
+	return tea.Tick(eventPollInterval, func(time.Time) tea.Msg { return eventTickMsg{gen: gen} })
+}
+
+// pollEvents reads the stack's status and the events since the newest one
+// shown.
+func (dv *DetailView) pollEvents() tea.Cmd {
+	client, router, name, gen, after := dv.client, dv.router, dv.name, dv.eventGen, dv.lastEventID
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		msg := stackEventsMsg{gen: gen}
+		msg.err = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			if msg.stack, err = client.GetStack(ctx, name); err != nil {
+				return err
+			}
+			msg.events, err = client.ListEvents(ctx, name, after)
+			return err
+		})
+		return msg
+	}
+}
+
+// updateEvents handles the messages of the Events tab.
+func (dv *DetailView) updateEvents(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case eventTickMsg:
+		if msg.gen != dv.eventGen {
+			return nil
+		}
+		if !dv.shouldTail() {
+			dv.tailing = false
+			return nil
+		}
+		return dv.pollEvents()
+
+	case stackEventsMsg:
+		if msg.gen != dv.eventGen {
+			return nil
+		}
+		if msg.err != nil {
+			dv.tailing = false
+			dv.router.Toast(plugin.ToastError, "Following stack events failed: "+msg.err.Error())
+			return nil
+		}
+		dv.eventView.AppendLines(eventLines(msg.events))
+		if len(msg.events) > 0 {
+			dv.lastEventID = msg.events[0].ID
+		}
+		dv.stack = &msg.stack
+		if inProgress(msg.stack.Status) {
+			return dv.scheduleTail()
+		}
+
+		// The operation finished: reload the resources, outputs and
+		// template it changed.
+		dv.tailing = false
+		level := plugin.ToastInfo
+		if failed(msg.stack.Status) || strings.Contains(msg.stack.Status, "ROLLBACK") {
+			level = plugin.ToastError
+		}
+		dv.router.Toast(level, fmt.Sprintf("Stack %s is %s", dv.name, msg.stack.Status))
+		dv.eventGen++
+		return dv.loadStack()
+
+	case ui.LogSavedMsg:
+		if msg.Err != nil {
+			dv.router.Toast(plugin.ToastError, "Saving events failed: "+msg.Err.Error())
+		} else {
+			dv.router.Toast(plugin.ToastInfo, "Events saved to "+msg.Path)
+		}
+	}
+	return nil
+}
+
+// handleEventKey passes a key on the Events tab to the event view, resuming
+// the tail chain when it is unpaused. Events cannot be read from a point in
+// time, so the view's jump key is not offered.
+func (dv *DetailView) handleEventKey(msg tea.KeyPressMsg) tea.Cmd {
+	if msg.String() == "t" && !dv.eventView.Capturing() {
+		return nil
+	}
+	wasPaused := dv.eventView.Paused()
+	var cmd tea.Cmd
+	dv.eventView, cmd = dv.eventView.Update(msg)
+	if wasPaused && !dv.eventView.Paused() {
+		return tea.Batch(cmd, dv.startTail())
+	}
+	return cmd
+}
+
+func (dv *DetailView) renderEvents() string {
+	var b strings.Builder
+	b.WriteString("Status: " + dv.stack.Status)
+	switch {
+	case dv.tailing:
+		b.WriteString("  " + followingStyle.Render("● following events"))
+	case inProgress(dv.stack.Status) && dv.eventView.Paused():
+		b.WriteString("  (paused)")
+	}
+	b.WriteString("\n\n")
+
+	switch {
+	case dv.eventsErr != nil:
+		b.WriteString(fmt.Sprintf("Error: %v\nPress r to retry.", dv.eventsErr))
+	case dv.eventView.Lines() == 0:
+		b.WriteString("No events.")
+	default:
+		b.WriteString(dv.eventView.View())
+	}
+	return b.String()
+}
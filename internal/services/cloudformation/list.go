@@ -0,0 +1,164 @@
+package cloudformation
+
+import (
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awscfn "tasnim.dev/aws-tui/internal/aws/cloudformation"
+	"tasnim.dev/aws-tui/internal/cache"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// cacheKey is the cache service key for CloudFormation stacks.
+const cacheKey = "cloudformation"
+
+// stacksMsg carries the result of fetching stacks.
+type stacksMsg struct {
+	stacks []awscfn.Stack
+	err    error
+}
+
+// ListView displays CloudFormation stacks in a table.
+type ListView struct {
+	plugin.Revalidation
+
+	client  CloudFormationClient
+	router  plugin.Router
+	table   ui.TableView[awscfn.Stack]
+	loading bool
+	err     error
+	cache   *cache.Scope
+}
+
+// NewListView creates a new CloudFormation ListView.
+func NewListView(client CloudFormationClient, router plugin.Router) *ListView {
+	tv := ui.NewTableView(stackColumns(), nil, func(s awscfn.Stack) string {
+		return s.Name
+	})
+	return &ListView{
+		client:  client,
+		router:  router,
+		table:   tv,
+		loading: true,
+	}
+}
+
+func stackColumns() []ui.Column[awscfn.Stack] {
+	return []ui.Column[awscfn.Stack]{
+		{Title: "Name", Width: 36, Field: func(s awscfn.Stack) string { return s.Name }},
+		{Title: "Status", Width: 28, Field: func(s awscfn.Stack) string { return s.Status }},
+		{Title: "Drift", Width: 12, Field: func(s awscfn.Stack) string { return s.DriftStatus }},
+		{Title: "Last Updated", Width: 17, Field: func(s awscfn.Stack) string { return ui.FormatTime(lastUpdated(s)) }},
+		{Title: "Description", Width: 40, Field: func(s awscfn.Stack) string { return orDash(s.Description) }},
+	}
+}
+
+// lastUpdated returns when a stack was last updated, or created if it has
+// never been updated.
+func lastUpdated(s awscfn.Stack) time.Time {
+	if s.UpdatedAt.IsZero() {
+		return s.CreatedAt
+	}
+	return s.UpdatedAt
+}
+
+func (lv *ListView) fetchStacks() tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var stacks []awscfn.Stack
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			stacks, err = client.ListStacks(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, stacks, func(s awscfn.Stack) (string, string) {
+				return s.Name, s.Name
+			})
+		}
+		return stacksMsg{stacks: stacks, err: err}
+	}
+}
+
+func (lv *ListView) Init() tea.Cmd {
+	fetch := lv.fetchStacks()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awscfn.Stack](lv.cache, cacheKey, fetch)
+	}
+	return fetch
+}
+
+func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case plugin.CachedItems[awscfn.Stack]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchStacks()
+		}
+		return lv, nil
+
+	case stacksMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.err = nil
+		lv.table.SetItems(msg.stacks)
+		return lv, nil
+
+	case tea.KeyPressMsg:
+		if lv.loading {
+			return lv, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if id := lv.table.SelectedID(); id != "" {
+				view := NewDetailView(lv.client, lv.router, id)
+				lv.router.Push(view)
+				return lv, view.Init()
+			}
+			return lv, nil
+		case "esc", "backspace":
+			lv.router.Pop()
+			return lv, nil
+		case "r":
+			lv.loading = true
+			return lv, lv.fetchStacks()
+		}
+	}
+
+	var cmd tea.Cmd
+	lv.table, cmd = lv.table.Update(msg)
+	return lv, cmd
+}
+
+func (lv *ListView) View() tea.View {
+	if lv.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if lv.err != nil {
+		return tea.NewView("Error: " + lv.err.Error())
+	}
+	return tea.NewView(lv.table.View())
+}
+
+func (lv *ListView) Title() string { return "CloudFormation Stacks" }
+
+func (lv *ListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view stack"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+	}
+}
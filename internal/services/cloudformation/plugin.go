@@ -0,0 +1,115 @@
+package cloudformation
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awscfn "tasnim.dev/aws-tui/internal/aws/cloudformation"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// CloudFormationClient defines the subset of cloudformation.Client methods
+// used by the plugin.
+type CloudFormationClient interface {
+	ListStacks(ctx context.Context) ([]awscfn.Stack, error)
+	GetStack(ctx context.Context, name string) (awscfn.Stack, error)
+	ListResources(ctx context.Context, stack string) ([]awscfn.Resource, error)
+	ListEvents(ctx context.Context, stack, after string) ([]awscfn.Event, error)
+	GetTemplate(ctx context.Context, stack string) (string, error)
+}
+
+// Plugin implements plugin.ServicePlugin for AWS CloudFormation.
+type Plugin struct {
+	plugin.CacheHolder
+
+	client CloudFormationClient
+	stacks []awscfn.Stack // from the last summary, for PollConfig
+}
+
+// NewPlugin creates a new CloudFormation service plugin.
+func NewPlugin(client CloudFormationClient) *Plugin {
+	return &Plugin{client: client}
+}
+
+func (p *Plugin) ID() string   { return "cloudformation" }
+func (p *Plugin) Name() string { return "CloudFormation" }
+func (p *Plugin) Icon() string { return "\U000F0328" } // nf-md-layers
+
+func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
+	stacks, err := p.client.ListStacks(ctx)
+	if err != nil {
+		return plugin.ServiceSummary{}, err
+	}
+	p.stacks = stacks
+	return mapSummary(stacks), nil
+}
+
+// mapSummary counts stacks by status. A failed operation makes the service
+// critical; an operation in progress, a rolled back update or drift makes
+// it a warning.
+func mapSummary(stacks []awscfn.Stack) plugin.ServiceSummary {
+	status := make(map[string]int)
+	health := plugin.HealthHealthy
+	for _, s := range stacks {
+		status[s.Status]++
+		switch {
+		case failed(s.Status):
+			health = plugin.HealthCritical
+		case health == plugin.HealthHealthy && (inProgress(s.Status) || s.Status == "UPDATE_ROLLBACK_COMPLETE" || s.DriftStatus == "DRIFTED"):
+			health = plugin.HealthWarning
+		}
+	}
+	return plugin.ServiceSummary{
+		Total:  len(stacks),
+		Status: status,
+		Health: health,
+		Label:  "stacks",
+	}
+}
+
+// inProgress reports whether a stack status is a create, update, delete or
+// rollback that has not finished.
+func inProgress(status string) bool {
+	return strings.HasSuffix(status, "_IN_PROGRESS")
+}
+
+// failed reports whether a stack status is a failed operation, including a
+// creation that was rolled back.
+func failed(status string) bool {
+	return strings.HasSuffix(status, "_FAILED") || status == "ROLLBACK_COMPLETE"
+}
+
+func (p *Plugin) ListView(router plugin.Router) plugin.View {
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
+}
+
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	return NewDetailView(p.client, router, id)
+}
+
+func (p *Plugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{
+			Title:    "CloudFormation Stacks",
+			Keywords: []string{"cloudformation", "cfn", "stacks", "templates", "drift", "iac"},
+		},
+	}
+}
+
+func (p *Plugin) PollConfig() plugin.PollConfig {
+	return plugin.PollConfig{
+		IdleInterval:   2 * time.Minute,
+		ActiveInterval: 10 * time.Second,
+		IsActive: func() bool {
+			for _, s := range p.stacks {
+				if inProgress(s.Status) {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
@@ -0,0 +1,309 @@
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awscfn "tasnim.dev/aws-tui/internal/aws/cloudformation"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+type mockClient struct {
+	stacks    []awscfn.Stack
+	resources []awscfn.Resource
+	events    []awscfn.Event // newest first
+	template  string
+	after     []string // after argument of each ListEvents call
+	err       error
+}
+
+func (m *mockClient) ListStacks(_ context.Context) ([]awscfn.Stack, error) {
+	return m.stacks, m.err
+}
+
+func (m *mockClient) GetStack(_ context.Context, name string) (awscfn.Stack, error) {
+	for _, s := range m.stacks {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return awscfn.Stack{}, errors.New("stack " + name + " not found")
+}
+
+func (m *mockClient) ListResources(_ context.Context, _ string) ([]awscfn.Resource, error) {
+	return m.resources, nil
+}
+
+func (m *mockClient) ListEvents(_ context.Context, _, after string) ([]awscfn.Event, error) {
+	m.after = append(m.after, after)
+	for i, e := range m.events {
+		if e.ID == after {
+			return m.events[:i], nil
+		}
+	}
+	return m.events, nil
+}
+
+func (m *mockClient) GetTemplate(_ context.Context, _ string) (string, error) {
+	return m.template, nil
+}
+
+type mockRouter struct {
+	pushed []plugin.View
+	toasts []string
+	detail []string
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(pluginID, id string)    { m.detail = []string{pluginID, id} }
+func (m *mockRouter) Toast(_ plugin.ToastLevel, msg string) { m.toasts = append(m.toasts, msg) }
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	switch s {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	case "esc":
+		return tea.KeyPressMsg{Code: tea.KeyEscape}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+var created = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func webStack(status string) awscfn.Stack {
+	return awscfn.Stack{
+		Name:        "web",
+		ID:          "arn:aws:cloudformation:us-east-1:123456789012:stack/web/1a2b",
+		Status:      status,
+		Description: "Web tier",
+		CreatedAt:   created,
+		DriftStatus: "IN_SYNC",
+		Parameters: []awscfn.Parameter{
+			{Key: "AmiId", Value: "/aws/service/ami-amazon-linux-latest", ResolvedValue: "ami-0abc"},
+			{Key: "Env", Value: "prod"},
+		},
+		Outputs: []awscfn.Output{{Key: "Url", Value: "https://web.example.com", ExportName: "web-url"}},
+		Tags:    map[string]string{"team": "platform"},
+	}
+}
+
+func event(id, logicalID, status string) awscfn.Event {
+	return awscfn.Event{ID: id, Time: created, LogicalID: logicalID, ResourceType: "AWS::EC2::Instance", Status: status}
+}
+
+func TestPluginMetadata(t *testing.T) {
+	p := NewPlugin(nil)
+	assert.Equal(t, "cloudformation", p.ID())
+	assert.Equal(t, "CloudFormation", p.Name())
+	assert.NotEmpty(t, p.Icon())
+	require.Len(t, p.Commands(), 1)
+	assert.Contains(t, p.Commands()[0].Keywords, "cfn")
+}
+
+func TestSummary(t *testing.T) {
+	client := &mockClient{stacks: []awscfn.Stack{webStack("CREATE_COMPLETE"), {Name: "db", Status: "UPDATE_COMPLETE"}}}
+	p := NewPlugin(client)
+	s, err := p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Total)
+	assert.Equal(t, plugin.HealthHealthy, s.Health)
+	assert.Equal(t, "stacks", s.Label)
+	assert.False(t, p.PollConfig().IsActive())
+
+	client.stacks = append(client.stacks, awscfn.Stack{Name: "api", Status: "UPDATE_IN_PROGRESS"})
+	s, err = p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, plugin.HealthWarning, s.Health)
+	assert.True(t, p.PollConfig().IsActive())
+
+	client.stacks = append(client.stacks, awscfn.Stack{Name: "queue", Status: "ROLLBACK_COMPLETE"})
+	s, err = p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, plugin.HealthCritical, s.Health)
+	assert.Equal(t, 1, s.Status["ROLLBACK_COMPLETE"])
+
+	_, err = NewPlugin(&mockClient{err: errors.New("AccessDenied")}).Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestListView(t *testing.T) {
+	updated := webStack("UPDATE_COMPLETE")
+	updated.UpdatedAt = created.Add(48 * time.Hour)
+	client := &mockClient{stacks: []awscfn.Stack{updated, {Name: "db", Status: "CREATE_COMPLETE", DriftStatus: "DRIFTED", CreatedAt: created}}}
+	router := &mockRouter{}
+	lv := NewPlugin(client).ListView(router).(*ListView)
+	lv.Update(lv.Init()())
+
+	view := lv.View().Content
+	assert.Contains(t, view, "UPDATE_COMPLETE")
+	assert.Contains(t, view, "DRIFTED")
+	assert.Contains(t, view, ui.FormatTime(updated.UpdatedAt))
+	assert.Contains(t, view, ui.FormatTime(created))
+	assert.Contains(t, view, "Web tier")
+
+	lv.Update(key("enter"))
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "db", router.pushed[0].Title())
+}
+
+func TestDetailView(t *testing.T) {
+	client := &mockClient{
+		stacks:   []awscfn.Stack{webStack("CREATE_COMPLETE")},
+		events:   []awscfn.Event{event("e2", "web", "CREATE_COMPLETE"), event("e1", "Instance", "CREATE_IN_PROGRESS")},
+		template: "Resources:\n  Instance:\n    Type: AWS::EC2::Instance\n",
+	}
+	dv := NewPlugin(client).DetailView(&mockRouter{}, "web").(*DetailView)
+	dv.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	_, cmd := dv.Update(dv.Init()())
+	assert.Nil(t, cmd, "a finished stack is not tailed")
+
+	view := dv.View().Content
+	assert.Contains(t, view, "CREATE_COMPLETE")
+	assert.Contains(t, view, "IN_SYNC")
+	assert.Contains(t, view, "platform")
+
+	dv.Update(key("4"))
+	assert.Contains(t, dv.View().Content, "https://web.example.com  (export: web-url)")
+
+	dv.Update(key("5"))
+	view = dv.View().Content
+	assert.Contains(t, view, "/aws/service/ami-amazon-linux-latest → ami-0abc")
+	assert.Contains(t, view, "prod")
+
+	dv.Update(key("6"))
+	view = dv.View().Content
+	assert.Contains(t, view, "Lines 1-3 of 3")
+	assert.Contains(t, view, "Instance")
+}
+
+func TestDetailViewTailsEvents(t *testing.T) {
+	client := &mockClient{
+		stacks: []awscfn.Stack{webStack("UPDATE_IN_PROGRESS")},
+		events: []awscfn.Event{event("e1", "web", "UPDATE_IN_PROGRESS")},
+	}
+	router := &mockRouter{}
+	dv := NewPlugin(client).DetailView(router, "web").(*DetailView)
+	_, cmd := dv.Update(dv.Init()())
+	require.NotNil(t, cmd, "an operation in progress is tailed")
+	assert.True(t, dv.tailing)
+	dv.Update(key("2"))
+	assert.Contains(t, dv.View().Content, "following events")
+	assert.Equal(t, 1, dv.eventView.Lines())
+
+	// The next poll reads the events after the newest one shown.
+	client.events = append([]awscfn.Event{event("e2", "Instance", "UPDATE_IN_PROGRESS")}, client.events...)
+	_, cmd = dv.Update(eventTickMsg{gen: dv.eventGen})
+	require.NotNil(t, cmd)
+	_, cmd = dv.Update(cmd())
+	assert.Equal(t, []string{"", "e1"}, client.after)
+	assert.Equal(t, 2, dv.eventView.Lines())
+	assert.NotNil(t, cmd, "still in progress")
+
+	// Once the operation finishes the stack is reloaded.
+	client.stacks = []awscfn.Stack{webStack("UPDATE_COMPLETE")}
+	client.events = append([]awscfn.Event{event("e3", "web", "UPDATE_COMPLETE")}, client.events...)
+	_, cmd = dv.Update(eventTickMsg{gen: dv.eventGen})
+	_, cmd = dv.Update(cmd())
+	assert.False(t, dv.tailing)
+	assert.Equal(t, []string{"Stack web is UPDATE_COMPLETE"}, router.toasts)
+	require.NotNil(t, cmd)
+	dv.Update(cmd())
+	assert.Equal(t, 3, dv.eventView.Lines())
+	assert.NotContains(t, dv.View().Content, "following events")
+
+	// Ticks of an abandoned chain are dropped.
+	_, cmd = dv.Update(eventTickMsg{gen: dv.eventGen - 1})
+	assert.Nil(t, cmd)
+}
+
+func TestDetailViewOpensResources(t *testing.T) {
+	client := &mockClient{
+		stacks: []awscfn.Stack{webStack("CREATE_COMPLETE")},
+		resources: []awscfn.Resource{
+			{LogicalID: "Instance", Type: "AWS::EC2::Instance", PhysicalID: "i-0abc", Status: "CREATE_COMPLETE"},
+			{LogicalID: "Topic", Type: "AWS::SNS::Topic", PhysicalID: "arn:aws:sns:us-east-1:123456789012:alerts", Status: "CREATE_COMPLETE"},
+		},
+	}
+	router := &mockRouter{}
+	dv := NewPlugin(client).DetailView(router, "web").(*DetailView)
+	dv.Update(dv.Init()())
+	dv.Update(key("3"))
+	assert.Contains(t, dv.View().Content, "AWS::SNS::Topic")
+	assert.Contains(t, dv.KeyHints(), plugin.KeyHint{Key: "enter", Desc: "open EC2 instance i-0abc"})
+
+	dv.Update(key("enter"))
+	assert.Equal(t, []string{"ec2", "i-0abc"}, router.detail)
+
+	router.detail = nil
+	dv.Update(key("j"))
+	dv.Update(key("enter"))
+	assert.Nil(t, router.detail, "topics have no plugin")
+}
+
+func TestResourceLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource awscfn.Resource
+		want     resourceLink
+		ok       bool
+	}{
+		{
+			name:     "ecs service",
+			resource: awscfn.Resource{Type: "AWS::ECS::Service", PhysicalID: "arn:aws:ecs:us-east-1:123456789012:service/prod/api"},
+			want:     resourceLink{pluginID: "ecs", id: "prod/api", label: "ECS service prod/api"},
+			ok:       true,
+		},
+		{
+			name:     "bucket",
+			resource: awscfn.Resource{Type: "AWS::S3::Bucket", PhysicalID: "web-assets"},
+			want:     resourceLink{pluginID: "s3", id: "web-assets", label: "bucket web-assets"},
+			ok:       true,
+		},
+		{
+			name:     "role",
+			resource: awscfn.Resource{Type: "AWS::IAM::Role", PhysicalID: "web-InstanceRole-1X2Y"},
+			want:     resourceLink{pluginID: "iam", id: "role:web-InstanceRole-1X2Y", label: "role web-InstanceRole-1X2Y"},
+			ok:       true,
+		},
+		{
+			name:     "nested stack",
+			resource: awscfn.Resource{Type: "AWS::CloudFormation::Stack", PhysicalID: "arn:aws:cloudformation:us-east-1:123456789012:stack/web-Network-9Z/5e6f"},
+			want:     resourceLink{pluginID: "cloudformation", id: "web-Network-9Z", label: "stack web-Network-9Z"},
+			ok:       true,
+		},
+		{
+			name:     "not created",
+			resource: awscfn.Resource{Type: "AWS::EC2::Instance"},
+		},
+		{
+			name:     "other",
+			resource: awscfn.Resource{Type: "AWS::SNS::Topic", PhysicalID: "arn:aws:sns:us-east-1:123456789012:alerts"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := resourceLinkFor(tt.resource)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDetailViewError(t *testing.T) {
+	dv := NewPlugin(&mockClient{}).DetailView(&mockRouter{}, "gone").(*DetailView)
+	dv.Update(dv.Init()())
+	assert.Contains(t, dv.View().Content, "stack gone not found")
+}
@@ -0,0 +1,59 @@
+package cloudformation
+
+import (
+	"strings"
+
+	awscfn "tasnim.dev/aws-tui/internal/aws/cloudformation"
+)
+
+// resourceLink names a stack resource and where to open it.
+type resourceLink struct {
+	pluginID string
+	id       string // the detail view ID within the plugin
+	label    string
+}
+
+// resourceLinkFor returns where to open a stack resource, if its type is
+// one another plugin shows.
+func resourceLinkFor(r awscfn.Resource) (resourceLink, bool) {
+	id := r.PhysicalID
+	if id == "" {
+		return resourceLink{}, false
+	}
+	switch r.Type {
+	case "AWS::EC2::Instance":
+		return resourceLink{pluginID: "ec2", id: id, label: "EC2 instance " + id}, true
+	case "AWS::EC2::VPC":
+		return resourceLink{pluginID: "vpc", id: id, label: "VPC " + id}, true
+	case "AWS::ECS::Service":
+		// arn:aws:ecs:<region>:<account>:service/<cluster>/<service>; older
+		// service ARNs leave out the cluster.
+		_, rest, _ := strings.Cut(id, ":service/")
+		if cluster, service, ok := strings.Cut(rest, "/"); ok {
+			return resourceLink{pluginID: "ecs", id: cluster + "/" + service, label: "ECS service " + cluster + "/" + service}, true
+		}
+	case "AWS::S3::Bucket":
+		return resourceLink{pluginID: "s3", id: id, label: "bucket " + id}, true
+	case "AWS::IAM::Role":
+		return resourceLink{pluginID: "iam", id: "role:" + id, label: "role " + id}, true
+	case "AWS::Lambda::Function":
+		return resourceLink{pluginID: "lambda", id: id, label: "function " + id}, true
+	case "AWS::DynamoDB::Table":
+		return resourceLink{pluginID: "dynamodb", id: id, label: "table " + id}, true
+	case "AWS::RDS::DBInstance":
+		return resourceLink{pluginID: "rds", id: "instance:" + id, label: "DB instance " + id}, true
+	case "AWS::RDS::DBCluster":
+		return resourceLink{pluginID: "rds", id: "cluster:" + id, label: "DB cluster " + id}, true
+	case "AWS::ElasticLoadBalancingV2::LoadBalancer":
+		// arn:aws:elasticloadbalancing:<region>:<account>:loadbalancer/app/<name>/<id>
+		_, name, _ := strings.Cut(id, ":loadbalancer/")
+		return resourceLink{pluginID: "elb", id: id, label: "load balancer " + orDash(name)}, true
+	case "AWS::CloudFormation::Stack":
+		// arn:aws:cloudformation:<region>:<account>:stack/<name>/<id>
+		_, rest, _ := strings.Cut(id, ":stack/")
+		if name, _, ok := strings.Cut(rest, "/"); ok {
+			return resourceLink{pluginID: "cloudformation", id: name, label: "stack " + name}, true
+		}
+	}
+	return resourceLink{}, false
+}
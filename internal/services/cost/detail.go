@@ -105,16 +105,19 @@ func changeCols() []ui.Column[awscost.ServiceCostChange] {
 }
 
 func (dv *DetailView) loadCostData() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	month := dv.month
+	ctx := router.Context(dv)
 	return func() tea.Msg {
 		var data *awscost.CostData
-		var err error
-		if month.IsZero() {
-			data, err = client.FetchCostData(context.TODO())
-		} else {
-			data, err = client.FetchCostDataForMonth(context.TODO(), month)
-		}
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			if month.IsZero() {
+				data, err = client.FetchCostData(ctx)
+			} else {
+				data, err = client.FetchCostDataForMonth(ctx, month)
+			}
+			return err
+		})
 		return detailCostMsg{data: data, err: err}
 	}
 }
@@ -10,10 +10,15 @@ import (
 	"charm.land/lipgloss/v2"
 
 	awscost "tasnim.dev/aws-tui/internal/aws/cost"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
+// cacheKey is the cache service key for current-month cost data. Only the
+// current month is cached; other months are always fetched live.
+const cacheKey = "cost"
+
 // costDataMsg carries the result of fetching cost data.
 type costDataMsg struct {
 	data *awscost.CostData
@@ -31,6 +36,8 @@ var (
 
 // ListView displays a cost overview with current month total, forecast, and top services.
 type ListView struct {
+	plugin.Revalidation
+
 	client    CostClient
 	router    plugin.Router
 	table     ui.TableView[awscost.ServiceCost]
@@ -39,6 +46,7 @@ type ListView struct {
 	err       error
 	amortized bool
 	month     time.Time
+	cache     *cache.Scope
 }
 
 // NewListView creates a new Cost Explorer ListView.
@@ -67,15 +75,23 @@ func serviceColumns() []ui.Column[awscost.ServiceCost] {
 }
 
 func (lv *ListView) fetchCostData() tea.Cmd {
-	client := lv.client
+	client, scope, router := lv.client, lv.cache, lv.router
 	month := lv.month
+	ctx := router.Context(lv)
 	return func() tea.Msg {
 		var data *awscost.CostData
-		var err error
-		if month.IsZero() {
-			data, err = client.FetchCostData(context.TODO())
-		} else {
-			data, err = client.FetchCostDataForMonth(context.TODO(), month)
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			if month.IsZero() {
+				data, err = client.FetchCostData(ctx)
+			} else {
+				data, err = client.FetchCostDataForMonth(ctx, month)
+			}
+			return err
+		})
+		if month.IsZero() && err == nil && data != nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, []awscost.CostData{*data}, func(awscost.CostData) (string, string) {
+				return "current", "Cost Explorer current month"
+			})
 		}
 		return costDataMsg{data: data, err: err}
 	}
@@ -101,17 +117,34 @@ func (lv *ListView) updateTable() {
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchCostData()
+	fetch := lv.fetchCostData()
+	if lv.month.IsZero() && lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awscost.CostData](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awscost.CostData]:
+		lv.loading = false
+		lv.data = &msg.Items[0]
+		lv.updateTable()
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchCostData()
+		}
+		return lv, nil
+
 	case costDataMsg:
 		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
 			lv.err = msg.err
 			return lv, nil
 		}
+		lv.err = nil
 		lv.data = msg.data
 		lv.updateTable()
 		return lv, nil
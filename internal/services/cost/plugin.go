@@ -17,6 +17,8 @@ type CostClient interface {
 
 // Plugin implements plugin.ServicePlugin for AWS Cost Explorer.
 type Plugin struct {
+	plugin.CacheHolder
+
 	client CostClient
 }
 
@@ -63,7 +65,9 @@ func mapSummary(data *awscost.CostData) plugin.ServiceSummary {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router)
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
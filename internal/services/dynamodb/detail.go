@@ -0,0 +1,349 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awsdynamodb "tasnim.dev/aws-tui/internal/aws/dynamodb"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the detail view.
+const (
+	overviewTab = 0
+	indexesTab  = 1
+	itemsTab    = 2
+)
+
+var sectionStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+
+// tableLoadedMsg carries a table's description.
+type tableLoadedMsg struct {
+	table awsdynamodb.Table
+	err   error
+}
+
+// DetailView shows a table's settings and indexes, and browses its items
+// with a scan, a filtered scan or a query.
+type DetailView struct {
+	client  DynamoDBClient
+	router  plugin.Router
+	name    string
+	table   *awsdynamodb.Table
+	tabs    ui.TabController
+	loading bool
+	err     error
+	width   int
+	height  int
+
+	// Items tab state.
+	items        ui.TableView[awsdynamodb.Item]
+	itemsNext    *string
+	itemsGen     int // current listing; pages of older ones are dropped
+	itemsStarted bool
+	itemsLoading bool
+	itemsErr     error
+	query        *awsdynamodb.Query // nil while scanning
+	filter       string             // filter expression of the scan
+
+	// Item preview state.
+	previewing    bool
+	previewLines  []string
+	previewScroll int
+
+	// Query builder and filter prompts.
+	prompt    *ui.Input
+	promptFor string
+	picker    *ui.Picker
+	pickerFor string
+	draft     awsdynamodb.Query
+}
+
+// NewDetailView creates a DetailView for the table called name.
+func NewDetailView(client DynamoDBClient, router plugin.Router, name string) *DetailView {
+	return &DetailView{
+		client:  client,
+		router:  router,
+		name:    name,
+		tabs:    ui.NewTabController([]string{"Overview", "Indexes", "Items"}),
+		loading: true,
+	}
+}
+
+func (dv *DetailView) loadTable() tea.Cmd {
+	client, router, name := dv.client, dv.router, dv.name
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		var t awsdynamodb.Table
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			t, err = client.GetTable(ctx, name)
+			return err
+		})
+		return tableLoadedMsg{table: t, err: err}
+	}
+}
+
+func (dv *DetailView) Init() tea.Cmd {
+	return dv.loadTable()
+}
+
+func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tableLoadedMsg:
+		dv.loading = false
+		if msg.err != nil {
+			dv.err = msg.err
+			return dv, nil
+		}
+		dv.err = nil
+		first := dv.table == nil
+		dv.table = &msg.table
+		if first {
+			dv.items = newItemTable(msg.table)
+			dv.items.OnLoadMore(func() tea.Cmd { return dv.fetchItems(dv.itemsNext) })
+		}
+		return dv, dv.startItems()
+
+	case itemsMsg:
+		dv.itemsLoaded(msg)
+		return dv, nil
+
+	case ui.InputResult:
+		if dv.prompt != nil {
+			return dv, dv.answerPrompt(msg)
+		}
+		return dv, nil
+
+	case ui.PickerResult:
+		if dv.picker != nil {
+			return dv, dv.answerPicker(msg)
+		}
+		return dv, nil
+
+	case exportedMsg:
+		if msg.err != nil {
+			dv.router.Toast(plugin.ToastError, "Export failed: "+msg.err.Error())
+		} else {
+			dv.router.Toast(plugin.ToastInfo, fmt.Sprintf("Exported %d items to %s", msg.count, msg.path))
+		}
+		return dv, nil
+
+	case tea.WindowSizeMsg:
+		dv.width, dv.height = msg.Width, msg.Height
+		return dv, nil
+
+	case tea.KeyPressMsg:
+		if dv.picker != nil {
+			p, cmd := dv.picker.Update(msg)
+			dv.picker = &p
+			return dv, cmd
+		}
+		if dv.prompt != nil {
+			in, cmd := dv.prompt.Update(msg)
+			dv.prompt = &in
+			return dv, cmd
+		}
+		if dv.previewing {
+			dv.handlePreviewKey(msg.String())
+			return dv, nil
+		}
+		if dv.tabs.Active() == itemsTab && dv.items.Filtering() {
+			var cmd tea.Cmd
+			dv.items, cmd = dv.items.Update(msg)
+			return dv, cmd
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			dv.router.Pop()
+			return dv, nil
+		case "r":
+			if dv.tabs.Active() == itemsTab && dv.table != nil {
+				return dv, dv.restartItems()
+			}
+			if !dv.loading {
+				dv.loading = true
+				return dv, dv.loadTable()
+			}
+			return dv, nil
+		}
+		if dv.tabs.Active() == itemsTab && dv.table != nil {
+			if cmd, ok := dv.handleItemKey(msg); ok {
+				return dv, cmd
+			}
+		}
+
+		prev := dv.tabs.Active()
+		var cmd tea.Cmd
+		dv.tabs, cmd = dv.tabs.Update(msg)
+		if dv.tabs.Active() != prev {
+			return dv, tea.Batch(cmd, dv.startItems())
+		}
+		if dv.tabs.Active() == itemsTab && !dv.itemsLoading {
+			dv.items, cmd = dv.items.Update(msg)
+		}
+		return dv, cmd
+	}
+
+	return dv, nil
+}
+
+func (dv *DetailView) View() tea.View {
+	if dv.loading && dv.table == nil {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if dv.err != nil {
+		return tea.NewView("Error: " + dv.err.Error())
+	}
+	if dv.previewing {
+		return tea.NewView(dv.renderPreview())
+	}
+
+	var b strings.Builder
+	b.WriteString(dv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch dv.tabs.Active() {
+	case overviewTab:
+		b.WriteString(dv.renderOverview())
+	case indexesTab:
+		b.WriteString(dv.renderIndexes())
+	case itemsTab:
+		b.WriteString(dv.renderItems())
+	}
+
+	if dv.picker != nil {
+		b.WriteString("\n\n")
+		b.WriteString(dv.picker.View())
+	}
+	if dv.prompt != nil {
+		b.WriteString("\n\n")
+		b.WriteString(dv.prompt.View())
+	}
+	return tea.NewView(b.String())
+}
+
+func (dv *DetailView) renderOverview() string {
+	t := dv.table
+	ttl := t.TTLStatus
+	if t.TTLAttribute != "" {
+		ttl += " on " + t.TTLAttribute
+	}
+	stream := "disabled"
+	if t.StreamEnabled {
+		stream = t.StreamViewType
+	}
+	protection := "disabled"
+	if t.DeletionProtection {
+		protection = "enabled"
+	}
+	rows := []ui.KV{
+		{K: "Name", V: t.Name},
+		{K: "ARN", V: t.ARN},
+		{K: "Status", V: t.Status},
+		{K: "Created", V: ui.FormatTime(t.CreatedAt)},
+		{K: "Items", V: fmt.Sprintf("%d (approximate)", t.ItemCount)},
+		{K: "Size", V: ui.FormatSize(t.SizeBytes)},
+		{K: "Billing", V: billing(*t)},
+		{K: "Table Class", V: t.TableClass},
+		{K: "Partition Key", V: formatKey(t.PartitionKey)},
+		{K: "Sort Key", V: formatKey(t.SortKey)},
+		{K: "TTL", V: ttl},
+		{K: "Stream", V: stream},
+	}
+	if t.StreamARN != "" {
+		rows = append(rows, ui.KV{K: "Stream ARN", V: t.StreamARN})
+	}
+	rows = append(rows, ui.KV{K: "Deletion Protection", V: protection})
+	return ui.RenderKV(rows, 20, ui.KVValueWidth(dv.width))
+}
+
+func (dv *DetailView) renderIndexes() string {
+	t := dv.table
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Global Secondary Indexes"))
+	b.WriteString("\n")
+	if len(t.GSIs) == 0 {
+		b.WriteString("No global secondary indexes.\n")
+	}
+	for _, ix := range t.GSIs {
+		b.WriteString(formatIndex(ix))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(sectionStyle.Render("Local Secondary Indexes"))
+	b.WriteString("\n")
+	if len(t.LSIs) == 0 {
+		b.WriteString("No local secondary indexes.\n")
+	}
+	for _, ix := range t.LSIs {
+		b.WriteString(formatIndex(ix))
+	}
+	return b.String()
+}
+
+// formatIndex describes an index on one line, e.g.
+// "by-status  ACTIVE  status (S)  KEYS_ONLY  1200 items, 3.1 MB".
+func formatIndex(ix awsdynamodb.Index) string {
+	keys := formatKey(ix.PartitionKey)
+	if ix.SortKey.Name != "" {
+		keys += " + " + formatKey(ix.SortKey)
+	}
+	status := ix.Status
+	if status == "" {
+		status = "-"
+	}
+	return fmt.Sprintf("%-24s %-9s %-36s %-9s %d items, %s\n",
+		ix.Name, status, keys, ix.Projection, ix.ItemCount, ui.FormatSize(ix.SizeBytes))
+}
+
+// formatKey describes a key attribute with its type, e.g. "pk (S)", or "-"
+// if there is none.
+func formatKey(k awsdynamodb.Key) string {
+	if k.Name == "" {
+		return "-"
+	}
+	return k.Name + " (" + k.Type + ")"
+}
+
+func (dv *DetailView) Title() string {
+	return dv.name
+}
+
+// CapturingInput implements plugin.InputCapturer while a query or filter
+// prompt, or the item filter, is open.
+func (dv *DetailView) CapturingInput() bool {
+	return dv.prompt != nil || dv.picker != nil || (dv.tabs.Active() == itemsTab && dv.items.Filtering())
+}
+
+func (dv *DetailView) KeyHints() []plugin.KeyHint {
+	if dv.previewing {
+		return []plugin.KeyHint{
+			{Key: "j/k", Desc: "scroll"},
+			{Key: "g/G", Desc: "top/bottom"},
+			{Key: "esc", Desc: "close"},
+		}
+	}
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "1-3", Desc: "jump to tab"},
+	}
+	if dv.tabs.Active() == itemsTab {
+		hints = append(hints,
+			plugin.KeyHint{Key: "enter", Desc: "view item"},
+			plugin.KeyHint{Key: "Q", Desc: "query"},
+			plugin.KeyHint{Key: "f", Desc: "scan filter"},
+			plugin.KeyHint{Key: "c", Desc: "clear"},
+			plugin.KeyHint{Key: "e", Desc: "export JSONL"},
+			plugin.KeyHint{Key: "/", Desc: "filter"},
+		)
+	}
+	return hints
+}
@@ -0,0 +1,448 @@
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awsdynamodb "tasnim.dev/aws-tui/internal/aws/dynamodb"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// itemPage is how many items the Items tab reads at a time. A filtered scan
+// keeps reading until this many match or the table is exhausted.
+const itemPage = 100
+
+// Prompts and pickers of the query builder and the scan filter.
+const (
+	pickTarget      = "target"
+	pickSortOp      = "sort-op"
+	promptPartition = "partition"
+	promptSortValue = "sort-value"
+	promptSortEnd   = "sort-end"
+	promptFilter    = "filter"
+)
+
+// anySortKey is the sort key choice that queries the whole partition.
+const anySortKey = "any"
+
+// sortOps are the sort key conditions offered by the query builder.
+var sortOps = []string{anySortKey, "=", "<", "<=", ">", ">=", "begins_with", "between"}
+
+// itemsMsg carries a page of items; after is the token it was fetched from,
+// nil when it starts the listing.
+type itemsMsg struct {
+	gen   int
+	items []awsdynamodb.Item
+	after *string
+	next  *string
+	err   error
+}
+
+// exportedMsg is returned once the loaded items have been written to a file.
+type exportedMsg struct {
+	path  string
+	count int
+	err   error
+}
+
+// queryTarget is the table or an index the query builder can query.
+type queryTarget struct {
+	label string
+	index string
+	pk    awsdynamodb.Key
+	sk    awsdynamodb.Key
+}
+
+// newItemTable creates the item table, with columns for the table's key
+// attributes followed by the whole item.
+func newItemTable(t awsdynamodb.Table) ui.TableView[awsdynamodb.Item] {
+	pk, sk := t.PartitionKey.Name, t.SortKey.Name
+	cols := []ui.Column[awsdynamodb.Item]{
+		{Title: pk, Width: 24, Field: func(it awsdynamodb.Item) string { return formatValue(it[pk]) }},
+	}
+	if sk != "" {
+		cols = append(cols, ui.Column[awsdynamodb.Item]{
+			Title: sk, Width: 24, Field: func(it awsdynamodb.Item) string { return formatValue(it[sk]) },
+		})
+	}
+	cols = append(cols, ui.Column[awsdynamodb.Item]{Title: "Item", Width: 80, Field: compactJSON})
+	return ui.NewTableView(cols, nil, func(it awsdynamodb.Item) string {
+		id := formatValue(it[pk])
+		if sk != "" {
+			id += "\x00" + formatValue(it[sk])
+		}
+		return id
+	})
+}
+
+// formatValue renders an attribute value for a table cell: strings as they
+// are and anything else as JSON.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func compactJSON(it awsdynamodb.Item) string {
+	b, err := json.Marshal(it)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+// startItems loads the first page of items the first time the Items tab is
+// shown, so opening a table does not scan it.
+func (dv *DetailView) startItems() tea.Cmd {
+	if dv.tabs.Active() != itemsTab || dv.itemsStarted || dv.table == nil {
+		return nil
+	}
+	return dv.restartItems()
+}
+
+// restartItems reloads the items from the first page with the current
+// query or scan filter.
+func (dv *DetailView) restartItems() tea.Cmd {
+	dv.itemsStarted = true
+	dv.itemsGen++
+	dv.itemsLoading = true
+	dv.itemsErr = nil
+	dv.itemsNext = nil
+	return dv.fetchItems(nil)
+}
+
+// fetchItems fetches a page of items after token with the current query or
+// scan filter.
+func (dv *DetailView) fetchItems(after *string) tea.Cmd {
+	client, router, name := dv.client, dv.router, dv.name
+	gen, filter := dv.itemsGen, dv.filter
+	var query *awsdynamodb.Query
+	if dv.query != nil {
+		q := *dv.query
+		query = &q
+	}
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		items, next, err := plugin.FetchPages(ctx, router, after, itemPage, func(ctx context.Context, token *string) ([]awsdynamodb.Item, *string, error) {
+			if query != nil {
+				return client.QueryPage(ctx, name, *query, token)
+			}
+			return client.ScanPage(ctx, name, filter, token)
+		})
+		return itemsMsg{gen: gen, items: items, after: after, next: next, err: err}
+	}
+}
+
+func (dv *DetailView) itemsLoaded(msg itemsMsg) {
+	if msg.gen != dv.itemsGen {
+		// A page of a query or scan that has since been replaced.
+		return
+	}
+	if msg.after != nil {
+		if msg.after != dv.itemsNext {
+			return
+		}
+		if msg.err != nil {
+			dv.items.SetMore(true)
+			dv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+			return
+		}
+		dv.items.AppendItems(msg.items)
+	} else {
+		dv.itemsLoading = false
+		if msg.err != nil {
+			dv.itemsErr = msg.err
+			dv.items.SetItems(nil)
+			dv.items.SetMore(false)
+			return
+		}
+		dv.items.SetItems(msg.items)
+	}
+	dv.itemsNext = msg.next
+	dv.items.SetMore(msg.next != nil)
+}
+
+// handleItemKey handles the Items tab's own keys, reporting whether key
+// was one of them.
+func (dv *DetailView) handleItemKey(msg tea.KeyPressMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		if dv.items.FilteredCount() > 0 {
+			dv.openPreview(dv.items.SelectedItem())
+		}
+		return nil, true
+	case "Q":
+		dv.startQuery()
+		return nil, true
+	case "f":
+		in := ui.NewInput("Scan "+dv.name, "Filter:", false)
+		dv.prompt, dv.promptFor = &in, promptFilter
+		return nil, true
+	case "c":
+		if dv.query == nil && dv.filter == "" {
+			return nil, true
+		}
+		dv.query, dv.filter = nil, ""
+		return dv.restartItems(), true
+	case "e":
+		return dv.export(), true
+	}
+	return nil, false
+}
+
+// queryTargets returns the table and its indexes, which the query builder
+// offers in that order.
+func queryTargets(t awsdynamodb.Table) []queryTarget {
+	targets := []queryTarget{{label: t.Name + " (table)", pk: t.PartitionKey, sk: t.SortKey}}
+	for _, ix := range t.GSIs {
+		targets = append(targets, queryTarget{label: ix.Name + " (GSI)", index: ix.Name, pk: ix.PartitionKey, sk: ix.SortKey})
+	}
+	for _, ix := range t.LSIs {
+		targets = append(targets, queryTarget{label: ix.Name + " (LSI)", index: ix.Name, pk: ix.PartitionKey, sk: ix.SortKey})
+	}
+	return targets
+}
+
+// startQuery opens the query builder: it asks for the table or index to
+// query when the table has indexes, then the partition key value, then an
+// optional sort key condition.
+func (dv *DetailView) startQuery() {
+	targets := queryTargets(*dv.table)
+	if len(targets) == 1 {
+		dv.askPartition(targets[0])
+		return
+	}
+	labels := make([]string, len(targets))
+	for i, t := range targets {
+		labels[i] = t.label
+	}
+	p := ui.NewPicker("Query "+dv.name, labels)
+	dv.picker, dv.pickerFor = &p, pickTarget
+}
+
+func (dv *DetailView) askPartition(t queryTarget) {
+	dv.draft = awsdynamodb.Query{Index: t.index, PartitionKey: t.pk, SortKey: t.sk}
+	in := ui.NewInput("Query "+t.label, t.pk.Name+" =", false)
+	dv.prompt, dv.promptFor = &in, promptPartition
+}
+
+// answerPicker moves the query builder on with the chosen target or sort
+// key condition.
+func (dv *DetailView) answerPicker(res ui.PickerResult) tea.Cmd {
+	pickerFor := dv.pickerFor
+	dv.picker, dv.pickerFor = nil, ""
+	if res.Canceled {
+		return nil
+	}
+	switch pickerFor {
+	case pickTarget:
+		for _, t := range queryTargets(*dv.table) {
+			if t.label == res.Selected {
+				dv.askPartition(t)
+			}
+		}
+	case pickSortOp:
+		if res.Selected == anySortKey {
+			return dv.runQuery()
+		}
+		dv.draft.SortOp = res.Selected
+		in := ui.NewInput("Query "+dv.name, dv.draft.SortKey.Name+" "+res.Selected, false)
+		dv.prompt, dv.promptFor = &in, promptSortValue
+	}
+	return nil
+}
+
+// answerPrompt moves the query builder on with the value entered, or
+// applies the scan filter.
+func (dv *DetailView) answerPrompt(res ui.InputResult) tea.Cmd {
+	promptFor := dv.promptFor
+	dv.prompt, dv.promptFor = nil, ""
+	if res.Canceled {
+		return nil
+	}
+	switch promptFor {
+	case promptPartition:
+		dv.draft.PartitionValue = res.Value
+		if dv.draft.SortKey.Name == "" {
+			return dv.runQuery()
+		}
+		p := ui.NewPicker("Sort key condition on "+dv.draft.SortKey.Name, sortOps)
+		dv.picker, dv.pickerFor = &p, pickSortOp
+	case promptSortValue:
+		dv.draft.SortValues = []string{res.Value}
+		if dv.draft.SortOp == "between" {
+			in := ui.NewInput("Query "+dv.name, dv.draft.SortKey.Name+" between "+res.Value+" and", false)
+			dv.prompt, dv.promptFor = &in, promptSortEnd
+			return nil
+		}
+		return dv.runQuery()
+	case promptSortEnd:
+		dv.draft.SortValues = append(dv.draft.SortValues, res.Value)
+		return dv.runQuery()
+	case promptFilter:
+		if _, err := awsdynamodb.ParseExpression(res.Value); err != nil {
+			dv.router.Toast(plugin.ToastError, "Invalid filter: "+err.Error())
+			return nil
+		}
+		dv.query, dv.filter = nil, res.Value
+		return dv.restartItems()
+	}
+	return nil
+}
+
+// runQuery replaces the items with the results of the drafted query.
+func (dv *DetailView) runQuery() tea.Cmd {
+	q := dv.draft
+	dv.query, dv.filter = &q, ""
+	return dv.restartItems()
+}
+
+// describeItems describes where the Items tab's rows come from, e.g.
+// `Query by-status: status = "shipped"`.
+func (dv *DetailView) describeItems() string {
+	switch {
+	case dv.query != nil:
+		return describeQuery(*dv.query)
+	case dv.filter != "":
+		return "Scan where " + dv.filter
+	}
+	return "Scan"
+}
+
+func describeQuery(q awsdynamodb.Query) string {
+	target := "table"
+	if q.Index != "" {
+		target = q.Index
+	}
+	cond := fmt.Sprintf("%s = %q", q.PartitionKey.Name, q.PartitionValue)
+	sk := q.SortKey.Name
+	switch {
+	case q.SortOp == "":
+	case q.SortOp == "between" && len(q.SortValues) == 2:
+		cond += fmt.Sprintf(" AND %s BETWEEN %q AND %q", sk, q.SortValues[0], q.SortValues[1])
+	case q.SortOp == "begins_with" && len(q.SortValues) == 1:
+		cond += fmt.Sprintf(" AND begins_with(%s, %q)", sk, q.SortValues[0])
+	case len(q.SortValues) == 1:
+		cond += fmt.Sprintf(" AND %s %s %q", sk, q.SortOp, q.SortValues[0])
+	}
+	return "Query " + target + ": " + cond
+}
+
+func (dv *DetailView) renderItems() string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(dv.describeItems()))
+	switch {
+	case dv.itemsLoading:
+		b.WriteString("\n\n")
+		skel := ui.NewSkeleton(80, 6)
+		b.WriteString(skel.View())
+		return b.String()
+	case dv.itemsErr != nil:
+		b.WriteString("\n\nError: " + dv.itemsErr.Error())
+		return b.String()
+	case dv.items.ItemCount() == 0:
+		b.WriteString("\n\nNo items.")
+		return b.String()
+	}
+	more := ""
+	if dv.items.HasMore() {
+		more = ", more as you scroll"
+	}
+	b.WriteString(fmt.Sprintf("  (%d loaded%s)\n\n", dv.items.ItemCount(), more))
+	b.WriteString(dv.items.View())
+	return b.String()
+}
+
+// export writes the loaded items to <table>-<time>.jsonl in the working
+// directory, one JSON object per line.
+func (dv *DetailView) export() tea.Cmd {
+	items := dv.items.Items()
+	if len(items) == 0 {
+		dv.router.Toast(plugin.ToastWarning, "No items to export")
+		return nil
+	}
+	path := fmt.Sprintf("%s-%s.jsonl", dv.name, time.Now().Format("20060102-150405"))
+	return func() tea.Msg {
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		for _, it := range items {
+			if err := enc.Encode(it); err != nil {
+				return exportedMsg{err: err}
+			}
+		}
+		err := os.WriteFile(path, b.Bytes(), 0o644)
+		return exportedMsg{path: path, count: len(items), err: err}
+	}
+}
+
+// openPreview shows an item as indented, highlighted JSON.
+func (dv *DetailView) openPreview(it awsdynamodb.Item) {
+	body, err := json.MarshalIndent(it, "", "  ")
+	if err != nil {
+		dv.router.Toast(plugin.ToastError, "Rendering item failed: "+err.Error())
+		return
+	}
+	dv.previewing = true
+	dv.previewScroll = 0
+	dv.previewLines = strings.Split(ui.HighlightCode("item.json", string(body)), "\n")
+}
+
+func (dv *DetailView) handlePreviewKey(key string) {
+	maxScroll := max(len(dv.previewLines)-dv.previewVisibleLines(), 0)
+	switch key {
+	case "esc", "backspace":
+		dv.previewing = false
+		dv.previewLines = nil
+		dv.previewScroll = 0
+	case "j", "down":
+		dv.previewScroll = min(dv.previewScroll+1, maxScroll)
+	case "k", "up":
+		dv.previewScroll = max(dv.previewScroll-1, 0)
+	case "g":
+		dv.previewScroll = 0
+	case "G":
+		dv.previewScroll = maxScroll
+	}
+}
+
+// previewVisibleLines returns how many lines of the item fit on screen.
+func (dv *DetailView) previewVisibleLines() int {
+	// Reserve lines for the app header, the item header and its blank line,
+	// the scroll indicator and the status bar.
+	h := dv.height - 6
+	if h < 5 {
+		h = 30 // fallback if window size unknown
+	}
+	return h
+}
+
+func (dv *DetailView) renderPreview() string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(dv.name + " — item"))
+	b.WriteString("\n\n")
+	visible := dv.previewVisibleLines()
+	end := min(dv.previewScroll+visible, len(dv.previewLines))
+	b.WriteString(strings.Join(dv.previewLines[dv.previewScroll:end], "\n"))
+	if total := len(dv.previewLines); total > visible {
+		pct := dv.previewScroll * 100 / (total - visible)
+		b.WriteString(fmt.Sprintf("\n── %d%% ── j/k scroll · g/G top/bottom · esc close", pct))
+	}
+	return b.String()
+}
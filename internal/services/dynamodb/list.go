@@ -0,0 +1,193 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	awsdynamodb "tasnim.dev/aws-tui/internal/aws/dynamodb"
+	"tasnim.dev/aws-tui/internal/cache"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// cacheKey is the cache service key for DynamoDB tables.
+const cacheKey = "dynamodb"
+
+// tablesMsg carries the result of fetching tables.
+type tablesMsg struct {
+	tables []awsdynamodb.Table
+	err    error
+}
+
+// ListView displays DynamoDB tables in a table.
+type ListView struct {
+	plugin.Revalidation
+
+	client  DynamoDBClient
+	router  plugin.Router
+	table   ui.TableView[awsdynamodb.Table]
+	loading bool
+	err     error
+	cache   *cache.Scope
+}
+
+// NewListView creates a new DynamoDB ListView.
+func NewListView(client DynamoDBClient, router plugin.Router) *ListView {
+	tv := ui.NewTableView(tableColumns(), nil, func(t awsdynamodb.Table) string {
+		return t.Name
+	})
+	return &ListView{
+		client:  client,
+		router:  router,
+		table:   tv,
+		loading: true,
+	}
+}
+
+func tableColumns() []ui.Column[awsdynamodb.Table] {
+	return []ui.Column[awsdynamodb.Table]{
+		{Title: "Name", Width: 32, Field: func(t awsdynamodb.Table) string { return t.Name }},
+		{Title: "Status", Width: 10, Field: func(t awsdynamodb.Table) string { return t.Status }},
+		{Title: "Items", Width: 10, Field: func(t awsdynamodb.Table) string { return fmt.Sprintf("%d", t.ItemCount) }},
+		{Title: "Size", Width: 10, Field: func(t awsdynamodb.Table) string { return ui.FormatSize(t.SizeBytes) }},
+		{Title: "Billing", Width: 14, Field: billing},
+		{Title: "Indexes", Width: 13, Field: indexCounts},
+		{Title: "TTL", Width: 16, Field: func(t awsdynamodb.Table) string {
+			if t.TTLStatus != "ENABLED" {
+				return "-"
+			}
+			return t.TTLAttribute
+		}},
+		{Title: "Stream", Width: 18, Field: func(t awsdynamodb.Table) string {
+			if !t.StreamEnabled {
+				return "-"
+			}
+			return t.StreamViewType
+		}},
+	}
+}
+
+// billing describes a table's capacity mode, with its provisioned read and
+// write capacity units.
+func billing(t awsdynamodb.Table) string {
+	if t.BillingMode == "PAY_PER_REQUEST" {
+		return "on-demand"
+	}
+	return fmt.Sprintf("%d RCU/%d WCU", t.ReadCapacity, t.WriteCapacity)
+}
+
+// indexCounts summarizes a table's secondary indexes, e.g. "2 GSI, 1 LSI".
+func indexCounts(t awsdynamodb.Table) string {
+	var parts []string
+	if n := len(t.GSIs); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d GSI", n))
+	}
+	if n := len(t.LSIs); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d LSI", n))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (lv *ListView) fetchTables() tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var tables []awsdynamodb.Table
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			tables, err = client.ListTables(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, tables, func(t awsdynamodb.Table) (string, string) {
+				return t.Name, t.Name
+			})
+		}
+		return tablesMsg{tables: tables, err: err}
+	}
+}
+
+func (lv *ListView) Init() tea.Cmd {
+	fetch := lv.fetchTables()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awsdynamodb.Table](lv.cache, cacheKey, fetch)
+	}
+	return fetch
+}
+
+func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case plugin.CachedItems[awsdynamodb.Table]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchTables()
+		}
+		return lv, nil
+
+	case tablesMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.err = nil
+		lv.table.SetItems(msg.tables)
+		return lv, nil
+
+	case tea.KeyPressMsg:
+		if lv.loading {
+			return lv, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if id := lv.table.SelectedID(); id != "" {
+				view := NewDetailView(lv.client, lv.router, id)
+				lv.router.Push(view)
+				return lv, view.Init()
+			}
+			return lv, nil
+		case "esc", "backspace":
+			lv.router.Pop()
+			return lv, nil
+		case "r":
+			lv.loading = true
+			return lv, lv.fetchTables()
+		}
+	}
+
+	var cmd tea.Cmd
+	lv.table, cmd = lv.table.Update(msg)
+	return lv, cmd
+}
+
+func (lv *ListView) View() tea.View {
+	if lv.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if lv.err != nil {
+		return tea.NewView("Error: " + lv.err.Error())
+	}
+	return tea.NewView(lv.table.View())
+}
+
+func (lv *ListView) Title() string { return "DynamoDB Tables" }
+
+func (lv *ListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view table"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+	}
+}
@@ -0,0 +1,83 @@
+package dynamodb
+
+import (
+	"context"
+	"time"
+
+	awsdynamodb "tasnim.dev/aws-tui/internal/aws/dynamodb"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// DynamoDBClient defines the subset of dynamodb.Client methods used by the
+// plugin.
+type DynamoDBClient interface {
+	ListTables(ctx context.Context) ([]awsdynamodb.Table, error)
+	GetTable(ctx context.Context, name string) (awsdynamodb.Table, error)
+	ScanPage(ctx context.Context, table, filter string, token *string) ([]awsdynamodb.Item, *string, error)
+	QueryPage(ctx context.Context, table string, q awsdynamodb.Query, token *string) ([]awsdynamodb.Item, *string, error)
+}
+
+// Plugin implements plugin.ServicePlugin for Amazon DynamoDB.
+type Plugin struct {
+	plugin.CacheHolder
+
+	client DynamoDBClient
+}
+
+// NewPlugin creates a new DynamoDB service plugin.
+func NewPlugin(client DynamoDBClient) *Plugin {
+	return &Plugin{client: client}
+}
+
+func (p *Plugin) ID() string   { return "dynamodb" }
+func (p *Plugin) Name() string { return "DynamoDB" }
+func (p *Plugin) Icon() string { return "\U000F04EB" } // nf-md-table
+
+// Summary counts tables by status. A table that is not active, whether
+// being created, updated or archived, makes the service a warning.
+func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
+	tables, err := p.client.ListTables(ctx)
+	if err != nil {
+		return plugin.ServiceSummary{}, err
+	}
+
+	status := make(map[string]int)
+	health := plugin.HealthHealthy
+	for _, t := range tables {
+		status[t.Status]++
+		if t.Status != "ACTIVE" {
+			health = plugin.HealthWarning
+		}
+	}
+	return plugin.ServiceSummary{
+		Total:  len(tables),
+		Status: status,
+		Health: health,
+		Label:  "tables",
+	}, nil
+}
+
+func (p *Plugin) ListView(router plugin.Router) plugin.View {
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
+}
+
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	return NewDetailView(p.client, router, id)
+}
+
+func (p *Plugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{
+			Title:    "DynamoDB Tables",
+			Keywords: []string{"dynamodb", "ddb", "tables", "nosql", "items"},
+		},
+	}
+}
+
+func (p *Plugin) PollConfig() plugin.PollConfig {
+	return plugin.PollConfig{
+		IdleInterval: 2 * time.Minute,
+	}
+}
@@ -0,0 +1,343 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsdynamodb "tasnim.dev/aws-tui/internal/aws/dynamodb"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+type mockClient struct {
+	tables  []awsdynamodb.Table
+	pages   [][]awsdynamodb.Item // scan and query results, one page per token
+	scans   []string             // filter of each scan
+	queries []awsdynamodb.Query
+	err     error
+}
+
+func (m *mockClient) ListTables(_ context.Context) ([]awsdynamodb.Table, error) {
+	return m.tables, m.err
+}
+
+func (m *mockClient) GetTable(_ context.Context, name string) (awsdynamodb.Table, error) {
+	for _, t := range m.tables {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return awsdynamodb.Table{}, errors.New("table " + name + " not found")
+}
+
+func (m *mockClient) ScanPage(_ context.Context, _, filter string, token *string) ([]awsdynamodb.Item, *string, error) {
+	m.scans = append(m.scans, filter)
+	return m.page(token)
+}
+
+func (m *mockClient) QueryPage(_ context.Context, _ string, q awsdynamodb.Query, token *string) ([]awsdynamodb.Item, *string, error) {
+	m.queries = append(m.queries, q)
+	return m.page(token)
+}
+
+func (m *mockClient) page(token *string) ([]awsdynamodb.Item, *string, error) {
+	i := 0
+	if token != nil {
+		fmt.Sscan(*token, &i)
+	}
+	if i >= len(m.pages) {
+		return nil, nil, nil
+	}
+	var next *string
+	if i+1 < len(m.pages) {
+		s := fmt.Sprint(i + 1)
+		next = &s
+	}
+	return m.pages[i], next, nil
+}
+
+type mockRouter struct {
+	pushed []plugin.View
+	toasts []string
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_, _ string)            {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, msg string) { m.toasts = append(m.toasts, msg) }
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	switch s {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	case "esc":
+		return tea.KeyPressMsg{Code: tea.KeyEscape}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+// send delivers msg to the view and then the messages of the commands it
+// returns, until none are left.
+func send(v tea.Model, msg tea.Msg) {
+	_, cmd := v.Update(msg)
+	for cmd != nil {
+		_, cmd = v.Update(cmd())
+	}
+}
+
+// typeText types s into an open prompt and submits it.
+func typeText(v tea.Model, s string) {
+	for _, r := range s {
+		send(v, tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	send(v, key("enter"))
+}
+
+func ordersTable() awsdynamodb.Table {
+	return awsdynamodb.Table{
+		Name:         "orders",
+		Status:       "ACTIVE",
+		ItemCount:    1200,
+		SizeBytes:    3 << 20,
+		BillingMode:  "PAY_PER_REQUEST",
+		PartitionKey: awsdynamodb.Key{Name: "customerId", Type: "S"},
+		SortKey:      awsdynamodb.Key{Name: "createdAt", Type: "N"},
+		GSIs: []awsdynamodb.Index{{
+			Name: "by-status", Status: "ACTIVE", Projection: "KEYS_ONLY",
+			PartitionKey: awsdynamodb.Key{Name: "status", Type: "S"},
+		}},
+		TTLAttribute:   "expiresAt",
+		TTLStatus:      "ENABLED",
+		StreamEnabled:  true,
+		StreamViewType: "NEW_IMAGE",
+	}
+}
+
+func order(customer string, created int) awsdynamodb.Item {
+	return awsdynamodb.Item{
+		"customerId": customer,
+		"createdAt":  json.Number(fmt.Sprint(created)),
+		"lines":      []any{map[string]any{"sku": "A1"}},
+	}
+}
+
+// openItems opens the Items tab of a loaded orders table.
+func openItems(t *testing.T, client *mockClient, router *mockRouter) *DetailView {
+	t.Helper()
+	dv := NewPlugin(client).DetailView(router, "orders").(*DetailView)
+	send(dv, dv.Init()())
+	require.Empty(t, client.scans, "the table is only scanned once the Items tab is shown")
+	send(dv, key("3"))
+	return dv
+}
+
+func TestPluginMetadata(t *testing.T) {
+	p := NewPlugin(nil)
+	assert.Equal(t, "dynamodb", p.ID())
+	assert.Equal(t, "DynamoDB", p.Name())
+	assert.NotEmpty(t, p.Icon())
+	require.Len(t, p.Commands(), 1)
+	assert.Contains(t, p.Commands()[0].Keywords, "nosql")
+}
+
+func TestSummary(t *testing.T) {
+	client := &mockClient{tables: []awsdynamodb.Table{ordersTable(), {Name: "carts", Status: "ACTIVE"}}}
+	s, err := NewPlugin(client).Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Total)
+	assert.Equal(t, plugin.HealthHealthy, s.Health)
+	assert.Equal(t, "tables", s.Label)
+
+	client.tables = append(client.tables, awsdynamodb.Table{Name: "events", Status: "UPDATING"})
+	s, err = NewPlugin(client).Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, plugin.HealthWarning, s.Health)
+	assert.Equal(t, map[string]int{"ACTIVE": 2, "UPDATING": 1}, s.Status)
+
+	_, err = NewPlugin(&mockClient{err: errors.New("AccessDenied")}).Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestListView(t *testing.T) {
+	carts := awsdynamodb.Table{Name: "carts", Status: "ACTIVE", BillingMode: "PROVISIONED", ReadCapacity: 5, WriteCapacity: 2}
+	client := &mockClient{tables: []awsdynamodb.Table{ordersTable(), carts}}
+	router := &mockRouter{}
+	lv := NewPlugin(client).ListView(router).(*ListView)
+	send(lv, lv.Init()())
+
+	view := lv.View().Content
+	assert.Contains(t, view, "on-demand")
+	assert.Contains(t, view, "5 RCU/2 WCU")
+	assert.Contains(t, view, "1 GSI")
+	assert.Contains(t, view, "expiresAt")
+	assert.Contains(t, view, "NEW_IMAGE")
+
+	lv.Update(key("enter"))
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "carts", router.pushed[0].Title())
+}
+
+func TestDetailViewOverviewAndIndexes(t *testing.T) {
+	client := &mockClient{tables: []awsdynamodb.Table{ordersTable()}}
+	dv := NewPlugin(client).DetailView(&mockRouter{}, "orders").(*DetailView)
+	send(dv, dv.Init()())
+
+	view := dv.View().Content
+	assert.Contains(t, view, "customerId (S)")
+	assert.Contains(t, view, "createdAt (N)")
+	assert.Contains(t, view, "ENABLED on expiresAt")
+	assert.Contains(t, view, "3.0 MB")
+
+	send(dv, key("2"))
+	view = dv.View().Content
+	assert.Contains(t, view, "by-status")
+	assert.Contains(t, view, "KEYS_ONLY")
+	assert.Contains(t, view, "No local secondary indexes.")
+}
+
+func TestDetailViewBrowsesItems(t *testing.T) {
+	first := make([]awsdynamodb.Item, itemPage)
+	for i := range first {
+		first[i] = order(fmt.Sprintf("c-%03d", i), i)
+	}
+	client := &mockClient{
+		tables: []awsdynamodb.Table{ordersTable()},
+		pages:  [][]awsdynamodb.Item{first, {order("c-999", 999)}},
+	}
+	dv := openItems(t, client, &mockRouter{})
+	assert.Equal(t, []string{""}, client.scans)
+	assert.Contains(t, dv.View().Content, "(100 loaded, more as you scroll)")
+
+	send(dv, key("enter"))
+	require.True(t, dv.previewing)
+	assert.Contains(t, strings.Join(dv.previewLines, "\n"), `"sku"`)
+	send(dv, key("esc"))
+	assert.False(t, dv.previewing)
+
+	// Moving towards the end loads the next page.
+	for range itemPage {
+		send(dv, key("j"))
+	}
+	assert.Equal(t, itemPage+1, dv.items.ItemCount())
+	assert.False(t, dv.items.HasMore())
+	assert.Contains(t, dv.View().Content, "(101 loaded)")
+}
+
+func TestDetailViewQueryBuilder(t *testing.T) {
+	client := &mockClient{
+		tables: []awsdynamodb.Table{ordersTable()},
+		pages:  [][]awsdynamodb.Item{{order("c-1", 150)}},
+	}
+	dv := openItems(t, client, &mockRouter{})
+
+	// The table has an index, so Q first asks which to query.
+	send(dv, key("Q"))
+	require.NotNil(t, dv.picker)
+	assert.True(t, dv.CapturingInput())
+	send(dv, key("enter"))
+	require.NotNil(t, dv.prompt)
+	typeText(dv, "c-1")
+
+	// Then a sort key condition; between asks for both bounds.
+	require.NotNil(t, dv.picker)
+	for range 7 {
+		send(dv, key("j"))
+	}
+	send(dv, key("enter"))
+	typeText(dv, "100")
+	typeText(dv, "200")
+	assert.False(t, dv.CapturingInput())
+
+	require.Len(t, client.queries, 1)
+	assert.Equal(t, awsdynamodb.Query{
+		PartitionKey:   awsdynamodb.Key{Name: "customerId", Type: "S"},
+		PartitionValue: "c-1",
+		SortKey:        awsdynamodb.Key{Name: "createdAt", Type: "N"},
+		SortOp:         "between",
+		SortValues:     []string{"100", "200"},
+	}, client.queries[0])
+	assert.Contains(t, dv.View().Content, `Query table: customerId = "c-1" AND createdAt BETWEEN "100" AND "200"`)
+
+	// Querying the index with any sort key.
+	send(dv, key("Q"))
+	send(dv, key("j"))
+	send(dv, key("enter"))
+	typeText(dv, "shipped")
+	require.Len(t, client.queries, 2)
+	assert.Equal(t, "by-status", client.queries[1].Index)
+	assert.Equal(t, awsdynamodb.Key{Name: "status", Type: "S"}, client.queries[1].PartitionKey)
+	assert.Empty(t, client.queries[1].SortOp)
+
+	// c goes back to a scan.
+	send(dv, key("c"))
+	assert.Equal(t, []string{"", ""}, client.scans)
+	assert.Equal(t, "Scan", dv.describeItems())
+}
+
+func TestDetailViewScanFilter(t *testing.T) {
+	client := &mockClient{
+		tables: []awsdynamodb.Table{ordersTable()},
+		pages:  [][]awsdynamodb.Item{{order("c-1", 1)}},
+	}
+	router := &mockRouter{}
+	dv := openItems(t, client, router)
+
+	send(dv, key("f"))
+	typeText(dv, "total > :min")
+	assert.Len(t, client.scans, 1)
+	require.Len(t, router.toasts, 1)
+	assert.Contains(t, router.toasts[0], "Invalid filter")
+
+	send(dv, key("f"))
+	typeText(dv, "size(lines) > 0")
+	assert.Equal(t, []string{"", "size(lines) > 0"}, client.scans)
+	assert.Contains(t, dv.View().Content, "Scan where size(lines) > 0")
+}
+
+func TestDetailViewExport(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	client := &mockClient{
+		tables: []awsdynamodb.Table{ordersTable()},
+		pages:  [][]awsdynamodb.Item{{order("c-1", 1), order("c-2", 2)}},
+	}
+	router := &mockRouter{}
+	dv := openItems(t, client, router)
+
+	send(dv, key("e"))
+	require.Len(t, router.toasts, 1)
+	path := strings.TrimPrefix(router.toasts[0], "Exported 2 items to ")
+	require.NotEqual(t, router.toasts[0], path)
+	assert.True(t, strings.HasPrefix(path, "orders-"))
+	assert.True(t, strings.HasSuffix(path, ".jsonl"))
+
+	data, err := os.ReadFile(filepath.Join(dir, path))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"customerId":"c-1","createdAt":1,"lines":[{"sku":"A1"}]}`, lines[0])
+}
+
+func TestDetailViewError(t *testing.T) {
+	dv := NewPlugin(&mockClient{}).DetailView(&mockRouter{}, "missing").(*DetailView)
+	send(dv, dv.Init()())
+	assert.Contains(t, dv.View().Content, "table missing not found")
+}
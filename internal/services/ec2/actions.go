@@ -0,0 +1,234 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awsec2 "tasnim.dev/aws-tui/internal/aws/ec2"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// statePollInterval is how often instance states are re-read after an
+// action, and statePollLimit how many times before giving up.
+const (
+	statePollInterval = 3 * time.Second
+	statePollLimit    = 100
+)
+
+// instanceAction is a lifecycle action on one or more instances.
+type instanceAction struct {
+	key    string
+	verb   string   // e.g. "stop"; also the phrase confirming several instances
+	from   []string // the states the action applies to
+	settle string   // the state polled for afterwards, empty for none
+	run    func(ctx context.Context, client EC2Client, ids []string) error
+}
+
+var instanceActions = []instanceAction{
+	{
+		key: "U", verb: "start", from: []string{"stopped"}, settle: "running",
+		run: func(ctx context.Context, client EC2Client, ids []string) error {
+			return client.StartInstances(ctx, ids)
+		},
+	},
+	{
+		key: "D", verb: "stop", from: []string{"running"}, settle: "stopped",
+		run: func(ctx context.Context, client EC2Client, ids []string) error {
+			return client.StopInstances(ctx, ids, false)
+		},
+	},
+	{
+		key: "B", verb: "reboot", from: []string{"running"},
+		run: func(ctx context.Context, client EC2Client, ids []string) error {
+			return client.RebootInstances(ctx, ids)
+		},
+	},
+	{
+		key: "H", verb: "hibernate", from: []string{"running"}, settle: "stopped",
+		run: func(ctx context.Context, client EC2Client, ids []string) error {
+			return client.StopInstances(ctx, ids, true)
+		},
+	},
+	{
+		key: "X", verb: "terminate", from: []string{"pending", "running", "stopping", "stopped"}, settle: "terminated",
+		run: func(ctx context.Context, client EC2Client, ids []string) error {
+			return client.TerminateInstances(ctx, ids)
+		},
+	},
+}
+
+// instanceActionFor returns the action bound to key.
+func instanceActionFor(key string) (instanceAction, bool) {
+	for _, a := range instanceActions {
+		if a.key == key {
+			return a, true
+		}
+	}
+	return instanceAction{}, false
+}
+
+// title returns the verb capitalised, e.g. "Stop".
+func (a instanceAction) title() string {
+	return strings.ToUpper(a.verb[:1]) + a.verb[1:]
+}
+
+// past returns the verb in the past tense, e.g. "stopped".
+func (a instanceAction) past() string {
+	if strings.HasSuffix(a.verb, "e") {
+		return a.verb + "d"
+	}
+	if a.verb == "stop" {
+		return "stopped"
+	}
+	return a.verb + "ed"
+}
+
+// actionDoneMsg carries the result of running an action.
+type actionDoneMsg struct {
+	action instanceAction
+	ids    []string
+	err    error
+}
+
+// statesMsg carries instance states re-read while an action settles. poll
+// counts the reads so far.
+type statesMsg struct {
+	action instanceAction
+	ids    []string
+	states map[string]string
+	poll   int
+	err    error
+}
+
+// settled reports whether every instance has reached the action's state.
+func (m statesMsg) settled() bool {
+	for _, id := range m.ids {
+		if m.states[id] != m.action.settle {
+			return false
+		}
+	}
+	return true
+}
+
+// confirmInstanceAction asks the user to confirm running a on the instances
+// it applies to in region, the region client acts in. A single instance is
+// confirmed by typing its ID, several by typing the verb. Messages from the
+// action are sent to the current view.
+func confirmInstanceAction(ctx context.Context, client EC2Client, router plugin.Router, region string, a instanceAction, instances []awsec2.EC2Instance) {
+	if router.Offline() {
+		router.Toast(plugin.ToastWarning, a.title()+" is unavailable offline")
+		return
+	}
+	var ids, targets []string
+	for _, inst := range instances {
+		if !slices.Contains(a.from, inst.State) {
+			continue
+		}
+		ids = append(ids, inst.InstanceID)
+		target := inst.InstanceID
+		if inst.Name != "" {
+			target += "  " + inst.Name
+		}
+		targets = append(targets, target)
+	}
+	switch {
+	case len(ids) == 0 && len(instances) == 1:
+		router.Toast(plugin.ToastWarning, fmt.Sprintf("Cannot %s an instance that is %s", a.verb, instances[0].State))
+		return
+	case len(ids) == 0:
+		router.Toast(plugin.ToastWarning, "None of the marked instances can be "+a.past())
+		return
+	}
+
+	title, phrase := a.title()+" instance", ids[0]
+	if len(ids) > 1 {
+		title, phrase = fmt.Sprintf("%s %d instances", a.title(), len(ids)), a.verb
+	}
+	router.Confirm(plugin.Action{
+		Title:   title,
+		Targets: targets,
+		Phrase:  phrase,
+		Region:  region,
+		Run: func() tea.Cmd {
+			return func() tea.Msg {
+				return actionDoneMsg{action: a, ids: ids, err: a.run(ctx, client, ids)}
+			}
+		},
+	})
+}
+
+// afterAction reports the result of an action and, if it succeeded and has
+// a state to settle in, starts polling the instances.
+func afterAction(ctx context.Context, client EC2Client, router plugin.Router, msg actionDoneMsg) tea.Cmd {
+	if msg.err != nil {
+		router.Toast(plugin.ToastError, msg.action.title()+" failed: "+msg.err.Error())
+		return nil
+	}
+	router.Toast(plugin.ToastInfo, fmt.Sprintf("%s requested for %s", msg.action.title(), describeIDs(msg.ids)))
+	if msg.action.settle == "" {
+		return nil
+	}
+	return pollStates(ctx, client, router, msg.action, msg.ids, 0)
+}
+
+// afterStates polls again until the instances settle, reporting when they
+// do. It returns nil once polling has ended.
+func afterStates(ctx context.Context, client EC2Client, router plugin.Router, msg statesMsg) tea.Cmd {
+	switch {
+	case msg.err != nil:
+		if ctx.Err() == nil {
+			router.Toast(plugin.ToastError, "Reading instance state failed: "+msg.err.Error())
+		}
+		return nil
+	case msg.settled():
+		router.Toast(plugin.ToastInfo, fmt.Sprintf("%s now %s", describeIDs(msg.ids), msg.action.settle))
+		return nil
+	case msg.poll >= statePollLimit:
+		router.Toast(plugin.ToastWarning, fmt.Sprintf("%s not yet %s", describeIDs(msg.ids), msg.action.settle))
+		return nil
+	}
+	return pollStates(ctx, client, router, msg.action, msg.ids, msg.poll)
+}
+
+// pollStates reads the states of ids after statePollInterval.
+func pollStates(ctx context.Context, client EC2Client, router plugin.Router, a instanceAction, ids []string, poll int) tea.Cmd {
+	return tea.Tick(statePollInterval, func(time.Time) tea.Msg {
+		var states map[string]string
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			states, err = client.InstanceStates(ctx, ids)
+			return err
+		})
+		return statesMsg{action: a, ids: ids, states: states, poll: poll + 1, err: err}
+	})
+}
+
+// describeIDs names a single instance by ID and several by count.
+func describeIDs(ids []string) string {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return fmt.Sprintf("%d instances", len(ids))
+}
+
+// actionHints returns the hints for the actions that apply to any of the
+// given states, or none while read-only.
+func actionHints(router plugin.Router, states ...string) []plugin.KeyHint {
+	if router.ReadOnly() {
+		return nil
+	}
+	var hints []plugin.KeyHint
+	for _, a := range instanceActions {
+		for _, s := range states {
+			if slices.Contains(a.from, s) {
+				hints = append(hints, plugin.KeyHint{Key: a.key, Desc: a.verb})
+				break
+			}
+		}
+	}
+	return hints
+}
@@ -12,6 +12,7 @@ import (
 
 	awsec2 "tasnim.dev/aws-tui/internal/aws/ec2"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
@@ -32,6 +33,7 @@ type DetailView struct {
 	instance   *awsec2.EC2Instance
 	volumes    []awsec2.EBSVolume
 	tabs       ui.TabController
+	metrics    *metrics.Panel
 	loading    bool
 	err        error
 	width      int
@@ -45,7 +47,8 @@ func NewDetailView(client EC2Client, router plugin.Router, instanceID, region, p
 		client:     client,
 		router:     router,
 		instanceID: instanceID,
-		tabs:       ui.NewTabController([]string{"Overview", "Security Groups", "Volumes", "Tags"}),
+		tabs:       ui.NewTabController([]string{"Overview", "Security Groups", "Volumes", "Tags", "Metrics"}),
+		metrics:    metrics.NewPanel(router, instanceCharts(instanceID)),
 		loading:    true,
 		region:     region,
 		profile:    profile,
@@ -53,11 +56,15 @@ func NewDetailView(client EC2Client, router plugin.Router, instanceID, region, p
 }
 
 func (dv *DetailView) loadInstance() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	instanceID := dv.instanceID
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		ctx := context.TODO()
-		instances, _, err := client.ListInstances(ctx)
+		var instances []awsec2.EC2Instance
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			instances, _, err = client.ListInstances(ctx)
+			return err
+		})
 		if err != nil {
 			return detailLoadedMsg{err: err}
 		}
@@ -100,6 +107,10 @@ func (dv *DetailView) Init() tea.Cmd {
 }
 
 func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if dv.metrics.Update(msg) {
+		return dv, nil
+	}
+
 	switch msg := msg.(type) {
 	case detailLoadedMsg:
 		dv.loading = false
@@ -113,6 +124,7 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.WindowSizeMsg:
 		dv.width = msg.Width
+		dv.metrics.SetWidth(msg.Width)
 		return dv, nil
 
 	case execFinishedMsg:
@@ -121,21 +133,48 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return dv, nil
 
+	case actionDoneMsg:
+		return dv, afterAction(dv.router.Context(dv), dv.client, dv.router, msg)
+
+	case statesMsg:
+		if state, ok := msg.states[dv.instanceID]; ok && dv.instance != nil {
+			dv.instance.State = state
+		}
+		return dv, afterStates(dv.router.Context(dv), dv.client, dv.router, msg)
+
 	case tea.KeyPressMsg:
 		switch msg.String() {
 		case "esc", "backspace":
 			dv.router.Pop()
 			return dv, nil
 		case "x":
+			if dv.router.Offline() {
+				dv.router.Toast(plugin.ToastWarning, "SSM sessions are unavailable offline")
+				return dv, nil
+			}
 			if dv.instance != nil && dv.instance.State == "running" {
 				return dv, dv.execSSM()
 			}
 			return dv, nil
 		}
+		if dv.tabs.Active() == metricsTab {
+			if cmd, ok := dv.metrics.HandleKey(dv.router.Context(dv), msg.String()); ok {
+				return dv, cmd
+			}
+		}
+		if a, ok := instanceActionFor(msg.String()); ok {
+			if dv.instance != nil {
+				confirmInstanceAction(dv.router.Context(dv), dv.client, dv.router, dv.region, a, []awsec2.EC2Instance{*dv.instance})
+			}
+			return dv, nil
+		}
 	}
 
 	var cmd tea.Cmd
 	dv.tabs, cmd = dv.tabs.Update(msg)
+	if dv.tabs.Active() == metricsTab {
+		cmd = tea.Batch(cmd, dv.metrics.LoadOnce(dv.router.Context(dv)))
+	}
 	return dv, cmd
 }
 
@@ -176,6 +215,8 @@ func (dv *DetailView) View() tea.View {
 		b.WriteString(dv.renderVolumes())
 	case 3:
 		b.WriteString(dv.renderTags())
+	case metricsTab:
+		b.WriteString(dv.metrics.View())
 	}
 
 	return tea.NewView(b.String())
@@ -283,10 +324,16 @@ func (dv *DetailView) KeyHints() []plugin.KeyHint {
 	hints := []plugin.KeyHint{
 		{Key: "esc", Desc: "back"},
 		{Key: "[/]", Desc: "switch tab"},
-		{Key: "1-4", Desc: "jump to tab"},
+		{Key: "1-5", Desc: "jump to tab"},
+	}
+	if dv.tabs.Active() == metricsTab {
+		hints = append(hints, dv.metrics.KeyHints()...)
 	}
 	if dv.instance != nil && dv.instance.State == "running" {
 		hints = append(hints, plugin.KeyHint{Key: "x", Desc: "SSM session"})
 	}
+	if dv.instance != nil {
+		hints = append(hints, actionHints(dv.router, dv.instance.State)...)
+	}
 	return hints
 }
@@ -7,18 +7,28 @@ import (
 	"charm.land/lipgloss/v2"
 
 	awsec2 "tasnim.dev/aws-tui/internal/aws/ec2"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
-// instancesMsg carries the result of fetching instances.
+// cacheKey is the cache service key for EC2 instances.
+const cacheKey = "ec2"
+
+// instancesMsg carries the result of fetching pages of instances. after is
+// the token the pages were fetched from, nil when they start the listing.
 type instancesMsg struct {
 	instances []awsec2.EC2Instance
+	after     *string
+	next      *string
 	err       error
 }
 
 // ListView displays EC2 instances in a table.
 type ListView struct {
+	plugin.Revalidation
+
 	client  EC2Client
 	router  plugin.Router
 	table   ui.TableView[awsec2.EC2Instance]
@@ -26,6 +36,9 @@ type ListView struct {
 	err     error
 	region  string
 	profile string
+	cache   *cache.Scope
+	next    *string
+	metrics metrics.Client // read by detail views; nil without CloudWatch
 }
 
 // NewListView creates a new EC2 ListView.
@@ -34,7 +47,7 @@ func NewListView(client EC2Client, router plugin.Router, region, profile string)
 	tv := ui.NewTableView(cols, nil, func(i awsec2.EC2Instance) string {
 		return i.InstanceID
 	})
-	return &ListView{
+	lv := &ListView{
 		client:  client,
 		router:  router,
 		table:   tv,
@@ -42,6 +55,9 @@ func NewListView(client EC2Client, router plugin.Router, region, profile string)
 		region:  region,
 		profile: profile,
 	}
+	lv.table.OnLoadMore(func() tea.Cmd { return lv.fetchInstances(lv.next, 0) })
+	lv.table.EnableMarks()
+	return lv
 }
 
 func ec2Columns() []ui.Column[awsec2.EC2Instance] {
@@ -78,29 +94,97 @@ func stateDot(state string) string {
 	}
 }
 
-func (lv *ListView) fetchInstances() tea.Cmd {
-	client := lv.client
+// fetchInstances fetches pages of instances after token until at least want
+// have been read. Pages starting the listing replace the cached rows; later
+// pages are merged into them.
+func (lv *ListView) fetchInstances(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
 	return func() tea.Msg {
-		instances, _, err := client.ListInstances(context.TODO())
-		return instancesMsg{instances: instances, err: err}
+		instances, next, err := plugin.FetchPages(ctx, router, after, want, func(ctx context.Context, token *string) ([]awsec2.EC2Instance, *string, error) {
+			instances, _, next, err := client.ListInstancesPage(ctx, token)
+			return instances, next, err
+		})
+		if err == nil {
+			store := cache.Store[awsec2.EC2Instance]
+			if after != nil {
+				store = cache.Merge[awsec2.EC2Instance]
+			}
+			_ = store(context.Background(), scope, cacheKey, instances, func(i awsec2.EC2Instance) (string, string) {
+				return i.InstanceID, i.Name
+			})
+		}
+		return instancesMsg{instances: instances, after: after, next: next, err: err}
 	}
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchInstances()
+	fetch := lv.fetchInstances(nil, lv.table.ItemCount())
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awsec2.EC2Instance](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awsec2.EC2Instance]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchInstances(nil, lv.table.ItemCount())
+		}
+		return lv, nil
+
 	case instancesMsg:
+		if msg.after != nil && msg.after != lv.next {
+			// A page of a listing that has since been refreshed.
+			return lv, nil
+		}
 		lv.loading = false
+		if msg.after == nil && lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
+			if msg.after != nil {
+				lv.table.SetMore(true)
+				lv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return lv, nil
+			}
 			lv.err = msg.err
 			return lv, nil
 		}
-		lv.table.SetItems(msg.instances)
+		lv.err = nil
+		if msg.after != nil {
+			lv.table.AppendItems(msg.instances)
+		} else {
+			lv.table.SetItems(msg.instances)
+		}
+		lv.next = msg.next
+		lv.table.SetMore(msg.next != nil)
 		return lv, nil
 
+	case actionDoneMsg:
+		if msg.err == nil {
+			lv.table.ClearMarks()
+		}
+		return lv, afterAction(lv.router.Context(lv), lv.client, lv.router, msg)
+
+	case statesMsg:
+		items := lv.table.Items()
+		for i := range items {
+			if state, ok := msg.states[items[i].InstanceID]; ok {
+				items[i].State = state
+			}
+		}
+		lv.table.SetItems(items)
+		lv.table.SetMore(lv.next != nil)
+		if cmd := afterStates(lv.router.Context(lv), lv.client, lv.router, msg); cmd != nil {
+			return lv, cmd
+		}
+		// Settled or given up: reload for the addresses that came or went.
+		return lv, lv.fetchInstances(nil, lv.table.ItemCount())
+
 	case tea.KeyPressMsg:
 		if lv.loading {
 			return lv, nil
@@ -110,6 +194,7 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			if id := lv.table.SelectedID(); id != "" {
 				view := NewDetailView(lv.client, lv.router, id, lv.region, lv.profile)
+				view.metrics.SetClient(lv.metrics)
 				lv.router.Push(view)
 				return lv, view.Init()
 			}
@@ -119,7 +204,17 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return lv, nil
 		case "r":
 			lv.loading = true
-			return lv, lv.fetchInstances()
+			return lv, lv.fetchInstances(nil, lv.table.ItemCount())
+		}
+		if a, ok := instanceActionFor(msg.String()); ok && !lv.table.Filtering() {
+			targets := lv.table.Marked()
+			if len(targets) == 0 && lv.table.FilteredCount() > 0 {
+				targets = []awsec2.EC2Instance{lv.table.SelectedItem()}
+			}
+			if len(targets) > 0 {
+				confirmInstanceAction(lv.router.Context(lv), lv.client, lv.router, lv.region, a, targets)
+			}
+			return lv, nil
 		}
 	}
 
@@ -142,10 +237,15 @@ func (lv *ListView) View() tea.View {
 func (lv *ListView) Title() string { return "EC2 Instances" }
 
 func (lv *ListView) KeyHints() []plugin.KeyHint {
-	return []plugin.KeyHint{
+	hints := []plugin.KeyHint{
 		{Key: "enter", Desc: "view details"},
 		{Key: "r", Desc: "refresh"},
 		{Key: "/", Desc: "filter"},
 		{Key: "s", Desc: "sort"},
 	}
+	if actions := actionHints(lv.router, "running", "stopped"); len(actions) > 0 {
+		hints = append(hints, plugin.KeyHint{Key: "space", Desc: "mark"})
+		hints = append(hints, actions...)
+	}
+	return hints
 }
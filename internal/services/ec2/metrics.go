@@ -0,0 +1,23 @@
+package ec2
+
+import (
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+)
+
+// metricsTab is the index of the detail view's Metrics tab.
+const metricsTab = 4
+
+// instanceCharts returns the charts of an instance's Metrics tab.
+func instanceCharts(instanceID string) []metrics.Chart {
+	dims := []cloudwatch.Dimension{{Name: "InstanceId", Value: instanceID}}
+	query := func(metric, stat string) cloudwatch.MetricQuery {
+		return cloudwatch.MetricQuery{Namespace: "AWS/EC2", Metric: metric, Dimensions: dims, Stat: stat}
+	}
+	return []metrics.Chart{
+		{Title: "CPU Utilization", Query: query("CPUUtilization", "Average"), Format: metrics.Percent},
+		{Title: "Network In", Query: query("NetworkIn", "Sum"), Format: metrics.Bytes},
+		{Title: "Network Out", Query: query("NetworkOut", "Sum"), Format: metrics.Bytes},
+		{Title: "Status Check Failed", Query: query("StatusCheckFailed", "Maximum"), Format: metrics.Count},
+	}
+}
@@ -6,20 +6,33 @@ import (
 
 	awsec2 "tasnim.dev/aws-tui/internal/aws/ec2"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/services/regional"
 )
 
 // EC2Client defines the subset of ec2.Client methods used by the plugin.
 type EC2Client interface {
 	ListInstances(ctx context.Context) ([]awsec2.EC2Instance, awsec2.EC2Summary, error)
+	ListInstancesPage(ctx context.Context, token *string) ([]awsec2.EC2Instance, awsec2.EC2Summary, *string, error)
 	GetInstanceVolumes(ctx context.Context, volumeIDs []string) ([]awsec2.EBSVolume, error)
+	StartInstances(ctx context.Context, ids []string) error
+	StopInstances(ctx context.Context, ids []string, hibernate bool) error
+	RebootInstances(ctx context.Context, ids []string) error
+	TerminateInstances(ctx context.Context, ids []string) error
+	InstanceStates(ctx context.Context, ids []string) (map[string]string, error)
 }
 
 // Plugin implements plugin.ServicePlugin for AWS EC2 instances.
 type Plugin struct {
-	client    EC2Client
-	instances []awsec2.EC2Instance
-	region    string
-	profile   string
+	plugin.CacheHolder
+
+	client     EC2Client
+	instances  []awsec2.EC2Instance
+	region     string
+	profile    string
+	scope      plugin.RegionScope
+	clientFor  func(region string) EC2Client
+	metricsFor metrics.ClientFor
 }
 
 // NewPlugin creates a new EC2 ServicePlugin.
@@ -27,6 +40,28 @@ func NewPlugin(client EC2Client, region, profile string) *Plugin {
 	return &Plugin{client: client, region: region, profile: profile}
 }
 
+// SetRegionalClients sets the factory used to reach other regions in
+// all-regions scope.
+func (p *Plugin) SetRegionalClients(clientFor func(region string) EC2Client) {
+	p.clientFor = clientFor
+}
+
+// SetMetricsClients sets the factory of the CloudWatch clients detail views
+// read metrics with.
+func (p *Plugin) SetMetricsClients(clientFor metrics.ClientFor) { p.metricsFor = clientFor }
+
+// metricsClient returns the CloudWatch client for region, nil when metrics
+// are unavailable.
+func (p *Plugin) metricsClient(region string) metrics.Client {
+	if p.metricsFor == nil {
+		return nil
+	}
+	return p.metricsFor(region)
+}
+
+// SetRegionScope implements plugin.MultiRegion.
+func (p *Plugin) SetRegionScope(scope plugin.RegionScope) { p.scope = scope }
+
 func (p *Plugin) ID() string   { return "ec2" }
 func (p *Plugin) Name() string { return "EC2" }
 func (p *Plugin) Icon() string { return "\U000F01C4" } // nf-mdi-desktop-tower
@@ -69,11 +104,34 @@ func mapSummary(summary awsec2.EC2Summary) plugin.ServiceSummary {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router, p.region, p.profile)
+	if p.scope.All() && p.clientFor != nil {
+		return p.regionalListView(router)
+	}
+	lv := NewListView(p.client, router, p.region, p.profile)
+	lv.cache = p.Cache()
+	lv.metrics = p.metricsClient(p.region)
+	return lv
+}
+
+// regionalListView lists instances across every region in the scope.
+func (p *Plugin) regionalListView(router plugin.Router) plugin.View {
+	return regional.NewListView(router, "EC2 Instances", p.scope, ec2Columns(),
+		func(i awsec2.EC2Instance) string { return i.InstanceID },
+		func(ctx context.Context, region string) ([]awsec2.EC2Instance, error) {
+			instances, _, err := p.clientFor(region).ListInstances(ctx)
+			return instances, err
+		},
+		func(router plugin.Router, region string, i awsec2.EC2Instance) plugin.View {
+			dv := NewDetailView(p.clientFor(region), router, i.InstanceID, region, p.profile)
+			dv.metrics.SetClient(p.metricsClient(region))
+			return dv
+		})
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
-	return NewDetailView(p.client, router, id, p.region, p.profile)
+	dv := NewDetailView(p.client, router, id, p.region, p.profile)
+	dv.metrics.SetClient(p.metricsClient(p.region))
+	return dv
 }
 
 func (p *Plugin) Commands() []plugin.Command {
@@ -1,11 +1,21 @@
 package ec2
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
 	awsec2 "tasnim.dev/aws-tui/internal/aws/ec2"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/services/regional"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -139,3 +149,391 @@ func TestPluginMetadata(t *testing.T) {
 	assert.Equal(t, "ec2", p.ID())
 	assert.Equal(t, "EC2", p.Name())
 }
+
+// --- list view cache tests ---
+
+type mockClient struct {
+	instances []awsec2.EC2Instance
+	pageSize  int // 0 returns every instance in one page
+	err       error
+	calls     int
+	actions   []string          // lifecycle calls, e.g. "stop i-1"
+	states    map[string]string // returned by InstanceStates
+}
+
+func (m *mockClient) ListInstances(_ context.Context) ([]awsec2.EC2Instance, awsec2.EC2Summary, error) {
+	m.calls++
+	return m.instances, awsec2.EC2Summary{}, m.err
+}
+func (m *mockClient) ListInstancesPage(_ context.Context, token *string) ([]awsec2.EC2Instance, awsec2.EC2Summary, *string, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, awsec2.EC2Summary{}, nil, m.err
+	}
+	if m.pageSize == 0 {
+		return m.instances, awsec2.EC2Summary{}, nil, nil
+	}
+	start := 0
+	if token != nil {
+		start, _ = strconv.Atoi(*token)
+	}
+	end := min(start+m.pageSize, len(m.instances))
+	var next *string
+	if end < len(m.instances) {
+		next = aws.String(strconv.Itoa(end))
+	}
+	return m.instances[start:end], awsec2.EC2Summary{}, next, nil
+}
+func (m *mockClient) GetInstanceVolumes(_ context.Context, _ []string) ([]awsec2.EBSVolume, error) {
+	return nil, nil
+}
+func (m *mockClient) StartInstances(_ context.Context, ids []string) error {
+	return m.record("start", ids)
+}
+func (m *mockClient) StopInstances(_ context.Context, ids []string, hibernate bool) error {
+	if hibernate {
+		return m.record("hibernate", ids)
+	}
+	return m.record("stop", ids)
+}
+func (m *mockClient) RebootInstances(_ context.Context, ids []string) error {
+	return m.record("reboot", ids)
+}
+func (m *mockClient) TerminateInstances(_ context.Context, ids []string) error {
+	return m.record("terminate", ids)
+}
+func (m *mockClient) InstanceStates(_ context.Context, _ []string) (map[string]string, error) {
+	return m.states, m.err
+}
+func (m *mockClient) record(verb string, ids []string) error {
+	for _, id := range ids {
+		m.actions = append(m.actions, verb+" "+id)
+	}
+	return m.err
+}
+
+type mockRouter struct {
+	toasts   []string
+	offline  bool
+	readOnly bool
+	confirms []plugin.Action
+}
+
+func (m *mockRouter) Push(_ plugin.View)                    {}
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_ string, _ string)     {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, msg string) { m.toasts = append(m.toasts, msg) }
+func (m *mockRouter) Offline() bool                         { return m.offline }
+func (m *mockRouter) ReadOnly() bool                        { return m.readOnly }
+func (m *mockRouter) Confirm(action plugin.Action) {
+	if m.readOnly {
+		m.toasts = append(m.toasts, action.Title+" is disabled in read-only mode")
+		return
+	}
+	m.confirms = append(m.confirms, action)
+}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func TestListView_StaleWhileRevalidate(t *testing.T) {
+	db, err := cache.NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	scope := cache.NewScope(db, "us-east-1", "default", nil)
+	cached := []awsec2.EC2Instance{{InstanceID: "i-cached", Name: "cached"}}
+	require.NoError(t, cache.Store(context.Background(), scope, cacheKey, cached, func(i awsec2.EC2Instance) (string, string) {
+		return i.InstanceID, i.Name
+	}))
+
+	client := &mockClient{instances: []awsec2.EC2Instance{{InstanceID: "i-live", Name: "live"}}}
+	router := &mockRouter{}
+	p := NewPlugin(client, "us-east-1", "default")
+	p.SetCache(scope)
+	lv := p.ListView(router).(*ListView)
+
+	// A fresh cache entry is rendered without calling the API.
+	msg := lv.Init()()
+	require.IsType(t, plugin.CachedItems[awsec2.EC2Instance]{}, msg)
+	_, cmd := lv.Update(msg)
+	assert.Nil(t, cmd)
+	assert.False(t, lv.loading)
+	assert.False(t, lv.Stale())
+	assert.Equal(t, "i-cached", lv.table.SelectedID())
+	assert.Equal(t, 0, client.calls)
+
+	// An expired entry is shown as stale and revalidated.
+	_, cmd = lv.Update(plugin.CachedItems[awsec2.EC2Instance]{Items: cached, CacheEntry: plugin.CacheEntry{FetchedAt: time.Now().Add(-time.Hour)}})
+	assert.True(t, lv.Stale())
+	require.NotNil(t, cmd)
+	lv.Update(cmd())
+	assert.False(t, lv.Stale())
+	assert.Equal(t, "i-live", lv.table.SelectedID())
+	assert.Equal(t, 1, client.calls)
+
+	// The live result replaces the cached rows.
+	got, _, err := cache.Load[awsec2.EC2Instance](context.Background(), scope, cacheKey)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "i-live", got[0].InstanceID)
+
+	// A failed refresh keeps the rows and marks them stale.
+	client.err = errors.New("boom")
+	lv.Update(lv.Init()())
+	assert.True(t, lv.Stale())
+	assert.Nil(t, lv.err)
+	assert.Equal(t, "i-live", lv.table.SelectedID())
+	assert.Len(t, router.toasts, 1)
+}
+
+func TestListView_OfflineServesCache(t *testing.T) {
+	db, err := cache.NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	scope := cache.NewScope(db, "us-east-1", "default", nil)
+	cached := []awsec2.EC2Instance{{InstanceID: "i-cached", Name: "cached"}}
+	require.NoError(t, cache.Store(context.Background(), scope, cacheKey, cached, func(i awsec2.EC2Instance) (string, string) {
+		return i.InstanceID, i.Name
+	}))
+
+	client := &mockClient{}
+	router := &mockRouter{offline: true}
+	p := NewPlugin(client, "us-east-1", "default")
+	p.SetCache(scope)
+	lv := p.ListView(router).(*ListView)
+
+	// An expired entry is shown as stale without trying to revalidate.
+	_, cmd := lv.Update(plugin.CachedItems[awsec2.EC2Instance]{Items: cached, CacheEntry: plugin.CacheEntry{FetchedAt: time.Now().Add(-time.Hour)}})
+	assert.Nil(t, cmd)
+	assert.True(t, lv.Stale())
+	assert.Equal(t, "i-cached", lv.table.SelectedID())
+	assert.Equal(t, 0, client.calls)
+}
+
+func TestListView_LoadsPagesIncrementally(t *testing.T) {
+	db, err := cache.NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	instances := make([]awsec2.EC2Instance, 25)
+	for i := range instances {
+		instances[i] = awsec2.EC2Instance{InstanceID: fmt.Sprintf("i-%02d", i)}
+	}
+	client := &mockClient{instances: instances, pageSize: 10}
+	scope := cache.NewScope(db, "us-east-1", "default", nil)
+	p := NewPlugin(client, "us-east-1", "default")
+	p.SetCache(scope)
+	lv := p.ListView(&mockRouter{}).(*ListView)
+
+	// Only the first page is fetched up front.
+	lv.Update(lv.Init()())
+	assert.Equal(t, 10, lv.table.ItemCount())
+	assert.True(t, lv.table.HasMore())
+	assert.Equal(t, 1, client.calls)
+
+	// Scrolling towards the end fetches the next page.
+	var cmd tea.Cmd
+	for range 5 {
+		_, cmd = lv.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	}
+	require.NotNil(t, cmd)
+	lv.Update(cmd())
+	assert.Equal(t, 20, lv.table.ItemCount())
+
+	// A refresh re-reads as many pages as are loaded.
+	lv.Update(lv.Init()())
+	assert.Equal(t, 20, lv.table.ItemCount())
+	assert.True(t, lv.table.HasMore())
+
+	// Every loaded page is cached.
+	got, _, err := cache.Load[awsec2.EC2Instance](context.Background(), scope, cacheKey)
+	require.NoError(t, err)
+	assert.Len(t, got, 20)
+}
+
+func TestListView_AllRegionsScope(t *testing.T) {
+	clients := map[string]*mockClient{
+		"us-east-1": {instances: []awsec2.EC2Instance{{InstanceID: "i-east"}}},
+		"eu-west-1": {instances: []awsec2.EC2Instance{{InstanceID: "i-west"}}},
+	}
+	p := NewPlugin(&mockClient{}, "us-east-1", "default")
+	p.SetRegionalClients(func(region string) EC2Client { return clients[region] })
+
+	// Without a scope the session region's list view is used.
+	require.IsType(t, &ListView{}, p.ListView(&mockRouter{}))
+
+	p.SetRegionScope(plugin.RegionScope{Regions: []string{"us-east-1", "eu-west-1"}, Concurrency: 2})
+	lv := p.ListView(&mockRouter{})
+	require.IsType(t, &regional.ListView[awsec2.EC2Instance]{}, lv)
+	lv.Update(lv.Init()())
+	assert.Contains(t, lv.View().Content, "2 resources in 2 of 2 regions")
+	assert.Equal(t, 1, clients["us-east-1"].calls)
+	assert.Equal(t, 1, clients["eu-west-1"].calls)
+
+	p.SetRegionScope(plugin.RegionScope{})
+	assert.IsType(t, &ListView{}, p.ListView(&mockRouter{}))
+}
+
+func TestDetailView_ExecDisabledOffline(t *testing.T) {
+	router := &mockRouter{offline: true}
+	dv := NewDetailView(&mockClient{}, router, "i-1", "us-east-1", "default")
+	dv.instance = &awsec2.EC2Instance{InstanceID: "i-1", State: "running"}
+
+	_, cmd := dv.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	assert.Nil(t, cmd)
+	assert.Len(t, router.toasts, 1)
+}
+
+func TestListView_StopsMarkedInstances(t *testing.T) {
+	client := &mockClient{instances: []awsec2.EC2Instance{
+		{InstanceID: "i-1", Name: "web-1", State: "running"},
+		{InstanceID: "i-2", Name: "web-2", State: "stopped"},
+		{InstanceID: "i-3", Name: "web-3", State: "running"},
+	}}
+	router := &mockRouter{}
+	lv := NewPlugin(client, "us-east-1", "default").ListView(router).(*ListView)
+	lv.Update(lv.Init()())
+
+	// Mark every instance; only the running ones can be stopped.
+	for range 3 {
+		lv.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	}
+	_, cmd := lv.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	assert.Nil(t, cmd)
+	require.Len(t, router.confirms, 1)
+	action := router.confirms[0]
+	assert.Equal(t, "Stop 2 instances", action.Title)
+	assert.Equal(t, "stop", action.Phrase)
+	assert.Equal(t, []string{"i-1  web-1", "i-3  web-3"}, action.Targets)
+
+	// Running the confirmed action clears the marks and polls the states.
+	_, cmd = lv.Update(action.Run()())
+	assert.Equal(t, []string{"stop i-1", "stop i-3"}, client.actions)
+	assert.Empty(t, lv.table.Marked())
+	assert.NotNil(t, cmd)
+
+	// Intermediate states are shown while polling continues.
+	_, cmd = lv.Update(statesMsg{action: actionFor(t, "D"), ids: []string{"i-1", "i-3"},
+		states: map[string]string{"i-1": "stopping", "i-3": "stopped"}, poll: 1})
+	assert.Equal(t, "stopping", lv.table.Items()[0].State)
+	require.NotNil(t, cmd)
+
+	// Once settled the list is reloaded.
+	client.calls = 0
+	_, cmd = lv.Update(statesMsg{action: actionFor(t, "D"), ids: []string{"i-1", "i-3"},
+		states: map[string]string{"i-1": "stopped", "i-3": "stopped"}, poll: 2})
+	require.NotNil(t, cmd)
+	require.IsType(t, instancesMsg{}, cmd())
+	assert.Equal(t, 1, client.calls)
+}
+
+func TestDetailView_TerminateConfirmsInstanceID(t *testing.T) {
+	client := &mockClient{}
+	router := &mockRouter{}
+	dv := NewDetailView(client, router, "i-1", "us-east-1", "default")
+	dv.instance = &awsec2.EC2Instance{InstanceID: "i-1", State: "stopped"}
+
+	// Actions that do not apply to the state are refused.
+	dv.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Cannot stop an instance that is stopped"}, router.toasts)
+
+	dv.Update(tea.KeyPressMsg{Code: 'X', Text: "X"})
+	require.Len(t, router.confirms, 1)
+	assert.Equal(t, "Terminate instance", router.confirms[0].Title)
+	assert.Equal(t, "i-1", router.confirms[0].Phrase)
+
+	dv.Update(router.confirms[0].Run()())
+	assert.Equal(t, []string{"terminate i-1"}, client.actions)
+
+	_, cmd := dv.Update(statesMsg{action: actionFor(t, "X"), ids: []string{"i-1"},
+		states: map[string]string{"i-1": "terminated"}, poll: 1})
+	assert.Nil(t, cmd)
+	assert.Equal(t, "terminated", dv.instance.State)
+}
+
+func TestDetailView_ActionNamesViewRegion(t *testing.T) {
+	router := &mockRouter{}
+	dv := NewDetailView(&mockClient{}, router, "i-1", "eu-north-1", "default")
+	dv.instance = &awsec2.EC2Instance{InstanceID: "i-1", State: "running"}
+
+	dv.Update(tea.KeyPressMsg{Code: 'X', Text: "X"})
+	require.Len(t, router.confirms, 1)
+	assert.Equal(t, "eu-north-1", router.confirms[0].Region)
+}
+
+func TestDetailView_ActionsRespectReadOnlyAndOffline(t *testing.T) {
+	router := &mockRouter{readOnly: true}
+	dv := NewDetailView(&mockClient{}, router, "i-1", "us-east-1", "default")
+	dv.instance = &awsec2.EC2Instance{InstanceID: "i-1", State: "running"}
+
+	for _, h := range dv.KeyHints() {
+		assert.NotEqual(t, "D", h.Key)
+	}
+	dv.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Stop instance is disabled in read-only mode"}, router.toasts)
+
+	router = &mockRouter{offline: true}
+	dv = NewDetailView(&mockClient{}, router, "i-1", "us-east-1", "default")
+	dv.instance = &awsec2.EC2Instance{InstanceID: "i-1", State: "running"}
+	dv.Update(tea.KeyPressMsg{Code: 'B', Text: "B"})
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Reboot is unavailable offline"}, router.toasts)
+}
+
+// action0 returns the lifecycle action bound to key.
+func actionFor(t *testing.T, key string) instanceAction {
+	t.Helper()
+	a, ok := instanceActionFor(key)
+	require.True(t, ok)
+	return a
+}
+
+// mockMetrics returns three datapoints for every query.
+type mockMetrics struct {
+	queries []cloudwatch.MetricQuery
+	ranges  []string
+}
+
+func (m *mockMetrics) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, r cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries = queries
+	m.ranges = append(m.ranges, r.Label)
+	series := make([]cloudwatch.MetricSeries, len(queries))
+	for i := range series {
+		series[i] = cloudwatch.MetricSeries{ID: queries[i].ID, Values: []float64{1, 2, 3}}
+	}
+	return series, nil
+}
+
+func TestDetailView_MetricsTab(t *testing.T) {
+	cw := &mockMetrics{}
+	var regions []string
+	p := NewPlugin(&mockClient{}, "us-east-1", "default")
+	p.SetMetricsClients(func(region string) metrics.Client {
+		regions = append(regions, region)
+		return cw
+	})
+	dv := p.DetailView(&mockRouter{}, "i-1").(*DetailView)
+	dv.Update(detailLoadedMsg{instance: awsec2.EC2Instance{InstanceID: "i-1", State: "running"}})
+
+	_, cmd := dv.Update(tea.KeyPressMsg{Code: '5', Text: "5"})
+	require.NotNil(t, cmd)
+	dv.Update(cmd())
+	assert.Equal(t, []string{"us-east-1"}, regions)
+	require.Len(t, cw.queries, 4)
+	assert.Equal(t, "CPUUtilization", cw.queries[0].Metric)
+	assert.Equal(t, []cloudwatch.Dimension{{Name: "InstanceId", Value: "i-1"}}, cw.queries[0].Dimensions)
+	assert.Equal(t, "StatusCheckFailed", cw.queries[3].Metric)
+	assert.Contains(t, dv.View().Content, "Network Out")
+	assert.Contains(t, dv.KeyHints(), plugin.KeyHint{Key: "t/T", Desc: "time range (1h)"})
+
+	// Leaving and returning to the tab does not reload; t selects 6h.
+	dv.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	_, cmd = dv.Update(tea.KeyPressMsg{Code: '5', Text: "5"})
+	assert.Nil(t, cmd)
+	_, cmd = dv.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	dv.Update(cmd())
+	assert.Equal(t, []string{"1h", "6h"}, cw.ranges)
+}
@@ -68,10 +68,15 @@ func imageColumns() []ui.Column[awsecr.ECRImage] {
 }
 
 func (dv *DetailView) fetchImages() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	repoName := dv.repoName
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		images, err := client.ListImages(context.Background(), repoName)
+		var images []awsecr.ECRImage
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			images, err = client.ListImages(ctx, repoName)
+			return err
+		})
 		return imagesMsg{images: images, err: err}
 	}
 }
@@ -7,10 +7,14 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	awsecr "tasnim.dev/aws-tui/internal/aws/ecr"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
+// cacheKey is the cache service key for ECR repositories.
+const cacheKey = "ecr"
+
 // reposMsg carries the result of fetching repositories.
 type reposMsg struct {
 	repos []awsecr.ECRRepo
@@ -19,11 +23,14 @@ type reposMsg struct {
 
 // ListView displays ECR repositories in a table.
 type ListView struct {
+	plugin.Revalidation
+
 	client  ECRClient
 	router  plugin.Router
 	table   ui.TableView[awsecr.ECRRepo]
 	loading bool
 	err     error
+	cache   *cache.Scope
 }
 
 // NewListView creates a new ECR ListView.
@@ -57,25 +64,51 @@ func repoColumns() []ui.Column[awsecr.ECRRepo] {
 }
 
 func (lv *ListView) fetchRepos() tea.Cmd {
-	client := lv.client
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
 	return func() tea.Msg {
-		repos, err := client.ListRepositories(context.Background())
+		var repos []awsecr.ECRRepo
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			repos, err = client.ListRepositories(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, repos, func(r awsecr.ECRRepo) (string, string) {
+				return r.Name, r.Name
+			})
+		}
 		return reposMsg{repos: repos, err: err}
 	}
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchRepos()
+	fetch := lv.fetchRepos()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awsecr.ECRRepo](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awsecr.ECRRepo]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchRepos()
+		}
+		return lv, nil
+
 	case reposMsg:
 		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
 			lv.err = msg.err
 			return lv, nil
 		}
+		lv.err = nil
 		lv.table.SetItems(msg.repos)
 		return lv, nil
 
@@ -16,6 +16,8 @@ type ECRClient interface {
 
 // Plugin implements plugin.ServicePlugin for Amazon ECR.
 type Plugin struct {
+	plugin.CacheHolder
+
 	client ECRClient
 }
 
@@ -48,7 +50,9 @@ func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router)
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
@@ -0,0 +1,255 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"tasnim.dev/aws-tui/internal/aws/ecs"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// deploymentsTab is the index of the Deployments tab in a service's detail
+// view.
+const deploymentsTab = 1
+
+// Prompts that collect the input of an action before it is confirmed.
+const (
+	promptDesiredCount = "desired-count"
+	promptStopReason   = "stop-reason"
+)
+
+// actionDoneMsg carries the result of an action. done describes it for the
+// toast shown on success.
+type actionDoneMsg struct {
+	done string
+	err  error
+}
+
+// rolloutTickMsg is sent when the Deployments tab is due a refresh during a
+// rollout.
+type rolloutTickMsg struct{}
+
+func (v *DetailView) onDeploymentsTab() bool {
+	return !v.isTask && v.tabs.Active() == deploymentsTab
+}
+
+// rolloutInProgress reports whether the service is still replacing tasks:
+// a deployment reports an in-progress rollout, or an older one has not yet
+// drained.
+func (v *DetailView) rolloutInProgress() bool {
+	d := v.serviceDetail
+	if d == nil {
+		return false
+	}
+	for _, dep := range d.Deployments {
+		if dep.RolloutState == "IN_PROGRESS" {
+			return true
+		}
+	}
+	return len(d.Deployments) > 1
+}
+
+// watchRollout schedules a refresh of the service after rolloutInterval while
+// a rollout is in progress and the Deployments tab is shown.
+func (v *DetailView) watchRollout() tea.Cmd {
+	if v.rolloutWatching || v.rolloutInterval <= 0 || !v.onDeploymentsTab() || !v.rolloutInProgress() || v.router.Offline() {
+		return nil
+	}
+	v.rolloutWatching = true
+	return tea.Tick(v.rolloutInterval, func(time.Time) tea.Msg { return rolloutTickMsg{} })
+}
+
+// handleActionKey starts the action bound to key, if any, reporting whether
+// the key was handled.
+func (v *DetailView) handleActionKey(key string) bool {
+	switch {
+	case v.isTask && key == "D":
+		if d := v.taskDetail; d != nil && taskStoppable(d.Status) && v.actionAvailable("Stop task") {
+			in := ui.NewInput("Stop task "+d.TaskID, "Reason:", false)
+			v.prompt, v.promptFor = &in, promptStopReason
+		}
+		return true
+	case v.isTask:
+		return false
+	case key == "e":
+		if d := v.serviceDetail; d != nil && v.actionAvailable("Scaling") {
+			in := ui.NewInput(fmt.Sprintf("Scale %s (desired %d)", d.Name, d.DesiredCount), "Desired count:", false)
+			v.prompt, v.promptFor = &in, promptDesiredCount
+		}
+		return true
+	case key == "F":
+		if d := v.serviceDetail; d != nil && v.actionAvailable("Force new deployment") {
+			v.confirmServiceAction("Force new deployment", d.Name+": replace every task", "Forced a new deployment of "+d.Name,
+				func(ctx context.Context, client ECSClient, cluster string) error {
+					return client.ForceNewDeployment(ctx, cluster, d.Name)
+				})
+		}
+		return true
+	case key == "B" && v.onDeploymentsTab():
+		v.rollback()
+		return true
+	case (key == "j" || key == "down") && v.onDeploymentsTab():
+		if d := v.serviceDetail; d != nil && v.deploymentCursor < len(d.Deployments)-1 {
+			v.deploymentCursor++
+		}
+		return true
+	case (key == "k" || key == "up") && v.onDeploymentsTab():
+		if v.deploymentCursor > 0 {
+			v.deploymentCursor--
+		}
+		return true
+	}
+	return false
+}
+
+// actionAvailable reports whether mutating actions may run, with a toast
+// naming what is unavailable while read-only or offline. It is checked
+// before any prompt opens.
+func (v *DetailView) actionAvailable(what string) bool {
+	switch {
+	case v.router.ReadOnly():
+		v.router.Toast(plugin.ToastWarning, what+" is disabled in read-only mode")
+		return false
+	case v.router.Offline():
+		v.router.Toast(plugin.ToastWarning, what+" is unavailable offline")
+		return false
+	}
+	return true
+}
+
+// taskStoppable reports whether a task in the given status can be stopped.
+func taskStoppable(status string) bool {
+	switch status {
+	case "STOPPING", "DEPROVISIONING", "STOPPED":
+		return false
+	}
+	return true
+}
+
+// rollback confirms deploying the task definition of the selected
+// deployment.
+func (v *DetailView) rollback() {
+	d := v.serviceDetail
+	if d == nil || v.deploymentCursor >= len(d.Deployments) || !v.actionAvailable("Rollback") {
+		return
+	}
+	taskDef := d.Deployments[v.deploymentCursor].TaskDef
+	if taskDef == d.TaskDef {
+		v.router.Toast(plugin.ToastWarning, d.Name+" is already on "+taskDef+"; select an earlier deployment")
+		return
+	}
+	v.confirmServiceAction("Roll back service", fmt.Sprintf("%s: %s → %s", d.Name, d.TaskDef, taskDef), "Rolling back "+d.Name+" to "+taskDef,
+		func(ctx context.Context, client ECSClient, cluster string) error {
+			return client.SetTaskDefinition(ctx, cluster, d.Name, taskDef)
+		})
+}
+
+// answerPrompt confirms the action the prompt collected input for.
+func (v *DetailView) answerPrompt(res ui.InputResult) {
+	promptFor := v.promptFor
+	v.prompt, v.promptFor = nil, ""
+	if res.Canceled {
+		return
+	}
+	switch promptFor {
+	case promptDesiredCount:
+		d := v.serviceDetail
+		count, err := strconv.Atoi(strings.TrimSpace(res.Value))
+		if err != nil || count < 0 {
+			v.router.Toast(plugin.ToastError, "Desired count must be a whole number, not "+res.Value)
+			return
+		}
+		v.confirmServiceAction("Scale service", fmt.Sprintf("%s: desired %d → %d", d.Name, d.DesiredCount, count), fmt.Sprintf("Scaling %s to %d", d.Name, count),
+			func(ctx context.Context, client ECSClient, cluster string) error {
+				return client.SetDesiredCount(ctx, cluster, d.Name, count)
+			})
+	case promptStopReason:
+		d := v.taskDetail
+		cluster, _ := parseID(v.id)
+		client, ctx, reason := v.client, v.router.Context(v), res.Value
+		v.router.Confirm(plugin.Action{
+			Title:   "Stop task",
+			Targets: []string{d.TaskID + "  " + d.TaskDef, "Reason: " + reason},
+			Phrase:  d.TaskID,
+			Run: func() tea.Cmd {
+				return func() tea.Msg {
+					err := client.StopTask(ctx, cluster, d.TaskARN, reason)
+					return actionDoneMsg{done: "Stopping task " + d.TaskID, err: err}
+				}
+			},
+		})
+	}
+}
+
+// confirmServiceAction asks the user to confirm run by typing the service
+// name. done is toasted once it succeeds.
+func (v *DetailView) confirmServiceAction(title, target, done string, run func(ctx context.Context, client ECSClient, cluster string) error) {
+	cluster, service := parseID(v.id)
+	client, ctx := v.client, v.router.Context(v)
+	v.router.Confirm(plugin.Action{
+		Title:   title,
+		Targets: []string{target},
+		Phrase:  service,
+		Run: func() tea.Cmd {
+			return func() tea.Msg {
+				return actionDoneMsg{done: done, err: run(ctx, client, cluster)}
+			}
+		},
+	})
+}
+
+// afterAction reports the result of an action and reloads the resource it
+// changed.
+func (v *DetailView) afterAction(msg actionDoneMsg) tea.Cmd {
+	if msg.err != nil {
+		v.router.Toast(plugin.ToastError, "Action failed: "+msg.err.Error())
+		return nil
+	}
+	v.router.Toast(plugin.ToastInfo, msg.done)
+	return v.Init()
+}
+
+// actionHints returns the hints for the actions available in the view, or
+// none while read-only.
+func (v *DetailView) actionHints() []plugin.KeyHint {
+	if v.router.ReadOnly() {
+		return nil
+	}
+	if v.isTask {
+		if v.taskDetail != nil && taskStoppable(v.taskDetail.Status) {
+			return []plugin.KeyHint{{Key: "D", Desc: "stop task"}}
+		}
+		return nil
+	}
+	if v.serviceDetail == nil {
+		return nil
+	}
+	hints := []plugin.KeyHint{
+		{Key: "e", Desc: "edit desired count"},
+		{Key: "F", Desc: "force new deployment"},
+	}
+	if v.onDeploymentsTab() && len(v.serviceDetail.Deployments) > 1 {
+		hints = append(hints,
+			plugin.KeyHint{Key: "j/k", Desc: "select deployment"},
+			plugin.KeyHint{Key: "B", Desc: "roll back to selected"},
+		)
+	}
+	return hints
+}
+
+// deploymentMarker returns the prefix marking whether the deployment at
+// index i is selected for rollback.
+func (v *DetailView) deploymentMarker(d *ecs.ECSServiceDetail, i int) string {
+	if len(d.Deployments) < 2 {
+		return ""
+	}
+	if i == v.deploymentCursor {
+		return "▸ "
+	}
+	return "  "
+}
@@ -10,6 +10,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"tasnim.dev/aws-tui/internal/aws/ecs"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
@@ -42,10 +43,38 @@ type DetailView struct {
 	err     error
 	region  string
 	profile string
+	width   int
+	height  int
 
 	serviceDetail *ecs.ECSServiceDetail
 	taskDetail    *ecs.ECSTaskDetail
 	isTask        bool
+
+	// Logs tab state, one entry per task container.
+	logClient  LogsClient
+	logViews   []ui.LogView
+	logTokens  []string
+	logLoaded  []bool
+	logIdx     int  // container shown
+	logGen     int  // current poll chain
+	logPolling bool // a poll chain is running
+	logErr     error
+
+	// Auto Scaling tab state.
+	scalingClient  AutoScalingClient
+	scaling        *serviceScaling
+	scalingLoading bool
+	scalingErr     error
+
+	// Metrics tab state; a task's panel has no charts.
+	metrics *metrics.Panel
+
+	// Operational actions; see actions.go.
+	prompt           *ui.Input // collects an action's input before it is confirmed
+	promptFor        string
+	deploymentCursor int           // the deployment B rolls back to
+	rolloutInterval  time.Duration // refresh interval of the Deployments tab during a rollout
+	rolloutWatching  bool          // a refresh is scheduled
 }
 
 // NewDetailView creates a detail view. The id is expected in format "cluster/resourceID".
@@ -54,10 +83,12 @@ func NewDetailView(client ECSClient, router plugin.Router, id, region, profile s
 	isTask := strings.Contains(id, ":task/")
 
 	var tabTitles []string
+	var charts []metrics.Chart
 	if isTask {
-		tabTitles = []string{"Overview", "Containers"}
+		tabTitles = []string{"Overview", "Containers", "Logs"}
 	} else {
-		tabTitles = []string{"Overview", "Deployments", "Events"}
+		tabTitles = []string{"Overview", "Deployments", "Events", "Auto Scaling", "Metrics"}
+		charts = serviceCharts(parseID(id))
 	}
 
 	return &DetailView{
@@ -69,6 +100,7 @@ func NewDetailView(client ECSClient, router plugin.Router, id, region, profile s
 		isTask:  isTask,
 		region:  region,
 		profile: profile,
+		metrics: metrics.NewPanel(router, charts),
 	}
 }
 
@@ -90,6 +122,24 @@ func (v *DetailView) KeyHints() []plugin.KeyHint {
 	if v.isTask && v.taskDetail != nil && v.taskDetail.Status == "RUNNING" {
 		hints = append(hints, plugin.KeyHint{Key: "x", Desc: "exec into task"})
 	}
+	if v.onScalingTab() {
+		hints = append(hints, plugin.KeyHint{Key: "r", Desc: "refresh"})
+	}
+	if v.onMetricsTab() {
+		hints = append(hints, v.metrics.KeyHints()...)
+	}
+	hints = append(hints, v.actionHints()...)
+	if v.onLogsTab() {
+		hints = append(hints,
+			plugin.KeyHint{Key: "c", Desc: "next container"},
+			plugin.KeyHint{Key: "p", Desc: "pause/resume"},
+			plugin.KeyHint{Key: "/", Desc: "filter"},
+			plugin.KeyHint{Key: "w", Desc: "toggle wrap"},
+			plugin.KeyHint{Key: "t", Desc: "jump to time"},
+			plugin.KeyHint{Key: "g/G", Desc: "oldest/follow"},
+			plugin.KeyHint{Key: "S", Desc: "save to file"},
+		)
+	}
 	return hints
 }
 
@@ -111,24 +161,40 @@ func (v *DetailView) Init() tea.Cmd {
 }
 
 func (v *DetailView) fetchServiceDetail(cluster, service string) tea.Cmd {
+	router := v.router
+	viewCtx := router.Context(v)
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
 		defer cancel()
-		detail, err := v.client.DescribeService(ctx, cluster, service)
+		var detail *ecs.ECSServiceDetail
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			detail, err = v.client.DescribeService(ctx, cluster, service)
+			return err
+		})
 		return serviceDetailLoadedMsg{detail: detail, err: err}
 	}
 }
 
 func (v *DetailView) fetchTaskDetail(cluster, taskARN string) tea.Cmd {
+	router := v.router
+	viewCtx := router.Context(v)
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
 		defer cancel()
-		detail, err := v.client.DescribeTask(ctx, cluster, taskARN)
+		var detail *ecs.ECSTaskDetail
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			detail, err = v.client.DescribeTask(ctx, cluster, taskARN)
+			return err
+		})
 		return taskDetailLoadedMsg{detail: detail, err: err}
 	}
 }
 
 func (v *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if v.metrics.Update(msg) {
+		return v, nil
+	}
+
 	switch msg := msg.(type) {
 	case serviceDetailLoadedMsg:
 		v.loading = false
@@ -137,6 +203,32 @@ func (v *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return v, nil
 		}
 		v.serviceDetail = msg.detail
+		v.deploymentCursor = min(v.deploymentCursor, max(len(msg.detail.Deployments)-1, 0))
+		return v, v.watchRollout()
+
+	case rolloutTickMsg:
+		v.rolloutWatching = false
+		if v.onDeploymentsTab() && v.rolloutInProgress() {
+			return v, v.Init()
+		}
+		return v, nil
+
+	case actionDoneMsg:
+		return v, v.afterAction(msg)
+
+	case ui.InputResult:
+		if v.prompt != nil {
+			v.answerPrompt(msg)
+		}
+		return v, nil
+
+	case scalingLoadedMsg:
+		v.scalingLoading = false
+		if msg.err != nil {
+			v.scalingErr = msg.err
+			return v, nil
+		}
+		v.scaling = msg.scaling
 		return v, nil
 
 	case taskDetailLoadedMsg:
@@ -146,6 +238,19 @@ func (v *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return v, nil
 		}
 		v.taskDetail = msg.detail
+		if len(v.logViews) != len(msg.detail.Containers) {
+			// First load; a reload keeps the buffered logs.
+			v.initLogs()
+		}
+		return v, v.startLogs()
+
+	case logEventsMsg, logTickMsg, ui.LogJumpMsg, ui.LogSavedMsg:
+		return v, v.updateLogs(msg)
+
+	case tea.WindowSizeMsg:
+		v.width, v.height = msg.Width, msg.Height
+		v.resizeLogs()
+		v.metrics.SetWidth(msg.Width)
 		return v, nil
 
 	case ecsExecFinishedMsg:
@@ -155,19 +260,51 @@ func (v *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return v, nil
 
 	case tea.KeyPressMsg:
+		if v.prompt != nil {
+			in, cmd := v.prompt.Update(msg)
+			v.prompt = &in
+			return v, cmd
+		}
+		if v.CapturingInput() {
+			return v, v.handleLogKey(msg)
+		}
 		switch msg.String() {
 		case "esc", "backspace":
 			v.router.Pop()
 			return v, nil
 		case "x":
+			if v.router.Offline() {
+				v.router.Toast(plugin.ToastWarning, "ECS exec is unavailable offline")
+				return v, nil
+			}
 			if v.isTask && v.taskDetail != nil && v.taskDetail.Status == "RUNNING" && len(v.taskDetail.Containers) > 0 {
 				return v, v.execTask()
 			}
 			return v, nil
+		case "r":
+			if v.onScalingTab() && !v.scalingLoading {
+				v.scaling = nil
+				return v, v.loadScaling()
+			}
+		}
+		if v.onMetricsTab() {
+			if cmd, ok := v.metrics.HandleKey(v.router.Context(v), msg.String()); ok {
+				return v, cmd
+			}
+		}
+		if v.handleActionKey(msg.String()) {
+			return v, nil
 		}
 
+		prev := v.tabs.Active()
 		var cmd tea.Cmd
 		v.tabs, cmd = v.tabs.Update(msg)
+		if v.tabs.Active() != prev {
+			return v, tea.Batch(cmd, v.startLogs(), v.loadScaling(), v.loadMetrics(), v.watchRollout())
+		}
+		if v.onLogsTab() {
+			return v, v.handleLogKey(msg)
+		}
 		return v, cmd
 	}
 
@@ -215,6 +352,10 @@ func (v *DetailView) View() tea.View {
 	} else if v.serviceDetail != nil {
 		b.WriteString(v.renderServiceTab())
 	}
+	if v.prompt != nil {
+		b.WriteString("\n")
+		b.WriteString(v.prompt.View())
+	}
 
 	return tea.NewView(b.String())
 }
@@ -230,6 +371,10 @@ func (v *DetailView) renderServiceTab() string {
 		return v.renderDeployments(d)
 	case 2: // Events
 		return v.renderEvents(d)
+	case 3: // Auto Scaling
+		return v.renderScaling(d)
+	case 4: // Metrics
+		return v.metrics.View()
 	}
 	return ""
 }
@@ -260,8 +405,8 @@ func (v *DetailView) renderDeployments(d *ecs.ECSServiceDetail) string {
 		return "No deployments."
 	}
 	var b strings.Builder
-	for _, dep := range d.Deployments {
-		b.WriteString(fmt.Sprintf("ID:       %s\n", dep.ID))
+	for i, dep := range d.Deployments {
+		b.WriteString(fmt.Sprintf("%sID:       %s\n", v.deploymentMarker(d, i), dep.ID))
 		b.WriteString(fmt.Sprintf("Status:   %s\n", dep.Status))
 		b.WriteString(fmt.Sprintf("Rollout:  %s\n", dep.RolloutState))
 		b.WriteString(fmt.Sprintf("Task Def: %s\n", dep.TaskDef))
@@ -299,6 +444,8 @@ func (v *DetailView) renderTaskTab() string {
 		return v.renderTaskOverview(d)
 	case 1: // Containers
 		return v.renderContainers(d)
+	case 2: // Logs
+		return v.renderLogs()
 	}
 	return ""
 }
@@ -8,24 +8,45 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"tasnim.dev/aws-tui/internal/aws/ecs"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
+// --- cache keys ---
+
+// clustersCacheKey is the cache service key for ECS clusters.
+const clustersCacheKey = "ecs"
+
+// servicesCacheKey returns the cache service key for the services of a cluster.
+// Cached service IDs are "cluster/service", the form DetailView accepts.
+func servicesCacheKey(cluster string) string {
+	return "ecs:services:" + cluster
+}
+
 // --- messages ---
 
+// clustersLoadedMsg carries pages of clusters. after is the token the pages
+// were fetched from, nil when they start the listing; the same holds for
+// servicesLoadedMsg and tasksLoadedMsg.
 type clustersLoadedMsg struct {
 	clusters []ecs.ECSCluster
+	after    *string
+	next     *string
 	err      error
 }
 
 type servicesLoadedMsg struct {
 	services []ecs.ECSService
+	after    *string
+	next     *string
 	err      error
 }
 
 type tasksLoadedMsg struct {
 	tasks []ecs.ECSTask
+	after *string
+	next  *string
 	err   error
 }
 
@@ -50,6 +71,8 @@ func statusDot(status string) string {
 
 // ClusterListView shows a table of ECS clusters.
 type ClusterListView struct {
+	plugin.Revalidation
+
 	client   ECSClient
 	router   plugin.Router
 	table    ui.TableView[ecs.ECSCluster]
@@ -58,25 +81,32 @@ type ClusterListView struct {
 	skeleton ui.Skeleton
 	region   string
 	profile  string
+	cache    *cache.Scope
+	next     *string
 }
 
 // NewClusterListView creates a new cluster list view.
 func NewClusterListView(client ECSClient, router plugin.Router, region, profile string) *ClusterListView {
-	cols := []ui.Column[ecs.ECSCluster]{
-		{Title: "Status", Width: 6, Field: func(c ecs.ECSCluster) string { return statusDot(c.Status) }},
-		{Title: "Name", Width: 30, Field: func(c ecs.ECSCluster) string { return c.Name }},
-		{Title: "Services", Width: 10, Field: func(c ecs.ECSCluster) string { return fmt.Sprintf("%d", c.ServiceCount) }},
-		{Title: "Running", Width: 10, Field: func(c ecs.ECSCluster) string { return fmt.Sprintf("%d", c.RunningTaskCount) }},
-	}
-	return &ClusterListView{
+	v := &ClusterListView{
 		client:   client,
 		router:   router,
-		table:    ui.NewTableView(cols, nil, func(c ecs.ECSCluster) string { return c.ARN }),
+		table:    ui.NewTableView(clusterColumns(), nil, func(c ecs.ECSCluster) string { return c.ARN }),
 		loading:  true,
 		skeleton: ui.NewSkeleton(60, 5),
 		region:   region,
 		profile:  profile,
 	}
+	v.table.OnLoadMore(func() tea.Cmd { return v.fetchClusters(v.next, 0) })
+	return v
+}
+
+func clusterColumns() []ui.Column[ecs.ECSCluster] {
+	return []ui.Column[ecs.ECSCluster]{
+		{Title: "Status", Width: 6, Field: func(c ecs.ECSCluster) string { return statusDot(c.Status) }},
+		{Title: "Name", Width: 30, Field: func(c ecs.ECSCluster) string { return c.Name }},
+		{Title: "Services", Width: 10, Field: func(c ecs.ECSCluster) string { return fmt.Sprintf("%d", c.ServiceCount) }},
+		{Title: "Running", Width: 10, Field: func(c ecs.ECSCluster) string { return fmt.Sprintf("%d", c.RunningTaskCount) }},
+	}
 }
 
 func (v *ClusterListView) Title() string { return "ECS Clusters" }
@@ -90,27 +120,72 @@ func (v *ClusterListView) KeyHints() []plugin.KeyHint {
 }
 
 func (v *ClusterListView) Init() tea.Cmd {
-	return v.fetchClusters()
+	fetch := v.fetchClusters(nil, v.table.ItemCount())
+	if v.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[ecs.ECSCluster](v.cache, clustersCacheKey, fetch)
+	}
+	return fetch
 }
 
-func (v *ClusterListView) fetchClusters() tea.Cmd {
+// fetchClusters fetches pages of clusters after token until at least want
+// have been read. Pages starting the listing replace the cached rows; later
+// pages are merged into them.
+func (v *ClusterListView) fetchClusters(after *string, want int) tea.Cmd {
+	scope, router := v.cache, v.router
+	viewCtx := router.Context(v)
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
 		defer cancel()
-		clusters, err := v.client.ListClusters(ctx)
-		return clustersLoadedMsg{clusters: clusters, err: err}
+		clusters, next, err := plugin.FetchPages(ctx, router, after, want, v.client.ListClustersPage)
+		if err == nil {
+			store := cache.Store[ecs.ECSCluster]
+			if after != nil {
+				store = cache.Merge[ecs.ECSCluster]
+			}
+			_ = store(context.Background(), scope, clustersCacheKey, clusters, func(c ecs.ECSCluster) (string, string) {
+				return c.Name, c.Name
+			})
+		}
+		return clustersLoadedMsg{clusters: clusters, after: after, next: next, err: err}
 	}
 }
 
 func (v *ClusterListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[ecs.ECSCluster]:
+		v.loading = false
+		v.table.SetItems(msg.Items)
+		if v.FromCache(v.router, msg.CacheEntry) {
+			return v, v.fetchClusters(nil, v.table.ItemCount())
+		}
+		return v, nil
+
 	case clustersLoadedMsg:
+		if msg.after != nil && msg.after != v.next {
+			// A page of a listing that has since been refreshed.
+			return v, nil
+		}
 		v.loading = false
+		if msg.after == nil && v.Fetched(v.router, msg.err) {
+			return v, nil
+		}
 		if msg.err != nil {
+			if msg.after != nil {
+				v.table.SetMore(true)
+				v.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return v, nil
+			}
 			v.err = msg.err
 			return v, nil
 		}
-		v.table.SetItems(msg.clusters)
+		v.err = nil
+		if msg.after != nil {
+			v.table.AppendItems(msg.clusters)
+		} else {
+			v.table.SetItems(msg.clusters)
+		}
+		v.next = msg.next
+		v.table.SetMore(msg.next != nil)
 		return v, nil
 
 	case tea.KeyPressMsg:
@@ -122,6 +197,7 @@ func (v *ClusterListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			selected := v.table.SelectedItem()
 			if selected.Name != "" {
 				view := NewServiceListView(v.client, v.router, selected.Name, v.region, v.profile)
+				view.cache = v.cache
 				v.router.Push(view)
 				return v, view.Init()
 			}
@@ -131,7 +207,7 @@ func (v *ClusterListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return v, nil
 		case "r":
 			v.loading = true
-			return v, v.fetchClusters()
+			return v, v.fetchClusters(nil, v.table.ItemCount())
 		}
 
 		var cmd tea.Cmd
@@ -161,6 +237,8 @@ func (v *ClusterListView) View() tea.View {
 
 // ServiceListView shows a table of ECS services within a cluster.
 type ServiceListView struct {
+	plugin.Revalidation
+
 	client      ECSClient
 	router      plugin.Router
 	clusterName string
@@ -170,6 +248,8 @@ type ServiceListView struct {
 	skeleton    ui.Skeleton
 	region      string
 	profile     string
+	cache       *cache.Scope
+	next        *string
 }
 
 // NewServiceListView creates a service list view for the given cluster.
@@ -182,7 +262,7 @@ func NewServiceListView(client ECSClient, router plugin.Router, clusterName, reg
 		{Title: "Running", Width: 8, Field: func(s ecs.ECSService) string { return fmt.Sprintf("%d", s.RunningCount) }},
 		{Title: "Pending", Width: 8, Field: func(s ecs.ECSService) string { return fmt.Sprintf("%d", s.PendingCount) }},
 	}
-	return &ServiceListView{
+	v := &ServiceListView{
 		client:      client,
 		router:      router,
 		clusterName: clusterName,
@@ -192,6 +272,8 @@ func NewServiceListView(client ECSClient, router plugin.Router, clusterName, reg
 		region:      region,
 		profile:     profile,
 	}
+	v.table.OnLoadMore(func() tea.Cmd { return v.fetchServices(v.next, 0) })
+	return v
 }
 
 func (v *ServiceListView) Title() string {
@@ -201,34 +283,80 @@ func (v *ServiceListView) Title() string {
 func (v *ServiceListView) KeyHints() []plugin.KeyHint {
 	return []plugin.KeyHint{
 		{Key: "enter", Desc: "view tasks"},
+		{Key: "d", Desc: "service detail"},
 		{Key: "esc", Desc: "back"},
 		{Key: "/", Desc: "filter"},
 	}
 }
 
 func (v *ServiceListView) Init() tea.Cmd {
-	return v.fetchServices()
+	fetch := v.fetchServices(nil, v.table.ItemCount())
+	if v.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[ecs.ECSService](v.cache, servicesCacheKey(v.clusterName), fetch)
+	}
+	return fetch
 }
 
-func (v *ServiceListView) fetchServices() tea.Cmd {
-	cluster := v.clusterName
+// fetchServices fetches pages of services after token until at least want
+// have been read, caching them like fetchClusters.
+func (v *ServiceListView) fetchServices(after *string, want int) tea.Cmd {
+	cluster, scope, router := v.clusterName, v.cache, v.router
+	viewCtx := router.Context(v)
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
 		defer cancel()
-		services, err := v.client.ListServices(ctx, cluster)
-		return servicesLoadedMsg{services: services, err: err}
+		services, next, err := plugin.FetchPages(ctx, router, after, want, func(ctx context.Context, token *string) ([]ecs.ECSService, *string, error) {
+			return v.client.ListServicesPage(ctx, cluster, token)
+		})
+		if err == nil {
+			store := cache.Store[ecs.ECSService]
+			if after != nil {
+				store = cache.Merge[ecs.ECSService]
+			}
+			_ = store(context.Background(), scope, servicesCacheKey(cluster), services, func(s ecs.ECSService) (string, string) {
+				return cluster + "/" + s.Name, s.Name
+			})
+		}
+		return servicesLoadedMsg{services: services, after: after, next: next, err: err}
 	}
 }
 
 func (v *ServiceListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[ecs.ECSService]:
+		v.loading = false
+		v.table.SetItems(msg.Items)
+		if v.FromCache(v.router, msg.CacheEntry) {
+			return v, v.fetchServices(nil, v.table.ItemCount())
+		}
+		return v, nil
+
 	case servicesLoadedMsg:
+		if msg.after != nil && msg.after != v.next {
+			// A page of a listing that has since been refreshed.
+			return v, nil
+		}
 		v.loading = false
+		if msg.after == nil && v.Fetched(v.router, msg.err) {
+			return v, nil
+		}
 		if msg.err != nil {
+			if msg.after != nil {
+				v.table.SetMore(true)
+				v.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return v, nil
+			}
 			v.err = msg.err
 			return v, nil
 		}
-		v.table.SetItems(msg.services)
+		v.err = nil
+		if msg.after != nil {
+			v.table.AppendItems(msg.services)
+		} else {
+			v.table.SetItems(msg.services)
+		}
+		v.next = msg.next
+		v.table.SetMore(msg.next != nil)
 		return v, nil
 
 	case tea.KeyPressMsg:
@@ -244,12 +372,17 @@ func (v *ServiceListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return v, view.Init()
 			}
 			return v, nil
+		case "d":
+			if selected := v.table.SelectedItem(); selected.Name != "" {
+				v.router.NavigateDetail("ecs", v.clusterName+"/"+selected.Name)
+			}
+			return v, nil
 		case "esc", "backspace":
 			v.router.Pop()
 			return v, nil
 		case "r":
 			v.loading = true
-			return v, v.fetchServices()
+			return v, v.fetchServices(nil, v.table.ItemCount())
 		}
 
 		var cmd tea.Cmd
@@ -289,6 +422,7 @@ type TaskListView struct {
 	skeleton    ui.Skeleton
 	region      string
 	profile     string
+	next        *string
 }
 
 // NewTaskListView creates a task list view for the given cluster and service.
@@ -305,7 +439,7 @@ func NewTaskListView(client ECSClient, router plugin.Router, clusterName, servic
 			return t.StartedAt.Format("2006-01-02 15:04")
 		}},
 	}
-	return &TaskListView{
+	v := &TaskListView{
 		client:      client,
 		router:      router,
 		clusterName: clusterName,
@@ -316,6 +450,8 @@ func NewTaskListView(client ECSClient, router plugin.Router, clusterName, servic
 		region:      region,
 		profile:     profile,
 	}
+	v.table.OnLoadMore(func() tea.Cmd { return v.fetchTasks(v.next, 0) })
+	return v
 }
 
 func (v *TaskListView) Title() string {
@@ -331,28 +467,48 @@ func (v *TaskListView) KeyHints() []plugin.KeyHint {
 }
 
 func (v *TaskListView) Init() tea.Cmd {
-	return v.fetchTasks()
+	return v.fetchTasks(nil, v.table.ItemCount())
 }
 
-func (v *TaskListView) fetchTasks() tea.Cmd {
-	cluster, service := v.clusterName, v.serviceName
+// fetchTasks fetches pages of tasks after token until at least want have
+// been read.
+func (v *TaskListView) fetchTasks(after *string, want int) tea.Cmd {
+	cluster, service, router := v.clusterName, v.serviceName, v.router
+	viewCtx := router.Context(v)
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
 		defer cancel()
-		tasks, err := v.client.ListTasks(ctx, cluster, service)
-		return tasksLoadedMsg{tasks: tasks, err: err}
+		tasks, next, err := plugin.FetchPages(ctx, router, after, want, func(ctx context.Context, token *string) ([]ecs.ECSTask, *string, error) {
+			return v.client.ListTasksPage(ctx, cluster, service, token)
+		})
+		return tasksLoadedMsg{tasks: tasks, after: after, next: next, err: err}
 	}
 }
 
 func (v *TaskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tasksLoadedMsg:
+		if msg.after != nil && msg.after != v.next {
+			// A page of a listing that has since been refreshed.
+			return v, nil
+		}
 		v.loading = false
 		if msg.err != nil {
+			if msg.after != nil {
+				v.table.SetMore(true)
+				v.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return v, nil
+			}
 			v.err = msg.err
 			return v, nil
 		}
-		v.table.SetItems(msg.tasks)
+		if msg.after != nil {
+			v.table.AppendItems(msg.tasks)
+		} else {
+			v.table.SetItems(msg.tasks)
+		}
+		v.next = msg.next
+		v.table.SetMore(msg.next != nil)
 		return v, nil
 
 	case tea.KeyPressMsg:
@@ -371,7 +527,7 @@ func (v *TaskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return v, nil
 		case "r":
 			v.loading = true
-			return v, v.fetchTasks()
+			return v, v.fetchTasks(nil, v.table.ItemCount())
 		}
 
 		var cmd tea.Cmd
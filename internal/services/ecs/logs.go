@@ -0,0 +1,284 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"tasnim.dev/aws-tui/internal/aws/ecs"
+	"tasnim.dev/aws-tui/internal/aws/logs"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// logsTab is the index of the Logs tab in a task's detail view.
+const logsTab = 2
+
+// logPollInterval is how often a followed log stream is polled.
+const logPollInterval = 2 * time.Second
+
+// logInitialLimit is how many events are shown when a stream is first opened
+// or a time is jumped to.
+const logInitialLimit = 200
+
+// logChromeHeight is the number of terminal rows around the log lines: the
+// breadcrumb, status bar, tab bar and container line.
+const logChromeHeight = 9
+
+// logEventsMsg carries events fetched for container. gen identifies the poll
+// chain that asked for them; events from an abandoned chain are dropped.
+type logEventsMsg struct {
+	container int
+	gen       int
+	events    []logs.LogEvent
+	token     string
+	reset     bool // replace the buffer rather than append
+	follow    bool // with reset, show the newest lines
+	err       error
+}
+
+// logTickMsg schedules the next poll of chain gen.
+type logTickMsg struct{ gen int }
+
+// initLogs creates a log buffer for each of the task's containers.
+func (v *DetailView) initLogs() {
+	n := len(v.taskDetail.Containers)
+	v.logViews = make([]ui.LogView, n)
+	v.logTokens = make([]string, n)
+	v.logLoaded = make([]bool, n)
+	for i, c := range v.taskDetail.Containers {
+		v.logViews[i] = ui.NewLogView(c.Name + "-" + v.taskDetail.TaskID)
+	}
+	v.resizeLogs()
+}
+
+func (v *DetailView) resizeLogs() {
+	if v.width == 0 || v.height == 0 {
+		return
+	}
+	for i := range v.logViews {
+		v.logViews[i].SetSize(v.width, v.height-logChromeHeight)
+	}
+}
+
+func (v *DetailView) onLogsTab() bool {
+	return v.isTask && v.tabs.Active() == logsTab
+}
+
+// logContainer returns the container whose log is shown, if it has one.
+func (v *DetailView) logContainer() (ecs.ECSContainerDetail, bool) {
+	if v.taskDetail == nil || v.logIdx >= len(v.taskDetail.Containers) {
+		return ecs.ECSContainerDetail{}, false
+	}
+	c := v.taskDetail.Containers[v.logIdx]
+	return c, c.LogGroup != "" && c.LogStream != ""
+}
+
+// shouldPoll reports whether the shown log is being followed.
+func (v *DetailView) shouldPoll() bool {
+	if v.logClient == nil || !v.onLogsTab() || v.logErr != nil || v.router.Offline() {
+		return false
+	}
+	if _, ok := v.logContainer(); !ok {
+		return false
+	}
+	return !v.logViews[v.logIdx].Paused()
+}
+
+// startLogs starts a poll chain for the shown container unless one is
+// already running. The first fetch of a container loads its latest events.
+func (v *DetailView) startLogs() tea.Cmd {
+	if v.logPolling || !v.shouldPoll() {
+		return nil
+	}
+	v.logGen++
+	v.logPolling = true
+	return v.pollLogs()
+}
+
+// restartLogs abandons the running poll chain and starts a new one.
+func (v *DetailView) restartLogs() tea.Cmd {
+	v.logGen++
+	v.logPolling = false
+	v.logErr = nil
+	return v.startLogs()
+}
+
+// pollLogs fetches the events after the shown container's forward token, or
+// its latest events if it has none yet.
+func (v *DetailView) pollLogs() tea.Cmd {
+	idx := v.logIdx
+	if token := v.logTokens[idx]; v.logLoaded[idx] && token != "" {
+		return v.fetchLogs(false, false, func(ctx context.Context, group, stream string) ([]logs.LogEvent, string, error) {
+			return v.logClient.GetLogEventsSince(ctx, group, stream, token)
+		})
+	}
+	return v.fetchLogs(true, true, func(ctx context.Context, group, stream string) ([]logs.LogEvent, string, error) {
+		return v.logClient.GetLatestLogEvents(ctx, group, stream, logInitialLimit)
+	})
+}
+
+// jumpLogs replaces the shown container's buffer with the events from at on
+// and carries on following from there.
+func (v *DetailView) jumpLogs(at time.Time) tea.Cmd {
+	if _, ok := v.logContainer(); !ok || v.logClient == nil {
+		return nil
+	}
+	if v.router.Offline() {
+		v.router.Toast(plugin.ToastWarning, "Logs are unavailable offline")
+		return nil
+	}
+	v.logGen++
+	v.logPolling = true
+	v.logErr = nil
+	return v.fetchLogs(true, false, func(ctx context.Context, group, stream string) ([]logs.LogEvent, string, error) {
+		return v.logClient.GetLogEventsFrom(ctx, group, stream, at, logInitialLimit)
+	})
+}
+
+func (v *DetailView) fetchLogs(reset, follow bool, get func(ctx context.Context, group, stream string) ([]logs.LogEvent, string, error)) tea.Cmd {
+	c, _ := v.logContainer()
+	idx, gen, router := v.logIdx, v.logGen, v.router
+	viewCtx := router.Context(v)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
+		defer cancel()
+		var events []logs.LogEvent
+		var token string
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			events, token, err = get(ctx, c.LogGroup, c.LogStream)
+			return err
+		})
+		return logEventsMsg{container: idx, gen: gen, events: events, token: token, reset: reset, follow: follow, err: err}
+	}
+}
+
+// updateLogs handles the log messages of a task detail view.
+func (v *DetailView) updateLogs(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case logEventsMsg:
+		if msg.gen != v.logGen {
+			return nil
+		}
+		if msg.err != nil {
+			v.logErr = msg.err
+			v.logPolling = false
+			return nil
+		}
+		lines := make([]ui.LogLine, len(msg.events))
+		for i, e := range msg.events {
+			lines[i] = ui.LogLine{Time: e.Timestamp, Message: e.Message}
+		}
+		lv := &v.logViews[msg.container]
+		if msg.reset {
+			lv.SetLines(lines, msg.follow)
+		} else {
+			lv.AppendLines(lines)
+		}
+		v.logTokens[msg.container] = msg.token
+		v.logLoaded[msg.container] = true
+		return v.scheduleLogs()
+
+	case logTickMsg:
+		if msg.gen != v.logGen {
+			return nil
+		}
+		if !v.shouldPoll() {
+			v.logPolling = false
+			return nil
+		}
+		return v.pollLogs()
+
+	case ui.LogJumpMsg:
+		return v.jumpLogs(msg.At)
+
+	case ui.LogSavedMsg:
+		if msg.Err != nil {
+			v.router.Toast(plugin.ToastError, "Saving log failed: "+msg.Err.Error())
+		} else {
+			v.router.Toast(plugin.ToastInfo, "Log saved to "+msg.Path)
+		}
+	}
+	return nil
+}
+
+// scheduleLogs schedules the next poll of the running chain, or ends it if
+// the log is no longer followed.
+func (v *DetailView) scheduleLogs() tea.Cmd {
+	if !v.shouldPoll() {
+		v.logPolling = false
+		return nil
+	}
+	gen := v.logGen
+	return tea.Tick(logPollInterval, func(time.Time) tea.Msg { return logTickMsg{gen: gen} })
+}
+
+// handleLogKey handles a key on the Logs tab that is not a tab switch.
+func (v *DetailView) handleLogKey(msg tea.KeyPressMsg) tea.Cmd {
+	if len(v.logViews) == 0 {
+		return nil
+	}
+	lv := v.logViews[v.logIdx]
+	if !lv.Capturing() {
+		switch msg.String() {
+		case "c":
+			v.logIdx = (v.logIdx + 1) % len(v.logViews)
+			return v.restartLogs()
+		case "r":
+			return v.restartLogs()
+		}
+	}
+
+	wasPaused := lv.Paused()
+	var cmd tea.Cmd
+	v.logViews[v.logIdx], cmd = lv.Update(msg)
+	if wasPaused && !v.logViews[v.logIdx].Paused() {
+		return tea.Batch(cmd, v.startLogs())
+	}
+	return cmd
+}
+
+// CapturingInput implements plugin.InputCapturer while an action prompt,
+// the log filter or the log time prompt is open.
+func (v *DetailView) CapturingInput() bool {
+	if v.prompt != nil {
+		return true
+	}
+	return v.onLogsTab() && len(v.logViews) > 0 && v.logViews[v.logIdx].Capturing()
+}
+
+func (v *DetailView) renderLogs() string {
+	if len(v.logViews) == 0 {
+		return "No containers."
+	}
+	c, ok := v.logContainer()
+
+	var b strings.Builder
+	header := fmt.Sprintf("Container: %s", c.Name)
+	if len(v.logViews) > 1 {
+		header += fmt.Sprintf(" (%d/%d, c for next)", v.logIdx+1, len(v.logViews))
+	}
+	if ok {
+		header += fmt.Sprintf("  %s / %s", c.LogGroup, c.LogStream)
+	}
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	switch {
+	case !ok:
+		b.WriteString("This container does not log to CloudWatch Logs.")
+	case v.logClient == nil:
+		b.WriteString("Logs are unavailable.")
+	case v.logErr != nil:
+		b.WriteString(fmt.Sprintf("Error: %v\nPress r to retry.", v.logErr))
+	case !v.logLoaded[v.logIdx] && v.router.Offline():
+		b.WriteString("Logs are unavailable offline.")
+	case !v.logLoaded[v.logIdx]:
+		b.WriteString("Loading log events...")
+	default:
+		b.WriteString(v.logViews[v.logIdx].View())
+	}
+	return b.String()
+}
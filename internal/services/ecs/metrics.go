@@ -0,0 +1,42 @@
+package ecs
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+)
+
+// metricsTab is the index of the Metrics tab in a service's detail view.
+const metricsTab = 4
+
+func (v *DetailView) onMetricsTab() bool {
+	return !v.isTask && v.tabs.Active() == metricsTab
+}
+
+// serviceCharts returns the charts of a service's Metrics tab.
+func serviceCharts(cluster, service string) []metrics.Chart {
+	dims := []cloudwatch.Dimension{
+		{Name: "ClusterName", Value: cluster},
+		{Name: "ServiceName", Value: service},
+	}
+	return []metrics.Chart{
+		{
+			Title:  "CPU Utilization",
+			Query:  cloudwatch.MetricQuery{Namespace: "AWS/ECS", Metric: "CPUUtilization", Dimensions: dims, Stat: "Average"},
+			Format: metrics.Percent,
+		},
+		{
+			Title:  "Memory Utilization",
+			Query:  cloudwatch.MetricQuery{Namespace: "AWS/ECS", Metric: "MemoryUtilization", Dimensions: dims, Stat: "Average"},
+			Format: metrics.Percent,
+		},
+	}
+}
+
+// loadMetrics loads the service's metrics the first time its tab is shown.
+func (v *DetailView) loadMetrics() tea.Cmd {
+	if !v.onMetricsTab() {
+		return nil
+	}
+	return v.metrics.LoadOnce(v.router.Context(v))
+}
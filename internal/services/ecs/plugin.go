@@ -2,30 +2,65 @@ package ecs
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
+	"tasnim.dev/aws-tui/internal/aws/autoscaling"
 	"tasnim.dev/aws-tui/internal/aws/ecs"
+	"tasnim.dev/aws-tui/internal/aws/logs"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/services/regional"
 )
 
 // ECSClient defines the subset of ecs.Client methods used by the plugin.
 type ECSClient interface {
 	ListClusters(ctx context.Context) ([]ecs.ECSCluster, error)
+	ListClustersPage(ctx context.Context, token *string) ([]ecs.ECSCluster, *string, error)
 	ListServices(ctx context.Context, clusterName string) ([]ecs.ECSService, error)
+	ListServicesPage(ctx context.Context, clusterName string, token *string) ([]ecs.ECSService, *string, error)
 	ListTasks(ctx context.Context, clusterName, serviceName string) ([]ecs.ECSTask, error)
+	ListTasksPage(ctx context.Context, clusterName, serviceName string, token *string) ([]ecs.ECSTask, *string, error)
 	DescribeService(ctx context.Context, clusterName, serviceName string) (*ecs.ECSServiceDetail, error)
 	DescribeTask(ctx context.Context, clusterName, taskARN string) (*ecs.ECSTaskDetail, error)
+	SetDesiredCount(ctx context.Context, clusterName, serviceName string, count int) error
+	ForceNewDeployment(ctx context.Context, clusterName, serviceName string) error
+	SetTaskDefinition(ctx context.Context, clusterName, serviceName, taskDef string) error
+	StopTask(ctx context.Context, clusterName, taskARN, reason string) error
+}
+
+// LogsClient defines the subset of logs.Client methods used to tail
+// container logs.
+type LogsClient interface {
+	GetLatestLogEvents(ctx context.Context, logGroup, logStream string, limit int) ([]logs.LogEvent, string, error)
+	GetLogEventsSince(ctx context.Context, logGroup, logStream, forwardToken string) ([]logs.LogEvent, string, error)
+	GetLogEventsFrom(ctx context.Context, logGroup, logStream string, start time.Time, limit int) ([]logs.LogEvent, string, error)
+}
+
+// AutoScalingClient defines the subset of autoscaling.Client methods used to
+// show a service's auto scaling.
+type AutoScalingClient interface {
+	GetECSScalingTargets(ctx context.Context, clusterName, serviceName string) ([]autoscaling.AutoScalingTarget, error)
+	GetECSScalingPolicies(ctx context.Context, clusterName, serviceName string) ([]autoscaling.AutoScalingPolicy, error)
+	GetECSScalingActivities(ctx context.Context, clusterName, serviceName string, limit int) ([]autoscaling.ScalingActivity, error)
 }
 
 // Plugin implements plugin.ServicePlugin for Amazon ECS.
 type Plugin struct {
+	plugin.CacheHolder
+
 	client ECSClient
 
 	mu             sync.Mutex
 	hasPendingTask bool
 	region         string
 	profile        string
+	scope          plugin.RegionScope
+	clientFor      func(region string) ECSClient
+	logs           LogsClient
+	scaling        AutoScalingClient
+	metrics        metrics.Client
 }
 
 // NewPlugin creates a new ECS service plugin.
@@ -33,6 +68,27 @@ func NewPlugin(client ECSClient, region, profile string) *Plugin {
 	return &Plugin{client: client, region: region, profile: profile}
 }
 
+// SetRegionalClients sets the factory used to reach other regions in
+// all-regions scope.
+func (p *Plugin) SetRegionalClients(clientFor func(region string) ECSClient) {
+	p.clientFor = clientFor
+}
+
+// SetLogsClient sets the client task detail views use to tail container
+// logs. Without one the Logs tab reports that logs are unavailable.
+func (p *Plugin) SetLogsClient(c LogsClient) { p.logs = c }
+
+// SetAutoScalingClient sets the client service detail views use to show
+// auto scaling. Without one the Auto Scaling tab reports it is unavailable.
+func (p *Plugin) SetAutoScalingClient(c AutoScalingClient) { p.scaling = c }
+
+// SetMetricsClient sets the client service detail views read CloudWatch
+// metrics with. Without one the Metrics tab reports they are unavailable.
+func (p *Plugin) SetMetricsClient(c metrics.Client) { p.metrics = c }
+
+// SetRegionScope implements plugin.MultiRegion.
+func (p *Plugin) SetRegionScope(scope plugin.RegionScope) { p.scope = scope }
+
 func (p *Plugin) ID() string   { return "ecs" }
 func (p *Plugin) Name() string { return "ECS" }
 func (p *Plugin) Icon() string { return "\U000F01A7" } // nf-mdi-cloud
@@ -82,13 +138,44 @@ func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewClusterListView(p.client, router, p.region, p.profile)
+	if p.scope.All() && p.clientFor != nil {
+		return p.regionalListView(router)
+	}
+	v := NewClusterListView(p.client, router, p.region, p.profile)
+	v.cache = p.Cache()
+	return v
 }
 
-func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
-	return NewDetailView(p.client, router, id, p.region, p.profile)
+// regionalListView lists clusters across every region in the scope. Selecting
+// one opens its services in that region; they are not cached, since the
+// cache is bound to the session region.
+func (p *Plugin) regionalListView(router plugin.Router) plugin.View {
+	return regional.NewListView(router, "ECS Clusters", p.scope, clusterColumns(),
+		func(c ecs.ECSCluster) string { return c.ARN },
+		func(ctx context.Context, region string) ([]ecs.ECSCluster, error) {
+			return p.clientFor(region).ListClusters(ctx)
+		},
+		func(router plugin.Router, region string, c ecs.ECSCluster) plugin.View {
+			return NewServiceListView(p.clientFor(region), router, c.Name, region, p.profile)
+		})
 }
 
+// DetailView returns the detail view for "cluster/service" or a task ARN. A
+// bare cluster name, as found in the resource cache, opens that cluster's
+// service list instead since clusters have no detail view of their own.
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	if !strings.Contains(id, "/") {
+		v := NewServiceListView(p.client, router, id, p.region, p.profile)
+		v.cache = p.Cache()
+		return v
+	}
+	v := NewDetailView(p.client, router, id, p.region, p.profile)
+	v.logClient = p.logs
+	v.scalingClient = p.scaling
+	v.metrics.SetClient(p.metrics)
+	v.rolloutInterval = p.PollConfig().ActiveInterval
+	return v
+}
 
 func (p *Plugin) Commands() []plugin.Command {
 	return []plugin.Command{
@@ -2,13 +2,20 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"tasnim.dev/aws-tui/internal/aws/autoscaling"
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
 	"tasnim.dev/aws-tui/internal/aws/ecs"
+	"tasnim.dev/aws-tui/internal/aws/logs"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
 )
 
 // --- mock ECS client ---
@@ -19,6 +26,7 @@ type mockClient struct {
 	listTasksFunc       func(ctx context.Context, cluster, service string) ([]ecs.ECSTask, error)
 	describeServiceFunc func(ctx context.Context, cluster, service string) (*ecs.ECSServiceDetail, error)
 	describeTaskFunc    func(ctx context.Context, cluster, taskARN string) (*ecs.ECSTaskDetail, error)
+	actions             []string // calls that change resources, e.g. "scale prod/web 4"
 }
 
 func (m *mockClient) ListClusters(ctx context.Context) ([]ecs.ECSCluster, error) {
@@ -30,6 +38,21 @@ func (m *mockClient) ListServices(ctx context.Context, cluster string) ([]ecs.EC
 func (m *mockClient) ListTasks(ctx context.Context, cluster, service string) ([]ecs.ECSTask, error) {
 	return m.listTasksFunc(ctx, cluster, service)
 }
+
+// The page methods return everything as a single page.
+func (m *mockClient) ListClustersPage(ctx context.Context, _ *string) ([]ecs.ECSCluster, *string, error) {
+	clusters, err := m.listClustersFunc(ctx)
+	return clusters, nil, err
+}
+func (m *mockClient) ListServicesPage(ctx context.Context, cluster string, _ *string) ([]ecs.ECSService, *string, error) {
+	services, err := m.listServicesFunc(ctx, cluster)
+	return services, nil, err
+}
+func (m *mockClient) ListTasksPage(ctx context.Context, cluster, service string, _ *string) ([]ecs.ECSTask, *string, error) {
+	tasks, err := m.listTasksFunc(ctx, cluster, service)
+	return tasks, nil, err
+}
+
 func (m *mockClient) DescribeService(ctx context.Context, cluster, service string) (*ecs.ECSServiceDetail, error) {
 	return m.describeServiceFunc(ctx, cluster, service)
 }
@@ -37,15 +60,106 @@ func (m *mockClient) DescribeTask(ctx context.Context, cluster, taskARN string)
 	return m.describeTaskFunc(ctx, cluster, taskARN)
 }
 
+func (m *mockClient) SetDesiredCount(_ context.Context, cluster, service string, count int) error {
+	m.actions = append(m.actions, fmt.Sprintf("scale %s/%s %d", cluster, service, count))
+	return nil
+}
+func (m *mockClient) ForceNewDeployment(_ context.Context, cluster, service string) error {
+	m.actions = append(m.actions, fmt.Sprintf("force %s/%s", cluster, service))
+	return nil
+}
+func (m *mockClient) SetTaskDefinition(_ context.Context, cluster, service, taskDef string) error {
+	m.actions = append(m.actions, fmt.Sprintf("deploy %s/%s %s", cluster, service, taskDef))
+	return nil
+}
+func (m *mockClient) StopTask(_ context.Context, cluster, taskARN, reason string) error {
+	m.actions = append(m.actions, fmt.Sprintf("stop %s/%s %s", cluster, taskARN, reason))
+	return nil
+}
+
+// --- mock logs client ---
+
+type mockLogsClient struct {
+	latest []logs.LogEvent
+	since  map[string][]logs.LogEvent // keyed by forward token
+	from   []logs.LogEvent
+	start  time.Time
+}
+
+func (m *mockLogsClient) GetLatestLogEvents(_ context.Context, _, _ string, _ int) ([]logs.LogEvent, string, error) {
+	return m.latest, "t1", nil
+}
+func (m *mockLogsClient) GetLogEventsSince(_ context.Context, _, _, token string) ([]logs.LogEvent, string, error) {
+	return m.since[token], token + "+", nil
+}
+func (m *mockLogsClient) GetLogEventsFrom(_ context.Context, _, _ string, start time.Time, _ int) ([]logs.LogEvent, string, error) {
+	m.start = start
+	return m.from, "t9", nil
+}
+
+// --- mock auto scaling client ---
+
+type mockScalingClient struct {
+	calls int
+}
+
+func (m *mockScalingClient) GetECSScalingTargets(_ context.Context, _, _ string) ([]autoscaling.AutoScalingTarget, error) {
+	m.calls++
+	return []autoscaling.AutoScalingTarget{{MinCapacity: 2, MaxCapacity: 8}}, nil
+}
+func (m *mockScalingClient) GetECSScalingPolicies(_ context.Context, _, _ string) ([]autoscaling.AutoScalingPolicy, error) {
+	lower := 0.0
+	return []autoscaling.AutoScalingPolicy{
+		{PolicyName: "cpu", PolicyType: "TargetTrackingScaling", TargetValue: 60, MetricName: "ECSServiceAverageCPUUtilization", ScaleOutCooldown: 60, ScaleInCooldown: 300},
+		{PolicyName: "queue", PolicyType: "StepScaling", AdjustmentType: "ChangeInCapacity", StepAdjustments: []autoscaling.StepAdjustment{{LowerBound: &lower, Adjustment: 2}}, Alarms: []string{"queue-high"}},
+	}, nil
+}
+func (m *mockScalingClient) GetECSScalingActivities(_ context.Context, _, _ string, _ int) ([]autoscaling.ScalingActivity, error) {
+	return []autoscaling.ScalingActivity{
+		{StartTime: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), Status: "Successful", Description: "Setting desired count to 4.", Cause: "alarm queue-high in state ALARM"},
+	}, nil
+}
+
 // --- mock router ---
 
 type mockRouter struct{}
 
-func (m mockRouter) Push(_ plugin.View)                  {}
-func (m mockRouter) Pop()                                {}
-func (m mockRouter) Navigate(_ string)                   {}
-func (m mockRouter) NavigateDetail(_ string, _ string)   {}
-func (m mockRouter) Toast(_ plugin.ToastLevel, _ string) {}
+func (m mockRouter) Push(_ plugin.View)                    {}
+func (m mockRouter) Pop()                                  {}
+func (m mockRouter) Navigate(_ string)                     {}
+func (m mockRouter) NavigateDetail(_ string, _ string)     {}
+func (m mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m mockRouter) Offline() bool                         { return false }
+func (m mockRouter) ReadOnly() bool                        { return false }
+func (m mockRouter) Confirm(_ plugin.Action)               {}
+func (m mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+// actionRouter records toasts and the actions it is asked to confirm.
+type actionRouter struct {
+	mockRouter
+	readOnly bool
+	toasts   []string
+	confirms []plugin.Action
+}
+
+func (r *actionRouter) Toast(_ plugin.ToastLevel, msg string) { r.toasts = append(r.toasts, msg) }
+func (r *actionRouter) ReadOnly() bool                        { return r.readOnly }
+func (r *actionRouter) Confirm(action plugin.Action) {
+	if r.readOnly {
+		r.toasts = append(r.toasts, action.Title+" is disabled in read-only mode")
+		return
+	}
+	r.confirms = append(r.confirms, action)
+}
+
+// typeText sends s to v one key at a time and returns the last command.
+func typeText(v *DetailView, s string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, c := range s {
+		_, cmd = v.Update(tea.KeyPressMsg{Code: c, Text: string(c)})
+	}
+	return cmd
+}
 
 // --- tests ---
 
@@ -162,3 +276,305 @@ func TestPlugin_DetailView(t *testing.T) {
 	require.NotNil(t, view)
 	assert.Equal(t, "ECS Detail", view.Title())
 }
+
+func TestPlugin_DetailView_ClusterName(t *testing.T) {
+	p := NewPlugin(&mockClient{}, "", "")
+	view := p.DetailView(mockRouter{}, "prod")
+	require.NotNil(t, view)
+	assert.Equal(t, "Services — prod", view.Title())
+}
+
+func TestDetailView_LogsTab(t *testing.T) {
+	now := time.Now()
+	client := &mockClient{
+		describeTaskFunc: func(_ context.Context, _, _ string) (*ecs.ECSTaskDetail, error) {
+			return &ecs.ECSTaskDetail{
+				TaskID: "abc",
+				Status: "RUNNING",
+				Containers: []ecs.ECSContainerDetail{
+					{Name: "web", LogGroup: "/ecs/app", LogStream: "ecs/web/abc"},
+					{Name: "sidecar"},
+				},
+			}, nil
+		},
+	}
+	logsClient := &mockLogsClient{
+		latest: []logs.LogEvent{{Timestamp: now, Message: "server started"}},
+		since:  map[string][]logs.LogEvent{"t1": {{Timestamp: now, Message: "GET /health"}}},
+		from:   []logs.LogEvent{{Timestamp: now, Message: "from the past"}},
+	}
+	p := NewPlugin(client, "", "")
+	p.SetLogsClient(logsClient)
+	v := p.DetailView(mockRouter{}, "prod/arn:aws:ecs:us-east-1:123:task/prod/abc").(*DetailView)
+	v.Update(v.Init()())
+
+	// Opening the Logs tab loads the latest events and schedules a poll.
+	_, cmd := v.Update(tea.KeyPressMsg{Code: '3', Text: "3"})
+	require.NotNil(t, cmd)
+	_, cmd = v.Update(cmd())
+	require.NotNil(t, cmd, "a followed log is polled")
+	assert.Contains(t, v.View().Content, "server started")
+
+	// A poll appends the events after the forward token.
+	_, cmd = v.Update(logTickMsg{gen: v.logGen})
+	v.Update(cmd())
+	content := v.View().Content
+	assert.Contains(t, content, "server started")
+	assert.Contains(t, content, "GET /health")
+
+	// A tick from an abandoned chain is ignored.
+	_, cmd = v.Update(logTickMsg{gen: v.logGen - 1})
+	assert.Nil(t, cmd)
+
+	// Pausing ends the chain at the next tick; resuming restarts it.
+	v.Update(tea.KeyPressMsg{Code: 'p', Text: "p"})
+	_, cmd = v.Update(logTickMsg{gen: v.logGen})
+	assert.Nil(t, cmd)
+	assert.False(t, v.logPolling)
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'p', Text: "p"})
+	require.NotNil(t, cmd)
+	assert.True(t, v.logPolling)
+
+	// Jumping to a time replaces the buffer.
+	at := now.Add(-time.Hour)
+	_, cmd = v.Update(ui.LogJumpMsg{At: at})
+	require.NotNil(t, cmd)
+	v.Update(cmd())
+	assert.Equal(t, at, logsClient.start)
+	content = v.View().Content
+	assert.Contains(t, content, "from the past")
+	assert.NotContains(t, content, "server started")
+
+	// While the filter is open the view captures keys, including "c".
+	v.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	assert.True(t, v.CapturingInput())
+	v.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	assert.Equal(t, 0, v.logIdx)
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.False(t, v.CapturingInput())
+
+	// The next container has no log configuration.
+	v.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	assert.Equal(t, 1, v.logIdx)
+	assert.Contains(t, v.View().Content, "does not log to CloudWatch Logs")
+}
+
+func TestDetailView_AutoScalingTab(t *testing.T) {
+	client := &mockClient{
+		describeServiceFunc: func(_ context.Context, _, _ string) (*ecs.ECSServiceDetail, error) {
+			return &ecs.ECSServiceDetail{Name: "web", DesiredCount: 4, RunningCount: 3}, nil
+		},
+	}
+	scaling := &mockScalingClient{}
+	p := NewPlugin(client, "", "")
+	p.SetAutoScalingClient(scaling)
+	v := p.DetailView(mockRouter{}, "prod/web").(*DetailView)
+	v.Update(v.Init()())
+	assert.Zero(t, scaling.calls, "auto scaling loads when its tab is opened")
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: '4', Text: "4"})
+	require.NotNil(t, cmd)
+	assert.Contains(t, v.View().Content, "Loading auto scaling")
+	v.Update(cmd())
+
+	content := v.View().Content
+	assert.Contains(t, content, "Min:      2")
+	assert.Contains(t, content, "Max:      8")
+	assert.Contains(t, content, "Desired:  4")
+	assert.Contains(t, content, "Target:    60")
+	assert.Contains(t, content, "Step:      [0, +∞) → +2")
+	assert.Contains(t, content, "Alarms:    queue-high")
+	assert.Contains(t, content, "Setting desired count to 4.")
+	assert.Contains(t, content, "Cause: alarm queue-high in state ALARM")
+
+	// Switching away and back does not refetch; r does.
+	v.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: '4', Text: "4"})
+	assert.Nil(t, cmd)
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	require.NotNil(t, cmd)
+	v.Update(cmd())
+	assert.Equal(t, 2, scaling.calls)
+}
+
+// mockMetrics returns a datapoint for every query.
+type mockMetrics struct {
+	queries []cloudwatch.MetricQuery
+	calls   int
+}
+
+func (m *mockMetrics) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, _ cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries = queries
+	m.calls++
+	series := make([]cloudwatch.MetricSeries, len(queries))
+	for i := range series {
+		series[i].Values = []float64{42}
+	}
+	return series, nil
+}
+
+func TestDetailView_MetricsTab(t *testing.T) {
+	client := &mockClient{
+		describeServiceFunc: func(_ context.Context, _, _ string) (*ecs.ECSServiceDetail, error) {
+			return &ecs.ECSServiceDetail{Name: "web"}, nil
+		},
+	}
+	cw := &mockMetrics{}
+	p := NewPlugin(client, "", "")
+	p.SetMetricsClient(cw)
+	v := p.DetailView(mockRouter{}, "prod/web").(*DetailView)
+	v.Update(v.Init()())
+	assert.Zero(t, cw.calls, "metrics load when their tab is opened")
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: '5', Text: "5"})
+	require.NotNil(t, cmd)
+	v.Update(cmd())
+	require.Len(t, cw.queries, 2)
+	assert.Equal(t, "AWS/ECS", cw.queries[0].Namespace)
+	assert.Equal(t, "MemoryUtilization", cw.queries[1].Metric)
+	assert.Equal(t, []cloudwatch.Dimension{{Name: "ClusterName", Value: "prod"}, {Name: "ServiceName", Value: "web"}}, cw.queries[1].Dimensions)
+	content := v.View().Content
+	assert.Contains(t, content, "CPU Utilization")
+	assert.Contains(t, content, "last 42.0%")
+
+	// r reloads the charts on the Metrics tab.
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	require.NotNil(t, cmd)
+	v.Update(cmd())
+	assert.Equal(t, 2, cw.calls)
+}
+
+func rollingService() *ecs.ECSServiceDetail {
+	return &ecs.ECSServiceDetail{
+		Name: "web", DesiredCount: 2, TaskDef: "web:12",
+		Deployments: []ecs.ECSDeployment{
+			{ID: "ecs-svc/2", Status: "PRIMARY", TaskDef: "web:12", RolloutState: "IN_PROGRESS"},
+			{ID: "ecs-svc/1", Status: "ACTIVE", TaskDef: "web:11", RolloutState: "COMPLETED"},
+		},
+	}
+}
+
+func TestDetailView_ScaleService(t *testing.T) {
+	client := &mockClient{
+		describeServiceFunc: func(_ context.Context, _, _ string) (*ecs.ECSServiceDetail, error) {
+			return rollingService(), nil
+		},
+	}
+	router := &actionRouter{}
+	v := NewPlugin(client, "", "").DetailView(router, "prod/web").(*DetailView)
+	v.Update(v.Init()())
+
+	// e prompts for the count, capturing keys while open.
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	require.True(t, v.CapturingInput())
+	assert.Contains(t, v.View().Content, "Desired count:")
+	typeText(v, "x")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	v.Update(cmd())
+	assert.False(t, v.CapturingInput())
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Desired count must be a whole number, not x"}, router.toasts)
+
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	typeText(v, "4")
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	v.Update(cmd())
+	require.Len(t, router.confirms, 1)
+	action := router.confirms[0]
+	assert.Equal(t, "Scale service", action.Title)
+	assert.Equal(t, "web", action.Phrase)
+	assert.Equal(t, []string{"web: desired 2 → 4"}, action.Targets)
+
+	// Once it runs the service is reloaded.
+	_, cmd = v.Update(action.Run()())
+	assert.Equal(t, []string{"scale prod/web 4"}, client.actions)
+	require.NotNil(t, cmd)
+	assert.IsType(t, serviceDetailLoadedMsg{}, cmd())
+}
+
+func TestDetailView_RollbackAndRolloutRefresh(t *testing.T) {
+	client := &mockClient{
+		describeServiceFunc: func(_ context.Context, _, _ string) (*ecs.ECSServiceDetail, error) {
+			return rollingService(), nil
+		},
+	}
+	router := &actionRouter{}
+	v := NewPlugin(client, "", "").DetailView(router, "prod/web").(*DetailView)
+	v.Update(v.Init()())
+	assert.False(t, v.rolloutWatching, "only the Deployments tab refreshes")
+
+	// Opening the Deployments tab during a rollout schedules a refresh.
+	_, cmd := v.Update(tea.KeyPressMsg{Code: '2', Text: "2"})
+	assert.NotNil(t, cmd)
+	assert.True(t, v.rolloutWatching)
+	_, cmd = v.Update(rolloutTickMsg{})
+	require.NotNil(t, cmd)
+	_, cmd = v.Update(cmd())
+	assert.NotNil(t, cmd, "still rolling out")
+
+	// The current revision cannot be rolled back to.
+	v.Update(tea.KeyPressMsg{Code: 'B', Text: "B"})
+	assert.Empty(t, router.confirms)
+	require.Len(t, router.toasts, 1)
+
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	assert.Contains(t, v.View().Content, "▸ ID:       ecs-svc/1")
+	v.Update(tea.KeyPressMsg{Code: 'B', Text: "B"})
+	require.Len(t, router.confirms, 1)
+	assert.Equal(t, []string{"web: web:12 → web:11"}, router.confirms[0].Targets)
+	v.Update(router.confirms[0].Run()())
+	assert.Equal(t, []string{"deploy prod/web web:11"}, client.actions)
+}
+
+func TestDetailView_StopTask(t *testing.T) {
+	client := &mockClient{
+		describeTaskFunc: func(_ context.Context, _, _ string) (*ecs.ECSTaskDetail, error) {
+			return &ecs.ECSTaskDetail{TaskID: "abc", TaskARN: "arn:task/prod/abc", TaskDef: "web:12", Status: "RUNNING"}, nil
+		},
+	}
+	router := &actionRouter{}
+	v := NewPlugin(client, "", "").DetailView(router, "prod/arn:aws:ecs:us-east-1:123:task/prod/abc").(*DetailView)
+	v.Update(v.Init()())
+
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	typeText(v, "stuck")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	v.Update(cmd())
+	require.Len(t, router.confirms, 1)
+	assert.Equal(t, "abc", router.confirms[0].Phrase)
+	v.Update(router.confirms[0].Run()())
+	assert.Equal(t, []string{"stop prod/arn:task/prod/abc stuck"}, client.actions)
+}
+
+func TestDetailView_ActionsReadOnly(t *testing.T) {
+	client := &mockClient{
+		describeServiceFunc: func(_ context.Context, _, _ string) (*ecs.ECSServiceDetail, error) {
+			return rollingService(), nil
+		},
+	}
+	router := &actionRouter{readOnly: true}
+	v := NewPlugin(client, "", "").DetailView(router, "prod/web").(*DetailView)
+	v.Update(v.Init()())
+
+	for _, h := range v.KeyHints() {
+		assert.NotEqual(t, "F", h.Key)
+	}
+	v.Update(tea.KeyPressMsg{Code: 'F', Text: "F"})
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Force new deployment is disabled in read-only mode"}, router.toasts)
+
+	// Actions that ask for a value refuse before the prompt opens.
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	assert.False(t, v.CapturingInput())
+	assert.Equal(t, "Scaling is disabled in read-only mode", router.toasts[1])
+
+	client.describeTaskFunc = func(_ context.Context, _, _ string) (*ecs.ECSTaskDetail, error) {
+		return &ecs.ECSTaskDetail{TaskID: "abc", TaskARN: "arn:task/prod/abc", Status: "RUNNING"}, nil
+	}
+	v = NewPlugin(client, "", "").DetailView(router, "prod/arn:aws:ecs:us-east-1:123:task/prod/abc").(*DetailView)
+	v.Update(v.Init()())
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	assert.False(t, v.CapturingInput())
+	assert.Equal(t, "Stop task is disabled in read-only mode", router.toasts[2])
+	assert.Empty(t, router.confirms)
+}
@@ -0,0 +1,146 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"tasnim.dev/aws-tui/internal/aws/autoscaling"
+	"tasnim.dev/aws-tui/internal/aws/ecs"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// scalingTab is the index of the Auto Scaling tab in a service's detail view.
+const scalingTab = 3
+
+// scalingActivityLimit is how many recent scaling activities are shown.
+const scalingActivityLimit = 10
+
+// serviceScaling is the auto scaling configuration and history of a service.
+type serviceScaling struct {
+	targets    []autoscaling.AutoScalingTarget
+	policies   []autoscaling.AutoScalingPolicy
+	activities []autoscaling.ScalingActivity
+}
+
+type scalingLoadedMsg struct {
+	scaling *serviceScaling
+	err     error
+}
+
+func (v *DetailView) onScalingTab() bool {
+	return !v.isTask && v.tabs.Active() == scalingTab
+}
+
+// loadScaling fetches the service's auto scaling the first time its tab is
+// shown.
+func (v *DetailView) loadScaling() tea.Cmd {
+	if !v.onScalingTab() || v.scalingClient == nil || v.scaling != nil || v.scalingLoading {
+		return nil
+	}
+	v.scalingLoading = true
+	v.scalingErr = nil
+	return v.fetchScaling()
+}
+
+func (v *DetailView) fetchScaling() tea.Cmd {
+	cluster, service := parseID(v.id)
+	client, router := v.scalingClient, v.router
+	viewCtx := router.Context(v)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
+		defer cancel()
+		var s serviceScaling
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			if s.targets, err = client.GetECSScalingTargets(ctx, cluster, service); err != nil {
+				return err
+			}
+			if s.policies, err = client.GetECSScalingPolicies(ctx, cluster, service); err != nil {
+				return err
+			}
+			s.activities, err = client.GetECSScalingActivities(ctx, cluster, service, scalingActivityLimit)
+			return err
+		})
+		if err != nil {
+			return scalingLoadedMsg{err: err}
+		}
+		return scalingLoadedMsg{scaling: &s}
+	}
+}
+
+func (v *DetailView) renderScaling(d *ecs.ECSServiceDetail) string {
+	switch {
+	case v.scalingClient == nil:
+		return "Auto scaling is unavailable."
+	case v.scalingErr != nil:
+		return fmt.Sprintf("Error: %v\nPress r to retry.", v.scalingErr)
+	case v.scaling == nil:
+		return "Loading auto scaling..."
+	case len(v.scaling.targets) == 0:
+		return "This service does not use auto scaling."
+	}
+
+	var b strings.Builder
+	t := v.scaling.targets[0]
+	b.WriteString(fmt.Sprintf("Min:      %d\n", t.MinCapacity))
+	b.WriteString(fmt.Sprintf("Max:      %d\n", t.MaxCapacity))
+	b.WriteString(fmt.Sprintf("Desired:  %d\n", d.DesiredCount))
+	b.WriteString(fmt.Sprintf("Running:  %d\n", d.RunningCount))
+
+	b.WriteString("\nPolicies:\n")
+	if len(v.scaling.policies) == 0 {
+		b.WriteString("  None.\n")
+	}
+	for _, p := range v.scaling.policies {
+		b.WriteString(fmt.Sprintf("  %s (%s)\n", p.PolicyName, p.PolicyType))
+		if p.MetricName != "" {
+			b.WriteString(fmt.Sprintf("    Metric:    %s\n", p.MetricName))
+		}
+		switch p.PolicyType {
+		case "TargetTrackingScaling":
+			b.WriteString(fmt.Sprintf("    Target:    %g\n", p.TargetValue))
+			b.WriteString(fmt.Sprintf("    Cooldown:  out %ds, in %ds\n", p.ScaleOutCooldown, p.ScaleInCooldown))
+			if p.DisableScaleIn {
+				b.WriteString("    Scale-in:  disabled\n")
+			}
+		case "StepScaling":
+			b.WriteString(fmt.Sprintf("    Adjust:    %s, cooldown %ds\n", p.AdjustmentType, p.Cooldown))
+			for _, s := range p.StepAdjustments {
+				b.WriteString(fmt.Sprintf("    Step:      %s → %+d\n", stepRange(s), s.Adjustment))
+			}
+		}
+		if len(p.Alarms) > 0 {
+			b.WriteString(fmt.Sprintf("    Alarms:    %s\n", strings.Join(p.Alarms, ", ")))
+		}
+	}
+
+	b.WriteString("\nRecent Activity:\n")
+	if len(v.scaling.activities) == 0 {
+		b.WriteString("  None.\n")
+	}
+	for _, a := range v.scaling.activities {
+		b.WriteString(fmt.Sprintf("  [%s] %s %s\n", a.StartTime.Format("2006-01-02 15:04"), a.Status, a.Description))
+		if a.Cause != "" {
+			b.WriteString(fmt.Sprintf("    Cause: %s\n", a.Cause))
+		}
+		if a.StatusMessage != "" {
+			b.WriteString(fmt.Sprintf("    %s\n", a.StatusMessage))
+		}
+	}
+	return b.String()
+}
+
+// stepRange describes the metric interval of a step, relative to the alarm
+// threshold.
+func stepRange(s autoscaling.StepAdjustment) string {
+	lower, upper := "-∞", "+∞"
+	if s.LowerBound != nil {
+		lower = fmt.Sprintf("%g", *s.LowerBound)
+	}
+	if s.UpperBound != nil {
+		upper = fmt.Sprintf("%g", *s.UpperBound)
+	}
+	return fmt.Sprintf("[%s, %s)", lower, upper)
+}
@@ -0,0 +1,153 @@
+package eks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the cluster detail view that actions and loaders refer to.
+const (
+	nodeGroupsTab = 1
+	upgradeTab    = 6
+)
+
+// scaleDoneMsg carries the result of changing a node group's scaling config.
+type scaleDoneMsg struct {
+	nodeGroup string
+	scaling   string
+	err       error
+}
+
+// startScale opens the prompt for the selected node group's new scaling
+// config.
+func (dv *DetailView) startScale() {
+	if dv.tabs.Active() != nodeGroupsTab || dv.nodeGroupTable.FilteredCount() == 0 {
+		return
+	}
+	switch {
+	case dv.router.ReadOnly():
+		dv.router.Toast(plugin.ToastWarning, "Scaling is disabled in read-only mode")
+		return
+	case dv.router.Offline():
+		dv.router.Toast(plugin.ToastWarning, "Scaling is unavailable offline")
+		return
+	}
+	ng := dv.nodeGroupTable.SelectedItem()
+	in := ui.NewInput(fmt.Sprintf("Scale %s (now %s)", ng.Name, formatScaling(ng.MinSize, ng.MaxSize, ng.DesiredSize)), "min/max/desired:", false)
+	dv.prompt, dv.promptFor = &in, ng
+}
+
+// answerScale confirms scaling the node group the prompt was opened for.
+func (dv *DetailView) answerScale(res ui.InputResult) {
+	ng := dv.promptFor
+	dv.prompt = nil
+	if res.Canceled {
+		return
+	}
+	minSize, maxSize, desiredSize, err := parseScaling(res.Value)
+	if err != nil {
+		dv.router.Toast(plugin.ToastError, "Invalid scaling: "+err.Error())
+		return
+	}
+	client, ctx, cluster := dv.client, dv.router.Context(dv), dv.clusterName
+	scaling := formatScaling(minSize, maxSize, desiredSize)
+	dv.router.Confirm(plugin.Action{
+		Title:   "Scale node group",
+		Targets: []string{fmt.Sprintf("%s/%s: %s → %s", cluster, ng.Name, formatScaling(ng.MinSize, ng.MaxSize, ng.DesiredSize), scaling)},
+		Phrase:  ng.Name,
+		Run: func() tea.Cmd {
+			return func() tea.Msg {
+				err := client.ScaleNodeGroup(ctx, cluster, ng.Name, minSize, maxSize, desiredSize)
+				return scaleDoneMsg{nodeGroup: ng.Name, scaling: scaling, err: err}
+			}
+		},
+	})
+}
+
+// afterScale reports the result of scaling and reloads the node groups.
+func (dv *DetailView) afterScale(msg scaleDoneMsg) tea.Cmd {
+	if msg.err != nil {
+		dv.router.Toast(plugin.ToastError, "Scaling failed: "+msg.err.Error())
+		return nil
+	}
+	dv.router.Toast(plugin.ToastInfo, "Scaling "+msg.nodeGroup+" to "+msg.scaling)
+	if dv.tabs.Active() != nodeGroupsTab {
+		return nil
+	}
+	dv.tabLoading = true
+	return dv.loadNodeGroups()
+}
+
+// parseScaling parses "min/max/desired", also accepting spaces or commas
+// between the sizes.
+func parseScaling(s string) (minSize, maxSize, desiredSize int, err error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '/' || r == ',' || r == ' '
+	})
+	if len(fields) != 3 {
+		return 0, 0, 0, fmt.Errorf("scaling must be min/max/desired, not %q", s)
+	}
+	sizes := make([]int, 3)
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return 0, 0, 0, fmt.Errorf("sizes must be whole numbers, not %q", f)
+		}
+		sizes[i] = n
+	}
+	minSize, maxSize, desiredSize = sizes[0], sizes[1], sizes[2]
+	switch {
+	case maxSize < 1:
+		return 0, 0, 0, errors.New("max size must be at least 1")
+	case minSize > maxSize:
+		return 0, 0, 0, fmt.Errorf("min size %d is above max size %d", minSize, maxSize)
+	case desiredSize < minSize || desiredSize > maxSize:
+		return 0, 0, 0, fmt.Errorf("desired size %d is outside %d–%d", desiredSize, minSize, maxSize)
+	}
+	return minSize, maxSize, desiredSize, nil
+}
+
+func formatScaling(minSize, maxSize, desiredSize int) string {
+	return fmt.Sprintf("%d/%d/%d", minSize, maxSize, desiredSize)
+}
+
+// actionHints returns the hints for the actions available on the active
+// tab, or none while read-only.
+func (dv *DetailView) actionHints() []plugin.KeyHint {
+	if dv.router.ReadOnly() || dv.tabs.Active() != nodeGroupsTab || dv.nodeGroupTable.FilteredCount() == 0 {
+		return nil
+	}
+	return []plugin.KeyHint{{Key: "e", Desc: "edit scaling"}}
+}
+
+// nodeGroupColumns returns the columns of the Node Groups tab.
+func nodeGroupColumns() []ui.Column[awseks.EKSNodeGroup] {
+	return []ui.Column[awseks.EKSNodeGroup]{
+		{Title: "Status", Width: 3, Field: func(ng awseks.EKSNodeGroup) string {
+			return nodeGroupStatusDot(ng.Status)
+		}},
+		{Title: "Name", Width: 24, Field: func(ng awseks.EKSNodeGroup) string { return ng.Name }},
+		{Title: "Version", Width: 8, Field: func(ng awseks.EKSNodeGroup) string { return ng.Version }},
+		{Title: "Instance Types", Width: 20, Field: func(ng awseks.EKSNodeGroup) string {
+			return strings.Join(ng.InstanceTypes, ", ")
+		}},
+		{Title: "AMI Type", Width: 16, Field: func(ng awseks.EKSNodeGroup) string { return ng.AMIType }},
+		{Title: "Desired", Width: 8, Field: func(ng awseks.EKSNodeGroup) string {
+			return fmt.Sprintf("%d", ng.DesiredSize)
+		}},
+		{Title: "Min", Width: 5, Field: func(ng awseks.EKSNodeGroup) string {
+			return fmt.Sprintf("%d", ng.MinSize)
+		}},
+		{Title: "Max", Width: 5, Field: func(ng awseks.EKSNodeGroup) string {
+			return fmt.Sprintf("%d", ng.MaxSize)
+		}},
+	}
+}
@@ -0,0 +1,388 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Cluster browser tabs.
+const (
+	tabPods = iota
+	tabDeployments
+	tabStatefulSets
+	tabDaemonSets
+	tabServices
+	tabNodes
+	tabNamespaces
+	browserTabCount
+)
+
+var namespaceStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("245"))
+
+// k8sListMsg carries the resources listed for one browser tab. namespace is
+// the selection they were listed for; results for an earlier selection are
+// dropped.
+type k8sListMsg struct {
+	tab       int
+	namespace string
+	items     any
+	err       error
+}
+
+// ClusterBrowser lists the Kubernetes resources inside an EKS cluster, one
+// kind per tab, optionally narrowed to a namespace. Selecting a workload,
+// service or node lists its pods; selecting a pod opens it.
+type ClusterBrowser struct {
+	api       K8sAPI
+	router    plugin.Router
+	cluster   string
+	namespace string // empty for all namespaces
+	tabs      ui.TabController
+
+	pods         ui.TableView[awseks.K8sPod]
+	deployments  ui.TableView[awseks.K8sWorkload]
+	statefulsets ui.TableView[awseks.K8sWorkload]
+	daemonsets   ui.TableView[awseks.K8sWorkload]
+	services     ui.TableView[awseks.K8sService]
+	nodes        ui.TableView[awseks.K8sNode]
+	namespaces   ui.TableView[awseks.K8sNamespace]
+
+	loaded [browserTabCount]bool
+	errs   [browserTabCount]error
+}
+
+// NewClusterBrowser creates a browser for cluster that starts on the Pods tab
+// across all namespaces.
+func NewClusterBrowser(api K8sAPI, router plugin.Router, cluster string) *ClusterBrowser {
+	return &ClusterBrowser{
+		api:          api,
+		router:       router,
+		cluster:      cluster,
+		tabs:         ui.NewTabController([]string{"Pods", "Deployments", "StatefulSets", "DaemonSets", "Services", "Nodes", "Namespaces"}),
+		pods:         ui.NewTableView(podColumns(), nil, podID),
+		deployments:  ui.NewTableView(workloadColumns(), nil, workloadID),
+		statefulsets: ui.NewTableView(workloadColumns(), nil, workloadID),
+		daemonsets:   ui.NewTableView(workloadColumns(), nil, workloadID),
+		services:     ui.NewTableView(serviceColumns(), nil, serviceID),
+		nodes:        ui.NewTableView(nodeColumns(), nil, func(n awseks.K8sNode) string { return n.Name }),
+		namespaces:   ui.NewTableView(namespaceColumns(), nil, func(n awseks.K8sNamespace) string { return n.Name }),
+	}
+}
+
+func (b *ClusterBrowser) Title() string {
+	return "Kubernetes — " + b.cluster
+}
+
+func (b *ClusterBrowser) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "1-7", Desc: "jump to tab"},
+		{Key: "/", Desc: "filter"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "esc", Desc: "back"},
+	}
+	switch b.tabs.Active() {
+	case tabPods:
+		hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "view pod"})
+	case tabNamespaces:
+		hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "select namespace"})
+	default:
+		hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "list pods"})
+	}
+	if b.namespace != "" {
+		hints = append(hints, plugin.KeyHint{Key: "N", Desc: "all namespaces"})
+	}
+	return hints
+}
+
+// Init lists the active tab. The app calls it again on auto-refresh.
+func (b *ClusterBrowser) Init() tea.Cmd {
+	return b.fetch(b.tabs.Active())
+}
+
+func (b *ClusterBrowser) fetch(tab int) tea.Cmd {
+	api, router, namespace := b.api, b.router, b.namespace
+	viewCtx := router.Context(b)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(viewCtx, k8sTimeout)
+		defer cancel()
+		var items any
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			items, err = listTab(ctx, api, tab, namespace)
+			return err
+		})
+		return k8sListMsg{tab: tab, namespace: namespace, items: items, err: err}
+	}
+}
+
+// listTab lists the resources shown on tab. Nodes and namespaces are not
+// namespaced and ignore namespace.
+func listTab(ctx context.Context, api K8sAPI, tab int, namespace string) (any, error) {
+	switch tab {
+	case tabPods:
+		pods, err := api.ListPods(ctx, namespace, awseks.PodFilter{})
+		return pods, err
+	case tabDeployments:
+		deployments, err := api.ListDeployments(ctx, namespace)
+		return deployments, err
+	case tabStatefulSets:
+		statefulsets, err := api.ListStatefulSets(ctx, namespace)
+		return statefulsets, err
+	case tabDaemonSets:
+		daemonsets, err := api.ListDaemonSets(ctx, namespace)
+		return daemonsets, err
+	case tabServices:
+		services, err := api.ListServices(ctx, namespace)
+		return services, err
+	case tabNodes:
+		nodes, err := api.ListNodes(ctx)
+		return nodes, err
+	case tabNamespaces:
+		namespaces, err := api.ListNamespaces(ctx)
+		return namespaces, err
+	}
+	return nil, fmt.Errorf("unknown tab %d", tab)
+}
+
+func (b *ClusterBrowser) setItems(tab int, items any) {
+	switch tab {
+	case tabPods:
+		b.pods.SetItems(items.([]awseks.K8sPod))
+	case tabDeployments:
+		b.deployments.SetItems(items.([]awseks.K8sWorkload))
+	case tabStatefulSets:
+		b.statefulsets.SetItems(items.([]awseks.K8sWorkload))
+	case tabDaemonSets:
+		b.daemonsets.SetItems(items.([]awseks.K8sWorkload))
+	case tabServices:
+		b.services.SetItems(items.([]awseks.K8sService))
+	case tabNodes:
+		b.nodes.SetItems(items.([]awseks.K8sNode))
+	case tabNamespaces:
+		b.namespaces.SetItems(items.([]awseks.K8sNamespace))
+	}
+}
+
+// setNamespace narrows the namespaced tabs to namespace and shows its pods.
+func (b *ClusterBrowser) setNamespace(namespace string) tea.Cmd {
+	b.namespace = namespace
+	for tab := range b.loaded {
+		if tab != tabNodes && tab != tabNamespaces {
+			b.loaded[tab] = false
+			b.errs[tab] = nil
+		}
+	}
+	b.tabs.SetActive(tabPods)
+	return b.fetch(tabPods)
+}
+
+func (b *ClusterBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case k8sListMsg:
+		if msg.namespace != b.namespace && msg.tab != tabNodes && msg.tab != tabNamespaces {
+			return b, nil
+		}
+		b.loaded[msg.tab] = true
+		b.errs[msg.tab] = msg.err
+		if msg.err == nil {
+			b.setItems(msg.tab, msg.items)
+		}
+		return b, nil
+
+	case tea.KeyPressMsg:
+		if b.CapturingInput() {
+			return b, b.updateTable(msg)
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			b.router.Pop()
+			return b, nil
+		case "r":
+			return b, b.fetch(b.tabs.Active())
+		case "N":
+			if b.namespace != "" {
+				return b, b.setNamespace("")
+			}
+			return b, nil
+		case "enter":
+			return b, b.open()
+		}
+
+		prev := b.tabs.Active()
+		b.tabs, _ = b.tabs.Update(msg)
+		if tab := b.tabs.Active(); tab != prev {
+			if !b.loaded[tab] {
+				return b, b.fetch(tab)
+			}
+			return b, nil
+		}
+		return b, b.updateTable(msg)
+	}
+	return b, nil
+}
+
+func (b *ClusterBrowser) updateTable(msg tea.KeyPressMsg) tea.Cmd {
+	var cmd tea.Cmd
+	switch b.tabs.Active() {
+	case tabPods:
+		b.pods, cmd = b.pods.Update(msg)
+	case tabDeployments:
+		b.deployments, cmd = b.deployments.Update(msg)
+	case tabStatefulSets:
+		b.statefulsets, cmd = b.statefulsets.Update(msg)
+	case tabDaemonSets:
+		b.daemonsets, cmd = b.daemonsets.Update(msg)
+	case tabServices:
+		b.services, cmd = b.services.Update(msg)
+	case tabNodes:
+		b.nodes, cmd = b.nodes.Update(msg)
+	case tabNamespaces:
+		b.namespaces, cmd = b.namespaces.Update(msg)
+	}
+	return cmd
+}
+
+// CapturingInput implements plugin.InputCapturer while a table filter is
+// being typed.
+func (b *ClusterBrowser) CapturingInput() bool {
+	switch b.tabs.Active() {
+	case tabPods:
+		return b.pods.Filtering()
+	case tabDeployments:
+		return b.deployments.Filtering()
+	case tabStatefulSets:
+		return b.statefulsets.Filtering()
+	case tabDaemonSets:
+		return b.daemonsets.Filtering()
+	case tabServices:
+		return b.services.Filtering()
+	case tabNodes:
+		return b.nodes.Filtering()
+	case tabNamespaces:
+		return b.namespaces.Filtering()
+	}
+	return false
+}
+
+// open drills into the selected row.
+func (b *ClusterBrowser) open() tea.Cmd {
+	var view plugin.View
+	switch b.tabs.Active() {
+	case tabPods:
+		if b.pods.FilteredCount() == 0 {
+			return nil
+		}
+		view = NewPodDetailView(b.api, b.router, b.cluster, b.pods.SelectedItem())
+	case tabDeployments, tabStatefulSets, tabDaemonSets:
+		table := b.workloadTable(b.tabs.Active())
+		if table.FilteredCount() == 0 {
+			return nil
+		}
+		w := table.SelectedItem()
+		owner := strings.ToLower(w.Kind) + "/" + w.Name
+		view = NewPodListView(b.api, b.router, b.cluster, w.Namespace, owner, awseks.PodFilter{LabelSelector: w.Selector})
+	case tabServices:
+		if b.services.FilteredCount() == 0 {
+			return nil
+		}
+		s := b.services.SelectedItem()
+		if s.Selector == "" {
+			b.router.Toast(plugin.ToastInfo, "Service "+s.Name+" has no pod selector")
+			return nil
+		}
+		view = NewPodListView(b.api, b.router, b.cluster, s.Namespace, "service/"+s.Name, awseks.PodFilter{LabelSelector: s.Selector})
+	case tabNodes:
+		if b.nodes.FilteredCount() == 0 {
+			return nil
+		}
+		n := b.nodes.SelectedItem()
+		view = NewPodListView(b.api, b.router, b.cluster, "", "node/"+n.Name, awseks.PodFilter{FieldSelector: "spec.nodeName=" + n.Name})
+	case tabNamespaces:
+		if b.namespaces.FilteredCount() == 0 {
+			return nil
+		}
+		return b.setNamespace(b.namespaces.SelectedItem().Name)
+	}
+	b.router.Push(view)
+	return view.Init()
+}
+
+// workloadTable returns the table of the deployments, statefulsets or
+// daemonsets tab.
+func (b *ClusterBrowser) workloadTable(tab int) ui.TableView[awseks.K8sWorkload] {
+	switch tab {
+	case tabStatefulSets:
+		return b.statefulsets
+	case tabDaemonSets:
+		return b.daemonsets
+	default:
+		return b.deployments
+	}
+}
+
+func (b *ClusterBrowser) View() tea.View {
+	var s strings.Builder
+	s.WriteString(namespaceStyle.Render("Namespace: " + namespaceLabel(b.namespace)))
+	s.WriteString("\n")
+	s.WriteString(b.tabs.View())
+	s.WriteString("\n\n")
+
+	tab := b.tabs.Active()
+	switch {
+	case !b.loaded[tab]:
+		skel := ui.NewSkeleton(80, 6)
+		s.WriteString(skel.View())
+	case b.errs[tab] != nil:
+		s.WriteString(fmt.Sprintf("Error: %v", b.errs[tab]))
+	default:
+		s.WriteString(b.tableView(tab))
+	}
+	return tea.NewView(s.String())
+}
+
+func (b *ClusterBrowser) tableView(tab int) string {
+	switch tab {
+	case tabPods:
+		if b.pods.ItemCount() == 0 {
+			return "No pods found."
+		}
+		return b.pods.View()
+	case tabDeployments:
+		if b.deployments.ItemCount() == 0 {
+			return "No deployments found."
+		}
+		return b.deployments.View()
+	case tabStatefulSets:
+		if b.statefulsets.ItemCount() == 0 {
+			return "No statefulsets found."
+		}
+		return b.statefulsets.View()
+	case tabDaemonSets:
+		if b.daemonsets.ItemCount() == 0 {
+			return "No daemonsets found."
+		}
+		return b.daemonsets.View()
+	case tabServices:
+		if b.services.ItemCount() == 0 {
+			return "No services found."
+		}
+		return b.services.View()
+	case tabNodes:
+		if b.nodes.ItemCount() == 0 {
+			return "No nodes found."
+		}
+		return b.nodes.View()
+	case tabNamespaces:
+		return b.namespaces.View()
+	}
+	return ""
+}
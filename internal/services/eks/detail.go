@@ -10,6 +10,7 @@ import (
 
 	awseks "tasnim.dev/aws-tui/internal/aws/eks"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
@@ -41,85 +42,148 @@ type accessEntriesMsg struct {
 	err     error
 }
 
+type eventsMsg struct {
+	groups []awseks.K8sEventGroup
+	err    error
+}
+
 // DetailView shows detailed information for a single EKS cluster.
 type DetailView struct {
-	client      *awseks.Client
-	router      plugin.Router
-	clusterName string
-	cluster     *awseks.EKSCluster
-	nodeGroups  []awseks.EKSNodeGroup
-	addons      []awseks.EKSAddon
-	fargate     []awseks.EKSFargateProfile
-	access      []awseks.EKSAccessEntry
-	tabs        ui.TabController
-	loading     bool
-	tabLoading  bool
-	err         error
-	tabErr      error
-	lastTab     int
-	region      string
-	profile     string
+	client         *awseks.Client
+	router         plugin.Router
+	clusterName    string
+	cluster        *awseks.EKSCluster
+	nodeGroupTable ui.TableView[awseks.EKSNodeGroup]
+	addons         []awseks.EKSAddon
+	fargate        []awseks.EKSFargateProfile
+	access         []awseks.EKSAccessEntry
+	events         []awseks.K8sEventGroup
+	upgrade        *upgradeReadiness
+	metrics        *metrics.Panel
+	tabs           ui.TabController
+	loading        bool
+	tabLoading     bool
+	err            error
+	tabErr         error
+	lastTab        int
+	region         string
+	profile        string
+	k8s            K8sConnector
+	prompt         *ui.Input           // collects a node group's new scaling config
+	promptFor      awseks.EKSNodeGroup // the node group the prompt scales
 }
 
 // NewDetailView creates a DetailView for the given cluster name.
 func NewDetailView(client *awseks.Client, router plugin.Router, clusterName, region, profile string) *DetailView {
-	return &DetailView{
-		client:      client,
-		router:      router,
-		clusterName: clusterName,
-		tabs:        ui.NewTabController([]string{"Overview", "Node Groups", "Addons", "Fargate Profiles", "Access Entries"}),
-		loading:     true,
-		lastTab:     -1,
-		region:      region,
-		profile:     profile,
+	dv := &DetailView{
+		client:         client,
+		router:         router,
+		clusterName:    clusterName,
+		nodeGroupTable: ui.NewTableView(nodeGroupColumns(), nil, func(ng awseks.EKSNodeGroup) string { return ng.Name }),
+		tabs:           ui.NewTabController([]string{"Overview", "Node Groups", "Addons", "Fargate Profiles", "Access Entries", "Events", "Upgrade Readiness", "Metrics"}),
+		loading:        true,
+		lastTab:        -1,
+		region:         region,
+		profile:        profile,
 	}
+	dv.metrics = newMetricsPanel(dv)
+	return dv
 }
 
 func (dv *DetailView) loadCluster() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	name := dv.clusterName
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		cluster, err := client.DescribeCluster(context.TODO(), name)
+		var cluster awseks.EKSCluster
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			cluster, err = client.DescribeCluster(ctx, name)
+			return err
+		})
 		return clusterDetailMsg{cluster: cluster, err: err}
 	}
 }
 
 func (dv *DetailView) loadNodeGroups() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	name := dv.clusterName
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		ngs, err := client.ListNodeGroups(context.TODO(), name)
+		var ngs []awseks.EKSNodeGroup
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			ngs, err = client.ListNodeGroups(ctx, name)
+			return err
+		})
 		return nodeGroupsMsg{nodeGroups: ngs, err: err}
 	}
 }
 
 func (dv *DetailView) loadAddons() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	name := dv.clusterName
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		addons, err := client.ListAddons(context.TODO(), name)
+		var addons []awseks.EKSAddon
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			addons, err = client.ListAddons(ctx, name)
+			return err
+		})
 		return addonsMsg{addons: addons, err: err}
 	}
 }
 
 func (dv *DetailView) loadFargateProfiles() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	name := dv.clusterName
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		profiles, err := client.ListFargateProfiles(context.TODO(), name)
+		var profiles []awseks.EKSFargateProfile
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			profiles, err = client.ListFargateProfiles(ctx, name)
+			return err
+		})
 		return fargateProfilesMsg{profiles: profiles, err: err}
 	}
 }
 
 func (dv *DetailView) loadAccessEntries() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	name := dv.clusterName
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		entries, err := client.ListAccessEntries(context.TODO(), name)
+		var entries []awseks.EKSAccessEntry
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			entries, err = client.ListAccessEntries(ctx, name)
+			return err
+		})
 		return accessEntriesMsg{entries: entries, err: err}
 	}
 }
 
+// loadEvents lists the cluster's Warning events through the Kubernetes API.
+func (dv *DetailView) loadEvents() tea.Cmd {
+	if dv.k8s == nil || dv.cluster.Status != "ACTIVE" {
+		return func() tea.Msg { return eventsMsg{} }
+	}
+	connect, router := dv.k8s, dv.router
+	cluster, region := *dv.cluster, dv.region
+	viewCtx := router.Context(dv)
+	return func() tea.Msg {
+		api, err := connect(cluster, region)
+		if err != nil {
+			return eventsMsg{err: err}
+		}
+		ctx, cancel := context.WithTimeout(viewCtx, k8sTimeout)
+		defer cancel()
+		var events []awseks.K8sEvent
+		err = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			events, err = api.ListWarningEvents(ctx, "")
+			return err
+		})
+		return eventsMsg{groups: awseks.GroupEvents(events), err: err}
+	}
+}
+
 func (dv *DetailView) loadTabData() tea.Cmd {
 	switch dv.tabs.Active() {
 	case 1:
@@ -130,6 +194,10 @@ func (dv *DetailView) loadTabData() tea.Cmd {
 		return dv.loadFargateProfiles()
 	case 4:
 		return dv.loadAccessEntries()
+	case 5:
+		return dv.loadEvents()
+	case upgradeTab:
+		return dv.loadUpgradeReadiness()
 	default:
 		return nil
 	}
@@ -140,6 +208,10 @@ func (dv *DetailView) Init() tea.Cmd {
 }
 
 func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if dv.metrics.Update(msg) {
+		return dv, nil
+	}
+
 	switch msg := msg.(type) {
 	case clusterDetailMsg:
 		dv.loading = false
@@ -158,7 +230,7 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			dv.tabErr = msg.err
 			return dv, nil
 		}
-		dv.nodeGroups = msg.nodeGroups
+		dv.nodeGroupTable.SetItems(msg.nodeGroups)
 		return dv, nil
 
 	case addonsMsg:
@@ -188,6 +260,37 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		dv.access = msg.entries
 		return dv, nil
 
+	case eventsMsg:
+		dv.tabLoading = false
+		if msg.err != nil {
+			dv.tabErr = msg.err
+			return dv, nil
+		}
+		dv.events = msg.groups
+		return dv, nil
+
+	case upgradeReadinessMsg:
+		dv.tabLoading = false
+		if msg.err != nil {
+			dv.tabErr = msg.err
+			return dv, nil
+		}
+		dv.upgrade = &msg.readiness
+		return dv, nil
+
+	case scaleDoneMsg:
+		return dv, dv.afterScale(msg)
+
+	case ui.InputResult:
+		if dv.prompt != nil {
+			dv.answerScale(msg)
+		}
+		return dv, nil
+
+	case tea.WindowSizeMsg:
+		dv.metrics.SetWidth(msg.Width)
+		return dv, nil
+
 	case eksExecFinishedMsg:
 		if msg.err != nil {
 			dv.router.Toast(plugin.ToastError, "kubectl failed: "+msg.err.Error())
@@ -195,15 +298,39 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return dv, nil
 
 	case tea.KeyPressMsg:
+		if dv.prompt != nil {
+			in, cmd := dv.prompt.Update(msg)
+			dv.prompt = &in
+			return dv, cmd
+		}
+		if dv.CapturingInput() {
+			var cmd tea.Cmd
+			dv.nodeGroupTable, cmd = dv.nodeGroupTable.Update(msg)
+			return dv, cmd
+		}
+		if dv.tabs.Active() == metricsTab {
+			if cmd, ok := dv.metrics.HandleKey(dv.router.Context(dv), msg.String()); ok {
+				return dv, cmd
+			}
+		}
 		switch msg.String() {
 		case "esc", "backspace":
 			dv.router.Pop()
 			return dv, nil
 		case "x":
+			if dv.router.Offline() {
+				dv.router.Toast(plugin.ToastWarning, "kubectl is unavailable offline")
+				return dv, nil
+			}
 			if dv.cluster != nil && dv.cluster.Status == "ACTIVE" {
 				return dv, dv.execKubectl()
 			}
 			return dv, nil
+		case "w":
+			return dv, dv.browseWorkloads()
+		case "e":
+			dv.startScale()
+			return dv, nil
 		}
 	}
 
@@ -214,14 +341,49 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// If tab changed, fetch data for the new tab.
 	if dv.tabs.Active() != prevTab && dv.cluster != nil {
 		dv.lastTab = dv.tabs.Active()
-		dv.tabLoading = true
 		dv.tabErr = nil
+		if dv.tabs.Active() == metricsTab {
+			// The panel shows its own loading state.
+			dv.tabLoading = false
+			return dv, tea.Batch(cmd, dv.metrics.LoadOnce(dv.router.Context(dv)))
+		}
+		dv.tabLoading = true
 		return dv, tea.Batch(cmd, dv.loadTabData())
 	}
 
+	if _, ok := msg.(tea.KeyPressMsg); ok && dv.tabs.Active() == nodeGroupsTab {
+		var tableCmd tea.Cmd
+		dv.nodeGroupTable, tableCmd = dv.nodeGroupTable.Update(msg)
+		return dv, tea.Batch(cmd, tableCmd)
+	}
+
 	return dv, cmd
 }
 
+// CapturingInput implements plugin.InputCapturer while the scaling prompt or
+// the node group filter is open.
+func (dv *DetailView) CapturingInput() bool {
+	return dv.prompt != nil || (dv.tabs.Active() == nodeGroupsTab && dv.nodeGroupTable.Filtering())
+}
+
+// browseWorkloads opens the Kubernetes workload browser for an active cluster.
+func (dv *DetailView) browseWorkloads() tea.Cmd {
+	if dv.k8s == nil || dv.cluster == nil || dv.cluster.Status != "ACTIVE" {
+		return nil
+	}
+	if dv.router.Offline() {
+		dv.router.Toast(plugin.ToastWarning, "The Kubernetes API is unavailable offline")
+		return nil
+	}
+	api, err := dv.k8s(*dv.cluster, dv.region)
+	if err != nil {
+		dv.router.Toast(plugin.ToastError, "Connecting to cluster failed: "+err.Error())
+		return nil
+	}
+	view := NewClusterBrowser(api, dv.router, dv.cluster.Name)
+	dv.router.Push(view)
+	return view.Init()
+}
 
 func (dv *DetailView) execKubectl() tea.Cmd {
 	// Update kubeconfig for this cluster, then open an interactive kubectl shell.
@@ -271,8 +433,18 @@ func (dv *DetailView) View() tea.View {
 			b.WriteString(dv.renderFargateProfiles())
 		case 4:
 			b.WriteString(dv.renderAccessEntries())
+		case 5:
+			b.WriteString(dv.renderEvents())
+		case upgradeTab:
+			b.WriteString(dv.renderUpgradeReadiness())
+		case metricsTab:
+			b.WriteString(dv.metrics.View())
 		}
 	}
+	if dv.prompt != nil {
+		b.WriteString("\n\n")
+		b.WriteString(dv.prompt.View())
+	}
 
 	return tea.NewView(b.String())
 }
@@ -303,32 +475,10 @@ func (dv *DetailView) renderOverview() string {
 }
 
 func (dv *DetailView) renderNodeGroups() string {
-	if len(dv.nodeGroups) == 0 {
+	if dv.nodeGroupTable.ItemCount() == 0 {
 		return "No node groups found."
 	}
-
-	cols := []ui.Column[awseks.EKSNodeGroup]{
-		{Title: "Status", Width: 3, Field: func(ng awseks.EKSNodeGroup) string {
-			return nodeGroupStatusDot(ng.Status)
-		}},
-		{Title: "Name", Width: 24, Field: func(ng awseks.EKSNodeGroup) string { return ng.Name }},
-		{Title: "Instance Types", Width: 20, Field: func(ng awseks.EKSNodeGroup) string {
-			return strings.Join(ng.InstanceTypes, ", ")
-		}},
-		{Title: "AMI Type", Width: 16, Field: func(ng awseks.EKSNodeGroup) string { return ng.AMIType }},
-		{Title: "Desired", Width: 8, Field: func(ng awseks.EKSNodeGroup) string {
-			return fmt.Sprintf("%d", ng.DesiredSize)
-		}},
-		{Title: "Min", Width: 5, Field: func(ng awseks.EKSNodeGroup) string {
-			return fmt.Sprintf("%d", ng.MinSize)
-		}},
-		{Title: "Max", Width: 5, Field: func(ng awseks.EKSNodeGroup) string {
-			return fmt.Sprintf("%d", ng.MaxSize)
-		}},
-	}
-
-	tv := ui.NewTableView(cols, dv.nodeGroups, func(ng awseks.EKSNodeGroup) string { return ng.Name })
-	return tv.View()
+	return dv.nodeGroupTable.View()
 }
 
 func nodeGroupStatusDot(status string) string {
@@ -432,6 +582,31 @@ func (dv *DetailView) renderAccessEntries() string {
 	return tv.View()
 }
 
+func (dv *DetailView) renderEvents() string {
+	switch {
+	case dv.k8s == nil:
+		return "Kubernetes events are unavailable."
+	case dv.cluster.Status != "ACTIVE":
+		return "Events are available once the cluster is active."
+	case len(dv.events) == 0:
+		return "No warning events."
+	}
+
+	cols := []ui.Column[awseks.K8sEventGroup]{
+		{Title: "Last Seen", Width: 9, Field: func(g awseks.K8sEventGroup) string { return age(g.LastSeen) }},
+		{Title: "Object", Width: 36, Field: func(g awseks.K8sEventGroup) string { return g.Kind + "/" + g.Name }},
+		{Title: "Namespace", Width: 16, Field: func(g awseks.K8sEventGroup) string { return g.Namespace }},
+		{Title: "Reasons", Width: 24, Field: func(g awseks.K8sEventGroup) string { return strings.Join(g.Reasons, ", ") }},
+		{Title: "Count", Width: 6, Field: func(g awseks.K8sEventGroup) string { return fmt.Sprintf("%d", g.Count) }},
+		{Title: "Message", Width: 60, Field: func(g awseks.K8sEventGroup) string { return g.Message }},
+	}
+
+	tv := ui.NewTableView(cols, dv.events, func(g awseks.K8sEventGroup) string {
+		return g.Kind + "/" + g.Namespace + "/" + g.Name
+	})
+	return tv.View()
+}
+
 func (dv *DetailView) Title() string {
 	if dv.cluster != nil {
 		return dv.cluster.Name
@@ -443,10 +618,17 @@ func (dv *DetailView) KeyHints() []plugin.KeyHint {
 	hints := []plugin.KeyHint{
 		{Key: "esc", Desc: "back"},
 		{Key: "[/]", Desc: "switch tab"},
-		{Key: "1-5", Desc: "jump to tab"},
+		{Key: "1-8", Desc: "jump to tab"},
 	}
+	if dv.tabs.Active() == metricsTab {
+		hints = append(hints, dv.metrics.KeyHints()...)
+	}
+	hints = append(hints, dv.actionHints()...)
 	if dv.cluster != nil && dv.cluster.Status == "ACTIVE" {
 		hints = append(hints, plugin.KeyHint{Key: "x", Desc: "kubectl shell"})
+		if dv.k8s != nil {
+			hints = append(hints, plugin.KeyHint{Key: "w", Desc: "browse workloads"})
+		}
 	}
 	return hints
 }
@@ -0,0 +1,124 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// K8sAPI defines the subset of awseks.K8sClient methods used to browse a
+// cluster's Kubernetes resources.
+type K8sAPI interface {
+	ListNamespaces(ctx context.Context) ([]awseks.K8sNamespace, error)
+	ListPods(ctx context.Context, namespace string, filter awseks.PodFilter) ([]awseks.K8sPod, error)
+	GetPod(ctx context.Context, namespace, name string) (awseks.K8sPod, error)
+	ListDeployments(ctx context.Context, namespace string) ([]awseks.K8sWorkload, error)
+	ListStatefulSets(ctx context.Context, namespace string) ([]awseks.K8sWorkload, error)
+	ListDaemonSets(ctx context.Context, namespace string) ([]awseks.K8sWorkload, error)
+	ListServices(ctx context.Context, namespace string) ([]awseks.K8sService, error)
+	ListNodes(ctx context.Context) ([]awseks.K8sNode, error)
+	ListWarningEvents(ctx context.Context, namespace string) ([]awseks.K8sEvent, error)
+	StreamPodLogs(ctx context.Context, namespace, pod string, opts awseks.PodLogOptions) (io.ReadCloser, error)
+	Exec(namespace, pod, container string, command []string) *awseks.PodExec
+}
+
+// K8sConnector returns a Kubernetes API client for an EKS cluster in region.
+type K8sConnector func(cluster awseks.EKSCluster, region string) (K8sAPI, error)
+
+// k8sTimeout bounds a single Kubernetes API list call.
+const k8sTimeout = 30 * time.Second
+
+// age formats the time since t the way kubectl does: 45s, 12m, 5h, 3d.
+func age(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
+
+// namespaceLabel describes a namespace selection, where empty means all.
+func namespaceLabel(namespace string) string {
+	if namespace == "" {
+		return "all namespaces"
+	}
+	return namespace
+}
+
+func podColumns() []ui.Column[awseks.K8sPod] {
+	return []ui.Column[awseks.K8sPod]{
+		{Title: "Name", Width: 40, Field: func(p awseks.K8sPod) string { return p.Name }},
+		{Title: "Namespace", Width: 16, Field: func(p awseks.K8sPod) string { return p.Namespace }},
+		{Title: "Ready", Width: 6, Field: func(p awseks.K8sPod) string { return fmt.Sprintf("%d/%d", p.Ready, p.Total) }},
+		{Title: "Status", Width: 18, Field: func(p awseks.K8sPod) string { return p.Status }},
+		{Title: "Restarts", Width: 8, Field: func(p awseks.K8sPod) string { return fmt.Sprintf("%d", p.Restarts) }},
+		{Title: "Node", Width: 30, Field: func(p awseks.K8sPod) string { return p.Node }},
+		{Title: "IP", Width: 15, Field: func(p awseks.K8sPod) string { return p.IP }},
+		{Title: "Age", Width: 5, Field: func(p awseks.K8sPod) string { return age(p.CreatedAt) }},
+	}
+}
+
+func podID(p awseks.K8sPod) string { return p.Namespace + "/" + p.Name }
+
+func workloadColumns() []ui.Column[awseks.K8sWorkload] {
+	return []ui.Column[awseks.K8sWorkload]{
+		{Title: "Name", Width: 32, Field: func(w awseks.K8sWorkload) string { return w.Name }},
+		{Title: "Namespace", Width: 16, Field: func(w awseks.K8sWorkload) string { return w.Namespace }},
+		{Title: "Ready", Width: 7, Field: func(w awseks.K8sWorkload) string { return fmt.Sprintf("%d/%d", w.Ready, w.Desired) }},
+		{Title: "Up-to-date", Width: 10, Field: func(w awseks.K8sWorkload) string { return fmt.Sprintf("%d", w.UpToDate) }},
+		{Title: "Available", Width: 9, Field: func(w awseks.K8sWorkload) string { return fmt.Sprintf("%d", w.Available) }},
+		{Title: "Images", Width: 40, Field: func(w awseks.K8sWorkload) string { return strings.Join(w.Images, ", ") }},
+		{Title: "Age", Width: 5, Field: func(w awseks.K8sWorkload) string { return age(w.CreatedAt) }},
+	}
+}
+
+func workloadID(w awseks.K8sWorkload) string { return w.Namespace + "/" + w.Name }
+
+func serviceColumns() []ui.Column[awseks.K8sService] {
+	return []ui.Column[awseks.K8sService]{
+		{Title: "Name", Width: 32, Field: func(s awseks.K8sService) string { return s.Name }},
+		{Title: "Namespace", Width: 16, Field: func(s awseks.K8sService) string { return s.Namespace }},
+		{Title: "Type", Width: 12, Field: func(s awseks.K8sService) string { return s.Type }},
+		{Title: "Cluster IP", Width: 15, Field: func(s awseks.K8sService) string { return s.ClusterIP }},
+		{Title: "External IP", Width: 30, Field: func(s awseks.K8sService) string { return s.ExternalIP }},
+		{Title: "Ports", Width: 24, Field: func(s awseks.K8sService) string { return strings.Join(s.Ports, ",") }},
+		{Title: "Age", Width: 5, Field: func(s awseks.K8sService) string { return age(s.CreatedAt) }},
+	}
+}
+
+func serviceID(s awseks.K8sService) string { return s.Namespace + "/" + s.Name }
+
+func nodeColumns() []ui.Column[awseks.K8sNode] {
+	return []ui.Column[awseks.K8sNode]{
+		{Title: "Name", Width: 30, Field: func(n awseks.K8sNode) string { return n.Name }},
+		{Title: "Status", Width: 10, Field: func(n awseks.K8sNode) string { return n.Status }},
+		{Title: "Instance Type", Width: 13, Field: func(n awseks.K8sNode) string { return n.InstanceType }},
+		{Title: "Zone", Width: 12, Field: func(n awseks.K8sNode) string { return n.Zone }},
+		{Title: "Node Group", Width: 20, Field: func(n awseks.K8sNode) string { return n.NodeGroup }},
+		{Title: "Internal IP", Width: 15, Field: func(n awseks.K8sNode) string { return n.InternalIP }},
+		{Title: "Version", Width: 20, Field: func(n awseks.K8sNode) string { return n.Version }},
+		{Title: "Age", Width: 5, Field: func(n awseks.K8sNode) string { return age(n.CreatedAt) }},
+	}
+}
+
+func namespaceColumns() []ui.Column[awseks.K8sNamespace] {
+	return []ui.Column[awseks.K8sNamespace]{
+		{Title: "Name", Width: 32, Field: func(n awseks.K8sNamespace) string { return n.Name }},
+		{Title: "Status", Width: 12, Field: func(n awseks.K8sNamespace) string { return n.Status }},
+		{Title: "Age", Width: 5, Field: func(n awseks.K8sNamespace) string { return age(n.CreatedAt) }},
+	}
+}
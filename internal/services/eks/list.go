@@ -7,18 +7,28 @@ import (
 	"charm.land/lipgloss/v2"
 
 	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
-// clustersMsg carries the result of fetching clusters.
+// cacheKey is the cache service key for EKS clusters.
+const cacheKey = "eks"
+
+// clustersMsg carries the result of fetching pages of clusters. after is the
+// token the pages were fetched from, nil when they start the listing.
 type clustersMsg struct {
 	clusters []awseks.EKSCluster
+	after    *string
+	next     *string
 	err      error
 }
 
 // ListView displays EKS clusters in a table.
 type ListView struct {
+	plugin.Revalidation
+
 	client  *awseks.Client
 	router  plugin.Router
 	table   ui.TableView[awseks.EKSCluster]
@@ -26,6 +36,9 @@ type ListView struct {
 	err     error
 	region  string
 	profile string
+	cache   *cache.Scope
+	next    *string
+	metrics metrics.Client // read by detail views; nil without CloudWatch
 }
 
 // NewListView creates a new EKS ListView.
@@ -34,7 +47,7 @@ func NewListView(client *awseks.Client, router plugin.Router, region, profile st
 	tv := ui.NewTableView(cols, nil, func(c awseks.EKSCluster) string {
 		return c.Name
 	})
-	return &ListView{
+	lv := &ListView{
 		client:  client,
 		router:  router,
 		table:   tv,
@@ -42,6 +55,8 @@ func NewListView(client *awseks.Client, router plugin.Router, region, profile st
 		region:  region,
 		profile: profile,
 	}
+	lv.table.OnLoadMore(func() tea.Cmd { return lv.fetchClusters(lv.next, 0) })
+	return lv
 }
 
 func clusterColumns() []ui.Column[awseks.EKSCluster] {
@@ -76,27 +91,71 @@ func clusterStatusDot(status string) string {
 	}
 }
 
-func (lv *ListView) fetchClusters() tea.Cmd {
-	client := lv.client
+// fetchClusters fetches pages of clusters after token until at least want
+// have been read. Pages starting the listing replace the cached rows; later
+// pages are merged into them.
+func (lv *ListView) fetchClusters(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
 	return func() tea.Msg {
-		clusters, err := client.ListClusters(context.TODO())
-		return clustersMsg{clusters: clusters, err: err}
+		clusters, next, err := plugin.FetchPages(ctx, router, after, want, client.ListClustersPage)
+		if err == nil {
+			store := cache.Store[awseks.EKSCluster]
+			if after != nil {
+				store = cache.Merge[awseks.EKSCluster]
+			}
+			_ = store(context.Background(), scope, cacheKey, clusters, func(c awseks.EKSCluster) (string, string) {
+				return c.Name, c.Name
+			})
+		}
+		return clustersMsg{clusters: clusters, after: after, next: next, err: err}
 	}
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchClusters()
+	fetch := lv.fetchClusters(nil, lv.table.ItemCount())
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awseks.EKSCluster](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awseks.EKSCluster]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchClusters(nil, lv.table.ItemCount())
+		}
+		return lv, nil
+
 	case clustersMsg:
+		if msg.after != nil && msg.after != lv.next {
+			// A page of a listing that has since been refreshed.
+			return lv, nil
+		}
 		lv.loading = false
+		if msg.after == nil && lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
+			if msg.after != nil {
+				lv.table.SetMore(true)
+				lv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return lv, nil
+			}
 			lv.err = msg.err
 			return lv, nil
 		}
-		lv.table.SetItems(msg.clusters)
+		lv.err = nil
+		if msg.after != nil {
+			lv.table.AppendItems(msg.clusters)
+		} else {
+			lv.table.SetItems(msg.clusters)
+		}
+		lv.next = msg.next
+		lv.table.SetMore(msg.next != nil)
 		return lv, nil
 
 	case tea.KeyPressMsg:
@@ -108,6 +167,7 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			if id := lv.table.SelectedID(); id != "" {
 				view := NewDetailView(lv.client, lv.router, id, lv.region, lv.profile)
+				view.metrics.SetClient(lv.metrics)
 				lv.router.Push(view)
 				return lv, view.Init()
 			}
@@ -117,7 +177,7 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return lv, nil
 		case "r":
 			lv.loading = true
-			return lv, lv.fetchClusters()
+			return lv, lv.fetchClusters(nil, lv.table.ItemCount())
 		}
 	}
 
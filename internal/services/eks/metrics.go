@@ -0,0 +1,30 @@
+package eks
+
+import (
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+)
+
+// metricsTab is the index of the Metrics tab.
+const metricsTab = 7
+
+// clusterCharts returns the charts of a cluster's Metrics tab, which Container
+// Insights publishes.
+func clusterCharts(clusterName string) []metrics.Chart {
+	dims := []cloudwatch.Dimension{{Name: "ClusterName", Value: clusterName}}
+	query := func(metric, stat string) cloudwatch.MetricQuery {
+		return cloudwatch.MetricQuery{Namespace: "ContainerInsights", Metric: metric, Dimensions: dims, Stat: stat}
+	}
+	return []metrics.Chart{
+		{Title: "Node CPU Utilization", Query: query("node_cpu_utilization", "Average"), Format: metrics.Percent},
+		{Title: "Node Memory Utilization", Query: query("node_memory_utilization", "Average"), Format: metrics.Percent},
+		{Title: "Failed Nodes", Query: query("cluster_failed_node_count", "Maximum"), Format: metrics.Count},
+	}
+}
+
+// newMetricsPanel creates the Metrics tab panel of a cluster.
+func newMetricsPanel(dv *DetailView) *metrics.Panel {
+	p := metrics.NewPanel(dv.router, clusterCharts(dv.clusterName))
+	p.SetEmptyHint("No datapoints: enable Container Insights on the cluster to publish these metrics.")
+	return p
+}
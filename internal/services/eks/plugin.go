@@ -2,18 +2,35 @@ package eks
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	awseks "tasnim.dev/aws-tui/internal/aws/eks"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/services/regional"
 )
 
 // Plugin implements plugin.ServicePlugin for AWS EKS clusters.
 type Plugin struct {
-	client   *awseks.Client
-	clusters []awseks.EKSCluster
-	region   string
-	profile  string
+	plugin.CacheHolder
+
+	client     *awseks.Client
+	clusters   []awseks.EKSCluster
+	region     string
+	profile    string
+	scope      plugin.RegionScope
+	clientFor  func(region string) *awseks.Client
+	k8s        K8sConnector
+	metricsFor metrics.ClientFor
+
+	k8sMu      sync.Mutex
+	k8sClients map[string]K8sAPI
+
+	troubleMu  sync.Mutex
+	troubleAt  time.Time // when trouble was last checked
+	troubleHit bool
 }
 
 // NewPlugin creates a new EKS ServicePlugin.
@@ -21,6 +38,63 @@ func NewPlugin(client *awseks.Client, region, profile string) *Plugin {
 	return &Plugin{client: client, region: region, profile: profile}
 }
 
+// SetRegionalClients sets the factory used to reach other regions in
+// all-regions scope.
+func (p *Plugin) SetRegionalClients(clientFor func(region string) *awseks.Client) {
+	p.clientFor = clientFor
+}
+
+// SetK8sConnector sets how cluster detail views reach the Kubernetes API to
+// browse workloads. Without one the workload browser is unavailable.
+func (p *Plugin) SetK8sConnector(connect K8sConnector) { p.k8s = connect }
+
+// SetMetricsClients sets the factory of the CloudWatch clients detail views
+// read metrics with.
+func (p *Plugin) SetMetricsClients(clientFor metrics.ClientFor) { p.metricsFor = clientFor }
+
+// metricsClient returns the CloudWatch client for region, nil when metrics
+// are unavailable.
+func (p *Plugin) metricsClient(region string) metrics.Client {
+	if p.metricsFor == nil {
+		return nil
+	}
+	return p.metricsFor(region)
+}
+
+// connectK8s returns the Kubernetes client for cluster, reusing one made
+// earlier so its token is shared.
+func (p *Plugin) connectK8s(cluster awseks.EKSCluster, region string) (K8sAPI, error) {
+	p.k8sMu.Lock()
+	defer p.k8sMu.Unlock()
+	key := region + "/" + cluster.Name
+	if api, ok := p.k8sClients[key]; ok {
+		return api, nil
+	}
+	api, err := p.k8s(cluster, region)
+	if err != nil {
+		return nil, err
+	}
+	if p.k8sClients == nil {
+		p.k8sClients = make(map[string]K8sAPI)
+	}
+	p.k8sClients[key] = api
+	return api, nil
+}
+
+// detailView creates a cluster detail view that can browse workloads when a
+// connector is set.
+func (p *Plugin) detailView(client *awseks.Client, router plugin.Router, name, region string) *DetailView {
+	dv := NewDetailView(client, router, name, region, p.profile)
+	if p.k8s != nil {
+		dv.k8s = p.connectK8s
+	}
+	dv.metrics.SetClient(p.metricsClient(region))
+	return dv
+}
+
+// SetRegionScope implements plugin.MultiRegion.
+func (p *Plugin) SetRegionScope(scope plugin.RegionScope) { p.scope = scope }
+
 func (p *Plugin) ID() string   { return "eks" }
 func (p *Plugin) Name() string { return "EKS" }
 func (p *Plugin) Icon() string { return "\U000F10FE" } // nf-mdi-kubernetes
@@ -31,7 +105,90 @@ func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
 		return plugin.ServiceSummary{}, err
 	}
 	p.clusters = clusters
-	return mapSummary(clusters), nil
+	summary := mapSummary(clusters)
+	if summary.Health == plugin.HealthHealthy && p.recentlyTroubled(ctx, clusters) {
+		summary.Health = plugin.HealthWarning
+	}
+	return summary, nil
+}
+
+const (
+	// recentEventWindow is how far back Summary looks for events that make
+	// a cluster unhealthy.
+	recentEventWindow = 15 * time.Minute
+	// troubleLimit is how many clusters' events are read at once.
+	troubleLimit = 4
+)
+
+// troubleTimeout bounds reading one cluster's events, so that a cluster with
+// an unreachable endpoint does not hold up the dashboard. Tests shorten it.
+var troubleTimeout = 5 * time.Second
+
+// recentlyTroubled is troubled, reusing the answer for the idle poll
+// interval so that each dashboard refresh does not call every cluster.
+func (p *Plugin) recentlyTroubled(ctx context.Context, clusters []awseks.EKSCluster) bool {
+	p.troubleMu.Lock()
+	defer p.troubleMu.Unlock()
+	if !p.troubleAt.IsZero() && time.Since(p.troubleAt) < p.PollConfig().IdleInterval {
+		return p.troubleHit
+	}
+	p.troubleHit = p.troubled(ctx, clusters)
+	p.troubleAt = time.Now()
+	return p.troubleHit
+}
+
+// troubled reports whether an active cluster has recent FailedScheduling,
+// BackOff or NodeNotReady events. Clusters are checked troubleLimit at a
+// time, each within troubleTimeout; those whose Kubernetes API cannot be
+// reached in time are skipped.
+func (p *Plugin) troubled(ctx context.Context, clusters []awseks.EKSCluster) bool {
+	if p.k8s == nil {
+		return false
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	since := time.Now().Add(-recentEventWindow)
+
+	var found atomic.Bool
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, troubleLimit)
+	for _, c := range clusters {
+		if c.Status != "ACTIVE" {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			if p.clusterTroubled(ctx, c, since) {
+				found.Store(true)
+				cancel()
+			}
+		})
+	}
+	wg.Wait()
+	return found.Load()
+}
+
+// clusterTroubled reports whether cluster has had trouble events since
+// since, giving up after troubleTimeout.
+func (p *Plugin) clusterTroubled(ctx context.Context, cluster awseks.EKSCluster, since time.Time) bool {
+	api, err := p.connectK8s(cluster, p.region)
+	if err != nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(ctx, troubleTimeout)
+	defer cancel()
+	events, err := api.ListWarningEvents(ctx, "")
+	if err != nil {
+		return false
+	}
+	return awseks.HasTroubleEvents(events, since)
 }
 
 // mapSummary converts a list of EKS clusters into a plugin.ServiceSummary.
@@ -58,11 +215,29 @@ func mapSummary(clusters []awseks.EKSCluster) plugin.ServiceSummary {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router, p.region, p.profile)
+	if p.scope.All() && p.clientFor != nil {
+		return p.regionalListView(router)
+	}
+	lv := NewListView(p.client, router, p.region, p.profile)
+	lv.cache = p.Cache()
+	lv.metrics = p.metricsClient(p.region)
+	return lv
+}
+
+// regionalListView lists clusters across every region in the scope.
+func (p *Plugin) regionalListView(router plugin.Router) plugin.View {
+	return regional.NewListView(router, "EKS Clusters", p.scope, clusterColumns(),
+		func(c awseks.EKSCluster) string { return c.Name },
+		func(ctx context.Context, region string) ([]awseks.EKSCluster, error) {
+			return p.clientFor(region).ListClusters(ctx)
+		},
+		func(router plugin.Router, region string, c awseks.EKSCluster) plugin.View {
+			return p.detailView(p.clientFor(region), router, c.Name, region)
+		})
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
-	return NewDetailView(p.client, router, id, p.region, p.profile)
+	return p.detailView(p.client, router, id, p.region)
 }
 
 func (p *Plugin) Commands() []plugin.Command {
@@ -1,12 +1,23 @@
 package eks
 
 import (
+	"context"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
+
+	"tasnim.dev/aws-tui/internal/app"
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
 	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/config"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkeks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -123,3 +134,532 @@ func TestPluginMetadata(t *testing.T) {
 	assert.Equal(t, "eks", p.ID())
 	assert.Equal(t, "EKS", p.Name())
 }
+
+// --- Kubernetes browser ---
+
+type mockK8s struct {
+	pods        []awseks.K8sPod
+	deployments []awseks.K8sWorkload
+	namespaces  []awseks.K8sNamespace
+	events      []awseks.K8sEvent
+	logs        string
+	lastNS      string
+	lastFilter  awseks.PodFilter
+	lastLogOpts awseks.PodLogOptions
+}
+
+func (m *mockK8s) ListNamespaces(_ context.Context) ([]awseks.K8sNamespace, error) {
+	return m.namespaces, nil
+}
+
+func (m *mockK8s) ListPods(_ context.Context, namespace string, filter awseks.PodFilter) ([]awseks.K8sPod, error) {
+	m.lastNS, m.lastFilter = namespace, filter
+	return m.pods, nil
+}
+
+func (m *mockK8s) GetPod(_ context.Context, namespace, name string) (awseks.K8sPod, error) {
+	for _, p := range m.pods {
+		if p.Namespace == namespace && p.Name == name {
+			return p, nil
+		}
+	}
+	return awseks.K8sPod{}, nil
+}
+
+func (m *mockK8s) ListDeployments(_ context.Context, namespace string) ([]awseks.K8sWorkload, error) {
+	m.lastNS = namespace
+	return m.deployments, nil
+}
+
+func (m *mockK8s) ListStatefulSets(_ context.Context, _ string) ([]awseks.K8sWorkload, error) {
+	return nil, nil
+}
+
+func (m *mockK8s) ListDaemonSets(_ context.Context, _ string) ([]awseks.K8sWorkload, error) {
+	return nil, nil
+}
+
+func (m *mockK8s) ListServices(_ context.Context, _ string) ([]awseks.K8sService, error) {
+	return nil, nil
+}
+
+func (m *mockK8s) ListNodes(_ context.Context) ([]awseks.K8sNode, error) {
+	return nil, nil
+}
+
+func (m *mockK8s) ListWarningEvents(_ context.Context, _ string) ([]awseks.K8sEvent, error) {
+	return m.events, nil
+}
+
+func (m *mockK8s) StreamPodLogs(_ context.Context, _, _ string, opts awseks.PodLogOptions) (io.ReadCloser, error) {
+	m.lastLogOpts = opts
+	return io.NopCloser(strings.NewReader(m.logs)), nil
+}
+
+func (m *mockK8s) Exec(_, _, _ string, _ []string) *awseks.PodExec {
+	return &awseks.PodExec{}
+}
+
+type mockRouter struct {
+	pushed []plugin.View
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_ string, _ string)     {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	switch s {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+func TestClusterBrowser_Tabs(t *testing.T) {
+	api := &mockK8s{
+		pods:        []awseks.K8sPod{{Name: "web-1", Namespace: "default", Status: "Running", Ready: 1, Total: 1, Node: "ip-10-0-1-5"}},
+		deployments: []awseks.K8sWorkload{{Kind: "Deployment", Name: "web", Namespace: "default", Selector: "app=web"}},
+	}
+	router := &mockRouter{}
+	b := NewClusterBrowser(api, router, "prod")
+
+	b.Update(b.Init()())
+	assert.True(t, b.loaded[tabPods])
+	assert.Contains(t, b.View().Content, "web-1")
+	assert.Contains(t, b.View().Content, "ip-10-0-1-5")
+
+	// Switching tab lists it the first time only.
+	_, cmd := b.Update(key("2"))
+	require.NotNil(t, cmd)
+	b.Update(cmd())
+	assert.Contains(t, b.View().Content, "web")
+	_, cmd = b.Update(key("1"))
+	assert.Nil(t, cmd)
+
+	// Enter on a deployment lists its pods by selector.
+	b.Update(key("2"))
+	_, cmd = b.Update(key("enter"))
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "Pods — deployment/web", router.pushed[0].Title())
+	router.pushed[0].Update(cmd())
+	assert.Equal(t, "default", api.lastNS)
+	assert.Equal(t, "app=web", api.lastFilter.LabelSelector)
+}
+
+func TestClusterBrowser_Namespace(t *testing.T) {
+	api := &mockK8s{namespaces: []awseks.K8sNamespace{{Name: "kube-system", Status: "Active"}}}
+	b := NewClusterBrowser(api, &mockRouter{}, "prod")
+	b.Update(b.Init()())
+
+	_, cmd := b.Update(key("7"))
+	b.Update(cmd())
+	_, cmd = b.Update(key("enter"))
+	require.NotNil(t, cmd)
+	assert.Equal(t, "kube-system", b.namespace)
+	assert.Equal(t, tabPods, b.tabs.Active())
+	b.Update(cmd())
+	assert.Equal(t, "kube-system", api.lastNS)
+	assert.False(t, b.loaded[tabDeployments])
+	assert.True(t, b.loaded[tabNamespaces])
+
+	// A list for the old selection arriving late is dropped.
+	b.Update(k8sListMsg{tab: tabPods, namespace: "", items: []awseks.K8sPod{{Name: "stale"}}})
+	assert.NotContains(t, b.View().Content, "stale")
+
+	_, cmd = b.Update(key("N"))
+	require.NotNil(t, cmd)
+	assert.Empty(t, b.namespace)
+}
+
+func TestClusterBrowser_FilterCapturesInput(t *testing.T) {
+	b := NewClusterBrowser(&mockK8s{}, &mockRouter{}, "prod")
+	b.Update(b.Init()())
+
+	b.Update(key("/"))
+	assert.True(t, b.CapturingInput())
+	_, cmd := b.Update(key("r"))
+	assert.Nil(t, cmd, "r is typed into the filter rather than refreshing")
+	assert.Equal(t, tabPods, b.tabs.Active())
+}
+
+// drain runs cmd and feeds its messages back into v until no command is left.
+func drain(v tea.Model, cmd tea.Cmd) {
+	for cmd != nil {
+		_, cmd = v.Update(cmd())
+	}
+}
+
+func TestPodDetailView_Logs(t *testing.T) {
+	api := &mockK8s{logs: "2024-05-01T12:00:01Z starting\n2024-05-01T12:00:02Z listening on :8080\n"}
+	pod := awseks.K8sPod{
+		Name: "web-1", Namespace: "default", Status: "Running",
+		Containers: []awseks.K8sContainer{{Name: "app"}, {Name: "proxy"}},
+	}
+	v := NewPodDetailView(api, &mockRouter{}, "prod", pod)
+
+	_, cmd := v.Update(key("3"))
+	require.NotNil(t, cmd, "opening the Logs tab streams the log")
+	drain(v, cmd)
+	assert.Equal(t, awseks.PodLogOptions{Container: "app", Follow: true, TailLines: podLogTail}, api.lastLogOpts)
+	out := v.View().Content
+	assert.Contains(t, out, "listening on :8080")
+	assert.Contains(t, out, "Container: app (1/2")
+
+	// The previous instance of the next container.
+	_, cmd = v.Update(key("c"))
+	drain(v, cmd)
+	_, cmd = v.Update(key("o"))
+	drain(v, cmd)
+	assert.Equal(t, "proxy", api.lastLogOpts.Container)
+	assert.True(t, api.lastLogOpts.Previous)
+	assert.Contains(t, v.View().Content, "previous instance")
+
+	// Resuming after a pause asks only for newer lines.
+	v.Update(key("p"))
+	_, cmd = v.Update(key("p"))
+	drain(v, cmd)
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 2, 0, time.UTC), api.lastLogOpts.Since)
+	assert.Equal(t, 2, v.logViews[v.logIdx].Lines(), "lines already shown are not repeated")
+}
+
+// podPlugin opens a PodDetailView as its detail view, so that tests can
+// drive it through the app.
+type podPlugin struct {
+	plugin.ServicePlugin
+	api K8sAPI
+	pod awseks.K8sPod
+}
+
+func (p podPlugin) ID() string                 { return "pods" }
+func (p podPlugin) Name() string               { return "Pods" }
+func (p podPlugin) Commands() []plugin.Command { return nil }
+func (p podPlugin) ListView(router plugin.Router) plugin.View {
+	return NewClusterBrowser(p.api, router, "prod")
+}
+func (p podPlugin) DetailView(router plugin.Router, _ string) plugin.View {
+	return NewPodDetailView(p.api, router, "prod", p.pod)
+}
+
+func TestPodDetailView_LogKeysReachViewInApp(t *testing.T) {
+	api := &mockK8s{logs: "2024-05-01T12:00:01Z starting\n"}
+	pod := awseks.K8sPod{Name: "web-1", Namespace: "default", Status: "Running", Containers: []awseks.K8sContainer{{Name: "app"}}}
+	reg := plugin.NewRegistry()
+	reg.Add(podPlugin{api: api, pod: pod})
+	a := app.New(app.AppConfig{Registry: reg, Config: &config.Config{}, Region: "us-east-1", Profile: "dev"})
+	_, cmd := a.Update(app.SearchSelectMsg{Hit: app.SearchHit{PluginID: "pods", ID: "default/web-1"}})
+	require.NotNil(t, cmd)
+
+	_, cmd = a.Update(key("3"))
+	drain(a, cmd)
+	require.False(t, api.lastLogOpts.Previous)
+
+	// The previous-instance key is not taken by a global shortcut.
+	_, cmd = a.Update(key("o"))
+	drain(a, cmd)
+	assert.True(t, api.lastLogOpts.Previous)
+	assert.Contains(t, a.View().Content, "previous instance")
+}
+
+func TestPodDetailView_Exec(t *testing.T) {
+	pod := awseks.K8sPod{Name: "web-1", Namespace: "default", Status: "Running", Containers: []awseks.K8sContainer{{Name: "app"}}}
+	v := NewPodDetailView(&mockK8s{}, &mockRouter{}, "prod", pod)
+	_, cmd := v.Update(key("x"))
+	assert.NotNil(t, cmd)
+
+	v.pod.Status = "Pending"
+	_, cmd = v.Update(key("x"))
+	assert.Nil(t, cmd)
+}
+
+func TestPlugin_SummaryWarnsOnTroubleEvents(t *testing.T) {
+	api := &mockK8s{}
+	p := NewPlugin(nil, "us-east-1", "")
+	p.SetK8sConnector(func(_ awseks.EKSCluster, _ string) (K8sAPI, error) { return api, nil })
+	clusters := []awseks.EKSCluster{{Name: "prod", Status: "ACTIVE"}}
+
+	api.events = []awseks.K8sEvent{{Reason: "BackOff", LastSeen: time.Now().Add(-time.Hour)}}
+	assert.False(t, p.troubled(context.Background(), clusters), "old events are ignored")
+
+	api.events = []awseks.K8sEvent{{Reason: "FailedScheduling", LastSeen: time.Now().Add(-time.Minute)}}
+	assert.True(t, p.troubled(context.Background(), clusters))
+	assert.False(t, p.troubled(context.Background(), []awseks.EKSCluster{{Name: "new", Status: "CREATING"}}))
+}
+
+// unreachableK8s blocks reading events until the call's context is done, like
+// a cluster whose private endpoint cannot be reached.
+type unreachableK8s struct {
+	mockK8s
+}
+
+func (m *unreachableK8s) ListWarningEvents(ctx context.Context, _ string) ([]awseks.K8sEvent, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestPlugin_TroubledSkipsUnreachableClusters(t *testing.T) {
+	orig := troubleTimeout
+	troubleTimeout = 20 * time.Millisecond
+	t.Cleanup(func() { troubleTimeout = orig })
+
+	healthy := &mockK8s{}
+	failing := &mockK8s{events: []awseks.K8sEvent{{Reason: "BackOff", LastSeen: time.Now()}}}
+	apis := map[string]K8sAPI{"private": &unreachableK8s{}, "prod": healthy}
+	p := NewPlugin(nil, "us-east-1", "")
+	p.SetK8sConnector(func(c awseks.EKSCluster, _ string) (K8sAPI, error) { return apis[c.Name], nil })
+	clusters := []awseks.EKSCluster{{Name: "private", Status: "ACTIVE"}, {Name: "prod", Status: "ACTIVE"}}
+
+	start := time.Now()
+	assert.False(t, p.troubled(context.Background(), clusters))
+	assert.Less(t, time.Since(start), time.Second, "an unreachable cluster times out")
+
+	// Trouble in one cluster stops waiting for the others.
+	apis["prod"] = failing
+	p = NewPlugin(nil, "us-east-1", "")
+	p.SetK8sConnector(func(c awseks.EKSCluster, _ string) (K8sAPI, error) { return apis[c.Name], nil })
+	troubleTimeout = time.Hour
+	assert.True(t, p.troubled(context.Background(), clusters))
+}
+
+func TestPlugin_RecentlyTroubledIsCached(t *testing.T) {
+	api := &mockK8s{events: []awseks.K8sEvent{{Reason: "BackOff", LastSeen: time.Now()}}}
+	p := NewPlugin(nil, "us-east-1", "")
+	p.SetK8sConnector(func(_ awseks.EKSCluster, _ string) (K8sAPI, error) { return api, nil })
+	clusters := []awseks.EKSCluster{{Name: "prod", Status: "ACTIVE"}}
+
+	assert.True(t, p.recentlyTroubled(context.Background(), clusters))
+	api.events = nil
+	assert.True(t, p.recentlyTroubled(context.Background(), clusters), "reused within the poll interval")
+
+	p.troubleAt = time.Now().Add(-2 * p.PollConfig().IdleInterval)
+	assert.False(t, p.recentlyTroubled(context.Background(), clusters))
+}
+
+func TestDetailView_EventsTab(t *testing.T) {
+	now := time.Now()
+	api := &mockK8s{events: []awseks.K8sEvent{
+		{Kind: "Pod", Namespace: "prod", Name: "web-abc", Reason: "BackOff", Message: "Back-off restarting failed container", Count: 4, LastSeen: now},
+		{Kind: "Pod", Namespace: "prod", Name: "web-abc", Reason: "Unhealthy", Message: "Readiness probe failed", Count: 2, LastSeen: now.Add(-time.Minute)},
+	}}
+	p := NewPlugin(nil, "us-east-1", "")
+	p.SetK8sConnector(func(_ awseks.EKSCluster, _ string) (K8sAPI, error) { return api, nil })
+	dv := p.detailView(nil, &mockRouter{}, "prod", "us-east-1")
+	dv.Update(clusterDetailMsg{cluster: awseks.EKSCluster{Name: "prod", Status: "ACTIVE"}})
+
+	_, cmd := dv.Update(key("6"))
+	require.NotNil(t, cmd)
+	dv.Update(cmd())
+	require.Len(t, dv.events, 1, "events are grouped by object")
+	assert.Equal(t, 6, dv.events[0].Count)
+	out := dv.View().Content
+	assert.Contains(t, out, "Pod/web-abc")
+	assert.Contains(t, out, "BackOff, Unhealthy")
+}
+
+// --- node group scaling and upgrade readiness ---
+
+// scalingAPI records node group scaling updates. The embedded interface is
+// nil, so any other call panics.
+type scalingAPI struct {
+	awseks.EKSAPI
+	updates []*sdkeks.UpdateNodegroupConfigInput
+}
+
+func (a *scalingAPI) UpdateNodegroupConfig(_ context.Context, params *sdkeks.UpdateNodegroupConfigInput, _ ...func(*sdkeks.Options)) (*sdkeks.UpdateNodegroupConfigOutput, error) {
+	a.updates = append(a.updates, params)
+	return &sdkeks.UpdateNodegroupConfigOutput{}, nil
+}
+
+type actionRouter struct {
+	mockRouter
+	readOnly bool
+	toasts   []string
+	confirms []plugin.Action
+}
+
+func (r *actionRouter) Toast(_ plugin.ToastLevel, msg string) { r.toasts = append(r.toasts, msg) }
+func (r *actionRouter) ReadOnly() bool                        { return r.readOnly }
+func (r *actionRouter) Confirm(action plugin.Action) {
+	if r.readOnly {
+		r.toasts = append(r.toasts, action.Title+" is disabled in read-only mode")
+		return
+	}
+	r.confirms = append(r.confirms, action)
+}
+
+// submit types s into the open prompt and answers it.
+func submit(dv *DetailView, s string) {
+	for _, c := range s {
+		dv.Update(tea.KeyPressMsg{Code: c, Text: string(c)})
+	}
+	_, cmd := dv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	dv.Update(cmd())
+}
+
+func TestDetailView_ScaleNodeGroup(t *testing.T) {
+	api := &scalingAPI{}
+	router := &actionRouter{}
+	dv := NewDetailView(awseks.NewClient(api), router, "prod", "us-east-1", "")
+	dv.Update(clusterDetailMsg{cluster: awseks.EKSCluster{Name: "prod", Status: "ACTIVE", Version: "1.29"}})
+	dv.tabs.SetActive(nodeGroupsTab)
+	dv.Update(nodeGroupsMsg{nodeGroups: []awseks.EKSNodeGroup{
+		{Name: "system", Status: "ACTIVE", MinSize: 2, MaxSize: 2, DesiredSize: 2},
+		{Name: "workers", Status: "ACTIVE", MinSize: 1, MaxSize: 3, DesiredSize: 2},
+	}})
+	assert.Contains(t, dv.KeyHints(), plugin.KeyHint{Key: "e", Desc: "edit scaling"})
+
+	// e prompts for the selected node group, capturing keys while open.
+	dv.Update(key("j"))
+	dv.Update(key("e"))
+	require.True(t, dv.CapturingInput())
+	assert.Contains(t, dv.View().Content, "Scale workers (now 1/3/2)")
+	submit(dv, "4/2/3")
+	assert.False(t, dv.CapturingInput())
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Invalid scaling: min size 4 is above max size 2"}, router.toasts)
+
+	dv.Update(key("e"))
+	submit(dv, "1 6 4")
+	require.Len(t, router.confirms, 1)
+	action := router.confirms[0]
+	assert.Equal(t, "Scale node group", action.Title)
+	assert.Equal(t, "workers", action.Phrase)
+	assert.Equal(t, []string{"prod/workers: 1/3/2 → 1/6/4"}, action.Targets)
+
+	// Once it runs the node groups are reloaded.
+	_, cmd := dv.Update(action.Run()())
+	require.Len(t, api.updates, 1)
+	sc := api.updates[0].ScalingConfig
+	assert.Equal(t, "workers", aws.ToString(api.updates[0].NodegroupName))
+	assert.Equal(t, []int32{1, 6, 4}, []int32{aws.ToInt32(sc.MinSize), aws.ToInt32(sc.MaxSize), aws.ToInt32(sc.DesiredSize)})
+	assert.Equal(t, "Scaling workers to 1/6/4", router.toasts[1])
+	assert.NotNil(t, cmd)
+	assert.True(t, dv.tabLoading)
+}
+
+func TestDetailView_ScaleNodeGroupReadOnly(t *testing.T) {
+	router := &actionRouter{readOnly: true}
+	dv := NewDetailView(nil, router, "prod", "us-east-1", "")
+	dv.Update(clusterDetailMsg{cluster: awseks.EKSCluster{Name: "prod", Status: "ACTIVE", Version: "1.29"}})
+	dv.tabs.SetActive(nodeGroupsTab)
+	dv.Update(nodeGroupsMsg{nodeGroups: []awseks.EKSNodeGroup{{Name: "workers", MinSize: 1, MaxSize: 3, DesiredSize: 2}}})
+	assert.NotContains(t, dv.KeyHints(), plugin.KeyHint{Key: "e", Desc: "edit scaling"})
+
+	// The prompt does not open, so nothing is typed for a refused action.
+	dv.Update(key("e"))
+	assert.Nil(t, dv.prompt)
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"Scaling is disabled in read-only mode"}, router.toasts)
+}
+
+func TestParseScaling(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    [3]int
+		wantErr string
+	}{
+		{in: "1/5/3", want: [3]int{1, 5, 3}},
+		{in: " 0, 2, 0 ", want: [3]int{0, 2, 0}},
+		{in: "1/5", wantErr: `scaling must be min/max/desired, not "1/5"`},
+		{in: "1/x/3", wantErr: `sizes must be whole numbers, not "x"`},
+		{in: "0/0/0", wantErr: "max size must be at least 1"},
+		{in: "1/5/6", wantErr: "desired size 6 is outside 1–5"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			minSize, maxSize, desiredSize, err := parseScaling(tc.in)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, [3]int{minSize, maxSize, desiredSize})
+		})
+	}
+}
+
+func TestVersionSkew(t *testing.T) {
+	assert.Equal(t, "1.30", nextMinorVersion("1.29"))
+	assert.Empty(t, nextMinorVersion("latest"))
+
+	tests := []struct {
+		version string
+		dot     string
+		note    string
+	}{
+		{version: "1.29", dot: greenDot, note: "matches the control plane"},
+		{version: "1.27", dot: yellowDot, note: "2 minor behind the control plane"},
+		{version: "1.26", dot: redDot, note: "upgrade first: 4 minor versions behind 1.30"},
+		{version: "1.30", dot: redDot, note: "newer than the control plane"},
+		{version: "", dot: grayDot, note: "unknown version"},
+	}
+	for _, tc := range tests {
+		dot, note := nodeGroupReadiness(tc.version, "1.29", "1.30")
+		assert.Equal(t, tc.dot, dot, tc.version)
+		assert.Equal(t, tc.note, note, tc.version)
+	}
+}
+
+func TestDetailView_UpgradeReadinessTab(t *testing.T) {
+	dv := NewDetailView(nil, &mockRouter{}, "prod", "us-east-1", "")
+	dv.Update(clusterDetailMsg{cluster: awseks.EKSCluster{Name: "prod", Status: "ACTIVE", Version: "1.29"}})
+	dv.tabs.SetActive(upgradeTab)
+	dv.Update(upgradeReadinessMsg{readiness: upgradeReadiness{
+		target:     "1.30",
+		nodeGroups: []awseks.EKSNodeGroup{{Name: "workers", Version: "1.28"}},
+		addons:     []awseks.EKSAddon{{Name: "vpc-cni", Version: "v1.16.0-eksbuild.1"}, {Name: "coredns", Version: "v1.11.1-eksbuild.9"}},
+		compatible: map[string]awseks.EKSAddonVersions{
+			"vpc-cni": {KubernetesVersion: "1.30", Versions: []string{"v1.18.3-eksbuild.1", "v1.18.1-eksbuild.3"}, Default: "v1.18.1-eksbuild.3"},
+			"coredns": {KubernetesVersion: "1.30", Versions: []string{"v1.11.3-eksbuild.1", "v1.11.1-eksbuild.9"}},
+		},
+		insights: []awseks.EKSInsight{
+			{Name: "Cluster health issues", Status: "PASSING"},
+			{Name: "Deprecated APIs removed in Kubernetes v1.30", Status: "ERROR", Reason: "Deprecated API usage detected", Recommendation: "Update manifests", Resources: 2},
+		},
+	}})
+
+	out := dv.View().Content
+	assert.Contains(t, out, "Target Version")
+	assert.Contains(t, out, "1 minor behind the control plane")
+	assert.Contains(t, out, "update before upgrading")
+	assert.Contains(t, out, "latest v1.18.3-eksbuild.1, default v1.18.1-eksbuild.3, 2 compatible")
+	assert.Contains(t, out, "compatible\n")
+	assert.Contains(t, out, "→ Update manifests (2 resources)")
+	assert.Less(t, strings.Index(out, "Deprecated APIs"), strings.Index(out, "Cluster health issues"), "failing insights come first")
+}
+
+// --- metrics ---
+
+// emptyMetrics records queries and returns no datapoints.
+type emptyMetrics struct {
+	queries []cloudwatch.MetricQuery
+}
+
+func (m *emptyMetrics) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, _ cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries = queries
+	return make([]cloudwatch.MetricSeries, len(queries)), nil
+}
+
+func TestDetailView_MetricsTab(t *testing.T) {
+	cw := &emptyMetrics{}
+	p := NewPlugin(nil, "us-east-1", "")
+	p.SetMetricsClients(func(string) metrics.Client { return cw })
+	dv := p.detailView(nil, &mockRouter{}, "prod", "us-east-1")
+	dv.Update(clusterDetailMsg{cluster: awseks.EKSCluster{Name: "prod", Status: "ACTIVE"}})
+
+	_, cmd := dv.Update(key("8"))
+	require.NotNil(t, cmd)
+	dv.Update(cmd())
+	require.Len(t, cw.queries, 3)
+	assert.Equal(t, "ContainerInsights", cw.queries[0].Namespace)
+	assert.Equal(t, []cloudwatch.Dimension{{Name: "ClusterName", Value: "prod"}}, cw.queries[0].Dimensions)
+	out := dv.View().Content
+	assert.Contains(t, out, "Failed Nodes")
+	assert.Contains(t, out, "enable Container Insights")
+}
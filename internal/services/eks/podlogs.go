@@ -0,0 +1,366 @@
+package eks
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// podLogTail is how many lines are shown when a container's log is first
+// opened without a since time.
+const podLogTail = 500
+
+// podLogBatch caps the lines delivered to the view in one message.
+const podLogBatch = 500
+
+// podLogChromeHeight is the number of terminal rows around the log lines:
+// the breadcrumb, status bar, tab bar and container line.
+const podLogChromeHeight = 9
+
+// podShell starts bash in the container if it has it, otherwise sh.
+var podShell = []string{"/bin/sh", "-c", "command -v bash >/dev/null && exec bash || exec sh"}
+
+// podLogStream is an open log stream whose lines are read in the background.
+// err is set before lines is closed.
+type podLogStream struct {
+	lines chan ui.LogLine
+	err   error
+}
+
+// podLogOpenedMsg reports that stream gen has been opened.
+type podLogOpenedMsg struct {
+	gen    int
+	stream *podLogStream
+	err    error
+}
+
+// podLogLinesMsg carries lines read from stream gen. done is set when the
+// stream has ended.
+type podLogLinesMsg struct {
+	gen    int
+	stream *podLogStream
+	lines  []ui.LogLine
+	done   bool
+	err    error
+}
+
+type podExecFinishedMsg struct{ err error }
+
+func (v *PodDetailView) initLogs() {
+	if len(v.logViews) == len(v.pod.Containers) {
+		return
+	}
+	v.logViews = make([]ui.LogView, len(v.pod.Containers))
+	for i, c := range v.pod.Containers {
+		v.logViews[i] = ui.NewLogView(v.pod.Name + "-" + c.Name)
+	}
+	v.logIdx = min(v.logIdx, max(len(v.logViews)-1, 0))
+	v.resizeLogs()
+}
+
+func (v *PodDetailView) resizeLogs() {
+	if v.width == 0 || v.height == 0 {
+		return
+	}
+	for i := range v.logViews {
+		v.logViews[i].SetSize(v.width, v.height-podLogChromeHeight)
+	}
+}
+
+func (v *PodDetailView) onLogsTab() bool {
+	return v.tabs.Active() == podTabLogs
+}
+
+// container returns the container whose log is shown and that exec enters.
+func (v *PodDetailView) container() string {
+	if v.logIdx < len(v.pod.Containers) {
+		return v.pod.Containers[v.logIdx].Name
+	}
+	return ""
+}
+
+// restartLogs clears the shown container's buffer and streams its log with
+// the current options.
+func (v *PodDetailView) restartLogs() tea.Cmd {
+	v.stopLogs()
+	v.logErr = nil
+	v.logLoaded = false
+	v.logLast = time.Time{}
+	v.logSkipTo = time.Time{}
+	return v.startLogs()
+}
+
+// resumeLogs carries on streaming after the last line shown, for when the log
+// was paused or its tab left.
+func (v *PodDetailView) resumeLogs() tea.Cmd {
+	if !v.logLoaded {
+		return v.restartLogs()
+	}
+	if v.logStreaming {
+		return nil
+	}
+	v.logErr = nil
+	v.logSkipTo = v.logLast
+	return v.startLogs()
+}
+
+// stopLogs abandons the running stream.
+func (v *PodDetailView) stopLogs() {
+	v.logGen++
+	v.logStreaming = false
+	if v.logCancel != nil {
+		v.logCancel()
+		v.logCancel = nil
+	}
+}
+
+func (v *PodDetailView) startLogs() tea.Cmd {
+	if !v.onLogsTab() || len(v.logViews) == 0 {
+		return nil
+	}
+	if v.router.Offline() {
+		v.logErr = errors.New("logs are unavailable offline")
+		return nil
+	}
+
+	// A paused log is loaded once but not followed.
+	opts := awseks.PodLogOptions{
+		Container: v.container(),
+		Follow:    v.logFollow && !v.logViews[v.logIdx].Paused(),
+		Previous:  v.logPrevious,
+		Since:     v.logSince,
+	}
+	switch {
+	case !v.logSkipTo.IsZero():
+		// Resuming: ask from the last line shown and drop what was seen.
+		opts.Since = v.logSkipTo
+	case opts.Since.IsZero():
+		opts.TailLines = podLogTail
+	}
+
+	v.logGen++
+	v.logStreaming = true
+	gen, api, router := v.logGen, v.api, v.router
+	namespace, name := v.pod.Namespace, v.pod.Name
+	ctx, cancel := context.WithCancel(router.Context(v))
+	v.logCancel = cancel
+	return func() tea.Msg {
+		var s *podLogStream
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) error {
+			rc, err := api.StreamPodLogs(ctx, namespace, name, opts)
+			if err != nil {
+				return err
+			}
+			s = &podLogStream{lines: make(chan ui.LogLine, podLogBatch)}
+			go s.read(rc)
+			return nil
+		})
+		return podLogOpenedMsg{gen: gen, stream: s, err: err}
+	}
+}
+
+// read scans the stream into lines until it ends.
+func (s *podLogStream) read(rc io.ReadCloser) {
+	defer close(s.lines)
+	defer rc.Close()
+	sc := bufio.NewScanner(rc)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		at, msg := awseks.ParsePodLogLine(sc.Text())
+		s.lines <- ui.LogLine{Time: at, Message: msg}
+	}
+	s.err = sc.Err()
+}
+
+// waitLogs delivers the next lines of stream gen, blocking for the first and
+// taking whatever else is already buffered.
+func waitLogs(gen int, s *podLogStream) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-s.lines
+		if !ok {
+			return podLogLinesMsg{gen: gen, done: true, err: s.err}
+		}
+		lines := []ui.LogLine{line}
+		for len(lines) < podLogBatch {
+			select {
+			case line, ok := <-s.lines:
+				if !ok {
+					return podLogLinesMsg{gen: gen, lines: lines, done: true, err: s.err}
+				}
+				lines = append(lines, line)
+			default:
+				return podLogLinesMsg{gen: gen, stream: s, lines: lines}
+			}
+		}
+		return podLogLinesMsg{gen: gen, stream: s, lines: lines}
+	}
+}
+
+// updateLogs handles the log and exec messages of a pod detail view.
+func (v *PodDetailView) updateLogs(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case podLogOpenedMsg:
+		if msg.gen != v.logGen {
+			return nil
+		}
+		if msg.err != nil {
+			v.logErr = msg.err
+			v.logStreaming = false
+			return nil
+		}
+		if !v.logLoaded {
+			v.logViews[v.logIdx].SetLines(nil, true)
+			v.logLoaded = true
+		}
+		return waitLogs(msg.gen, msg.stream)
+
+	case podLogLinesMsg:
+		if msg.gen != v.logGen {
+			return nil
+		}
+		lines := msg.lines
+		if skip := v.logSkipTo; !skip.IsZero() {
+			for len(lines) > 0 && !lines[0].Time.After(skip) {
+				lines = lines[1:]
+			}
+			if len(lines) > 0 {
+				v.logSkipTo = time.Time{}
+			}
+		}
+		if n := len(lines); n > 0 {
+			v.logViews[v.logIdx].AppendLines(lines)
+			v.logLast = lines[n-1].Time
+		}
+		if msg.done {
+			v.logStreaming = false
+			if msg.err != nil && !errors.Is(msg.err, context.Canceled) {
+				v.logErr = msg.err
+			}
+			return nil
+		}
+		return waitLogs(msg.gen, msg.stream)
+
+	case ui.LogJumpMsg:
+		v.logSince = msg.At
+		return v.restartLogs()
+
+	case ui.LogSavedMsg:
+		if msg.Err != nil {
+			v.router.Toast(plugin.ToastError, "Saving log failed: "+msg.Err.Error())
+		} else {
+			v.router.Toast(plugin.ToastInfo, "Log saved to "+msg.Path)
+		}
+
+	case podExecFinishedMsg:
+		if msg.err != nil {
+			v.router.Toast(plugin.ToastError, "Exec failed: "+msg.err.Error())
+		}
+	}
+	return nil
+}
+
+// handleLogKey handles a key on the Logs tab that is not a tab switch.
+func (v *PodDetailView) handleLogKey(msg tea.KeyPressMsg) tea.Cmd {
+	if len(v.logViews) == 0 {
+		return nil
+	}
+	lv := v.logViews[v.logIdx]
+	if !lv.Capturing() {
+		switch msg.String() {
+		case "c":
+			v.logIdx = (v.logIdx + 1) % len(v.logViews)
+			return v.restartLogs()
+		case "o":
+			v.logPrevious = !v.logPrevious
+			return v.restartLogs()
+		case "F":
+			v.logFollow = !v.logFollow
+			return v.restartLogs()
+		case "T":
+			v.logSince = time.Time{}
+			return v.restartLogs()
+		case "r":
+			return v.restartLogs()
+		}
+	}
+
+	wasPaused := lv.Paused()
+	var cmd tea.Cmd
+	v.logViews[v.logIdx], cmd = lv.Update(msg)
+	switch paused := v.logViews[v.logIdx].Paused(); {
+	case paused && !wasPaused:
+		v.stopLogs()
+	case !paused && wasPaused:
+		return tea.Batch(cmd, v.resumeLogs())
+	}
+	return cmd
+}
+
+// CapturingInput implements plugin.InputCapturer while the log filter or
+// time prompt is open.
+func (v *PodDetailView) CapturingInput() bool {
+	return v.onLogsTab() && len(v.logViews) > 0 && v.logViews[v.logIdx].Capturing()
+}
+
+// execShell hands the terminal to a shell in the selected container.
+func (v *PodDetailView) execShell() tea.Cmd {
+	if v.router.Offline() {
+		v.router.Toast(plugin.ToastWarning, "Exec is unavailable offline")
+		return nil
+	}
+	if v.pod.Status != "Running" || v.container() == "" {
+		v.router.Toast(plugin.ToastWarning, "Exec needs a running container")
+		return nil
+	}
+	cmd := v.api.Exec(v.pod.Namespace, v.pod.Name, v.container(), podShell)
+	return tea.Exec(cmd, func(err error) tea.Msg {
+		return podExecFinishedMsg{err: err}
+	})
+}
+
+func (v *PodDetailView) renderLogs() string {
+	if len(v.logViews) == 0 {
+		return "No containers."
+	}
+
+	var b strings.Builder
+	header := fmt.Sprintf("Container: %s", v.container())
+	if len(v.logViews) > 1 {
+		header += fmt.Sprintf(" (%d/%d, c for next)", v.logIdx+1, len(v.logViews))
+	}
+	var opts []string
+	if v.logPrevious {
+		opts = append(opts, "previous instance")
+	}
+	if !v.logSince.IsZero() {
+		opts = append(opts, "since "+v.logSince.Format("2006-01-02 15:04:05"))
+	}
+	if !v.logFollow {
+		opts = append(opts, "not following")
+	}
+	if len(opts) > 0 {
+		header += "  " + strings.Join(opts, " • ")
+	}
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	switch {
+	case v.logErr != nil:
+		b.WriteString(fmt.Sprintf("Error: %v\nPress r to retry.", v.logErr))
+	case !v.logLoaded:
+		b.WriteString("Loading log...")
+	default:
+		b.WriteString(v.logViews[v.logIdx].View())
+	}
+	return b.String()
+}
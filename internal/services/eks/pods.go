@@ -0,0 +1,357 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+type podsMsg struct {
+	pods []awseks.K8sPod
+	err  error
+}
+
+type podMsg struct {
+	pod awseks.K8sPod
+	err error
+}
+
+// PodListView lists the pods matching a selector, such as those of a
+// deployment or scheduled on a node.
+type PodListView struct {
+	api       K8sAPI
+	router    plugin.Router
+	cluster   string
+	namespace string
+	label     string // what the pods belong to, e.g. deployment/web
+	filter    awseks.PodFilter
+	table     ui.TableView[awseks.K8sPod]
+	loading   bool
+	err       error
+}
+
+// NewPodListView creates a PodListView of the pods in namespace (empty for
+// all) matching filter. label names what the pods belong to in the title.
+func NewPodListView(api K8sAPI, router plugin.Router, cluster, namespace, label string, filter awseks.PodFilter) *PodListView {
+	return &PodListView{
+		api:       api,
+		router:    router,
+		cluster:   cluster,
+		namespace: namespace,
+		label:     label,
+		filter:    filter,
+		table:     ui.NewTableView(podColumns(), nil, podID),
+		loading:   true,
+	}
+}
+
+func (v *PodListView) Init() tea.Cmd {
+	api, router := v.api, v.router
+	namespace, filter := v.namespace, v.filter
+	viewCtx := router.Context(v)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(viewCtx, k8sTimeout)
+		defer cancel()
+		var pods []awseks.K8sPod
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			pods, err = api.ListPods(ctx, namespace, filter)
+			return err
+		})
+		return podsMsg{pods: pods, err: err}
+	}
+}
+
+func (v *PodListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case podsMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.table.SetItems(msg.pods)
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.table.Filtering() {
+			var cmd tea.Cmd
+			v.table, cmd = v.table.Update(msg)
+			return v, cmd
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			v.router.Pop()
+			return v, nil
+		case "r":
+			return v, v.Init()
+		case "enter":
+			if v.table.FilteredCount() == 0 {
+				return v, nil
+			}
+			view := NewPodDetailView(v.api, v.router, v.cluster, v.table.SelectedItem())
+			v.router.Push(view)
+			return v, view.Init()
+		}
+		var cmd tea.Cmd
+		v.table, cmd = v.table.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+// CapturingInput implements plugin.InputCapturer while the filter is being
+// typed.
+func (v *PodListView) CapturingInput() bool {
+	return v.table.Filtering()
+}
+
+func (v *PodListView) View() tea.View {
+	if v.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if v.err != nil {
+		return tea.NewView("Error: " + v.err.Error())
+	}
+	if v.table.ItemCount() == 0 {
+		return tea.NewView("No pods found.")
+	}
+	return tea.NewView(v.table.View())
+}
+
+func (v *PodListView) Title() string {
+	return "Pods — " + v.label
+}
+
+func (v *PodListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view pod"},
+		{Key: "/", Desc: "filter"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "esc", Desc: "back"},
+	}
+}
+
+// Pod detail tabs.
+const (
+	podTabOverview = iota
+	podTabContainers
+	podTabLogs
+)
+
+// PodDetailView shows a single pod and its containers, streams their logs
+// and execs into them.
+type PodDetailView struct {
+	api     K8sAPI
+	router  plugin.Router
+	cluster string
+	pod     awseks.K8sPod
+	tabs    ui.TabController
+	err     error
+	width   int
+	height  int
+
+	logViews     []ui.LogView
+	logIdx       int
+	logGen       int
+	logCancel    context.CancelFunc
+	logStreaming bool
+	logLoaded    bool
+	logErr       error
+	logFollow    bool
+	logPrevious  bool
+	logSince     time.Time // zero for the latest lines
+	logLast      time.Time // time of the last line shown
+	logSkipTo    time.Time // when resuming, drop lines up to this time
+}
+
+// NewPodDetailView creates a PodDetailView that starts from pod as listed and
+// refreshes it on Init.
+func NewPodDetailView(api K8sAPI, router plugin.Router, cluster string, pod awseks.K8sPod) *PodDetailView {
+	v := &PodDetailView{
+		api:       api,
+		router:    router,
+		cluster:   cluster,
+		pod:       pod,
+		tabs:      ui.NewTabController([]string{"Overview", "Containers", "Logs"}),
+		logFollow: true,
+	}
+	v.initLogs()
+	return v
+}
+
+func (v *PodDetailView) Init() tea.Cmd {
+	api, router := v.api, v.router
+	namespace, name := v.pod.Namespace, v.pod.Name
+	viewCtx := router.Context(v)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(viewCtx, k8sTimeout)
+		defer cancel()
+		var pod awseks.K8sPod
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			pod, err = api.GetPod(ctx, namespace, name)
+			return err
+		})
+		return podMsg{pod: pod, err: err}
+	}
+}
+
+func (v *PodDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case podMsg:
+		v.err = msg.err
+		if msg.err == nil {
+			v.pod = msg.pod
+			v.initLogs()
+		}
+		return v, nil
+
+	case tea.WindowSizeMsg:
+		v.width, v.height = msg.Width, msg.Height
+		v.resizeLogs()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.CapturingInput() {
+			return v, v.handleLogKey(msg)
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			v.router.Pop()
+			return v, nil
+		case "x":
+			return v, v.execShell()
+		case "r":
+			if !v.onLogsTab() {
+				return v, v.Init()
+			}
+		}
+
+		prev := v.tabs.Active()
+		v.tabs, _ = v.tabs.Update(msg)
+		if tab := v.tabs.Active(); tab != prev {
+			if prev == podTabLogs {
+				v.stopLogs()
+			}
+			if tab == podTabLogs {
+				return v, v.resumeLogs()
+			}
+			return v, nil
+		}
+		if v.onLogsTab() {
+			return v, v.handleLogKey(msg)
+		}
+		return v, nil
+	}
+	return v, v.updateLogs(msg)
+}
+
+func (v *PodDetailView) View() tea.View {
+	var b strings.Builder
+	b.WriteString(v.tabs.View())
+	b.WriteString("\n\n")
+	if v.err != nil {
+		b.WriteString("Error: " + v.err.Error() + "\n\n")
+	}
+	switch v.tabs.Active() {
+	case podTabOverview:
+		b.WriteString(v.renderOverview())
+	case podTabContainers:
+		b.WriteString(v.renderContainers())
+	case podTabLogs:
+		b.WriteString(v.renderLogs())
+	}
+	return tea.NewView(b.String())
+}
+
+func (v *PodDetailView) renderOverview() string {
+	p := v.pod
+	rows := []ui.KV{
+		{K: "Name", V: p.Name},
+		{K: "Namespace", V: p.Namespace},
+		{K: "Status", V: p.Status},
+		{K: "Ready", V: fmt.Sprintf("%d/%d", p.Ready, p.Total)},
+		{K: "Restarts", V: fmt.Sprintf("%d", p.Restarts)},
+		{K: "Node", V: p.Node},
+		{K: "Pod IP", V: p.IP},
+		{K: "QoS Class", V: p.QoS},
+		{K: "Controlled By", V: p.Owner},
+		{K: "Age", V: age(p.CreatedAt)},
+	}
+	if len(p.Labels) > 0 {
+		keys := make([]string, 0, len(p.Labels))
+		for k := range p.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		labels := make([]string, len(keys))
+		for i, k := range keys {
+			labels[i] = k + "=" + p.Labels[k]
+		}
+		rows = append(rows, ui.KV{K: "Labels", V: strings.Join(labels, ", ")})
+	}
+	return ui.RenderKV(rows, 16, 0)
+}
+
+func (v *PodDetailView) renderContainers() string {
+	if len(v.pod.Containers) == 0 {
+		return "No containers."
+	}
+
+	cols := []ui.Column[awseks.K8sContainer]{
+		{Title: "Name", Width: 24, Field: func(c awseks.K8sContainer) string { return c.Name }},
+		{Title: "Image", Width: 48, Field: func(c awseks.K8sContainer) string { return c.Image }},
+		{Title: "State", Width: 24, Field: func(c awseks.K8sContainer) string {
+			if c.Reason != "" {
+				return c.State + " (" + c.Reason + ")"
+			}
+			return c.State
+		}},
+		{Title: "Ready", Width: 6, Field: func(c awseks.K8sContainer) string {
+			if c.Ready {
+				return "yes"
+			}
+			return "no"
+		}},
+		{Title: "Restarts", Width: 8, Field: func(c awseks.K8sContainer) string {
+			return fmt.Sprintf("%d", c.Restarts)
+		}},
+	}
+
+	tv := ui.NewTableView(cols, v.pod.Containers, func(c awseks.K8sContainer) string { return c.Name })
+	return tv.View()
+}
+
+func (v *PodDetailView) Title() string {
+	return v.pod.Name
+}
+
+func (v *PodDetailView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "r", Desc: "refresh"},
+	}
+	if v.pod.Status == "Running" {
+		hints = append(hints, plugin.KeyHint{Key: "x", Desc: "exec into " + v.container()})
+	}
+	if v.onLogsTab() {
+		hints = append(hints,
+			plugin.KeyHint{Key: "c", Desc: "next container"},
+			plugin.KeyHint{Key: "p", Desc: "pause"},
+			plugin.KeyHint{Key: "F", Desc: "toggle follow"},
+			plugin.KeyHint{Key: "o", Desc: "previous instance"},
+			plugin.KeyHint{Key: "t/T", Desc: "since time/clear"},
+			plugin.KeyHint{Key: "/", Desc: "filter"},
+			plugin.KeyHint{Key: "S", Desc: "save"},
+		)
+	}
+	return hints
+}
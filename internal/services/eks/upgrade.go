@@ -0,0 +1,234 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awseks "tasnim.dev/aws-tui/internal/aws/eks"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// maxNodeSkew is how many minor versions the kubelet may be behind the
+// control plane (Kubernetes 1.28 and later).
+const maxNodeSkew = 3
+
+var upgradeHeadingStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+
+// upgradeReadiness is what the Upgrade Readiness tab compares: the cluster's
+// node groups and addons against the next Kubernetes version.
+type upgradeReadiness struct {
+	target     string
+	nodeGroups []awseks.EKSNodeGroup
+	addons     []awseks.EKSAddon
+	compatible map[string]awseks.EKSAddonVersions // by addon name, for target
+	insights   []awseks.EKSInsight
+}
+
+type upgradeReadinessMsg struct {
+	readiness upgradeReadiness
+	err       error
+}
+
+func (dv *DetailView) loadUpgradeReadiness() tea.Cmd {
+	client, router := dv.client, dv.router
+	name, target := dv.clusterName, nextMinorVersion(dv.cluster.Version)
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		r := upgradeReadiness{target: target, compatible: make(map[string]awseks.EKSAddonVersions)}
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			if r.nodeGroups, err = client.ListNodeGroups(ctx, name); err != nil {
+				return err
+			}
+			if r.addons, err = client.ListAddons(ctx, name); err != nil {
+				return err
+			}
+			if target != "" {
+				for _, a := range r.addons {
+					versions, err := client.AddonVersions(ctx, a.Name, target)
+					if err != nil {
+						return err
+					}
+					r.compatible[a.Name] = versions
+				}
+			}
+			r.insights, err = client.ListUpgradeInsights(ctx, name)
+			return err
+		})
+		return upgradeReadinessMsg{readiness: r, err: err}
+	}
+}
+
+// nextMinorVersion returns the Kubernetes version after v, e.g. "1.30" for
+// "1.29", or "" if v is not a major.minor version.
+func nextMinorVersion(v string) string {
+	major, minor, ok := parseMinorVersion(v)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", major, minor+1)
+}
+
+// minorSkew returns how many minor versions v is behind of, or false if
+// either is not a major.minor version of the same major.
+func minorSkew(v, of string) (int, bool) {
+	major, minor, ok := parseMinorVersion(v)
+	ofMajor, ofMinor, ofOK := parseMinorVersion(of)
+	if !ok || !ofOK || major != ofMajor {
+		return 0, false
+	}
+	return ofMinor - minor, true
+}
+
+func parseMinorVersion(v string) (major, minor int, ok bool) {
+	majorStr, minorStr, found := strings.Cut(strings.TrimPrefix(v, "v"), ".")
+	if !found {
+		return 0, 0, false
+	}
+	minorStr, _, _ = strings.Cut(minorStr, ".")
+	major, err := strconv.Atoi(majorStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(minorStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// nodeGroupReadiness describes whether a node group running v blocks an
+// upgrade of the control plane from cluster to target.
+func nodeGroupReadiness(v, cluster, target string) (dot, note string) {
+	skew, ok := minorSkew(v, cluster)
+	if !ok {
+		return grayDot, "unknown version"
+	}
+	targetSkew, _ := minorSkew(v, target)
+	switch {
+	case skew < 0:
+		return redDot, "newer than the control plane"
+	case targetSkew > maxNodeSkew:
+		return redDot, fmt.Sprintf("upgrade first: %d minor versions behind %s", targetSkew, target)
+	case skew > 0:
+		return yellowDot, fmt.Sprintf("%d minor behind the control plane", skew)
+	}
+	return greenDot, "matches the control plane"
+}
+
+// addonReadiness describes whether an addon's installed version is
+// compatible with the target version.
+func addonReadiness(a awseks.EKSAddon, versions awseks.EKSAddonVersions) (dot, note string) {
+	switch {
+	case len(versions.Versions) == 0:
+		return redDot, "no version for " + versions.KubernetesVersion
+	case slices.Contains(versions.Versions, a.Version):
+		return greenDot, "compatible"
+	}
+	return yellowDot, "update before upgrading"
+}
+
+// insightRank orders insights by severity, most severe first.
+func insightRank(status string) int {
+	switch status {
+	case "ERROR":
+		return 0
+	case "WARNING":
+		return 1
+	case "UNKNOWN":
+		return 2
+	}
+	return 3
+}
+
+func insightStatusDot(status string) string {
+	switch status {
+	case "PASSING":
+		return greenDot
+	case "WARNING":
+		return yellowDot
+	case "ERROR":
+		return redDot
+	default:
+		return grayDot
+	}
+}
+
+func (dv *DetailView) renderUpgradeReadiness() string {
+	r := dv.upgrade
+	if r == nil {
+		return ""
+	}
+	c := dv.cluster
+	if r.target == "" {
+		return "Cannot determine the next version after " + c.Version + "."
+	}
+
+	var b strings.Builder
+	b.WriteString(ui.RenderKV([]ui.KV{
+		{K: "Cluster Version", V: c.Version},
+		{K: "Target Version", V: r.target},
+	}, 22, 0))
+
+	b.WriteString("\n\n")
+	b.WriteString(upgradeHeadingStyle.Render("Node Groups"))
+	b.WriteString("\n")
+	if len(r.nodeGroups) == 0 {
+		b.WriteString("No node groups found.\n")
+	}
+	for _, ng := range r.nodeGroups {
+		dot, note := nodeGroupReadiness(ng.Version, c.Version, r.target)
+		fmt.Fprintf(&b, "%s %-24s %-8s %s\n", dot, ng.Name, ng.Version, note)
+	}
+
+	b.WriteString("\n")
+	b.WriteString(upgradeHeadingStyle.Render("Addons compatible with " + r.target))
+	b.WriteString("\n")
+	if len(r.addons) == 0 {
+		b.WriteString("No addons found.\n")
+	}
+	for _, a := range r.addons {
+		versions := r.compatible[a.Name]
+		dot, note := addonReadiness(a, versions)
+		fmt.Fprintf(&b, "%s %-28s %-22s %s\n", dot, a.Name, a.Version, note)
+		if len(versions.Versions) > 0 {
+			latest, def := versions.Versions[0], versions.Default
+			if def == "" {
+				def = "-"
+			}
+			fmt.Fprintf(&b, "  %-28s latest %s, default %s, %d compatible\n", "", latest, def, len(versions.Versions))
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(upgradeHeadingStyle.Render("Upgrade Insights"))
+	b.WriteString("\n")
+	if len(r.insights) == 0 {
+		b.WriteString("No upgrade insights.")
+		return b.String()
+	}
+	insights := slices.Clone(r.insights)
+	slices.SortStableFunc(insights, func(a, b awseks.EKSInsight) int {
+		return insightRank(a.Status) - insightRank(b.Status)
+	})
+	for _, ins := range insights {
+		fmt.Fprintf(&b, "%s %-8s %s\n", insightStatusDot(ins.Status), ins.Status, ins.Name)
+		if ins.Reason != "" {
+			fmt.Fprintf(&b, "  %-8s %s\n", "", ins.Reason)
+		}
+		if ins.Recommendation != "" {
+			fmt.Fprintf(&b, "  %-8s → %s", "", ins.Recommendation)
+			if ins.Resources > 0 {
+				fmt.Fprintf(&b, " (%d resources)", ins.Resources)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -9,6 +9,7 @@ import (
 
 	awselb "tasnim.dev/aws-tui/internal/aws/elb"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
@@ -31,6 +32,8 @@ type DetailView struct {
 	tgs        []awselb.ELBTargetGroup
 	attributes []awselb.ELBAttribute
 	tabs       ui.TabController
+	metrics    *metrics.Panel
+	cw         metrics.Client // passed on to target group views
 	loading    bool
 	err        error
 }
@@ -41,18 +44,29 @@ func NewDetailView(client *awselb.Client, router plugin.Router, lbARN string) *D
 		client:  client,
 		router:  router,
 		lbARN:   lbARN,
-		tabs:    ui.NewTabController([]string{"Overview", "Listeners", "Target Groups", "Attributes"}),
+		tabs:    ui.NewTabController([]string{"Overview", "Listeners", "Target Groups", "Attributes", "Metrics"}),
+		metrics: metrics.NewPanel(router, loadBalancerCharts(lbARN)),
 		loading: true,
 	}
 }
 
+// setMetricsClient sets the client the view and the target group views it
+// opens read metrics with.
+func (dv *DetailView) setMetricsClient(c metrics.Client) {
+	dv.cw = c
+	dv.metrics.SetClient(c)
+}
+
 func (dv *DetailView) loadDetail() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	lbARN := dv.lbARN
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		ctx := context.Background()
-
-		lbs, err := client.ListLoadBalancers(ctx)
+		var lbs []awselb.ELBLoadBalancer
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			lbs, err = client.ListLoadBalancers(ctx)
+			return err
+		})
 		if err != nil {
 			return detailLoadedMsg{err: err}
 		}
@@ -81,6 +95,10 @@ func (dv *DetailView) Init() tea.Cmd {
 }
 
 func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if dv.metrics.Update(msg) {
+		return dv, nil
+	}
+
 	switch msg := msg.(type) {
 	case detailLoadedMsg:
 		dv.loading = false
@@ -94,6 +112,10 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		dv.attributes = msg.attributes
 		return dv, nil
 
+	case tea.WindowSizeMsg:
+		dv.metrics.SetWidth(msg.Width)
+		return dv, nil
+
 	case tea.KeyPressMsg:
 		switch msg.String() {
 		case "esc", "backspace":
@@ -102,16 +124,25 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			// Drill into target group to see targets.
 			if dv.tabs.Active() == 2 && len(dv.tgs) > 0 {
-				view := NewTGDetailView(dv.client, dv.router, dv.tgs)
+				view := NewTGDetailView(dv.client, dv.router, dv.lbARN, dv.tgs)
+				view.setMetricsClient(dv.cw)
 				dv.router.Push(view)
 				return dv, view.Init()
 			}
 			return dv, nil
 		}
+		if dv.tabs.Active() == metricsTab {
+			if cmd, ok := dv.metrics.HandleKey(dv.router.Context(dv), msg.String()); ok {
+				return dv, cmd
+			}
+		}
 	}
 
 	var cmd tea.Cmd
 	dv.tabs, cmd = dv.tabs.Update(msg)
+	if dv.tabs.Active() == metricsTab {
+		cmd = tea.Batch(cmd, dv.metrics.LoadOnce(dv.router.Context(dv)))
+	}
 	return dv, cmd
 }
 
@@ -137,6 +168,8 @@ func (dv *DetailView) View() tea.View {
 		b.WriteString(dv.renderTargetGroups())
 	case 3:
 		b.WriteString(dv.renderAttributes())
+	case metricsTab:
+		b.WriteString(dv.metrics.View())
 	}
 
 	return tea.NewView(b.String())
@@ -232,5 +265,8 @@ func (dv *DetailView) KeyHints() []plugin.KeyHint {
 	if dv.tabs.Active() == 2 {
 		hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "view targets"})
 	}
+	if dv.tabs.Active() == metricsTab {
+		hints = append(hints, dv.metrics.KeyHints()...)
+	}
 	return hints
 }
@@ -8,23 +8,36 @@ import (
 	"charm.land/lipgloss/v2"
 
 	awselb "tasnim.dev/aws-tui/internal/aws/elb"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
-// loadBalancersMsg carries the result of fetching load balancers.
+// cacheKey is the cache service key for load balancers.
+const cacheKey = "elb"
+
+// loadBalancersMsg carries the result of fetching pages of load balancers. after is the
+// token the pages were fetched from, nil when they start the listing.
 type loadBalancersMsg struct {
-	lbs []awselb.ELBLoadBalancer
-	err error
+	lbs   []awselb.ELBLoadBalancer
+	after *string
+	next  *string
+	err   error
 }
 
 // ListView displays ELB load balancers in a table.
 type ListView struct {
+	plugin.Revalidation
+
 	client  *awselb.Client
 	router  plugin.Router
 	table   ui.TableView[awselb.ELBLoadBalancer]
 	loading bool
 	err     error
+	cache   *cache.Scope
+	next    *string
+	metrics metrics.Client // read by detail views; nil without CloudWatch
 }
 
 // NewListView creates a new ELB ListView.
@@ -33,12 +46,14 @@ func NewListView(client *awselb.Client, router plugin.Router) *ListView {
 	tv := ui.NewTableView(cols, nil, func(lb awselb.ELBLoadBalancer) string {
 		return lb.ARN
 	})
-	return &ListView{
+	lv := &ListView{
 		client:  client,
 		router:  router,
 		table:   tv,
 		loading: true,
 	}
+	lv.table.OnLoadMore(func() tea.Cmd { return lv.fetchLoadBalancers(lv.next, 0) })
+	return lv
 }
 
 var (
@@ -80,27 +95,71 @@ func elbColumns() []ui.Column[awselb.ELBLoadBalancer] {
 	}
 }
 
-func (lv *ListView) fetchLoadBalancers() tea.Cmd {
-	client := lv.client
+// fetchLoadBalancers fetches pages of load balancers after token until at least want
+// have been read. Pages starting the listing replace the cached rows; later
+// pages are merged into them.
+func (lv *ListView) fetchLoadBalancers(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
 	return func() tea.Msg {
-		lbs, err := client.ListLoadBalancers(context.Background())
-		return loadBalancersMsg{lbs: lbs, err: err}
+		lbs, next, err := plugin.FetchPages(ctx, router, after, want, client.ListLoadBalancersPage)
+		if err == nil {
+			store := cache.Store[awselb.ELBLoadBalancer]
+			if after != nil {
+				store = cache.Merge[awselb.ELBLoadBalancer]
+			}
+			_ = store(context.Background(), scope, cacheKey, lbs, func(lb awselb.ELBLoadBalancer) (string, string) {
+				return lb.ARN, lb.Name
+			})
+		}
+		return loadBalancersMsg{lbs: lbs, after: after, next: next, err: err}
 	}
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchLoadBalancers()
+	fetch := lv.fetchLoadBalancers(nil, lv.table.ItemCount())
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awselb.ELBLoadBalancer](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awselb.ELBLoadBalancer]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchLoadBalancers(nil, lv.table.ItemCount())
+		}
+		return lv, nil
+
 	case loadBalancersMsg:
+		if msg.after != nil && msg.after != lv.next {
+			// A page of a listing that has since been refreshed.
+			return lv, nil
+		}
 		lv.loading = false
+		if msg.after == nil && lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
+			if msg.after != nil {
+				lv.table.SetMore(true)
+				lv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return lv, nil
+			}
 			lv.err = msg.err
 			return lv, nil
 		}
-		lv.table.SetItems(msg.lbs)
+		lv.err = nil
+		if msg.after != nil {
+			lv.table.AppendItems(msg.lbs)
+		} else {
+			lv.table.SetItems(msg.lbs)
+		}
+		lv.next = msg.next
+		lv.table.SetMore(msg.next != nil)
 		return lv, nil
 
 	case tea.KeyPressMsg:
@@ -112,6 +171,7 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			if id := lv.table.SelectedID(); id != "" {
 				view := NewDetailView(lv.client, lv.router, id)
+				view.setMetricsClient(lv.metrics)
 				lv.router.Push(view)
 				return lv, view.Init()
 			}
@@ -121,7 +181,7 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return lv, nil
 		case "r":
 			lv.loading = true
-			return lv, lv.fetchLoadBalancers()
+			return lv, lv.fetchLoadBalancers(nil, lv.table.ItemCount())
 		}
 	}
 
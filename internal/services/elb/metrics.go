@@ -0,0 +1,84 @@
+package elb
+
+import (
+	"strings"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+)
+
+// metricsTab is the index of the detail view's Metrics tab.
+const metricsTab = 4
+
+// lbDimension returns the CloudWatch namespace of a load balancer's metrics
+// and the value of their LoadBalancer dimension, "app/<name>/<id>" for
+// an ALB, both taken from its ARN.
+func lbDimension(lbARN string) (namespace, value string) {
+	_, value, _ = strings.Cut(lbARN, ":loadbalancer/")
+	switch {
+	case strings.HasPrefix(value, "app/"):
+		namespace = "AWS/ApplicationELB"
+	case strings.HasPrefix(value, "gwy/"):
+		namespace = "AWS/GatewayELB"
+	default:
+		namespace = "AWS/NetworkELB"
+	}
+	return namespace, value
+}
+
+// tgDimension returns the value of a target group's TargetGroup dimension,
+// "targetgroup/<name>/<id>".
+func tgDimension(tgARN string) string {
+	if i := strings.Index(tgARN, "targetgroup/"); i >= 0 {
+		return tgARN[i:]
+	}
+	return tgARN
+}
+
+// loadBalancerCharts returns the charts of a load balancer's Metrics tab:
+// requests, 5xx errors and latency for an ALB, flows and bytes otherwise.
+func loadBalancerCharts(lbARN string) []metrics.Chart {
+	namespace, lb := lbDimension(lbARN)
+	dims := []cloudwatch.Dimension{{Name: "LoadBalancer", Value: lb}}
+	query := func(metric, stat string) cloudwatch.MetricQuery {
+		return cloudwatch.MetricQuery{Namespace: namespace, Metric: metric, Dimensions: dims, Stat: stat}
+	}
+	if namespace == "AWS/ApplicationELB" {
+		return []metrics.Chart{
+			{Title: "Requests", Query: query("RequestCount", "Sum"), Format: metrics.Count},
+			{Title: "ELB 5XX Errors", Query: query("HTTPCode_ELB_5XX_Count", "Sum"), Format: metrics.Count},
+			{Title: "Target 5XX Errors", Query: query("HTTPCode_Target_5XX_Count", "Sum"), Format: metrics.Count},
+			{Title: "Target Response Time", Query: query("TargetResponseTime", "Average"), Format: metrics.Seconds},
+		}
+	}
+	return []metrics.Chart{
+		{Title: "Active Flows", Query: query("ActiveFlowCount", "Average"), Format: metrics.Count},
+		{Title: "New Flows", Query: query("NewFlowCount", "Sum"), Format: metrics.Count},
+		{Title: "Processed Bytes", Query: query("ProcessedBytes", "Sum"), Format: metrics.Bytes},
+	}
+}
+
+// targetGroupCharts returns the charts of a target group behind a load
+// balancer: requests, 5xx errors and latency for an ALB, target health
+// otherwise.
+func targetGroupCharts(lbARN, tgARN string) []metrics.Chart {
+	namespace, lb := lbDimension(lbARN)
+	dims := []cloudwatch.Dimension{
+		{Name: "TargetGroup", Value: tgDimension(tgARN)},
+		{Name: "LoadBalancer", Value: lb},
+	}
+	query := func(metric, stat string) cloudwatch.MetricQuery {
+		return cloudwatch.MetricQuery{Namespace: namespace, Metric: metric, Dimensions: dims, Stat: stat}
+	}
+	if namespace == "AWS/ApplicationELB" {
+		return []metrics.Chart{
+			{Title: "Requests", Query: query("RequestCount", "Sum"), Format: metrics.Count},
+			{Title: "Target 5XX Errors", Query: query("HTTPCode_Target_5XX_Count", "Sum"), Format: metrics.Count},
+			{Title: "Target Response Time", Query: query("TargetResponseTime", "Average"), Format: metrics.Seconds},
+		}
+	}
+	return []metrics.Chart{
+		{Title: "Healthy Hosts", Query: query("HealthyHostCount", "Average"), Format: metrics.Count},
+		{Title: "Unhealthy Hosts", Query: query("UnHealthyHostCount", "Maximum"), Format: metrics.Count},
+	}
+}
@@ -6,13 +6,20 @@ import (
 
 	awselb "tasnim.dev/aws-tui/internal/aws/elb"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/services/regional"
 )
 
 // Plugin implements plugin.ServicePlugin for AWS Elastic Load Balancers.
 type Plugin struct {
+	plugin.CacheHolder
+
 	client       *awselb.Client
 	loadBalancers []awselb.ELBLoadBalancer
 	hasUnhealthy bool
+	scope        plugin.RegionScope
+	clientFor    func(region string) *awselb.Client
+	metricsFor   metrics.ClientFor
 }
 
 // NewPlugin creates a new ELB ServicePlugin.
@@ -20,6 +27,28 @@ func NewPlugin(client *awselb.Client) *Plugin {
 	return &Plugin{client: client}
 }
 
+// SetRegionalClients sets the factory used to reach other regions in
+// all-regions scope.
+func (p *Plugin) SetRegionalClients(clientFor func(region string) *awselb.Client) {
+	p.clientFor = clientFor
+}
+
+// SetMetricsClients sets the factory of the CloudWatch clients detail views
+// read metrics with.
+func (p *Plugin) SetMetricsClients(clientFor metrics.ClientFor) { p.metricsFor = clientFor }
+
+// metricsClient returns the CloudWatch client for region, nil when metrics
+// are unavailable.
+func (p *Plugin) metricsClient(region string) metrics.Client {
+	if p.metricsFor == nil {
+		return nil
+	}
+	return p.metricsFor(region)
+}
+
+// SetRegionScope implements plugin.MultiRegion.
+func (p *Plugin) SetRegionScope(scope plugin.RegionScope) { p.scope = scope }
+
 func (p *Plugin) ID() string   { return "elb" }
 func (p *Plugin) Name() string { return "ELB" }
 func (p *Plugin) Icon() string { return "\U000F04E7" } // nf-mdi-scale-balance
@@ -73,11 +102,33 @@ func mapSummary(ctx context.Context, client *awselb.Client, lbs []awselb.ELBLoad
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router)
+	if p.scope.All() && p.clientFor != nil {
+		return p.regionalListView(router)
+	}
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	lv.metrics = p.metricsClient("")
+	return lv
+}
+
+// regionalListView lists load balancers across every region in the scope.
+func (p *Plugin) regionalListView(router plugin.Router) plugin.View {
+	return regional.NewListView(router, "Load Balancers", p.scope, elbColumns(),
+		func(lb awselb.ELBLoadBalancer) string { return lb.ARN },
+		func(ctx context.Context, region string) ([]awselb.ELBLoadBalancer, error) {
+			return p.clientFor(region).ListLoadBalancers(ctx)
+		},
+		func(router plugin.Router, region string, lb awselb.ELBLoadBalancer) plugin.View {
+			dv := NewDetailView(p.clientFor(region), router, lb.ARN)
+			dv.setMetricsClient(p.metricsClient(region))
+			return dv
+		})
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
-	return NewDetailView(p.client, router, id)
+	dv := NewDetailView(p.client, router, id)
+	dv.setMetricsClient(p.metricsClient(""))
+	return dv
 }
 
 func (p *Plugin) Commands() []plugin.Command {
@@ -5,14 +5,17 @@ import (
 	"testing"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
 	awselb "tasnim.dev/aws-tui/internal/aws/elb"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 )
 
 // mockELBAPI implements awselb.ELBAPI for testing.
@@ -205,3 +208,100 @@ func TestSummary_Empty(t *testing.T) {
 	assert.Equal(t, 0, summary.Total)
 	assert.Equal(t, plugin.HealthHealthy, summary.Health)
 }
+
+type mockRouter struct{}
+
+func (m mockRouter) Push(_ plugin.View)                    {}
+func (m mockRouter) Pop()                                  {}
+func (m mockRouter) Navigate(_ string)                     {}
+func (m mockRouter) NavigateDetail(_ string, _ string)     {}
+func (m mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m mockRouter) Offline() bool                         { return false }
+func (m mockRouter) ReadOnly() bool                        { return false }
+func (m mockRouter) Confirm(_ plugin.Action)               {}
+func (m mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+// mockMetrics returns a datapoint for every query.
+type mockMetrics struct {
+	queries []cloudwatch.MetricQuery
+}
+
+func (m *mockMetrics) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, _ cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries = queries
+	series := make([]cloudwatch.MetricSeries, len(queries))
+	for i := range series {
+		series[i].Values = []float64{0.25}
+	}
+	return series, nil
+}
+
+const (
+	albARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188"
+	nlbARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/api/7a3f2c1b9e8d4a60"
+	tgARN  = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web-tg/73e2d6bc24d8a067"
+)
+
+func TestLoadBalancerCharts(t *testing.T) {
+	charts := loadBalancerCharts(albARN)
+	require.Len(t, charts, 4)
+	q := charts[0].Query
+	assert.Equal(t, "AWS/ApplicationELB", q.Namespace)
+	assert.Equal(t, "RequestCount", q.Metric)
+	assert.Equal(t, []cloudwatch.Dimension{{Name: "LoadBalancer", Value: "app/web/50dc6c495c0c9188"}}, q.Dimensions)
+	assert.Equal(t, "HTTPCode_ELB_5XX_Count", charts[1].Query.Metric)
+	assert.Equal(t, "TargetResponseTime", charts[3].Query.Metric)
+
+	charts = loadBalancerCharts(nlbARN)
+	assert.Equal(t, "AWS/NetworkELB", charts[0].Query.Namespace)
+	assert.Equal(t, "net/api/7a3f2c1b9e8d4a60", charts[0].Query.Dimensions[0].Value)
+
+	charts = targetGroupCharts(albARN, tgARN)
+	require.Len(t, charts, 3)
+	assert.Equal(t, []cloudwatch.Dimension{
+		{Name: "TargetGroup", Value: "targetgroup/web-tg/73e2d6bc24d8a067"},
+		{Name: "LoadBalancer", Value: "app/web/50dc6c495c0c9188"},
+	}, charts[0].Query.Dimensions)
+	assert.Equal(t, "HTTPCode_Target_5XX_Count", charts[1].Query.Metric)
+}
+
+func TestDetailView_MetricsTab(t *testing.T) {
+	api := &mockELBAPI{lbs: []elbtypes.LoadBalancer{{
+		LoadBalancerName: aws.String("web"),
+		LoadBalancerArn:  aws.String(albARN),
+		Type:             elbtypes.LoadBalancerTypeEnumApplication,
+		State:            &elbtypes.LoadBalancerState{Code: elbtypes.LoadBalancerStateEnumActive},
+	}}}
+	cw := &mockMetrics{}
+	p := NewPlugin(newMockClient(api))
+	p.SetMetricsClients(func(string) metrics.Client { return cw })
+	dv := p.DetailView(mockRouter{}, albARN).(*DetailView)
+	dv.Update(dv.Init()())
+
+	_, cmd := dv.Update(tea.KeyPressMsg{Code: '5', Text: "5"})
+	require.NotNil(t, cmd)
+	dv.Update(cmd())
+	require.Len(t, cw.queries, 4)
+	content := dv.View().Content
+	assert.Contains(t, content, "Target Response Time")
+	assert.Contains(t, content, "last 250ms")
+}
+
+func TestTGDetailView_Metrics(t *testing.T) {
+	cw := &mockMetrics{}
+	v := NewTGDetailView(newMockClient(&mockELBAPI{}), mockRouter{}, albARN, []awselb.ELBTargetGroup{
+		{Name: "web-tg", ARN: tgARN},
+		{Name: "api-tg", ARN: "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/api-tg/0f1e2d3c4b5a6978"},
+	})
+	v.setMetricsClient(cw)
+	v.Update(v.loadMetrics()())
+	require.Len(t, cw.queries, 3)
+	assert.Equal(t, "targetgroup/web-tg/73e2d6bc24d8a067", cw.queries[0].Dimensions[0].Value)
+	v.Update(targetsMsg{tgName: "web-tg"})
+	assert.Contains(t, v.View().Content, "Requests")
+
+	// Switching target groups loads the next group's metrics.
+	_, cmd := v.Update(tea.KeyPressMsg{Code: ']', Text: "]"})
+	require.NotNil(t, cmd)
+	v.Update(cmd())
+	assert.Equal(t, "targetgroup/api-tg/0f1e2d3c4b5a6978", cw.queries[0].Dimensions[0].Value)
+}
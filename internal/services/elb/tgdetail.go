@@ -9,6 +9,7 @@ import (
 
 	awselb "tasnim.dev/aws-tui/internal/aws/elb"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
@@ -18,47 +19,87 @@ type targetsMsg struct {
 	err     error
 }
 
-// TGDetailView shows targets for each target group of a load balancer.
+// TGDetailView shows targets and metrics for each target group of a load
+// balancer.
 type TGDetailView struct {
 	client  *awselb.Client
 	router  plugin.Router
 	tgs     []awselb.ELBTargetGroup
 	targets map[string][]awselb.ELBTarget // keyed by TG name
+	metrics []*metrics.Panel              // one per TG
 	tabs    ui.TabController
 	loading bool
 	err     error
 }
 
-func NewTGDetailView(client *awselb.Client, router plugin.Router, tgs []awselb.ELBTargetGroup) *TGDetailView {
+func NewTGDetailView(client *awselb.Client, router plugin.Router, lbARN string, tgs []awselb.ELBTargetGroup) *TGDetailView {
 	names := make([]string, len(tgs))
+	panels := make([]*metrics.Panel, len(tgs))
 	for i, tg := range tgs {
 		names[i] = tg.Name
+		panels[i] = metrics.NewPanel(router, targetGroupCharts(lbARN, tg.ARN))
 	}
 	return &TGDetailView{
 		client:  client,
 		router:  router,
 		tgs:     tgs,
 		targets: make(map[string][]awselb.ELBTarget),
+		metrics: panels,
 		tabs:    ui.NewTabController(names),
 		loading: true,
 	}
 }
 
+// setMetricsClient sets the client target group metrics are read with.
+func (v *TGDetailView) setMetricsClient(c metrics.Client) {
+	for _, p := range v.metrics {
+		p.SetClient(c)
+	}
+}
+
+// activeMetrics returns the metrics panel of the shown target group.
+func (v *TGDetailView) activeMetrics() *metrics.Panel {
+	if active := v.tabs.Active(); active < len(v.metrics) {
+		return v.metrics[active]
+	}
+	return nil
+}
+
+// loadMetrics loads the shown target group's metrics unless they are loaded.
+func (v *TGDetailView) loadMetrics() tea.Cmd {
+	if p := v.activeMetrics(); p != nil {
+		return p.LoadOnce(v.router.Context(v))
+	}
+	return nil
+}
+
 func (v *TGDetailView) Init() tea.Cmd {
-	client := v.client
+	client, router := v.client, v.router
 	tgs := v.tgs
+	ctx := router.Context(v)
 	var cmds []tea.Cmd
 	for _, tg := range tgs {
 		tg := tg
 		cmds = append(cmds, func() tea.Msg {
-			targets, err := client.ListTargets(context.TODO(), tg.ARN)
+			var targets []awselb.ELBTarget
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				targets, err = client.ListTargets(ctx, tg.ARN)
+				return err
+			})
 			return targetsMsg{tgName: tg.Name, targets: targets, err: err}
 		})
 	}
+	cmds = append(cmds, v.loadMetrics())
 	return tea.Batch(cmds...)
 }
 
 func (v *TGDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	for _, p := range v.metrics {
+		if p.Update(msg) {
+			return v, nil
+		}
+	}
+
 	switch msg := msg.(type) {
 	case targetsMsg:
 		v.loading = false
@@ -69,17 +110,28 @@ func (v *TGDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		v.targets[msg.tgName] = msg.targets
 		return v, nil
 
+	case tea.WindowSizeMsg:
+		for _, p := range v.metrics {
+			p.SetWidth(msg.Width)
+		}
+		return v, nil
+
 	case tea.KeyPressMsg:
 		switch msg.String() {
 		case "esc", "backspace":
 			v.router.Pop()
 			return v, nil
 		}
+		if p := v.activeMetrics(); p != nil {
+			if cmd, ok := p.HandleKey(v.router.Context(v), msg.String()); ok {
+				return v, cmd
+			}
+		}
 	}
 
 	var cmd tea.Cmd
 	v.tabs, cmd = v.tabs.Update(msg)
-	return v, cmd
+	return v, tea.Batch(cmd, v.loadMetrics())
 }
 
 func (v *TGDetailView) View() tea.View {
@@ -132,6 +184,9 @@ func (v *TGDetailView) View() tea.View {
 			})
 			b.WriteString(tv.View())
 		}
+
+		b.WriteString("\n\n")
+		b.WriteString(v.metrics[active].View())
 	}
 
 	return tea.NewView(b.String())
@@ -142,8 +197,12 @@ func (v *TGDetailView) Title() string {
 }
 
 func (v *TGDetailView) KeyHints() []plugin.KeyHint {
-	return []plugin.KeyHint{
+	hints := []plugin.KeyHint{
 		{Key: "esc", Desc: "back"},
 		{Key: "[/]", Desc: "switch TG"},
 	}
+	if p := v.activeMetrics(); p != nil {
+		hints = append(hints, p.KeyHints()...)
+	}
+	return hints
 }
@@ -97,14 +97,18 @@ func NewDetailView(client IAMClient, router plugin.Router, id string) *DetailVie
 }
 
 func (dv *DetailView) Init() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	name := dv.name
+	ctx := router.Context(dv)
 
 	switch dv.kind {
 	case "user":
 		return func() tea.Msg {
-			ctx := context.TODO()
-			users, err := client.ListUsers(ctx)
+			var users []awsiam.IAMUser
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				users, err = client.ListUsers(ctx)
+				return err
+			})
 			if err != nil {
 				return userDetailMsg{err: err}
 			}
@@ -125,8 +129,11 @@ func (dv *DetailView) Init() tea.Cmd {
 		}
 	case "role":
 		return func() tea.Msg {
-			ctx := context.TODO()
-			roles, err := client.ListRoles(ctx)
+			var roles []awsiam.IAMRole
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				roles, err = client.ListRoles(ctx)
+				return err
+			})
 			if err != nil {
 				return roleDetailMsg{err: err}
 			}
@@ -146,8 +153,11 @@ func (dv *DetailView) Init() tea.Cmd {
 		}
 	default: // policy
 		return func() tea.Msg {
-			ctx := context.TODO()
-			policies, err := client.ListPolicies(ctx)
+			var policies []awsiam.IAMPolicy
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				policies, err = client.ListPolicies(ctx)
+				return err
+			})
 			if err != nil {
 				return policyDetailMsg{err: err}
 			}
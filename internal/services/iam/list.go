@@ -8,28 +8,54 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	awsiam "tasnim.dev/aws-tui/internal/aws/iam"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
-// Fetch result messages.
+// Cache service keys, one per tab. Cached IDs match the table IDs, which are
+// also the IDs DetailView accepts.
+const (
+	usersCacheKey    = "iam:users"
+	rolesCacheKey    = "iam:roles"
+	policiesCacheKey = "iam:policies"
+)
+
+// Fetch result messages. after is the token the pages were fetched from,
+// nil when they start the listing.
 type usersMsg struct {
 	users []awsiam.IAMUser
+	after *string
+	next  *string
 	err   error
 }
 
 type rolesMsg struct {
 	roles []awsiam.IAMRole
+	after *string
+	next  *string
 	err   error
 }
 
 type policiesMsg struct {
 	policies []awsiam.IAMPolicy
+	after    *string
+	next     *string
 	err      error
 }
 
+// cachedMsg carries all three tabs read from the local cache.
+type cachedMsg struct {
+	users    []awsiam.IAMUser
+	roles    []awsiam.IAMRole
+	policies []awsiam.IAMPolicy
+	plugin.CacheEntry
+}
+
 // ListView displays IAM resources in a tabbed table view.
 type ListView struct {
+	plugin.Revalidation
+
 	client IAMClient
 	router plugin.Router
 
@@ -40,6 +66,13 @@ type ListView struct {
 
 	loading bool
 	err     error
+
+	cache *cache.Scope
+
+	// Next page tokens, one per tab.
+	usersNext    *string
+	rolesNext    *string
+	policiesNext *string
 }
 
 // NewListView creates a new IAM ListView with tabs for Users, Roles, and Policies.
@@ -75,7 +108,7 @@ func NewListView(client IAMClient, router plugin.Router) *ListView {
 		}},
 	}
 
-	return &ListView{
+	lv := &ListView{
 		client:   client,
 		router:   router,
 		tabs:     ui.NewTabController([]string{"Users", "Roles", "Policies"}),
@@ -84,57 +117,179 @@ func NewListView(client IAMClient, router plugin.Router) *ListView {
 		policies: ui.NewTableView(policyCols, nil, func(p awsiam.IAMPolicy) string { return p.ARN }),
 		loading:  true,
 	}
+	lv.users.OnLoadMore(func() tea.Cmd { return lv.fetchUsers(lv.usersNext, 0) })
+	lv.roles.OnLoadMore(func() tea.Cmd { return lv.fetchRoles(lv.rolesNext, 0) })
+	lv.policies.OnLoadMore(func() tea.Cmd { return lv.fetchPolicies(lv.policiesNext, 0) })
+	return lv
 }
 
+// fetchAll reloads every tab, reading at least as many rows as each
+// already shows.
 func (lv *ListView) fetchAll() tea.Cmd {
-	client := lv.client
+	lv.Expect(3)
 	return tea.Batch(
-		func() tea.Msg {
-			users, err := client.ListUsers(context.TODO())
-			return usersMsg{users: users, err: err}
-		},
-		func() tea.Msg {
-			roles, err := client.ListRoles(context.TODO())
-			return rolesMsg{roles: roles, err: err}
-		},
-		func() tea.Msg {
-			policies, err := client.ListPolicies(context.TODO())
-			return policiesMsg{policies: policies, err: err}
-		},
+		lv.fetchUsers(nil, lv.users.ItemCount()),
+		lv.fetchRoles(nil, lv.roles.ItemCount()),
+		lv.fetchPolicies(nil, lv.policies.ItemCount()),
 	)
 }
 
+// fetchUsers fetches pages of users after token until at least want have
+// been read. Pages starting the listing replace the cached rows; later pages
+// are merged into them. fetchRoles and fetchPolicies do the same.
+func (lv *ListView) fetchUsers(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		users, next, err := plugin.FetchPages(ctx, router, after, want, client.ListUsersPage)
+		if err == nil {
+			store := cache.Store[awsiam.IAMUser]
+			if after != nil {
+				store = cache.Merge[awsiam.IAMUser]
+			}
+			_ = store(context.Background(), scope, usersCacheKey, users, func(u awsiam.IAMUser) (string, string) {
+				return "user:" + u.Name, u.Name
+			})
+		}
+		return usersMsg{users: users, after: after, next: next, err: err}
+	}
+}
+
+func (lv *ListView) fetchRoles(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		roles, next, err := plugin.FetchPages(ctx, router, after, want, client.ListRolesPage)
+		if err == nil {
+			store := cache.Store[awsiam.IAMRole]
+			if after != nil {
+				store = cache.Merge[awsiam.IAMRole]
+			}
+			_ = store(context.Background(), scope, rolesCacheKey, roles, func(r awsiam.IAMRole) (string, string) {
+				return "role:" + r.Name, r.Name
+			})
+		}
+		return rolesMsg{roles: roles, after: after, next: next, err: err}
+	}
+}
+
+func (lv *ListView) fetchPolicies(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		policies, next, err := plugin.FetchPages(ctx, router, after, want, client.ListPoliciesPage)
+		if err == nil {
+			store := cache.Store[awsiam.IAMPolicy]
+			if after != nil {
+				store = cache.Merge[awsiam.IAMPolicy]
+			}
+			_ = store(context.Background(), scope, policiesCacheKey, policies, func(p awsiam.IAMPolicy) (string, string) {
+				return p.ARN, p.Name
+			})
+		}
+		return policiesMsg{policies: policies, after: after, next: next, err: err}
+	}
+}
+
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchAll()
+	fetch := lv.fetchAll()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCached(lv.cache, fetch, func(r *plugin.CacheReader) tea.Msg {
+			users := plugin.ReadCached[awsiam.IAMUser](r, usersCacheKey)
+			roles := plugin.ReadCached[awsiam.IAMRole](r, rolesCacheKey)
+			policies := plugin.ReadCached[awsiam.IAMPolicy](r, policiesCacheKey)
+			return cachedMsg{users: users, roles: roles, policies: policies, CacheEntry: r.Entry()}
+		})
+	}
+	return fetch
+}
+
+// appendPage adds a page requested by table's OnLoadMore and returns the
+// token to request next. A failed page keeps token so it can be retried.
+func appendPage[T any](router plugin.Router, table *ui.TableView[T], items []T, token, next *string, err error) *string {
+	if err != nil {
+		table.SetMore(true)
+		router.Toast(plugin.ToastError, "Loading more failed: "+err.Error())
+		return token
+	}
+	table.AppendItems(items)
+	table.SetMore(next != nil)
+	return next
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case cachedMsg:
+		lv.loading = false
+		lv.users.SetItems(msg.users)
+		lv.roles.SetItems(msg.roles)
+		lv.policies.SetItems(msg.policies)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchAll()
+		}
+		return lv, nil
+
 	case usersMsg:
+		if msg.after != nil {
+			// Ignore pages of a listing that has since been refreshed.
+			if msg.after == lv.usersNext {
+				lv.usersNext = appendPage(lv.router, &lv.users, msg.users, msg.after, msg.next, msg.err)
+			}
+			return lv, nil
+		}
 		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
 			lv.err = msg.err
 			return lv, nil
 		}
 		lv.users.SetItems(msg.users)
+		lv.usersNext = msg.next
+		lv.users.SetMore(msg.next != nil)
 		return lv, nil
 
 	case rolesMsg:
+		if msg.after != nil {
+			// Ignore pages of a listing that has since been refreshed.
+			if msg.after == lv.rolesNext {
+				lv.rolesNext = appendPage(lv.router, &lv.roles, msg.roles, msg.after, msg.next, msg.err)
+			}
+			return lv, nil
+		}
 		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
 			lv.err = msg.err
 			return lv, nil
 		}
 		lv.roles.SetItems(msg.roles)
+		lv.rolesNext = msg.next
+		lv.roles.SetMore(msg.next != nil)
 		return lv, nil
 
 	case policiesMsg:
+		if msg.after != nil {
+			// Ignore pages of a listing that has since been refreshed.
+			if msg.after == lv.policiesNext {
+				lv.policiesNext = appendPage(lv.router, &lv.policies, msg.policies, msg.after, msg.next, msg.err)
+			}
+			return lv, nil
+		}
 		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
 			lv.err = msg.err
 			return lv, nil
 		}
 		lv.policies.SetItems(msg.policies)
+		lv.policiesNext = msg.next
+		lv.policies.SetMore(msg.next != nil)
 		return lv, nil
 
 	case tea.KeyPressMsg:
@@ -11,8 +11,11 @@ import (
 // IAMClient defines the subset of iam.Client methods used by the plugin.
 type IAMClient interface {
 	ListUsers(ctx context.Context) ([]awsiam.IAMUser, error)
+	ListUsersPage(ctx context.Context, marker *string) ([]awsiam.IAMUser, *string, error)
 	ListRoles(ctx context.Context) ([]awsiam.IAMRole, error)
+	ListRolesPage(ctx context.Context, marker *string) ([]awsiam.IAMRole, *string, error)
 	ListPolicies(ctx context.Context) ([]awsiam.IAMPolicy, error)
+	ListPoliciesPage(ctx context.Context, marker *string) ([]awsiam.IAMPolicy, *string, error)
 	ListAttachedUserPolicies(ctx context.Context, userName string) ([]awsiam.IAMAttachedPolicy, error)
 	ListGroupsForUser(ctx context.Context, userName string) ([]awsiam.IAMGroup, error)
 	ListAttachedRolePolicies(ctx context.Context, roleName string) ([]awsiam.IAMAttachedPolicy, error)
@@ -24,6 +27,8 @@ type IAMClient interface {
 
 // Plugin implements plugin.ServicePlugin for AWS IAM.
 type Plugin struct {
+	plugin.CacheHolder
+
 	client IAMClient
 }
 
@@ -51,7 +56,9 @@ func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router)
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
@@ -31,6 +31,18 @@ func (m *mockClient) ListPolicies(ctx context.Context) ([]awsiam.IAMPolicy, erro
 	return m.policies, m.err
 }
 
+func (m *mockClient) ListUsersPage(ctx context.Context, _ *string) ([]awsiam.IAMUser, *string, error) {
+	return m.users, nil, m.err
+}
+
+func (m *mockClient) ListRolesPage(ctx context.Context, _ *string) ([]awsiam.IAMRole, *string, error) {
+	return m.roles, nil, m.err
+}
+
+func (m *mockClient) ListPoliciesPage(ctx context.Context, _ *string) ([]awsiam.IAMPolicy, *string, error) {
+	return m.policies, nil, m.err
+}
+
 func (m *mockClient) ListAttachedUserPolicies(ctx context.Context, userName string) ([]awsiam.IAMAttachedPolicy, error) {
 	return nil, nil
 }
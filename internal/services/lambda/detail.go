@@ -0,0 +1,423 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awslambda "tasnim.dev/aws-tui/internal/aws/lambda"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the detail view.
+const (
+	configTab   = 0
+	envTab      = 1
+	versionsTab = 2
+	triggersTab = 3
+	logsTab     = 4
+	invokeTab   = 5
+)
+
+// maskedValue replaces environment variable values until they are revealed.
+const maskedValue = "••••••••"
+
+var sectionStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+
+// detailLoadedMsg carries a function with its versions, aliases and
+// triggers. Only a failure to read the function itself is fatal.
+type detailLoadedMsg struct {
+	fn          awslambda.FunctionDetail
+	versions    []awslambda.Version
+	aliases     []awslambda.Alias
+	versionsErr error
+	mappings    []awslambda.EventSourceMapping
+	permissions []awslambda.Permission
+	triggersErr error
+	err         error
+}
+
+// DetailView shows a function's configuration, environment, versions,
+// triggers and recent logs, and invokes it with a payload from $EDITOR.
+type DetailView struct {
+	client      LambdaClient
+	logClient   LogsClient
+	router      plugin.Router
+	name        string
+	fn          *awslambda.FunctionDetail
+	versions    []awslambda.Version
+	aliases     []awslambda.Alias
+	versionsErr error
+	mappings    []awslambda.EventSourceMapping
+	permissions []awslambda.Permission
+	triggersErr error
+	tabs        ui.TabController
+	loading     bool
+	err         error
+	width       int
+	height      int
+	revealEnv   bool
+
+	// Logs tab state.
+	logView    ui.LogView
+	logGen     int // current poll chain; older results are dropped
+	logPolling bool
+	logLoaded  bool
+	logFrom    time.Time // where the next poll reads from
+	logErr     error
+
+	// Invoke tab state.
+	payload   []byte
+	result    *awslambda.InvokeResult
+	resultErr error
+}
+
+// NewDetailView creates a DetailView for the function called name.
+func NewDetailView(client LambdaClient, router plugin.Router, name string) *DetailView {
+	return &DetailView{
+		client:  client,
+		router:  router,
+		name:    name,
+		tabs:    ui.NewTabController([]string{"Configuration", "Environment", "Versions", "Triggers", "Logs", "Invoke"}),
+		loading: true,
+		logView: ui.NewLogView(name),
+		payload: []byte("{}\n"),
+	}
+}
+
+func (dv *DetailView) loadFunction() tea.Cmd {
+	client, router := dv.client, dv.router
+	name := dv.name
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		var fn awslambda.FunctionDetail
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			fn, err = client.GetFunction(ctx, name)
+			return err
+		})
+		if err != nil {
+			return detailLoadedMsg{err: err}
+		}
+		msg := detailLoadedMsg{fn: fn}
+		msg.versions, msg.versionsErr = client.ListVersions(ctx, name)
+		if msg.versionsErr == nil {
+			msg.aliases, msg.versionsErr = client.ListAliases(ctx, name)
+		}
+		msg.mappings, msg.triggersErr = client.ListEventSourceMappings(ctx, name)
+		if msg.triggersErr == nil {
+			msg.permissions, msg.triggersErr = client.ListPermissions(ctx, name)
+		}
+		return msg
+	}
+}
+
+func (dv *DetailView) Init() tea.Cmd {
+	return dv.loadFunction()
+}
+
+func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case detailLoadedMsg:
+		dv.loading = false
+		if msg.err != nil {
+			dv.err = msg.err
+			return dv, nil
+		}
+		dv.err = nil
+		dv.fn = &msg.fn
+		dv.versions, dv.aliases, dv.versionsErr = msg.versions, msg.aliases, msg.versionsErr
+		dv.mappings, dv.permissions, dv.triggersErr = msg.mappings, msg.permissions, msg.triggersErr
+		return dv, dv.startLogs()
+
+	case logEventsMsg, logTickMsg, ui.LogJumpMsg, ui.LogSavedMsg:
+		return dv, dv.updateLogs(msg)
+
+	case payloadEditedMsg:
+		dv.payloadEdited(msg)
+		return dv, nil
+
+	case invokeDoneMsg:
+		dv.invokeDone(msg)
+		return dv, nil
+
+	case tea.WindowSizeMsg:
+		dv.width, dv.height = msg.Width, msg.Height
+		dv.logView.SetSize(msg.Width, msg.Height-logChromeHeight)
+		return dv, nil
+
+	case tea.KeyPressMsg:
+		if dv.CapturingInput() {
+			return dv, dv.handleLogKey(msg)
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			dv.router.Pop()
+			return dv, nil
+		case "I":
+			return dv, dv.startInvoke()
+		case "v":
+			if dv.tabs.Active() == envTab {
+				dv.revealEnv = !dv.revealEnv
+				return dv, nil
+			}
+		case "r":
+			if dv.tabs.Active() == logsTab {
+				return dv, dv.restartLogs()
+			}
+			if !dv.loading {
+				dv.loading = true
+				return dv, dv.loadFunction()
+			}
+			return dv, nil
+		}
+
+		prev := dv.tabs.Active()
+		var cmd tea.Cmd
+		dv.tabs, cmd = dv.tabs.Update(msg)
+		if dv.tabs.Active() != prev {
+			return dv, tea.Batch(cmd, dv.startLogs())
+		}
+		if dv.tabs.Active() == logsTab {
+			return dv, dv.handleLogKey(msg)
+		}
+		return dv, cmd
+	}
+
+	return dv, nil
+}
+
+func (dv *DetailView) View() tea.View {
+	if dv.loading && dv.fn == nil {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if dv.err != nil {
+		return tea.NewView("Error: " + dv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(dv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch dv.tabs.Active() {
+	case configTab:
+		b.WriteString(dv.renderConfig())
+	case envTab:
+		b.WriteString(dv.renderEnvironment())
+	case versionsTab:
+		b.WriteString(dv.renderVersions())
+	case triggersTab:
+		b.WriteString(dv.renderTriggers())
+	case logsTab:
+		b.WriteString(dv.renderLogs())
+	case invokeTab:
+		b.WriteString(dv.renderInvoke())
+	}
+
+	return tea.NewView(b.String())
+}
+
+func (dv *DetailView) renderConfig() string {
+	f := dv.fn
+	state := f.State
+	if f.StateReason != "" {
+		state += " — " + f.StateReason
+	}
+	runtime := f.Runtime
+	if runtime == "" {
+		runtime = f.PackageType
+	}
+	concurrency := "unreserved"
+	if f.ReservedConcurrency != nil {
+		concurrency = fmt.Sprintf("%d reserved", *f.ReservedConcurrency)
+	}
+	rows := []ui.KV{
+		{K: "Name", V: f.Name},
+		{K: "ARN", V: f.ARN},
+		{K: "Description", V: f.Description},
+		{K: "State", V: state},
+		{K: "Last Update", V: f.LastUpdateStatus},
+		{K: "Runtime", V: runtime},
+		{K: "Handler", V: f.Handler},
+		{K: "Architecture", V: strings.Join(f.Architectures, ", ")},
+		{K: "Memory", V: fmt.Sprintf("%d MB", f.MemoryMB)},
+		{K: "Ephemeral Storage", V: fmt.Sprintf("%d MB", f.EphemeralStorageMB)},
+		{K: "Timeout", V: f.Timeout.String()},
+		{K: "Concurrency", V: concurrency},
+		{K: "Code Size", V: ui.FormatSize(f.CodeSize)},
+		{K: "Last Modified", V: ui.FormatTime(f.LastModified)},
+		{K: "Role", V: f.Role},
+		{K: "Log Group", V: f.LogGroup},
+	}
+	if f.Tracing != "" {
+		rows = append(rows, ui.KV{K: "Tracing", V: f.Tracing})
+	}
+	if len(f.Layers) > 0 {
+		rows = append(rows, ui.KV{K: "Layers", V: strings.Join(f.Layers, ", ")})
+	}
+	if f.VPCID != "" {
+		rows = append(rows,
+			ui.KV{K: "VPC", V: f.VPCID},
+			ui.KV{K: "Subnets", V: strings.Join(f.Subnets, ", ")},
+			ui.KV{K: "Security Groups", V: strings.Join(f.SecurityGroups, ", ")},
+		)
+	}
+	if f.DeadLetterTarget != "" {
+		rows = append(rows, ui.KV{K: "Dead-letter Queue", V: f.DeadLetterTarget})
+	}
+	for _, k := range sortedKeys(f.Tags) {
+		rows = append(rows, ui.KV{K: "Tag: " + k, V: f.Tags[k]})
+	}
+	return ui.RenderKV(rows, 20, ui.KVValueWidth(dv.width))
+}
+
+func (dv *DetailView) renderEnvironment() string {
+	f := dv.fn
+	if f.EnvironmentError != "" {
+		return "Error: " + f.EnvironmentError
+	}
+	if len(f.Environment) == 0 {
+		return "No environment variables."
+	}
+	keys := sortedKeys(f.Environment)
+	labelWidth := 20
+	for _, k := range keys {
+		labelWidth = max(labelWidth, len(k)+2)
+	}
+	rows := make([]ui.KV, len(keys))
+	for i, k := range keys {
+		v := maskedValue
+		if dv.revealEnv {
+			v = f.Environment[k]
+		}
+		rows[i] = ui.KV{K: k, V: v}
+	}
+	return ui.RenderKV(rows, labelWidth, max(dv.width-labelWidth-2, 40))
+}
+
+func (dv *DetailView) renderVersions() string {
+	if dv.versionsErr != nil {
+		return "Error: " + dv.versionsErr.Error()
+	}
+
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Aliases"))
+	b.WriteString("\n")
+	if len(dv.aliases) == 0 {
+		b.WriteString("No aliases.\n")
+	}
+	for _, a := range dv.aliases {
+		b.WriteString(fmt.Sprintf("%-20s → %s%s", a.Name, a.Version, routing(a)))
+		if a.Description != "" {
+			b.WriteString("  " + a.Description)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(sectionStyle.Render("Versions"))
+	b.WriteString("\n")
+	if len(dv.versions) == 0 {
+		b.WriteString("No published versions; only $LATEST.\n")
+	}
+	for _, v := range dv.versions {
+		b.WriteString(fmt.Sprintf("%-8s %-17s %s\n", v.Version, ui.FormatTime(v.LastModified), v.Description))
+	}
+	return b.String()
+}
+
+// routing describes the share of an alias's invocations sent to other
+// versions, e.g. " (10% to 3)".
+func routing(a awslambda.Alias) string {
+	if len(a.Weights) == 0 {
+		return ""
+	}
+	var parts []string
+	for _, v := range sortedKeys(a.Weights) {
+		parts = append(parts, fmt.Sprintf("%.0f%% to %s", a.Weights[v]*100, v))
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+func (dv *DetailView) renderTriggers() string {
+	if dv.triggersErr != nil {
+		return "Error: " + dv.triggersErr.Error()
+	}
+
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Event Source Mappings"))
+	b.WriteString("\n")
+	if len(dv.mappings) == 0 {
+		b.WriteString("No event source mappings.\n")
+	}
+	for _, m := range dv.mappings {
+		b.WriteString(fmt.Sprintf("%-10s batch %-5d %s\n", m.State, m.BatchSize, m.Source))
+		if m.LastProcessing != "" {
+			b.WriteString(fmt.Sprintf("%-10s last result: %s\n", "", m.LastProcessing))
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(sectionStyle.Render("Resource Policy"))
+	b.WriteString("\n")
+	if len(dv.permissions) == 0 {
+		b.WriteString("No services or accounts are granted access.\n")
+	}
+	for _, p := range dv.permissions {
+		line := p.Principal
+		if p.SourceARN != "" {
+			line += " from " + p.SourceARN
+		}
+		b.WriteString(fmt.Sprintf("%-24s %s\n", p.Action, line))
+	}
+	return b.String()
+}
+
+func (dv *DetailView) Title() string {
+	return dv.name
+}
+
+func (dv *DetailView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "1-6", Desc: "jump to tab"},
+	}
+	switch dv.tabs.Active() {
+	case envTab:
+		desc := "show values"
+		if dv.revealEnv {
+			desc = "hide values"
+		}
+		hints = append(hints, plugin.KeyHint{Key: "v", Desc: desc})
+	case logsTab:
+		hints = append(hints,
+			plugin.KeyHint{Key: "p", Desc: "pause/resume"},
+			plugin.KeyHint{Key: "/", Desc: "filter"},
+			plugin.KeyHint{Key: "w", Desc: "toggle wrap"},
+			plugin.KeyHint{Key: "t", Desc: "jump to time"},
+			plugin.KeyHint{Key: "g/G", Desc: "oldest/follow"},
+			plugin.KeyHint{Key: "S", Desc: "save to file"},
+		)
+	}
+	if !dv.router.ReadOnly() && dv.fn != nil {
+		hints = append(hints, plugin.KeyHint{Key: "I", Desc: "test invoke"})
+	}
+	return hints
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,186 @@
+package lambda
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	awslambda "tasnim.dev/aws-tui/internal/aws/lambda"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// payloadPreviewWidth caps the payload shown in the confirmation prompt.
+const payloadPreviewWidth = 60
+
+// payloadEditedMsg carries the payload saved in the editor.
+type payloadEditedMsg struct {
+	payload []byte
+	err     error
+}
+
+// invokeDoneMsg carries the result of a test invocation.
+type invokeDoneMsg struct {
+	result awslambda.InvokeResult
+	err    error
+}
+
+// startInvoke opens the last payload in $EDITOR; the function is invoked
+// once the saved payload is confirmed.
+func (dv *DetailView) startInvoke() tea.Cmd {
+	if dv.fn == nil {
+		return nil
+	}
+	if dv.router.ReadOnly() {
+		dv.router.Toast(plugin.ToastWarning, "Invoke function is disabled in read-only mode")
+		return nil
+	}
+	if dv.router.Offline() {
+		dv.router.Toast(plugin.ToastWarning, "Invoke function is unavailable offline")
+		return nil
+	}
+
+	f, err := os.CreateTemp("", "aws-tui-"+dv.fn.Name+"-*.json")
+	if err != nil {
+		dv.router.Toast(plugin.ToastError, "Editing payload failed: "+err.Error())
+		return nil
+	}
+	path := f.Name()
+	_, err = f.Write(dv.payload)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		dv.router.Toast(plugin.ToastError, "Editing payload failed: "+err.Error())
+		return nil
+	}
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return payloadEditedMsg{err: err}
+		}
+		payload, err := os.ReadFile(path)
+		return payloadEditedMsg{payload: payload, err: err}
+	})
+}
+
+// editorCommand returns the command that edits path with $EDITOR, or vi if
+// it is unset. $EDITOR may carry arguments, such as "code --wait".
+func editorCommand(path string) *exec.Cmd {
+	args := strings.Fields(os.Getenv("EDITOR"))
+	if len(args) == 0 {
+		args = []string{"vi"}
+	}
+	return exec.Command(args[0], append(args[1:], path)...)
+}
+
+// payloadEdited keeps the edited payload for the next invocation and asks
+// the user to confirm invoking the function with it.
+func (dv *DetailView) payloadEdited(msg payloadEditedMsg) {
+	if msg.err != nil {
+		dv.router.Toast(plugin.ToastError, "Editing payload failed: "+msg.err.Error())
+		return
+	}
+	payload := bytes.TrimSpace(msg.payload)
+	if len(payload) == 0 {
+		dv.router.Toast(plugin.ToastWarning, "Invocation canceled: the payload is empty")
+		return
+	}
+	dv.payload = msg.payload
+	if !json.Valid(payload) {
+		dv.router.Toast(plugin.ToastError, "The payload is not valid JSON")
+		return
+	}
+
+	client, ctx, name := dv.client, dv.router.Context(dv), dv.fn.Name
+	dv.router.Confirm(plugin.Action{
+		Title:   "Invoke function",
+		Targets: []string{name, "Payload: " + preview(payload)},
+		Phrase:  name,
+		Run: func() tea.Cmd {
+			return func() tea.Msg {
+				res, err := client.Invoke(ctx, name, payload)
+				return invokeDoneMsg{result: res, err: err}
+			}
+		},
+	})
+}
+
+// preview compacts a JSON payload onto one line, shortened to
+// payloadPreviewWidth.
+func preview(payload []byte) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, payload); err != nil {
+		b.Reset()
+		b.Write(payload)
+	}
+	s := b.String()
+	if len(s) > payloadPreviewWidth {
+		s = s[:payloadPreviewWidth-1] + "…"
+	}
+	return s
+}
+
+// invokeDone shows the result of an invocation on the Invoke tab.
+func (dv *DetailView) invokeDone(msg invokeDoneMsg) {
+	dv.tabs.SetActive(invokeTab)
+	if msg.err != nil {
+		dv.result, dv.resultErr = nil, msg.err
+		dv.router.Toast(plugin.ToastError, "Invoke failed: "+msg.err.Error())
+		return
+	}
+	dv.result, dv.resultErr = &msg.result, nil
+	if msg.result.FunctionError != "" {
+		dv.router.Toast(plugin.ToastError, dv.name+" returned an error ("+msg.result.FunctionError+")")
+		return
+	}
+	dv.router.Toast(plugin.ToastInfo, "Invoked "+dv.name)
+}
+
+func (dv *DetailView) renderInvoke() string {
+	switch {
+	case dv.resultErr != nil:
+		return "Error: " + dv.resultErr.Error()
+	case dv.result == nil && dv.router.ReadOnly():
+		return "Invoking is disabled in read-only mode."
+	case dv.result == nil:
+		return "Press I to invoke the function with a JSON payload edited in $EDITOR."
+	}
+
+	res := dv.result
+	outcome := "succeeded"
+	if res.FunctionError != "" {
+		outcome = "failed (" + res.FunctionError + ")"
+	}
+	rows := []ui.KV{
+		{K: "Result", V: outcome},
+		{K: "Status Code", V: fmt.Sprintf("%d", res.StatusCode)},
+		{K: "Version", V: res.ExecutedVersion},
+	}
+
+	var b strings.Builder
+	b.WriteString(ui.RenderKV(rows, 20, ui.KVValueWidth(dv.width)))
+	b.WriteString("\n")
+	b.WriteString(sectionStyle.Render("Response"))
+	b.WriteString("\n")
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, res.Payload, "", "  "); err == nil {
+		b.WriteString(pretty.String())
+	} else {
+		b.Write(res.Payload)
+	}
+	b.WriteString("\n")
+	if res.Log != "" {
+		b.WriteString("\n")
+		b.WriteString(sectionStyle.Render("Log"))
+		b.WriteString("\n")
+		b.WriteString(strings.TrimRight(res.Log, "\n"))
+	}
+	return b.String()
+}
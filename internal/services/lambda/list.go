@@ -0,0 +1,165 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	awslambda "tasnim.dev/aws-tui/internal/aws/lambda"
+	"tasnim.dev/aws-tui/internal/cache"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// cacheKey is the cache service key for Lambda functions.
+const cacheKey = "lambda"
+
+// functionsMsg carries the result of fetching functions.
+type functionsMsg struct {
+	functions []awslambda.Function
+	err       error
+}
+
+// ListView displays Lambda functions in a table.
+type ListView struct {
+	plugin.Revalidation
+
+	client  LambdaClient
+	logs    LogsClient
+	router  plugin.Router
+	table   ui.TableView[awslambda.Function]
+	loading bool
+	err     error
+	cache   *cache.Scope
+}
+
+// NewListView creates a new Lambda ListView.
+func NewListView(client LambdaClient, router plugin.Router) *ListView {
+	tv := ui.NewTableView(functionColumns(), nil, func(f awslambda.Function) string {
+		return f.Name
+	})
+	return &ListView{
+		client:  client,
+		router:  router,
+		table:   tv,
+		loading: true,
+	}
+}
+
+func functionColumns() []ui.Column[awslambda.Function] {
+	return []ui.Column[awslambda.Function]{
+		{Title: "Name", Width: 36, Field: func(f awslambda.Function) string { return f.Name }},
+		{Title: "Runtime", Width: 16, Field: func(f awslambda.Function) string {
+			if f.Runtime == "" {
+				return f.PackageType
+			}
+			return f.Runtime
+		}},
+		{Title: "Memory", Width: 9, Field: func(f awslambda.Function) string {
+			return fmt.Sprintf("%d MB", f.MemoryMB)
+		}},
+		{Title: "Timeout", Width: 8, Field: func(f awslambda.Function) string { return f.Timeout.String() }},
+		{Title: "Code Size", Width: 10, Field: func(f awslambda.Function) string { return ui.FormatSize(f.CodeSize) }},
+		{Title: "Last Modified", Width: 18, Field: func(f awslambda.Function) string { return ui.FormatTime(f.LastModified) }},
+	}
+}
+
+func (lv *ListView) fetchFunctions() tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var fns []awslambda.Function
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			fns, err = client.ListFunctions(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, fns, func(f awslambda.Function) (string, string) {
+				return f.Name, f.Name
+			})
+		}
+		return functionsMsg{functions: fns, err: err}
+	}
+}
+
+func (lv *ListView) Init() tea.Cmd {
+	fetch := lv.fetchFunctions()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awslambda.Function](lv.cache, cacheKey, fetch)
+	}
+	return fetch
+}
+
+func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case plugin.CachedItems[awslambda.Function]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchFunctions()
+		}
+		return lv, nil
+
+	case functionsMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.err = nil
+		lv.table.SetItems(msg.functions)
+		return lv, nil
+
+	case tea.KeyPressMsg:
+		if lv.loading {
+			return lv, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if id := lv.table.SelectedID(); id != "" {
+				view := NewDetailView(lv.client, lv.router, id)
+				view.logClient = lv.logs
+				lv.router.Push(view)
+				return lv, view.Init()
+			}
+			return lv, nil
+		case "esc", "backspace":
+			lv.router.Pop()
+			return lv, nil
+		case "r":
+			lv.loading = true
+			return lv, lv.fetchFunctions()
+		}
+	}
+
+	var cmd tea.Cmd
+	lv.table, cmd = lv.table.Update(msg)
+	return lv, cmd
+}
+
+func (lv *ListView) View() tea.View {
+	if lv.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if lv.err != nil {
+		return tea.NewView("Error: " + lv.err.Error())
+	}
+	return tea.NewView(lv.table.View())
+}
+
+func (lv *ListView) Title() string { return "Lambda Functions" }
+
+func (lv *ListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view function"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+	}
+}
@@ -0,0 +1,224 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"tasnim.dev/aws-tui/internal/aws/logs"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// logPollInterval is how often a followed log group is polled.
+const logPollInterval = 5 * time.Second
+
+// logWindow is how far back the Logs tab reads when it is first shown.
+const logWindow = time.Hour
+
+// logInitialLimit is how many events are shown when the log is first
+// loaded or a time is jumped to.
+const logInitialLimit = 200
+
+// logChromeHeight is the number of terminal rows around the log lines: the
+// breadcrumb, status bar, tab bar and log group line.
+const logChromeHeight = 9
+
+// logEventsMsg carries events read from the function's log group. gen
+// identifies the poll chain that asked for them; events from an abandoned
+// chain are dropped.
+type logEventsMsg struct {
+	gen    int
+	events []logs.LogEvent
+	from   time.Time // where the read started
+	reset  bool      // replace the buffer rather than append
+	follow bool      // with reset, show the newest lines
+	err    error
+}
+
+// logTickMsg schedules the next poll of chain gen.
+type logTickMsg struct{ gen int }
+
+// shouldPoll reports whether the log is shown and being followed.
+func (dv *DetailView) shouldPoll() bool {
+	if dv.logClient == nil || dv.fn == nil || dv.tabs.Active() != logsTab || dv.logErr != nil || dv.router.Offline() {
+		return false
+	}
+	return !dv.logView.Paused()
+}
+
+// startLogs starts a poll chain unless one is already running. The first
+// read loads the events of the last logWindow.
+func (dv *DetailView) startLogs() tea.Cmd {
+	if dv.logPolling || !dv.shouldPoll() {
+		return nil
+	}
+	dv.logGen++
+	dv.logPolling = true
+	return dv.pollLogs()
+}
+
+// restartLogs abandons the running poll chain and reloads the log.
+func (dv *DetailView) restartLogs() tea.Cmd {
+	dv.logGen++
+	dv.logPolling = false
+	dv.logLoaded = false
+	dv.logErr = nil
+	return dv.startLogs()
+}
+
+// pollLogs reads the events logged since the last poll, or the recent
+// events if the log is not loaded yet.
+func (dv *DetailView) pollLogs() tea.Cmd {
+	client := dv.logClient
+	if dv.logLoaded {
+		from := dv.logFrom
+		return dv.fetchLogs(from, false, false, func(ctx context.Context, group string) ([]logs.LogEvent, error) {
+			return client.GetGroupEventsFrom(ctx, group, from, logInitialLimit)
+		})
+	}
+	since := time.Now().Add(-logWindow)
+	return dv.fetchLogs(since, true, true, func(ctx context.Context, group string) ([]logs.LogEvent, error) {
+		return client.GetRecentGroupEvents(ctx, group, since, logInitialLimit)
+	})
+}
+
+// jumpLogs replaces the buffer with the events from at on and carries on
+// following from there.
+func (dv *DetailView) jumpLogs(at time.Time) tea.Cmd {
+	if dv.logClient == nil || dv.fn == nil {
+		return nil
+	}
+	if dv.router.Offline() {
+		dv.router.Toast(plugin.ToastWarning, "Logs are unavailable offline")
+		return nil
+	}
+	dv.logGen++
+	dv.logPolling = true
+	dv.logErr = nil
+	client := dv.logClient
+	return dv.fetchLogs(at, true, false, func(ctx context.Context, group string) ([]logs.LogEvent, error) {
+		return client.GetGroupEventsFrom(ctx, group, at, logInitialLimit)
+	})
+}
+
+func (dv *DetailView) fetchLogs(from time.Time, reset, follow bool, get func(ctx context.Context, group string) ([]logs.LogEvent, error)) tea.Cmd {
+	group, gen, router := dv.fn.LogGroup, dv.logGen, dv.router
+	viewCtx := router.Context(dv)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(viewCtx, 30*time.Second)
+		defer cancel()
+		var events []logs.LogEvent
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			events, err = get(ctx, group)
+			return err
+		})
+		return logEventsMsg{gen: gen, events: events, from: from, reset: reset, follow: follow, err: err}
+	}
+}
+
+// updateLogs handles the messages of the Logs tab.
+func (dv *DetailView) updateLogs(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case logEventsMsg:
+		if msg.gen != dv.logGen {
+			return nil
+		}
+		if msg.err != nil {
+			dv.logErr = msg.err
+			dv.logPolling = false
+			return nil
+		}
+		lines := make([]ui.LogLine, len(msg.events))
+		for i, e := range msg.events {
+			lines[i] = ui.LogLine{Time: e.Timestamp, Message: strings.TrimRight(e.Message, "\n")}
+		}
+		if msg.reset {
+			dv.logView.SetLines(lines, msg.follow)
+			dv.logFrom = msg.from
+		} else {
+			dv.logView.AppendLines(lines)
+		}
+		if n := len(msg.events); n > 0 {
+			// The next read starts just after the newest event.
+			dv.logFrom = msg.events[n-1].Timestamp.Add(time.Millisecond)
+		}
+		dv.logLoaded = true
+		return dv.scheduleLogs()
+
+	case logTickMsg:
+		if msg.gen != dv.logGen {
+			return nil
+		}
+		if !dv.shouldPoll() {
+			dv.logPolling = false
+			return nil
+		}
+		return dv.pollLogs()
+
+	case ui.LogJumpMsg:
+		return dv.jumpLogs(msg.At)
+
+	case ui.LogSavedMsg:
+		if msg.Err != nil {
+			dv.router.Toast(plugin.ToastError, "Saving log failed: "+msg.Err.Error())
+		} else {
+			dv.router.Toast(plugin.ToastInfo, "Log saved to "+msg.Path)
+		}
+	}
+	return nil
+}
+
+// scheduleLogs schedules the next poll of the running chain, or ends it if
+// the log is no longer followed.
+func (dv *DetailView) scheduleLogs() tea.Cmd {
+	if !dv.shouldPoll() {
+		dv.logPolling = false
+		return nil
+	}
+	gen := dv.logGen
+	return tea.Tick(logPollInterval, func(time.Time) tea.Msg { return logTickMsg{gen: gen} })
+}
+
+// handleLogKey passes a key on the Logs tab to the log view, resuming the
+// poll chain when the log is unpaused.
+func (dv *DetailView) handleLogKey(msg tea.KeyPressMsg) tea.Cmd {
+	wasPaused := dv.logView.Paused()
+	var cmd tea.Cmd
+	dv.logView, cmd = dv.logView.Update(msg)
+	if wasPaused && !dv.logView.Paused() {
+		return tea.Batch(cmd, dv.startLogs())
+	}
+	return cmd
+}
+
+// CapturingInput implements plugin.InputCapturer while the log filter or
+// the log time prompt is open.
+func (dv *DetailView) CapturingInput() bool {
+	return dv.tabs.Active() == logsTab && dv.logView.Capturing()
+}
+
+func (dv *DetailView) renderLogs() string {
+	var b strings.Builder
+	b.WriteString("Log group: " + dv.fn.LogGroup)
+	b.WriteString("\n\n")
+
+	switch {
+	case dv.logClient == nil:
+		b.WriteString("Logs are unavailable.")
+	case dv.logErr != nil:
+		b.WriteString(fmt.Sprintf("Error: %v\nPress r to retry.", dv.logErr))
+	case !dv.logLoaded && dv.router.Offline():
+		b.WriteString("Logs are unavailable offline.")
+	case !dv.logLoaded:
+		b.WriteString("Loading log events...")
+	case dv.logView.Lines() == 0:
+		b.WriteString(fmt.Sprintf("No log events in the last %s.", logWindow))
+	default:
+		b.WriteString(dv.logView.View())
+	}
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package lambda
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awslambda "tasnim.dev/aws-tui/internal/aws/lambda"
+	"tasnim.dev/aws-tui/internal/aws/logs"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// LambdaClient defines the subset of lambda.Client methods used by the
+// plugin.
+type LambdaClient interface {
+	ListFunctions(ctx context.Context) ([]awslambda.Function, error)
+	GetFunction(ctx context.Context, name string) (awslambda.FunctionDetail, error)
+	ListVersions(ctx context.Context, name string) ([]awslambda.Version, error)
+	ListAliases(ctx context.Context, name string) ([]awslambda.Alias, error)
+	ListEventSourceMappings(ctx context.Context, name string) ([]awslambda.EventSourceMapping, error)
+	ListPermissions(ctx context.Context, name string) ([]awslambda.Permission, error)
+	Invoke(ctx context.Context, name string, payload []byte) (awslambda.InvokeResult, error)
+}
+
+// LogsClient defines the subset of logs.Client methods used to show a
+// function's recent logs.
+type LogsClient interface {
+	GetRecentGroupEvents(ctx context.Context, logGroup string, since time.Time, limit int) ([]logs.LogEvent, error)
+	GetGroupEventsFrom(ctx context.Context, logGroup string, start time.Time, limit int) ([]logs.LogEvent, error)
+}
+
+// Plugin implements plugin.ServicePlugin for AWS Lambda.
+type Plugin struct {
+	plugin.CacheHolder
+
+	client LambdaClient
+	logs   LogsClient
+}
+
+// NewPlugin creates a new Lambda service plugin.
+func NewPlugin(client LambdaClient) *Plugin {
+	return &Plugin{client: client}
+}
+
+// SetLogsClient sets the client used by the Logs tab of a function.
+func (p *Plugin) SetLogsClient(c LogsClient) { p.logs = c }
+
+func (p *Plugin) ID() string   { return "lambda" }
+func (p *Plugin) Name() string { return "Lambda" }
+func (p *Plugin) Icon() string { return "\U000F0627" } // nf-md-lambda
+
+func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
+	fns, err := p.client.ListFunctions(ctx)
+	if err != nil {
+		return plugin.ServiceSummary{}, err
+	}
+
+	status := make(map[string]int)
+	for _, f := range fns {
+		status[runtimeFamily(f)]++
+	}
+	return plugin.ServiceSummary{
+		Total:  len(fns),
+		Status: status,
+		Health: plugin.HealthHealthy,
+		Label:  "functions",
+	}, nil
+}
+
+// runtimeFamily returns a function's runtime without its version, e.g.
+// "python" for python3.12, or "image" for a container image.
+func runtimeFamily(f awslambda.Function) string {
+	if f.Runtime == "" {
+		return "image"
+	}
+	family := strings.TrimRight(strings.SplitN(f.Runtime, ".", 2)[0], "0123456789")
+	if family == "" {
+		return f.Runtime
+	}
+	return family
+}
+
+func (p *Plugin) ListView(router plugin.Router) plugin.View {
+	lv := NewListView(p.client, router)
+	lv.logs = p.logs
+	lv.cache = p.Cache()
+	return lv
+}
+
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	dv := NewDetailView(p.client, router, id)
+	dv.logClient = p.logs
+	return dv
+}
+
+func (p *Plugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{
+			Title:    "Lambda Functions",
+			Keywords: []string{"lambda", "functions", "serverless", "invoke"},
+		},
+	}
+}
+
+func (p *Plugin) PollConfig() plugin.PollConfig {
+	return plugin.PollConfig{
+		IdleInterval: 2 * time.Minute,
+	}
+}
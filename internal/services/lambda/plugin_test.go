@@ -0,0 +1,292 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	awslambda "tasnim.dev/aws-tui/internal/aws/lambda"
+	"tasnim.dev/aws-tui/internal/aws/logs"
+	"tasnim.dev/aws-tui/internal/plugin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockClient implements LambdaClient for testing.
+type mockClient struct {
+	functions []awslambda.Function
+	detail    awslambda.FunctionDetail
+	versions  []awslambda.Version
+	aliases   []awslambda.Alias
+	mappings  []awslambda.EventSourceMapping
+	perms     []awslambda.Permission
+	payloads  []string
+	result    awslambda.InvokeResult
+	err       error
+}
+
+func (m *mockClient) ListFunctions(_ context.Context) ([]awslambda.Function, error) {
+	return m.functions, m.err
+}
+
+func (m *mockClient) GetFunction(_ context.Context, _ string) (awslambda.FunctionDetail, error) {
+	return m.detail, m.err
+}
+
+func (m *mockClient) ListVersions(_ context.Context, _ string) ([]awslambda.Version, error) {
+	return m.versions, nil
+}
+
+func (m *mockClient) ListAliases(_ context.Context, _ string) ([]awslambda.Alias, error) {
+	return m.aliases, nil
+}
+
+func (m *mockClient) ListEventSourceMappings(_ context.Context, _ string) ([]awslambda.EventSourceMapping, error) {
+	return m.mappings, nil
+}
+
+func (m *mockClient) ListPermissions(_ context.Context, _ string) ([]awslambda.Permission, error) {
+	return m.perms, nil
+}
+
+func (m *mockClient) Invoke(_ context.Context, _ string, payload []byte) (awslambda.InvokeResult, error) {
+	m.payloads = append(m.payloads, string(payload))
+	return m.result, nil
+}
+
+// mockLogs implements LogsClient, recording where each read started.
+type mockLogs struct {
+	recent []logs.LogEvent
+	since  []logs.LogEvent
+	groups []string
+	froms  []time.Time
+}
+
+func (m *mockLogs) GetRecentGroupEvents(_ context.Context, group string, _ time.Time, _ int) ([]logs.LogEvent, error) {
+	m.groups = append(m.groups, group)
+	return m.recent, nil
+}
+
+func (m *mockLogs) GetGroupEventsFrom(_ context.Context, group string, start time.Time, _ int) ([]logs.LogEvent, error) {
+	m.groups = append(m.groups, group)
+	m.froms = append(m.froms, start)
+	return m.since, nil
+}
+
+type mockRouter struct {
+	readOnly bool
+	pushed   []plugin.View
+	toasts   []string
+	confirms []plugin.Action
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_, _ string)            {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, msg string) { m.toasts = append(m.toasts, msg) }
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return m.readOnly }
+func (m *mockRouter) Confirm(a plugin.Action)               { m.confirms = append(m.confirms, a) }
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	if s == "enter" {
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+func ordersAPI() awslambda.FunctionDetail {
+	return awslambda.FunctionDetail{
+		Function: awslambda.Function{
+			Name:     "orders-api",
+			Runtime:  "python3.12",
+			MemoryMB: 512,
+			Timeout:  30 * time.Second,
+			CodeSize: 2048,
+		},
+		Handler:     "app.handler",
+		LogGroup:    "/aws/lambda/orders-api",
+		Environment: map[string]string{"DB_PASSWORD": "hunter2", "STAGE": "prod"},
+	}
+}
+
+func TestPluginMetadata(t *testing.T) {
+	p := NewPlugin(nil)
+	assert.Equal(t, "lambda", p.ID())
+	assert.Equal(t, "Lambda", p.Name())
+	assert.NotEmpty(t, p.Icon())
+	require.Len(t, p.Commands(), 1)
+	assert.Contains(t, p.Commands()[0].Keywords, "lambda")
+}
+
+func TestSummary(t *testing.T) {
+	p := NewPlugin(&mockClient{functions: []awslambda.Function{
+		{Name: "a", Runtime: "python3.12"},
+		{Name: "b", Runtime: "python3.9"},
+		{Name: "c", Runtime: "nodejs20.x"},
+		{Name: "d", Runtime: "provided.al2023"},
+		{Name: "e", PackageType: "Image"},
+	}})
+	summary, err := p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 5, summary.Total)
+	assert.Equal(t, map[string]int{"python": 2, "nodejs": 1, "provided": 1, "image": 1}, summary.Status)
+	assert.Equal(t, plugin.HealthHealthy, summary.Health)
+	assert.Equal(t, "functions", summary.Label)
+
+	_, err = NewPlugin(&mockClient{err: assert.AnError}).Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestListView(t *testing.T) {
+	client := &mockClient{
+		functions: []awslambda.Function{ordersAPI().Function},
+		detail:    ordersAPI(),
+	}
+	router := &mockRouter{}
+	lv := NewPlugin(client).ListView(router).(*ListView)
+	lv.Update(lv.Init()())
+
+	out := lv.View().Content
+	assert.Contains(t, out, "orders-api")
+	assert.Contains(t, out, "python3.12")
+	assert.Contains(t, out, "512 MB")
+	assert.Contains(t, out, "2.0 KB")
+
+	lv.Update(key("enter"))
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "orders-api", router.pushed[0].Title())
+}
+
+func loadedDetail(t *testing.T, client *mockClient, router *mockRouter, logClient LogsClient) *DetailView {
+	t.Helper()
+	dv := NewDetailView(client, router, "orders-api")
+	dv.logClient = logClient
+	dv.Update(dv.Init()())
+	require.NotNil(t, dv.fn)
+	return dv
+}
+
+func TestDetailView_Tabs(t *testing.T) {
+	client := &mockClient{
+		detail:   ordersAPI(),
+		versions: []awslambda.Version{{Version: "3", Description: "release"}},
+		aliases:  []awslambda.Alias{{Name: "prod", Version: "2", Weights: map[string]float64{"3": 0.1}}},
+		mappings: []awslambda.EventSourceMapping{{State: "Enabled", BatchSize: 10, Source: "arn:aws:sqs:us-east-1:123456789012:orders"}},
+		perms:    []awslambda.Permission{{Principal: "s3.amazonaws.com", SourceARN: "arn:aws:s3:::uploads", Action: "lambda:InvokeFunction"}},
+	}
+	dv := loadedDetail(t, client, &mockRouter{}, nil)
+
+	out := dv.View().Content
+	assert.Contains(t, out, "app.handler")
+	assert.Contains(t, out, "/aws/lambda/orders-api")
+
+	// Environment values are masked until v reveals them.
+	dv.Update(key("2"))
+	out = dv.View().Content
+	assert.Contains(t, out, "DB_PASSWORD")
+	assert.NotContains(t, out, "hunter2")
+	assert.Contains(t, dv.KeyHints(), plugin.KeyHint{Key: "v", Desc: "show values"})
+	dv.Update(key("v"))
+	assert.Contains(t, dv.View().Content, "hunter2")
+
+	dv.Update(key("3"))
+	out = dv.View().Content
+	assert.Contains(t, out, "→ 2 (10% to 3)")
+	assert.Contains(t, out, "release")
+
+	dv.Update(key("4"))
+	out = dv.View().Content
+	assert.Contains(t, out, "arn:aws:sqs:us-east-1:123456789012:orders")
+	assert.Contains(t, out, "s3.amazonaws.com from arn:aws:s3:::uploads")
+
+	dv.Update(key("5"))
+	assert.Contains(t, dv.View().Content, "Logs are unavailable.")
+}
+
+func TestDetailView_Logs(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 14, 5, 0, 0, time.UTC)
+	logClient := &mockLogs{recent: []logs.LogEvent{
+		{Timestamp: t0, Message: "START RequestId: 1\n"},
+		{Timestamp: t0.Add(time.Second), Message: "processing order 42\n"},
+	}}
+	dv := loadedDetail(t, &mockClient{detail: ordersAPI()}, &mockRouter{}, logClient)
+
+	_, cmd := dv.Update(key("5"))
+	require.NotNil(t, cmd)
+	_, tick := dv.Update(cmd())
+	assert.Contains(t, dv.View().Content, "processing order 42")
+	assert.Equal(t, []string{"/aws/lambda/orders-api"}, logClient.groups)
+
+	// The next poll reads from just after the newest event.
+	require.NotNil(t, tick)
+	logClient.since = []logs.LogEvent{{Timestamp: t0.Add(2 * time.Second), Message: "END RequestId: 1"}}
+	_, cmd = dv.Update(logTickMsg{gen: dv.logGen})
+	dv.Update(cmd())
+	require.Len(t, logClient.froms, 1)
+	assert.Equal(t, t0.Add(time.Second+time.Millisecond), logClient.froms[0])
+	assert.Contains(t, dv.View().Content, "END RequestId: 1")
+}
+
+func TestDetailView_Invoke(t *testing.T) {
+	client := &mockClient{
+		detail: ordersAPI(),
+		result: awslambda.InvokeResult{StatusCode: 200, ExecutedVersion: "$LATEST", Payload: []byte(`{"ok":true}`), Log: "START RequestId: 1\n"},
+	}
+	router := &mockRouter{}
+	dv := loadedDetail(t, client, router, nil)
+	assert.Contains(t, dv.KeyHints(), plugin.KeyHint{Key: "I", Desc: "test invoke"})
+
+	// An invalid payload is kept for the next edit but not invoked.
+	dv.Update(payloadEditedMsg{payload: []byte(`{"id": `)})
+	assert.Empty(t, router.confirms)
+	assert.Equal(t, []string{"The payload is not valid JSON"}, router.toasts)
+	assert.Equal(t, `{"id": `, string(dv.payload))
+
+	dv.Update(payloadEditedMsg{payload: []byte("{\n  \"id\": 42\n}\n")})
+	require.Len(t, router.confirms, 1)
+	action := router.confirms[0]
+	assert.Equal(t, "orders-api", action.Phrase)
+	assert.Equal(t, []string{"orders-api", `Payload: {"id":42}`}, action.Targets)
+
+	dv.Update(action.Run()())
+	assert.Equal(t, []string{`{
+  "id": 42
+}`}, client.payloads)
+	assert.Equal(t, invokeTab, dv.tabs.Active())
+	out := dv.View().Content
+	assert.Contains(t, out, "succeeded")
+	assert.Contains(t, out, `"ok": true`)
+	assert.Contains(t, out, "START RequestId: 1")
+}
+
+func TestDetailView_InvokeReadOnly(t *testing.T) {
+	router := &mockRouter{readOnly: true}
+	dv := loadedDetail(t, &mockClient{detail: ordersAPI()}, router, nil)
+
+	_, cmd := dv.Update(key("I"))
+	assert.Nil(t, cmd)
+	assert.Equal(t, []string{"Invoke function is disabled in read-only mode"}, router.toasts)
+	assert.NotContains(t, dv.KeyHints(), plugin.KeyHint{Key: "I", Desc: "test invoke"})
+}
+
+func TestDetailView_Error(t *testing.T) {
+	dv := NewDetailView(&mockClient{err: errors.New("ResourceNotFoundException")}, &mockRouter{}, "gone")
+	dv.Update(dv.Init()())
+	assert.Equal(t, "Error: ResourceNotFoundException", dv.View().Content)
+}
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("EDITOR", "code --wait")
+	cmd := editorCommand("/tmp/payload.json")
+	assert.Equal(t, []string{"code", "--wait", "/tmp/payload.json"}, cmd.Args)
+
+	t.Setenv("EDITOR", "")
+	assert.Equal(t, []string{"vi", "/tmp/payload.json"}, editorCommand("/tmp/payload.json").Args)
+}
@@ -0,0 +1,145 @@
+package messaging
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// testGroupID is the message group of test messages sent to FIFO queues
+// and topics.
+const testGroupID = "aws-tui"
+
+// actionDoneMsg carries the result of running an action.
+type actionDoneMsg struct {
+	done string // toasted on success
+	err  error
+}
+
+// actionAvailable reports whether what may run, with a toast naming why not
+// while read-only or offline.
+func actionAvailable(router plugin.Router, what string) bool {
+	switch {
+	case router.ReadOnly():
+		router.Toast(plugin.ToastWarning, what+" is disabled in read-only mode")
+		return false
+	case router.Offline():
+		router.Toast(plugin.ToastWarning, what+" is unavailable offline")
+		return false
+	}
+	return true
+}
+
+// handleActionKey runs the queue action bound to key, reporting whether
+// there is one.
+func (qv *QueueView) handleActionKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "p":
+		qv.tabs.SetActive(messagesTab)
+		return qv.peek(), true
+	case "m":
+		if actionAvailable(qv.router, "Sending messages") {
+			in := ui.NewInput("Send Message", "Body:", false)
+			qv.prompt = &in
+		}
+		return nil, true
+	case "X":
+		qv.confirmPurge()
+		return nil, true
+	case "d":
+		if qv.queue.DLQ() {
+			qv.confirmRedrive()
+			return nil, true
+		}
+	}
+	return nil, false
+}
+
+// answerPrompt sends the message typed into the prompt.
+func (qv *QueueView) answerPrompt(res ui.InputResult) tea.Cmd {
+	qv.prompt = nil
+	if res.Canceled {
+		return nil
+	}
+	client, ctx, q, body := qv.client, qv.router.Context(qv), *qv.queue, res.Value
+	return func() tea.Msg {
+		id, err := client.SendMessage(ctx, q, body, testGroupID)
+		return actionDoneMsg{done: fmt.Sprintf("Sent message %s to %s", id, q.Name), err: err}
+	}
+}
+
+// confirmPurge asks the user to confirm deleting every message in the
+// queue by typing its name.
+func (qv *QueueView) confirmPurge() {
+	if qv.router.Offline() {
+		qv.router.Toast(plugin.ToastWarning, "Purge is unavailable offline")
+		return
+	}
+	client, ctx, q := qv.client, qv.router.Context(qv), *qv.queue
+	qv.router.Confirm(plugin.Action{
+		Title:   "Purge queue",
+		Targets: []string{fmt.Sprintf("%s  %d visible, %d in flight, %d delayed", q.Name, q.Visible, q.InFlight, q.Delayed)},
+		Phrase:  q.Name,
+		Run: func() tea.Cmd {
+			return func() tea.Msg {
+				return actionDoneMsg{done: "Purge requested for " + q.Name, err: client.PurgeQueue(ctx, q.URL)}
+			}
+		},
+	})
+}
+
+// confirmRedrive asks the user to confirm moving the messages of this
+// dead-letter queue back to their source queues.
+func (qv *QueueView) confirmRedrive() {
+	if qv.router.Offline() {
+		qv.router.Toast(plugin.ToastWarning, "Redrive is unavailable offline")
+		return
+	}
+	q := *qv.queue
+	if q.Visible == 0 {
+		qv.router.Toast(plugin.ToastWarning, q.Name+" has no messages to redrive")
+		return
+	}
+	client, ctx := qv.client, qv.router.Context(qv)
+	qv.router.Confirm(plugin.Action{
+		Title:   "Redrive messages",
+		Targets: []string{fmt.Sprintf("%s → %s  about %d messages", q.Name, strings.Join(q.Sources, ", "), q.Visible)},
+		Phrase:  q.Name,
+		Run: func() tea.Cmd {
+			return func() tea.Msg {
+				_, err := client.StartRedrive(ctx, q.ARN)
+				return actionDoneMsg{done: "Redrive started for " + q.Name, err: err}
+			}
+		},
+	})
+}
+
+// afterAction reports the result of an action and reloads the queue.
+func (qv *QueueView) afterAction(msg actionDoneMsg) tea.Cmd {
+	if msg.err != nil {
+		qv.router.Toast(plugin.ToastError, "Action failed: "+msg.err.Error())
+		return nil
+	}
+	qv.router.Toast(plugin.ToastInfo, msg.done)
+	return qv.loadQueue()
+}
+
+// actionHints returns the hints for the queue actions, or none while
+// read-only.
+func (qv *QueueView) actionHints() []plugin.KeyHint {
+	if qv.router.ReadOnly() || qv.queue == nil {
+		return nil
+	}
+	hints := []plugin.KeyHint{
+		{Key: "m", Desc: "send message"},
+		{Key: "X", Desc: "purge"},
+	}
+	if qv.queue.DLQ() {
+		hints = append(hints, plugin.KeyHint{Key: "d", Desc: "redrive"})
+	}
+	return hints
+}
@@ -0,0 +1,88 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+)
+
+// ageRange is the window read for the age of each queue's oldest message.
+// SQS reports the metric every minute while a queue is active.
+var ageRange = cloudwatch.TimeRange{Label: "15m", Duration: 15 * time.Minute, Period: time.Minute}
+
+// maxMetricQueries is the most queries one GetMetricData call accepts.
+const maxMetricQueries = 500
+
+// fillOldestAges sets the OldestAge of queues from their latest
+// ApproximateAgeOfOldestMessage datapoint. Queues without a recent
+// datapoint keep a zero age.
+func fillOldestAges(ctx context.Context, client metrics.Client, queues []awssqs.Queue) error {
+	if client == nil {
+		return nil
+	}
+	for start := 0; start < len(queues); start += maxMetricQueries {
+		batch := queues[start:min(start+maxMetricQueries, len(queues))]
+		queries := make([]cloudwatch.MetricQuery, len(batch))
+		for i, q := range batch {
+			queries[i] = cloudwatch.MetricQuery{
+				ID:         fmt.Sprintf("q%d", i),
+				Namespace:  "AWS/SQS",
+				Metric:     "ApproximateAgeOfOldestMessage",
+				Dimensions: []cloudwatch.Dimension{{Name: "QueueName", Value: q.Name}},
+				Stat:       "Maximum",
+			}
+		}
+		series, err := client.GetMetrics(ctx, queries, ageRange, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, s := range series {
+			var i int
+			if _, err := fmt.Sscanf(s.ID, "q%d", &i); err != nil || i >= len(batch) || len(s.Values) == 0 {
+				continue
+			}
+			batch[i].OldestAge = time.Duration(s.Values[len(s.Values)-1]) * time.Second
+		}
+	}
+	return nil
+}
+
+// formatDuration formats a duration with its two largest units, e.g.
+// "4d", "2h 30m" or "45s".
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	units := []struct {
+		size time.Duration
+		name string
+	}{{24 * time.Hour, "d"}, {time.Hour, "h"}, {time.Minute, "m"}, {time.Second, "s"}}
+	var parts []string
+	for _, u := range units {
+		if n := d / u.size; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, u.name))
+			d -= n * u.size
+		} else if len(parts) > 0 {
+			break
+		}
+		if len(parts) == 2 {
+			break
+		}
+	}
+	if len(parts) == 0 {
+		return "0s"
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatAge formats the age of a queue's oldest message, or "-" if the
+// queue is empty or the age is unknown.
+func formatAge(q awssqs.Queue) string {
+	if q.OldestAge == 0 || q.Visible+q.InFlight == 0 {
+		return "-"
+	}
+	return formatDuration(q.OldestAge)
+}
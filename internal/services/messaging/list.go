@@ -0,0 +1,295 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	awssns "tasnim.dev/aws-tui/internal/aws/sns"
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
+	"tasnim.dev/aws-tui/internal/cache"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Cache service keys, one per tab. Cached IDs match the table IDs, which are
+// also the IDs DetailView accepts.
+const (
+	queuesCacheKey = "messaging:queues"
+	topicsCacheKey = "messaging:topics"
+)
+
+// Tabs of the list view.
+const (
+	queuesTab = 0
+	topicsTab = 1
+)
+
+// Fetch result messages.
+type queuesMsg struct {
+	queues []awssqs.Queue
+	err    error
+}
+
+type topicsMsg struct {
+	topics []awssns.Topic
+	err    error
+}
+
+// cachedMsg carries both tabs read from the local cache.
+type cachedMsg struct {
+	queues []awssqs.Queue
+	topics []awssns.Topic
+	plugin.CacheEntry
+}
+
+// ListView displays SQS queues and SNS topics in a tabbed table view.
+type ListView struct {
+	plugin.Revalidation
+
+	sqs     SQSClient
+	sns     SNSClient
+	metrics metrics.Client
+	router  plugin.Router
+
+	tabs   ui.TabController
+	queues ui.TableView[awssqs.Queue]
+	topics ui.TableView[awssns.Topic]
+
+	loading bool
+	err     error
+
+	cache *cache.Scope
+}
+
+// NewListView creates a new ListView with tabs for Queues and Topics.
+func NewListView(sqs SQSClient, sns SNSClient, router plugin.Router) *ListView {
+	queueCols := []ui.Column[awssqs.Queue]{
+		{Title: "Name", Width: 36, Field: func(q awssqs.Queue) string { return q.Name }},
+		{Title: "Type", Width: 8, Field: queueType},
+		{Title: "Visible", Width: 9, Field: func(q awssqs.Queue) string { return fmt.Sprint(q.Visible) }},
+		{Title: "In Flight", Width: 9, Field: func(q awssqs.Queue) string { return fmt.Sprint(q.InFlight) }},
+		{Title: "Delayed", Width: 8, Field: func(q awssqs.Queue) string { return fmt.Sprint(q.Delayed) }},
+		{Title: "Oldest", Width: 9, Field: formatAge},
+		{Title: "Redrive", Width: 32, Field: redrive},
+	}
+
+	topicCols := []ui.Column[awssns.Topic]{
+		{Title: "Name", Width: 36, Field: func(t awssns.Topic) string { return t.Name }},
+		{Title: "Type", Width: 8, Field: func(t awssns.Topic) string { return fifoType(t.FIFO) }},
+		{Title: "Display Name", Width: 24, Field: func(t awssns.Topic) string { return t.DisplayName }},
+		{Title: "Confirmed", Width: 10, Field: func(t awssns.Topic) string { return fmt.Sprint(t.Confirmed) }},
+		{Title: "Pending", Width: 8, Field: func(t awssns.Topic) string { return fmt.Sprint(t.Pending) }},
+	}
+
+	return &ListView{
+		sqs:     sqs,
+		sns:     sns,
+		router:  router,
+		tabs:    ui.NewTabController([]string{"Queues", "Topics"}),
+		queues:  ui.NewTableView(queueCols, nil, func(q awssqs.Queue) string { return "queue:" + q.URL }),
+		topics:  ui.NewTableView(topicCols, nil, func(t awssns.Topic) string { return "topic:" + t.ARN }),
+		loading: true,
+	}
+}
+
+// queueType returns "FIFO" or "Standard".
+func queueType(q awssqs.Queue) string {
+	return fifoType(q.FIFO)
+}
+
+func fifoType(fifo bool) string {
+	if fifo {
+		return "FIFO"
+	}
+	return "Standard"
+}
+
+// redrive describes where a queue's failed messages go, e.g.
+// "→ orders-dlq after 5", or which queues a DLQ serves.
+func redrive(q awssqs.Queue) string {
+	switch {
+	case q.DLQ():
+		return "DLQ of " + strings.Join(q.Sources, ", ")
+	case q.Redrive != nil:
+		return fmt.Sprintf("→ %s after %d", q.Redrive.DLQName(), q.Redrive.MaxReceiveCount)
+	}
+	return "-"
+}
+
+// fetchAll reloads both tabs.
+func (lv *ListView) fetchAll() tea.Cmd {
+	lv.Expect(2)
+	return tea.Batch(lv.fetchQueues(), lv.fetchTopics())
+}
+
+func (lv *ListView) fetchQueues() tea.Cmd {
+	client, m, scope, router := lv.sqs, lv.metrics, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var queues []awssqs.Queue
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			queues, err = client.ListQueues(ctx)
+			return err
+		})
+		if err == nil {
+			// Ages are optional: without CloudWatch access they are not shown.
+			_ = fillOldestAges(ctx, m, queues)
+			_ = cache.Store(context.Background(), scope, queuesCacheKey, queues, func(q awssqs.Queue) (string, string) {
+				return "queue:" + q.URL, q.Name
+			})
+		}
+		return queuesMsg{queues: queues, err: err}
+	}
+}
+
+func (lv *ListView) fetchTopics() tea.Cmd {
+	client, scope, router := lv.sns, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var topics []awssns.Topic
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			topics, err = client.ListTopics(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, topicsCacheKey, topics, func(t awssns.Topic) (string, string) {
+				return "topic:" + t.ARN, t.Name
+			})
+		}
+		return topicsMsg{topics: topics, err: err}
+	}
+}
+
+func (lv *ListView) Init() tea.Cmd {
+	fetch := lv.fetchAll()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCached(lv.cache, fetch, func(r *plugin.CacheReader) tea.Msg {
+			queues := plugin.ReadCached[awssqs.Queue](r, queuesCacheKey)
+			topics := plugin.ReadCached[awssns.Topic](r, topicsCacheKey)
+			return cachedMsg{queues: queues, topics: topics, CacheEntry: r.Entry()}
+		})
+	}
+	return fetch
+}
+
+func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case cachedMsg:
+		lv.loading = false
+		lv.queues.SetItems(msg.queues)
+		lv.topics.SetItems(msg.topics)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchAll()
+		}
+		return lv, nil
+
+	case queuesMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.queues.SetItems(msg.queues)
+		return lv, nil
+
+	case topicsMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.topics.SetItems(msg.topics)
+		return lv, nil
+
+	case tea.KeyPressMsg:
+		if lv.loading {
+			return lv, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if id := lv.selectedID(); id != "" {
+				view := detailView(lv.sqs, lv.sns, lv.metrics, lv.router, id)
+				lv.router.Push(view)
+				return lv, view.Init()
+			}
+			return lv, nil
+		case "esc", "backspace":
+			lv.router.Pop()
+			return lv, nil
+		case "r":
+			lv.loading = true
+			return lv, lv.fetchAll()
+		}
+	}
+
+	// Forward to tab controller first.
+	var cmd tea.Cmd
+	lv.tabs, cmd = lv.tabs.Update(msg)
+
+	// Forward to the active table.
+	var tableCmd tea.Cmd
+	switch lv.tabs.Active() {
+	case queuesTab:
+		lv.queues, tableCmd = lv.queues.Update(msg)
+	case topicsTab:
+		lv.topics, tableCmd = lv.topics.Update(msg)
+	}
+
+	return lv, tea.Batch(cmd, tableCmd)
+}
+
+func (lv *ListView) selectedID() string {
+	switch lv.tabs.Active() {
+	case queuesTab:
+		return lv.queues.SelectedID()
+	case topicsTab:
+		return lv.topics.SelectedID()
+	}
+	return ""
+}
+
+func (lv *ListView) View() tea.View {
+	if lv.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if lv.err != nil {
+		return tea.NewView("Error: " + lv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(lv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch lv.tabs.Active() {
+	case queuesTab:
+		b.WriteString(lv.queues.View())
+	case topicsTab:
+		b.WriteString(lv.topics.View())
+	}
+
+	return tea.NewView(b.String())
+}
+
+func (lv *ListView) Title() string { return "SQS/SNS" }
+
+func (lv *ListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view details"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+		{Key: "[/]", Desc: "switch tab"},
+	}
+}
@@ -0,0 +1,122 @@
+package messaging
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awssns "tasnim.dev/aws-tui/internal/aws/sns"
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+)
+
+// SQSClient defines the subset of sqs.Client methods used by the plugin.
+type SQSClient interface {
+	ListQueues(ctx context.Context) ([]awssqs.Queue, error)
+	GetQueue(ctx context.Context, url string) (awssqs.Queue, error)
+	PeekMessages(ctx context.Context, url string, limit int) ([]awssqs.Message, error)
+	SendMessage(ctx context.Context, q awssqs.Queue, body, groupID string) (string, error)
+	PurgeQueue(ctx context.Context, url string) error
+	StartRedrive(ctx context.Context, dlqARN string) (string, error)
+	ListRedrives(ctx context.Context, dlqARN string) ([]awssqs.MoveTask, error)
+}
+
+// SNSClient defines the subset of sns.Client methods used by the plugin.
+type SNSClient interface {
+	ListTopics(ctx context.Context) ([]awssns.Topic, error)
+	GetTopic(ctx context.Context, arn string) (awssns.Topic, error)
+	ListSubscriptions(ctx context.Context, topicARN string) ([]awssns.Subscription, error)
+	Publish(ctx context.Context, t awssns.Topic, message, groupID string) (string, error)
+}
+
+// Plugin implements plugin.ServicePlugin for Amazon SQS queues and SNS
+// topics.
+type Plugin struct {
+	plugin.CacheHolder
+
+	sqs     SQSClient
+	sns     SNSClient
+	metrics metrics.Client
+}
+
+// NewPlugin creates a new SQS and SNS service plugin.
+func NewPlugin(sqs SQSClient, sns SNSClient) *Plugin {
+	return &Plugin{sqs: sqs, sns: sns}
+}
+
+// SetMetricsClient sets the client the age of each queue's oldest message
+// is read with. Without one the age is not shown.
+func (p *Plugin) SetMetricsClient(c metrics.Client) { p.metrics = c }
+
+func (p *Plugin) ID() string   { return "messaging" }
+func (p *Plugin) Name() string { return "SQS/SNS" }
+func (p *Plugin) Icon() string { return "\U000F01EE" } // nf-md-email
+
+// Summary counts queues by backlog. A dead-letter queue holding messages
+// makes the service a warning.
+func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
+	queues, err := p.sqs.ListQueues(ctx)
+	if err != nil {
+		return plugin.ServiceSummary{}, err
+	}
+	return mapSummary(queues), nil
+}
+
+func mapSummary(queues []awssqs.Queue) plugin.ServiceSummary {
+	status := make(map[string]int)
+	health := plugin.HealthHealthy
+	for _, q := range queues {
+		switch {
+		case q.DLQ() && q.Visible > 0:
+			status["DLQ with messages"]++
+			health = plugin.HealthWarning
+		case q.Visible > 0 || q.InFlight > 0 || q.Delayed > 0:
+			status["with messages"]++
+		default:
+			status["empty"]++
+		}
+	}
+	return plugin.ServiceSummary{
+		Total:  len(queues),
+		Status: status,
+		Health: health,
+		Label:  "queues",
+	}
+}
+
+func (p *Plugin) ListView(router plugin.Router) plugin.View {
+	lv := NewListView(p.sqs, p.sns, router)
+	lv.metrics = p.metrics
+	lv.cache = p.Cache()
+	return lv
+}
+
+// DetailView opens "queue:<url>" or "topic:<arn>".
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	return detailView(p.sqs, p.sns, p.metrics, router, id)
+}
+
+func detailView(sqs SQSClient, sns SNSClient, m metrics.Client, router plugin.Router, id string) plugin.View {
+	if arn, ok := strings.CutPrefix(id, "topic:"); ok {
+		return NewTopicView(sns, router, arn)
+	}
+	qv := NewQueueView(sqs, router, strings.TrimPrefix(id, "queue:"))
+	qv.metrics = m
+	return qv
+}
+
+func (p *Plugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{
+			Title:    "SQS Queues and SNS Topics",
+			Keywords: []string{"sqs", "sns", "queue", "topic", "dlq", "messages", "pubsub"},
+		},
+	}
+}
+
+func (p *Plugin) PollConfig() plugin.PollConfig {
+	return plugin.PollConfig{
+		IdleInterval: time.Minute,
+	}
+}
@@ -0,0 +1,359 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	awssns "tasnim.dev/aws-tui/internal/aws/sns"
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+type mockSQS struct {
+	queues   []awssqs.Queue
+	messages []awssqs.Message
+	redrives []awssqs.MoveTask
+	sent     []string
+	purged   []string
+	redriven []string
+	err      error
+}
+
+func (m *mockSQS) ListQueues(_ context.Context) ([]awssqs.Queue, error) {
+	return m.queues, m.err
+}
+
+func (m *mockSQS) GetQueue(_ context.Context, url string) (awssqs.Queue, error) {
+	for _, q := range m.queues {
+		if q.URL == url {
+			return q, nil
+		}
+	}
+	return awssqs.Queue{}, errors.New("queue " + url + " not found")
+}
+
+func (m *mockSQS) PeekMessages(_ context.Context, _ string, limit int) ([]awssqs.Message, error) {
+	return m.messages[:min(limit, len(m.messages))], nil
+}
+
+func (m *mockSQS) SendMessage(_ context.Context, q awssqs.Queue, body, _ string) (string, error) {
+	m.sent = append(m.sent, q.Name+": "+body)
+	return "m-1", nil
+}
+
+func (m *mockSQS) PurgeQueue(_ context.Context, url string) error {
+	m.purged = append(m.purged, url)
+	return nil
+}
+
+func (m *mockSQS) StartRedrive(_ context.Context, dlqARN string) (string, error) {
+	m.redriven = append(m.redriven, dlqARN)
+	return "task-1", nil
+}
+
+func (m *mockSQS) ListRedrives(_ context.Context, _ string) ([]awssqs.MoveTask, error) {
+	return m.redrives, nil
+}
+
+type mockSNS struct {
+	topics    []awssns.Topic
+	subs      []awssns.Subscription
+	published []string
+}
+
+func (m *mockSNS) ListTopics(_ context.Context) ([]awssns.Topic, error) {
+	return m.topics, nil
+}
+
+func (m *mockSNS) GetTopic(_ context.Context, arn string) (awssns.Topic, error) {
+	for _, t := range m.topics {
+		if t.ARN == arn {
+			return t, nil
+		}
+	}
+	return awssns.Topic{}, errors.New("topic " + arn + " not found")
+}
+
+func (m *mockSNS) ListSubscriptions(_ context.Context, _ string) ([]awssns.Subscription, error) {
+	return m.subs, nil
+}
+
+func (m *mockSNS) Publish(_ context.Context, t awssns.Topic, message, _ string) (string, error) {
+	m.published = append(m.published, t.Name+": "+message)
+	return "m-2", nil
+}
+
+// mockMetrics reports an oldest message age of 90s for every queue.
+type mockMetrics struct {
+	queries []cloudwatch.MetricQuery
+}
+
+func (m *mockMetrics) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, _ cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries = append(m.queries, queries...)
+	series := make([]cloudwatch.MetricSeries, len(queries))
+	for i, q := range queries {
+		series[i] = cloudwatch.MetricSeries{ID: q.ID, Values: []float64{30, 90}}
+	}
+	return series, nil
+}
+
+type mockRouter struct {
+	pushed   []plugin.View
+	toasts   []string
+	detail   []string
+	confirm  *plugin.Action
+	readOnly bool
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(pluginID, id string)    { m.detail = []string{pluginID, id} }
+func (m *mockRouter) Toast(_ plugin.ToastLevel, msg string) { m.toasts = append(m.toasts, msg) }
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return m.readOnly }
+func (m *mockRouter) Confirm(a plugin.Action)               { m.confirm = &a }
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	switch s {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	case "esc":
+		return tea.KeyPressMsg{Code: tea.KeyEscape}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+// send delivers msg to the view and then the messages of the commands it
+// returns, until none are left.
+func send(v tea.Model, msg tea.Msg) {
+	_, cmd := v.Update(msg)
+	for cmd != nil {
+		_, cmd = v.Update(cmd())
+	}
+}
+
+// typeText types s into an open prompt and submits it.
+func typeText(v tea.Model, s string) {
+	for _, r := range s {
+		send(v, tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	send(v, key("enter"))
+}
+
+const (
+	queueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/"
+	queueARN = "arn:aws:sqs:us-east-1:123456789012:"
+	topicARN = "arn:aws:sns:us-east-1:123456789012:"
+)
+
+func ordersQueues() []awssqs.Queue {
+	return []awssqs.Queue{
+		{
+			Name: "orders", URL: queueURL + "orders", ARN: queueARN + "orders",
+			Visible: 12, InFlight: 3, Delayed: 1, VisibilityTimeout: 30 * time.Second, Retention: 96 * time.Hour,
+			Redrive: &awssqs.RedrivePolicy{DLQARN: queueARN + "orders-dlq", MaxReceiveCount: 5},
+		},
+		{
+			Name: "orders-dlq", URL: queueURL + "orders-dlq", ARN: queueARN + "orders-dlq",
+			Sources: []string{"orders"},
+		},
+	}
+}
+
+func TestPluginMetadata(t *testing.T) {
+	p := NewPlugin(nil, nil)
+	assert.Equal(t, "messaging", p.ID())
+	assert.Equal(t, "SQS/SNS", p.Name())
+	assert.NotEmpty(t, p.Icon())
+	require.Len(t, p.Commands(), 1)
+	assert.Contains(t, p.Commands()[0].Keywords, "dlq")
+}
+
+func TestSummary(t *testing.T) {
+	client := &mockSQS{queues: ordersQueues()}
+	p := NewPlugin(client, &mockSNS{})
+	s, err := p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Total)
+	assert.Equal(t, plugin.HealthHealthy, s.Health, "an empty DLQ is healthy")
+	assert.Equal(t, map[string]int{"with messages": 1, "empty": 1}, s.Status)
+	assert.Equal(t, "queues", s.Label)
+
+	client.queues[1].Visible = 4
+	s, err = p.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, plugin.HealthWarning, s.Health)
+	assert.Equal(t, 1, s.Status["DLQ with messages"])
+
+	_, err = NewPlugin(&mockSQS{err: errors.New("AccessDenied")}, &mockSNS{}).Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestListView(t *testing.T) {
+	sns := &mockSNS{topics: []awssns.Topic{{Name: "alerts", ARN: topicARN + "alerts", DisplayName: "Alerts", Confirmed: 2, Pending: 1}}}
+	m := &mockMetrics{}
+	router := &mockRouter{}
+	p := NewPlugin(&mockSQS{queues: ordersQueues()}, sns)
+	p.SetMetricsClient(m)
+	lv := p.ListView(router).(*ListView)
+	cmd := lv.Init()
+	for _, msg := range cmd().(tea.BatchMsg) {
+		lv.Update(msg())
+	}
+
+	view := lv.View().Content
+	assert.Contains(t, view, "→ orders-dlq after 5")
+	assert.Contains(t, view, "DLQ of orders")
+	assert.Contains(t, view, "1m 30s", "the latest age datapoint is shown")
+	require.Len(t, m.queries, 2)
+	assert.Equal(t, "ApproximateAgeOfOldestMessage", m.queries[0].Metric)
+	assert.Equal(t, []cloudwatch.Dimension{{Name: "QueueName", Value: "orders"}}, m.queries[0].Dimensions)
+
+	send(lv, key("2"))
+	view = lv.View().Content
+	assert.Contains(t, view, "Alerts")
+
+	lv.Update(key("enter"))
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "alerts", router.pushed[0].Title())
+}
+
+func TestQueueViewPeeksMessages(t *testing.T) {
+	client := &mockSQS{
+		queues: ordersQueues(),
+		messages: []awssqs.Message{
+			{ID: "m2", Body: "second", SentAt: time.Unix(1700000060, 0)},
+			{ID: "m1", Body: `{"orderId": 7}`, SentAt: time.Unix(1700000000, 0), ReceiveCount: 2, Attributes: map[string]string{"source": "api"}},
+		},
+	}
+	qv := NewPlugin(client, &mockSNS{}).DetailView(&mockRouter{}, "queue:"+queueURL+"orders").(*QueueView)
+	qv.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	send(qv, qv.Init()())
+
+	view := qv.View().Content
+	assert.Contains(t, view, "Messages received 5 times go to orders-dlq.")
+	assert.Contains(t, view, "4d")
+
+	send(qv, key("2"))
+	warning := "Each peek counts as a receive: messages received 5 times (maxReceiveCount) move to orders-dlq."
+	assert.Contains(t, qv.View().Content, warning)
+
+	send(qv, key("p"))
+	assert.Equal(t, messagesTab, qv.tabs.Active())
+	view = qv.View().Content
+	assert.Contains(t, view, warning, "the warning stays while messages are shown")
+	assert.Contains(t, view, "second")
+	assert.Contains(t, view, "Message m1", "the oldest message is selected first")
+	assert.Contains(t, view, "source: api")
+	assert.Contains(t, view, "orderId")
+}
+
+func TestQueueViewActions(t *testing.T) {
+	client := &mockSQS{queues: ordersQueues()}
+	router := &mockRouter{}
+	qv := NewPlugin(client, &mockSNS{}).DetailView(router, "queue:"+queueURL+"orders").(*QueueView)
+	send(qv, qv.Init()())
+	assert.NotContains(t, qv.KeyHints(), plugin.KeyHint{Key: "d", Desc: "redrive"}, "only DLQs are redriven")
+
+	send(qv, key("m"))
+	require.NotNil(t, qv.prompt)
+	typeText(qv, "hi")
+	assert.Equal(t, []string{"orders: hi"}, client.sent)
+	assert.Contains(t, router.toasts, "Sent message m-1 to orders")
+
+	send(qv, key("X"))
+	require.NotNil(t, router.confirm)
+	assert.Equal(t, "Purge queue", router.confirm.Title)
+	assert.Equal(t, "orders", router.confirm.Phrase)
+	assert.Empty(t, client.purged, "nothing is purged before confirmation")
+	send(qv, router.confirm.Run()())
+	assert.Equal(t, []string{queueURL + "orders"}, client.purged)
+}
+
+func TestQueueViewRedrive(t *testing.T) {
+	client := &mockSQS{
+		queues:   ordersQueues(),
+		redrives: []awssqs.MoveTask{{Status: "COMPLETED", Moved: 4, StartedAt: time.Unix(1700000000, 0)}},
+	}
+	client.queues[1].Visible = 7
+	router := &mockRouter{}
+	qv := NewPlugin(client, &mockSNS{}).DetailView(router, "queue:"+queueURL+"orders-dlq").(*QueueView)
+	send(qv, qv.Init()())
+	view := qv.View().Content
+	assert.Contains(t, view, "This is the dead-letter queue of orders.")
+	assert.Contains(t, view, "COMPLETED   4 moved to source queues")
+	assert.Contains(t, qv.KeyHints(), plugin.KeyHint{Key: "d", Desc: "redrive"})
+
+	send(qv, key("d"))
+	require.NotNil(t, router.confirm)
+	assert.Equal(t, "Redrive messages", router.confirm.Title)
+	assert.Equal(t, []string{"orders-dlq → orders  about 7 messages"}, router.confirm.Targets)
+	send(qv, router.confirm.Run()())
+	assert.Equal(t, []string{queueARN + "orders-dlq"}, client.redriven)
+	assert.Contains(t, router.toasts, "Redrive started for orders-dlq")
+}
+
+func TestQueueViewReadOnly(t *testing.T) {
+	client := &mockSQS{queues: ordersQueues()}
+	router := &mockRouter{readOnly: true}
+	qv := NewPlugin(client, &mockSNS{}).DetailView(router, "queue:"+queueURL+"orders").(*QueueView)
+	send(qv, qv.Init()())
+	assert.NotContains(t, qv.KeyHints(), plugin.KeyHint{Key: "m", Desc: "send message"})
+
+	send(qv, key("m"))
+	assert.Nil(t, qv.prompt)
+	assert.Equal(t, []string{"Sending messages is disabled in read-only mode"}, router.toasts)
+}
+
+func TestTopicView(t *testing.T) {
+	sns := &mockSNS{
+		topics: []awssns.Topic{{Name: "alerts", ARN: topicARN + "alerts", Confirmed: 2, Pending: 1}},
+		subs: []awssns.Subscription{
+			{ARN: "PendingConfirmation", Protocol: "email", Endpoint: "ops@example.com"},
+			{ARN: topicARN + "alerts:1", Protocol: "lambda", Endpoint: "arn:aws:lambda:us-east-1:123456789012:function:notify:live"},
+			{ARN: topicARN + "alerts:2", Protocol: "sqs", Endpoint: queueARN + "alerts"},
+		},
+	}
+	router := &mockRouter{}
+	tv := NewPlugin(&mockSQS{}, sns).DetailView(router, "topic:"+topicARN+"alerts").(*TopicView)
+	send(tv, tv.Init()())
+	assert.Contains(t, tv.View().Content, "2 confirmed, 1 pending, 0 deleted")
+
+	send(tv, key("2"))
+	view := tv.View().Content
+	assert.Contains(t, view, "ops@example.com")
+	assert.Contains(t, view, "pending")
+
+	send(tv, key("enter"))
+	assert.Nil(t, router.detail, "email endpoints open nowhere")
+	send(tv, key("j"))
+	assert.Contains(t, tv.KeyHints(), plugin.KeyHint{Key: "enter", Desc: "open function notify"})
+	send(tv, key("enter"))
+	assert.Equal(t, []string{"lambda", "notify"}, router.detail)
+	send(tv, key("j"))
+	send(tv, key("enter"))
+	assert.Equal(t, []string{"messaging", "queue:" + queueURL + "alerts"}, router.detail)
+
+	send(tv, key("m"))
+	typeText(tv, "ping")
+	assert.Equal(t, []string{"alerts: ping"}, sns.published)
+	assert.Contains(t, router.toasts, "Published message m-2 to alerts")
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "0s", formatDuration(0))
+	assert.Equal(t, "45s", formatDuration(45*time.Second))
+	assert.Equal(t, "1m 30s", formatDuration(90*time.Second))
+	assert.Equal(t, "2h 30m", formatDuration(150*time.Minute+10*time.Second))
+	assert.Equal(t, "4d", formatDuration(96*time.Hour))
+	assert.Equal(t, "1d", formatDuration(24*time.Hour+5*time.Minute))
+}
@@ -0,0 +1,428 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/metrics"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the queue view.
+const (
+	queueOverviewTab = 0
+	messagesTab      = 1
+)
+
+// peekLimit is how many messages a peek reads.
+const peekLimit = 50
+
+// bodyLines is how many lines of the selected message's body are shown.
+const bodyLines = 12
+
+var (
+	sectionStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	warnStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+)
+
+// queueLoadedMsg carries a queue with its recent redrives. Only a failure
+// to read the queue itself is fatal.
+type queueLoadedMsg struct {
+	queue       awssqs.Queue
+	redrives    []awssqs.MoveTask
+	redrivesErr error
+	err         error
+}
+
+// peekedMsg carries messages read without deleting them.
+type peekedMsg struct {
+	messages []awssqs.Message
+	err      error
+}
+
+// QueueView shows a queue's counts, settings and redrive configuration,
+// peeks at its messages and runs the queue actions.
+type QueueView struct {
+	client      SQSClient
+	metrics     metrics.Client
+	router      plugin.Router
+	url         string
+	queue       *awssqs.Queue
+	redrives    []awssqs.MoveTask
+	redrivesErr error
+	tabs        ui.TabController
+	loading     bool
+	err         error
+	width       int
+
+	// Messages tab state.
+	messages ui.TableView[awssqs.Message]
+	peeked   bool
+	peeking  bool
+	peekErr  error
+
+	// Send message prompt.
+	prompt *ui.Input
+}
+
+// NewQueueView creates a QueueView for the queue at url.
+func NewQueueView(client SQSClient, router plugin.Router, url string) *QueueView {
+	cols := []ui.Column[awssqs.Message]{
+		{Title: "Sent", Width: 17, Field: func(m awssqs.Message) string { return ui.FormatTime(m.SentAt) }},
+		{Title: "Receives", Width: 9, Field: func(m awssqs.Message) string { return fmt.Sprint(m.ReceiveCount) }},
+		{Title: "Message ID", Width: 38, Field: func(m awssqs.Message) string { return m.ID }},
+		{Title: "Body", Width: 60, Field: func(m awssqs.Message) string { return oneLine(m.Body) }},
+	}
+	return &QueueView{
+		client:   client,
+		router:   router,
+		url:      url,
+		tabs:     ui.NewTabController([]string{"Overview", "Messages"}),
+		messages: ui.NewTableView(cols, nil, func(m awssqs.Message) string { return m.ID }),
+		loading:  true,
+	}
+}
+
+func (qv *QueueView) loadQueue() tea.Cmd {
+	client, m, router, url := qv.client, qv.metrics, qv.router, qv.url
+	ctx := router.Context(qv)
+	return func() tea.Msg {
+		var msg queueLoadedMsg
+		msg.err = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.queue, err = client.GetQueue(ctx, url)
+			return err
+		})
+		if msg.err != nil {
+			return msg
+		}
+		queues := []awssqs.Queue{msg.queue}
+		_ = fillOldestAges(ctx, m, queues)
+		msg.queue = queues[0]
+		if msg.queue.DLQ() {
+			msg.redrivesErr = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				msg.redrives, err = client.ListRedrives(ctx, msg.queue.ARN)
+				return err
+			})
+		}
+		return msg
+	}
+}
+
+// peek reads messages without deleting them.
+func (qv *QueueView) peek() tea.Cmd {
+	if qv.peeking {
+		return nil
+	}
+	if qv.router.Offline() {
+		qv.router.Toast(plugin.ToastWarning, "Peeking is unavailable offline")
+		return nil
+	}
+	qv.peeking = true
+	client, router, url := qv.client, qv.router, qv.url
+	ctx := router.Context(qv)
+	return func() tea.Msg {
+		var messages []awssqs.Message
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			messages, err = client.PeekMessages(ctx, url, peekLimit)
+			return err
+		})
+		return peekedMsg{messages: messages, err: err}
+	}
+}
+
+func (qv *QueueView) Init() tea.Cmd {
+	return qv.loadQueue()
+}
+
+func (qv *QueueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case queueLoadedMsg:
+		qv.loading = false
+		if msg.err != nil {
+			qv.err = msg.err
+			return qv, nil
+		}
+		qv.err = nil
+		qv.queue = &msg.queue
+		qv.redrives, qv.redrivesErr = msg.redrives, msg.redrivesErr
+		return qv, nil
+
+	case peekedMsg:
+		qv.peeking = false
+		qv.peeked = true
+		qv.peekErr = msg.err
+		if msg.err == nil {
+			// Oldest first, as they would be received.
+			sort.SliceStable(msg.messages, func(i, j int) bool { return msg.messages[i].SentAt.Before(msg.messages[j].SentAt) })
+			qv.messages.SetItems(msg.messages)
+		}
+		return qv, nil
+
+	case ui.InputResult:
+		if qv.prompt != nil {
+			return qv, qv.answerPrompt(msg)
+		}
+		return qv, nil
+
+	case actionDoneMsg:
+		return qv, qv.afterAction(msg)
+
+	case tea.WindowSizeMsg:
+		qv.width = msg.Width
+		return qv, nil
+
+	case tea.KeyPressMsg:
+		if qv.prompt != nil {
+			in, cmd := qv.prompt.Update(msg)
+			qv.prompt = &in
+			return qv, cmd
+		}
+		if qv.tabs.Active() == messagesTab && qv.messages.Filtering() {
+			var cmd tea.Cmd
+			qv.messages, cmd = qv.messages.Update(msg)
+			return qv, cmd
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			qv.router.Pop()
+			return qv, nil
+		case "r":
+			if !qv.loading {
+				qv.loading = true
+				return qv, qv.loadQueue()
+			}
+			return qv, nil
+		}
+		if qv.queue != nil {
+			if cmd, ok := qv.handleActionKey(msg.String()); ok {
+				return qv, cmd
+			}
+		}
+
+		prev := qv.tabs.Active()
+		var cmd tea.Cmd
+		qv.tabs, cmd = qv.tabs.Update(msg)
+		if qv.tabs.Active() != prev {
+			return qv, cmd
+		}
+		if qv.tabs.Active() == messagesTab {
+			qv.messages, cmd = qv.messages.Update(msg)
+		}
+		return qv, cmd
+	}
+
+	return qv, nil
+}
+
+func (qv *QueueView) View() tea.View {
+	if qv.loading && qv.queue == nil {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if qv.err != nil {
+		return tea.NewView("Error: " + qv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(qv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch qv.tabs.Active() {
+	case queueOverviewTab:
+		b.WriteString(qv.renderOverview())
+	case messagesTab:
+		b.WriteString(qv.renderMessages())
+	}
+
+	if qv.prompt != nil {
+		b.WriteString("\n\n")
+		b.WriteString(qv.prompt.View())
+	}
+	return tea.NewView(b.String())
+}
+
+func (qv *QueueView) renderOverview() string {
+	q := qv.queue
+	encryption := "disabled"
+	switch q.Encryption {
+	case "SSE-KMS":
+		encryption = "SSE-KMS (" + q.KMSKey + ")"
+	case "SSE-SQS":
+		encryption = "SSE-SQS"
+	}
+	oldest := formatAge(*q)
+	if qv.metrics == nil {
+		oldest = "unavailable"
+	}
+	typ := queueType(*q)
+	if q.FIFO && q.ContentDeduplicated {
+		typ += ", content-based deduplication"
+	}
+	rows := []ui.KV{
+		{K: "Name", V: q.Name},
+		{K: "URL", V: q.URL},
+		{K: "ARN", V: q.ARN},
+		{K: "Type", V: typ},
+		{K: "Visible", V: fmt.Sprint(q.Visible)},
+		{K: "In Flight", V: fmt.Sprint(q.InFlight)},
+		{K: "Delayed", V: fmt.Sprint(q.Delayed)},
+		{K: "Oldest Message", V: oldest},
+		{K: "Visibility Timeout", V: formatDuration(q.VisibilityTimeout)},
+		{K: "Retention", V: formatDuration(q.Retention)},
+		{K: "Delivery Delay", V: formatDuration(q.Delay)},
+		{K: "Receive Wait", V: formatDuration(q.ReceiveWait)},
+		{K: "Max Message Size", V: fmt.Sprintf("%d KB", q.MaxMessageSize/1024)},
+		{K: "Encryption", V: encryption},
+		{K: "Created", V: ui.FormatTime(q.CreatedAt)},
+		{K: "Last Modified", V: ui.FormatTime(q.ModifiedAt)},
+	}
+
+	var b strings.Builder
+	b.WriteString(ui.RenderKV(rows, 20, ui.KVValueWidth(qv.width)))
+	b.WriteString("\n\n")
+	b.WriteString(sectionStyle.Render("Dead-Letter Queue"))
+	b.WriteString("\n")
+	if q.Redrive != nil {
+		b.WriteString(fmt.Sprintf("Messages received %d times go to %s.\n", q.Redrive.MaxReceiveCount, q.Redrive.DLQName()))
+	} else {
+		b.WriteString("No dead-letter queue.\n")
+	}
+	if q.DLQ() {
+		b.WriteString("This is the dead-letter queue of " + strings.Join(q.Sources, ", ") + ".\n")
+		b.WriteString("\n")
+		b.WriteString(sectionStyle.Render("Redrives"))
+		b.WriteString("\n")
+		b.WriteString(qv.renderRedrives())
+	}
+	return b.String()
+}
+
+func (qv *QueueView) renderRedrives() string {
+	switch {
+	case qv.redrivesErr != nil:
+		return fmt.Sprintf("Error: %v\n", qv.redrivesErr)
+	case len(qv.redrives) == 0:
+		return "No recent redrives.\n"
+	}
+	var b strings.Builder
+	for _, t := range qv.redrives {
+		dest := "source queues"
+		if t.Destination != "" {
+			dest = awssqs.NameFromARN(t.Destination)
+		}
+		line := fmt.Sprintf("%-17s %-11s %d moved", ui.FormatTime(t.StartedAt), t.Status, t.Moved)
+		if t.ToMove > 0 {
+			line += fmt.Sprintf(" of %d", t.ToMove)
+		}
+		line += " to " + dest
+		if t.Failure != "" {
+			line += ": " + t.Failure
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+func (qv *QueueView) renderMessages() string {
+	var b strings.Builder
+	switch {
+	case qv.peeking:
+		b.WriteString("Peeking at messages...")
+	case qv.peekErr != nil:
+		b.WriteString(fmt.Sprintf("Error: %v\nPress p to retry.", qv.peekErr))
+	case !qv.peeked:
+		b.WriteString("Press p to peek at up to " + fmt.Sprint(peekLimit) + " messages without deleting them.\n")
+		b.WriteString(peekWarning(*qv.queue))
+	case qv.messages.ItemCount() == 0:
+		b.WriteString("No messages available. In-flight and delayed messages cannot be peeked.\n")
+		b.WriteString(peekWarning(*qv.queue))
+	default:
+		b.WriteString(peekWarning(*qv.queue))
+		b.WriteString("\n\n")
+		b.WriteString(qv.messages.View())
+		if qv.messages.FilteredCount() > 0 {
+			b.WriteString("\n\n")
+			b.WriteString(renderMessage(qv.messages.SelectedItem()))
+		}
+	}
+	return b.String()
+}
+
+// peekWarning explains that peeking receives messages, so that it counts
+// towards the queue's maxReceiveCount and can move them to its DLQ.
+func peekWarning(q awssqs.Queue) string {
+	if q.Redrive == nil {
+		return warnStyle.Render("Each peek counts as a receive of the messages shown.")
+	}
+	return warnStyle.Render(fmt.Sprintf("Each peek counts as a receive: messages received %d times (maxReceiveCount) move to %s.",
+		q.Redrive.MaxReceiveCount, q.Redrive.DLQName()))
+}
+
+// renderMessage shows a message's attributes and the start of its body,
+// highlighted if it is JSON.
+func renderMessage(m awssqs.Message) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("Message " + m.ID))
+	b.WriteString("\n")
+	if m.GroupID != "" {
+		b.WriteString("Group: " + m.GroupID + "\n")
+	}
+	if len(m.Attributes) > 0 {
+		names := make([]string, 0, len(m.Attributes))
+		for name := range m.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			b.WriteString(name + ": " + m.Attributes[name] + "\n")
+		}
+	}
+	body := m.Body
+	var indented bytes.Buffer
+	if json.Indent(&indented, []byte(body), "", "  ") == nil {
+		body = ui.HighlightCode("message.json", indented.String())
+	}
+	lines := strings.Split(body, "\n")
+	if len(lines) > bodyLines {
+		lines = append(lines[:bodyLines], fmt.Sprintf("… %d more lines", len(lines)-bodyLines))
+	}
+	b.WriteString(strings.Join(lines, "\n"))
+	return b.String()
+}
+
+func (qv *QueueView) Title() string {
+	return awssqs.NameFromURL(qv.url)
+}
+
+// CapturingInput implements plugin.InputCapturer while the send prompt or
+// the message filter is open.
+func (qv *QueueView) CapturingInput() bool {
+	return qv.prompt != nil || (qv.tabs.Active() == messagesTab && qv.messages.Filtering())
+}
+
+func (qv *QueueView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "p", Desc: "peek"},
+	}
+	if qv.tabs.Active() == messagesTab && qv.peeked {
+		hints = append(hints, plugin.KeyHint{Key: "/", Desc: "filter"})
+	}
+	return append(hints, qv.actionHints()...)
+}
+
+// oneLine collapses whitespace so a message body fits on a table row.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
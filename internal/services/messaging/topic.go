@@ -0,0 +1,305 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	awssns "tasnim.dev/aws-tui/internal/aws/sns"
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the topic view.
+const (
+	topicOverviewTab = 0
+	subscriptionsTab = 1
+)
+
+// topicLoadedMsg carries a topic with its subscriptions. Only a failure to
+// read the topic itself is fatal.
+type topicLoadedMsg struct {
+	topic   awssns.Topic
+	subs    []awssns.Subscription
+	subsErr error
+	err     error
+}
+
+// subscriptionLink names the view a subscription's endpoint opens in.
+type subscriptionLink struct {
+	pluginID string
+	id       string
+	label    string
+}
+
+// TopicView shows a topic and its subscriptions, and publishes test
+// messages to it.
+type TopicView struct {
+	client  SNSClient
+	router  plugin.Router
+	arn     string
+	topic   *awssns.Topic
+	subs    ui.TableView[awssns.Subscription]
+	subsErr error
+	tabs    ui.TabController
+	loading bool
+	err     error
+	width   int
+
+	// Publish prompt.
+	prompt *ui.Input
+}
+
+// NewTopicView creates a TopicView for the topic with the given ARN.
+func NewTopicView(client SNSClient, router plugin.Router, arn string) *TopicView {
+	cols := []ui.Column[awssns.Subscription]{
+		{Title: "Protocol", Width: 10, Field: func(s awssns.Subscription) string { return s.Protocol }},
+		{Title: "Endpoint", Width: 64, Field: func(s awssns.Subscription) string { return s.Endpoint }},
+		{Title: "Status", Width: 10, Field: func(s awssns.Subscription) string {
+			if s.Pending() {
+				return "pending"
+			}
+			return "confirmed"
+		}},
+	}
+	return &TopicView{
+		client: client,
+		router: router,
+		arn:    arn,
+		subs: ui.NewTableView(cols, nil, func(s awssns.Subscription) string {
+			return s.Protocol + " " + s.Endpoint
+		}),
+		tabs:    ui.NewTabController([]string{"Overview", "Subscriptions"}),
+		loading: true,
+	}
+}
+
+func (tv *TopicView) loadTopic() tea.Cmd {
+	client, router, arn := tv.client, tv.router, tv.arn
+	ctx := router.Context(tv)
+	return func() tea.Msg {
+		var msg topicLoadedMsg
+		msg.err = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.topic, err = client.GetTopic(ctx, arn)
+			return err
+		})
+		if msg.err != nil {
+			return msg
+		}
+		msg.subsErr = plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			msg.subs, err = client.ListSubscriptions(ctx, arn)
+			return err
+		})
+		return msg
+	}
+}
+
+func (tv *TopicView) Init() tea.Cmd {
+	return tv.loadTopic()
+}
+
+func (tv *TopicView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case topicLoadedMsg:
+		tv.loading = false
+		if msg.err != nil {
+			tv.err = msg.err
+			return tv, nil
+		}
+		tv.err = nil
+		tv.topic = &msg.topic
+		tv.subs.SetItems(msg.subs)
+		tv.subsErr = msg.subsErr
+		return tv, nil
+
+	case ui.InputResult:
+		if tv.prompt != nil {
+			return tv, tv.answerPrompt(msg)
+		}
+		return tv, nil
+
+	case actionDoneMsg:
+		if msg.err != nil {
+			tv.router.Toast(plugin.ToastError, "Action failed: "+msg.err.Error())
+		} else {
+			tv.router.Toast(plugin.ToastInfo, msg.done)
+		}
+		return tv, nil
+
+	case tea.WindowSizeMsg:
+		tv.width = msg.Width
+		return tv, nil
+
+	case tea.KeyPressMsg:
+		if tv.prompt != nil {
+			in, cmd := tv.prompt.Update(msg)
+			tv.prompt = &in
+			return tv, cmd
+		}
+		if tv.tabs.Active() == subscriptionsTab && tv.subs.Filtering() {
+			var cmd tea.Cmd
+			tv.subs, cmd = tv.subs.Update(msg)
+			return tv, cmd
+		}
+		switch msg.String() {
+		case "esc", "backspace":
+			tv.router.Pop()
+			return tv, nil
+		case "r":
+			if !tv.loading {
+				tv.loading = true
+				return tv, tv.loadTopic()
+			}
+			return tv, nil
+		case "m":
+			if tv.topic != nil && actionAvailable(tv.router, "Publishing messages") {
+				in := ui.NewInput("Publish Message", "Message:", false)
+				tv.prompt = &in
+			}
+			return tv, nil
+		case "enter":
+			if link, ok := tv.selectedLink(); ok && tv.tabs.Active() == subscriptionsTab {
+				tv.router.NavigateDetail(link.pluginID, link.id)
+			}
+			return tv, nil
+		}
+
+		prev := tv.tabs.Active()
+		var cmd tea.Cmd
+		tv.tabs, cmd = tv.tabs.Update(msg)
+		if tv.tabs.Active() != prev {
+			return tv, cmd
+		}
+		if tv.tabs.Active() == subscriptionsTab {
+			tv.subs, cmd = tv.subs.Update(msg)
+		}
+		return tv, cmd
+	}
+
+	return tv, nil
+}
+
+// answerPrompt publishes the message typed into the prompt.
+func (tv *TopicView) answerPrompt(res ui.InputResult) tea.Cmd {
+	tv.prompt = nil
+	if res.Canceled {
+		return nil
+	}
+	client, ctx, t, message := tv.client, tv.router.Context(tv), *tv.topic, res.Value
+	return func() tea.Msg {
+		id, err := client.Publish(ctx, t, message, testGroupID)
+		return actionDoneMsg{done: fmt.Sprintf("Published message %s to %s", id, t.Name), err: err}
+	}
+}
+
+// selectedLink returns where the selected subscription's endpoint opens:
+// SQS queues in this plugin and Lambda functions in theirs.
+func (tv *TopicView) selectedLink() (subscriptionLink, bool) {
+	if tv.subs.FilteredCount() == 0 {
+		return subscriptionLink{}, false
+	}
+	return subscriptionLinkFor(tv.subs.SelectedItem())
+}
+
+func subscriptionLinkFor(s awssns.Subscription) (subscriptionLink, bool) {
+	switch s.Protocol {
+	case "sqs":
+		if url, ok := awssqs.URLFromARN(s.Endpoint); ok {
+			name := awssqs.NameFromARN(s.Endpoint)
+			return subscriptionLink{pluginID: "messaging", id: "queue:" + url, label: "queue " + name}, true
+		}
+	case "lambda":
+		// arn:aws:lambda:<region>:<account>:function:<name>[:<qualifier>]
+		_, rest, _ := strings.Cut(s.Endpoint, ":function:")
+		if name, _, _ := strings.Cut(rest, ":"); name != "" {
+			return subscriptionLink{pluginID: "lambda", id: name, label: "function " + name}, true
+		}
+	}
+	return subscriptionLink{}, false
+}
+
+func (tv *TopicView) View() tea.View {
+	if tv.loading && tv.topic == nil {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if tv.err != nil {
+		return tea.NewView("Error: " + tv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(tv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch tv.tabs.Active() {
+	case topicOverviewTab:
+		b.WriteString(tv.renderOverview())
+	case subscriptionsTab:
+		switch {
+		case tv.subsErr != nil:
+			b.WriteString(fmt.Sprintf("Error: %v\nPress r to retry.", tv.subsErr))
+		case tv.subs.ItemCount() == 0:
+			b.WriteString("No subscriptions.")
+		default:
+			b.WriteString(tv.subs.View())
+		}
+	}
+
+	if tv.prompt != nil {
+		b.WriteString("\n\n")
+		b.WriteString(tv.prompt.View())
+	}
+	return tea.NewView(b.String())
+}
+
+func (tv *TopicView) renderOverview() string {
+	t := tv.topic
+	encryption := "disabled"
+	if t.KMSKey != "" {
+		encryption = "SSE-KMS (" + t.KMSKey + ")"
+	}
+	typ := fifoType(t.FIFO)
+	if t.FIFO && t.ContentDeduplicated {
+		typ += ", content-based deduplication"
+	}
+	rows := []ui.KV{
+		{K: "Name", V: t.Name},
+		{K: "ARN", V: t.ARN},
+		{K: "Display Name", V: t.DisplayName},
+		{K: "Type", V: typ},
+		{K: "Subscriptions", V: fmt.Sprintf("%d confirmed, %d pending, %d deleted", t.Confirmed, t.Pending, t.Deleted)},
+		{K: "Encryption", V: encryption},
+	}
+	return ui.RenderKV(rows, 20, ui.KVValueWidth(tv.width))
+}
+
+func (tv *TopicView) Title() string {
+	return awssns.NameFromARN(tv.arn)
+}
+
+// CapturingInput implements plugin.InputCapturer while the publish prompt
+// or the subscription filter is open.
+func (tv *TopicView) CapturingInput() bool {
+	return tv.prompt != nil || (tv.tabs.Active() == subscriptionsTab && tv.subs.Filtering())
+}
+
+func (tv *TopicView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "[/]", Desc: "switch tab"},
+	}
+	if tv.tabs.Active() == subscriptionsTab {
+		if link, ok := tv.selectedLink(); ok {
+			hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "open " + link.label})
+		}
+		hints = append(hints, plugin.KeyHint{Key: "/", Desc: "filter"})
+	}
+	if !tv.router.ReadOnly() {
+		hints = append(hints, plugin.KeyHint{Key: "m", Desc: "publish message"})
+	}
+	return hints
+}
@@ -0,0 +1,272 @@
+// Package metrics provides the Metrics tab detail views show: CloudWatch
+// metrics of one resource drawn as charts over a selectable time range.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+var (
+	rangeActiveStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	rangeStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+)
+
+// Client defines the subset of cloudwatch.Client methods used by the panel.
+type Client interface {
+	GetMetrics(ctx context.Context, queries []cloudwatch.MetricQuery, r cloudwatch.TimeRange, end time.Time) ([]cloudwatch.MetricSeries, error)
+}
+
+// ClientFor returns the CloudWatch client for a region, "" meaning the
+// session's region.
+type ClientFor func(region string) Client
+
+// Chart is one metric shown by a panel.
+type Chart struct {
+	Title  string
+	Query  cloudwatch.MetricQuery // its ID is assigned by the panel
+	Format func(float64) string
+}
+
+// loadedMsg carries the series of a panel's charts, in chart order.
+type loadedMsg struct {
+	panel  *Panel
+	gen    int
+	series []cloudwatch.MetricSeries
+	err    error
+}
+
+// Panel loads and draws the charts of one resource. The view embedding it
+// forwards messages to Update, and keys to HandleKey while its Metrics tab
+// is shown.
+type Panel struct {
+	client    Client
+	router    plugin.Router
+	charts    []Chart
+	views     []ui.Chart
+	rangeIdx  int
+	width     int
+	gen       int // current load; older results are dropped
+	loading   bool
+	loaded    bool
+	err       error
+	updated   time.Time
+	emptyHint string
+	now       func() time.Time
+}
+
+// NewPanel creates a Panel for charts. It shows that metrics are
+// unavailable until a client is set.
+func NewPanel(router plugin.Router, charts []Chart) *Panel {
+	p := &Panel{router: router, charts: charts, now: time.Now}
+	for i := range p.charts {
+		p.charts[i].Query.ID = fmt.Sprintf("m%d", i)
+		p.views = append(p.views, ui.NewChart(charts[i].Title, charts[i].Format))
+	}
+	return p
+}
+
+// SetClient sets the client metrics are read with. A nil client leaves the
+// panel unavailable.
+func (p *Panel) SetClient(c Client) { p.client = c }
+
+// SetEmptyHint sets the line shown when no chart has datapoints, such as
+// what must be enabled for the metrics to be published.
+func (p *Panel) SetEmptyHint(hint string) { p.emptyHint = hint }
+
+// SetWidth sets the width charts are drawn in.
+func (p *Panel) SetWidth(width int) {
+	p.width = width
+	for i := range p.views {
+		p.views[i].SetSize(min(width-2, 120), 0)
+	}
+}
+
+// Range returns the selected time range.
+func (p *Panel) Range() cloudwatch.TimeRange { return cloudwatch.TimeRanges[p.rangeIdx] }
+
+// LoadOnce loads the charts unless they are loaded or loading, for when the
+// Metrics tab is first shown.
+func (p *Panel) LoadOnce(ctx context.Context) tea.Cmd {
+	if p.loaded || p.loading {
+		return nil
+	}
+	return p.Load(ctx)
+}
+
+// Load reads the charts for the selected range.
+func (p *Panel) Load(ctx context.Context) tea.Cmd {
+	if p.client == nil {
+		return nil
+	}
+	p.gen++
+	p.loading = true
+	client, router, gen, r, end := p.client, p.router, p.gen, p.Range(), p.now()
+	queries := make([]cloudwatch.MetricQuery, len(p.charts))
+	for i, c := range p.charts {
+		queries[i] = c.Query
+	}
+	return func() tea.Msg {
+		var series []cloudwatch.MetricSeries
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			series, err = client.GetMetrics(ctx, queries, r, end)
+			return err
+		})
+		return loadedMsg{panel: p, gen: gen, series: series, err: err}
+	}
+}
+
+// Update applies the panel's own load results, reporting whether msg was
+// one.
+func (p *Panel) Update(msg tea.Msg) bool {
+	m, ok := msg.(loadedMsg)
+	if !ok || m.panel != p {
+		return false
+	}
+	if m.gen != p.gen {
+		return true
+	}
+	p.loading = false
+	p.loaded = true
+	p.err = m.err
+	if m.err != nil {
+		return true
+	}
+	for i := range p.views {
+		var values []float64
+		if i < len(m.series) {
+			values = m.series[i].Values
+		}
+		p.views[i].SetValues(values)
+	}
+	p.updated = p.now()
+	return true
+}
+
+// HandleKey handles t and T, which select the next and previous time range,
+// and r, which reloads the charts. It reports whether key was one of them.
+func (p *Panel) HandleKey(ctx context.Context, key string) (tea.Cmd, bool) {
+	switch key {
+	case "t":
+		p.rangeIdx = (p.rangeIdx + 1) % len(cloudwatch.TimeRanges)
+	case "T":
+		p.rangeIdx = (p.rangeIdx + len(cloudwatch.TimeRanges) - 1) % len(cloudwatch.TimeRanges)
+	case "r":
+	default:
+		return nil, false
+	}
+	return p.Load(ctx), true
+}
+
+// KeyHints returns the hints for the panel's keys.
+func (p *Panel) KeyHints() []plugin.KeyHint {
+	if p.client == nil {
+		return nil
+	}
+	return []plugin.KeyHint{
+		{Key: "t/T", Desc: "time range (" + p.Range().Label + ")"},
+		{Key: "r", Desc: "refresh"},
+	}
+}
+
+// View returns the range selector followed by the charts.
+func (p *Panel) View() string {
+	if p.client == nil {
+		return "Metrics are unavailable."
+	}
+
+	var b strings.Builder
+	b.WriteString("Range: ")
+	for i, r := range cloudwatch.TimeRanges {
+		style := rangeStyle
+		if i == p.rangeIdx {
+			style = rangeActiveStyle
+		}
+		b.WriteString(style.Render(r.Label))
+		b.WriteString("  ")
+	}
+	if !p.updated.IsZero() {
+		b.WriteString(rangeStyle.Render("updated " + p.updated.Format("15:04:05")))
+	}
+	b.WriteString("\n\n")
+
+	switch {
+	case p.err != nil:
+		b.WriteString("Error: " + p.err.Error())
+		return b.String()
+	case !p.loaded:
+		b.WriteString(ui.NewSkeleton(60, 6).View())
+		return b.String()
+	}
+
+	for i, c := range p.views {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(c.View())
+	}
+	if p.emptyHint != "" && p.empty() {
+		b.WriteString("\n\n")
+		b.WriteString(rangeStyle.Render(p.emptyHint))
+	}
+	return b.String()
+}
+
+// empty reports whether no chart has datapoints.
+func (p *Panel) empty() bool {
+	for _, c := range p.views {
+		if c.Len() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Percent formats a percentage.
+func Percent(v float64) string { return fmt.Sprintf("%.1f%%", v) }
+
+// Bytes formats a byte count with a binary unit.
+func Bytes(v float64) string {
+	switch {
+	case v >= 1<<30:
+		return fmt.Sprintf("%.1f GB", v/(1<<30))
+	case v >= 1<<20:
+		return fmt.Sprintf("%.1f MB", v/(1<<20))
+	case v >= 1<<10:
+		return fmt.Sprintf("%.1f KB", v/(1<<10))
+	default:
+		return fmt.Sprintf("%.0f B", v)
+	}
+}
+
+// Count formats a count, abbreviating thousands and millions.
+func Count(v float64) string {
+	switch {
+	case v >= 1e6:
+		return fmt.Sprintf("%.1fM", v/1e6)
+	case v >= 1e4:
+		return fmt.Sprintf("%.1fk", v/1e3)
+	case v == float64(int64(v)):
+		return fmt.Sprintf("%d", int64(v))
+	default:
+		return fmt.Sprintf("%.1f", v)
+	}
+}
+
+// Seconds formats a duration given in seconds, in milliseconds below one
+// second.
+func Seconds(v float64) string {
+	if v < 1 {
+		return fmt.Sprintf("%.0fms", v*1000)
+	}
+	return fmt.Sprintf("%.2fs", v)
+}
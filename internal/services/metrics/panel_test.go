@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tasnim.dev/aws-tui/internal/aws/cloudwatch"
+	"tasnim.dev/aws-tui/internal/plugin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRouter struct{}
+
+func (m *mockRouter) Push(_ plugin.View)                    {}
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_ string, _ string)     {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+type mockClient struct {
+	queries []cloudwatch.MetricQuery
+	ranges  []cloudwatch.TimeRange
+	series  []cloudwatch.MetricSeries
+	err     error
+}
+
+func (m *mockClient) GetMetrics(_ context.Context, queries []cloudwatch.MetricQuery, r cloudwatch.TimeRange, _ time.Time) ([]cloudwatch.MetricSeries, error) {
+	m.queries, m.ranges = queries, append(m.ranges, r)
+	return m.series, m.err
+}
+
+func newPanel(client Client) *Panel {
+	p := NewPanel(&mockRouter{}, []Chart{
+		{Title: "CPU Utilization", Query: cloudwatch.MetricQuery{Namespace: "AWS/EC2", Metric: "CPUUtilization", Stat: "Average"}, Format: Percent},
+		{Title: "Network In", Query: cloudwatch.MetricQuery{Namespace: "AWS/EC2", Metric: "NetworkIn", Stat: "Sum"}, Format: Bytes},
+	})
+	p.SetClient(client)
+	return p
+}
+
+func TestPanel_LoadAndRange(t *testing.T) {
+	client := &mockClient{series: []cloudwatch.MetricSeries{{Values: []float64{10, 50}}, {}}}
+	p := newPanel(client)
+	ctx := context.Background()
+
+	cmd := p.LoadOnce(ctx)
+	require.NotNil(t, cmd)
+	assert.Nil(t, p.LoadOnce(ctx), "a load in flight is not repeated")
+	assert.True(t, p.Update(cmd()))
+	assert.Nil(t, p.LoadOnce(ctx))
+
+	require.Len(t, client.queries, 2)
+	assert.Equal(t, "m0", client.queries[0].ID)
+	assert.Equal(t, "m1", client.queries[1].ID)
+	out := p.View()
+	assert.Contains(t, out, "CPU Utilization")
+	assert.Contains(t, out, "max 50.0%")
+	assert.Contains(t, out, "no datapoints")
+
+	// t and T step through the ranges, wrapping around.
+	cmd, ok := p.HandleKey(ctx, "t")
+	require.True(t, ok)
+	p.Update(cmd())
+	cmd, _ = p.HandleKey(ctx, "T")
+	p.Update(cmd())
+	cmd, _ = p.HandleKey(ctx, "T")
+	p.Update(cmd())
+	labels := make([]string, len(client.ranges))
+	for i, r := range client.ranges {
+		labels[i] = r.Label
+	}
+	assert.Equal(t, []string{"1h", "6h", "1h", "7d"}, labels)
+	assert.Contains(t, p.KeyHints(), plugin.KeyHint{Key: "t/T", Desc: "time range (7d)"})
+
+	_, ok = p.HandleKey(ctx, "x")
+	assert.False(t, ok)
+}
+
+func TestPanel_DropsStaleLoads(t *testing.T) {
+	client := &mockClient{series: []cloudwatch.MetricSeries{{Values: []float64{1}}, {Values: []float64{2}}}}
+	p := newPanel(client)
+	ctx := context.Background()
+
+	stale := p.Load(ctx)
+	fresh, _ := p.HandleKey(ctx, "t")
+	p.Update(fresh())
+	client.series = []cloudwatch.MetricSeries{{Values: []float64{99}}, {}}
+	assert.True(t, p.Update(stale()), "a stale load is still the panel's")
+	assert.NotContains(t, p.View(), "99")
+
+	other := newPanel(client)
+	assert.False(t, p.Update(other.Load(ctx)()), "loads of another panel are ignored")
+}
+
+func TestPanel_ErrorsAndUnavailable(t *testing.T) {
+	p := newPanel(&mockClient{err: errors.New("AccessDenied")})
+	p.Update(p.Load(context.Background())())
+	assert.Contains(t, p.View(), "Error: AccessDenied")
+
+	p = newPanel(nil)
+	assert.Nil(t, p.LoadOnce(context.Background()))
+	assert.Equal(t, "Metrics are unavailable.", p.View())
+	assert.Empty(t, p.KeyHints())
+
+	p = newPanel(&mockClient{})
+	p.SetEmptyHint("Enable Container Insights to publish these metrics.")
+	p.Update(p.Load(context.Background())())
+	assert.Contains(t, p.View(), "Enable Container Insights")
+}
+
+func TestFormats(t *testing.T) {
+	assert.Equal(t, "12.3%", Percent(12.34))
+	assert.Equal(t, "512 B", Bytes(512))
+	assert.Equal(t, "1.5 MB", Bytes(1.5*(1<<20)))
+	assert.Equal(t, "42", Count(42))
+	assert.Equal(t, "12.5k", Count(12500))
+	assert.Equal(t, "2.0M", Count(2e6))
+	assert.Equal(t, "120ms", Seconds(0.12))
+	assert.Equal(t, "1.50s", Seconds(1.5))
+}
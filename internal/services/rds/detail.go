@@ -0,0 +1,455 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	awsrds "tasnim.dev/aws-tui/internal/aws/rds"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Tabs of the detail view.
+const (
+	overviewTab    = 0
+	networkTab     = 1
+	maintenanceTab = 2
+	snapshotsTab   = 3
+)
+
+var sectionStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+
+// networkLink is a subnet or security group of a database, opened in the
+// VPC plugin.
+type networkLink struct {
+	Kind string
+	ID   string
+}
+
+// detailLoadedMsg carries an instance or cluster with its pending
+// maintenance and snapshots. Only a failure to read the database itself is
+// fatal.
+type detailLoadedMsg struct {
+	instance       *awsrds.Instance
+	cluster        *awsrds.Cluster
+	maintenance    []awsrds.MaintenanceAction
+	maintenanceErr error
+	snapshots      []awsrds.Snapshot
+	snapshotsErr   error
+	err            error
+}
+
+// DetailView shows a DB instance or cluster: its configuration, network
+// placement, pending maintenance and snapshots.
+type DetailView struct {
+	client RDSClient
+	router plugin.Router
+	// id is the DB identifier; isCluster records whether it names a
+	// cluster rather than an instance.
+	id        string
+	isCluster bool
+
+	instance       *awsrds.Instance
+	cluster        *awsrds.Cluster
+	maintenance    []awsrds.MaintenanceAction
+	maintenanceErr error
+	snapshotsErr   error
+
+	tabs      ui.TabController
+	network   ui.TableView[networkLink]
+	snapshots ui.TableView[awsrds.Snapshot]
+	loading   bool
+	err       error
+	width     int
+}
+
+// NewDetailView creates a DetailView for id, which is "instance:<id>" or
+// "cluster:<id>" as in the list view. A bare identifier names an instance.
+func NewDetailView(client RDSClient, router plugin.Router, id string) *DetailView {
+	isCluster := false
+	if name, ok := strings.CutPrefix(id, "cluster:"); ok {
+		id, isCluster = name, true
+	} else {
+		id = strings.TrimPrefix(id, "instance:")
+	}
+
+	networkCols := []ui.Column[networkLink]{
+		{Title: "Type", Width: 16, Field: func(l networkLink) string { return l.Kind }},
+		{Title: "ID", Width: 28, Field: func(l networkLink) string { return l.ID }},
+	}
+	snapshotCols := []ui.Column[awsrds.Snapshot]{
+		{Title: "Snapshot", Width: 40, Field: func(s awsrds.Snapshot) string { return s.ID }},
+		{Title: "Type", Width: 10, Field: func(s awsrds.Snapshot) string { return s.Type }},
+		{Title: "Status", Width: 12, Field: func(s awsrds.Snapshot) string { return s.Status }},
+		{Title: "Created", Width: 18, Field: func(s awsrds.Snapshot) string { return ui.FormatTime(s.CreatedAt) }},
+		{Title: "Storage", Width: 10, Field: func(s awsrds.Snapshot) string { return storage(s.StorageGB) }},
+	}
+
+	return &DetailView{
+		client:    client,
+		router:    router,
+		id:        id,
+		isCluster: isCluster,
+		tabs:      ui.NewTabController([]string{"Overview", "Network", "Maintenance", "Snapshots"}),
+		network:   ui.NewTableView(networkCols, nil, func(l networkLink) string { return l.ID }),
+		snapshots: ui.NewTableView(snapshotCols, nil, func(s awsrds.Snapshot) string { return s.ID }),
+		loading:   true,
+	}
+}
+
+func (dv *DetailView) load() tea.Cmd {
+	client, router := dv.client, dv.router
+	id, isCluster := dv.id, dv.isCluster
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		var msg detailLoadedMsg
+		var arn string
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) error {
+			if isCluster {
+				cl, err := client.GetCluster(ctx, id)
+				msg.cluster, arn = &cl, cl.ARN
+				return err
+			}
+			inst, err := client.GetInstance(ctx, id)
+			msg.instance, arn = &inst, inst.ARN
+			return err
+		})
+		if err != nil {
+			return detailLoadedMsg{err: err}
+		}
+		msg.maintenance, msg.maintenanceErr = client.ListPendingMaintenance(ctx, arn)
+		if isCluster {
+			msg.snapshots, msg.snapshotsErr = client.ListClusterSnapshots(ctx, id)
+		} else {
+			msg.snapshots, msg.snapshotsErr = client.ListSnapshots(ctx, id)
+		}
+		return msg
+	}
+}
+
+func (dv *DetailView) Init() tea.Cmd {
+	return dv.load()
+}
+
+func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case detailLoadedMsg:
+		dv.loading = false
+		if msg.err != nil {
+			dv.err = msg.err
+			return dv, nil
+		}
+		dv.err = nil
+		dv.instance, dv.cluster = msg.instance, msg.cluster
+		dv.maintenance, dv.maintenanceErr = msg.maintenance, msg.maintenanceErr
+		dv.snapshots.SetItems(msg.snapshots)
+		dv.snapshotsErr = msg.snapshotsErr
+		dv.network.SetItems(dv.networkLinks())
+		return dv, nil
+
+	case tea.WindowSizeMsg:
+		dv.width = msg.Width
+		return dv, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "esc", "backspace":
+			dv.router.Pop()
+			return dv, nil
+		case "r":
+			if !dv.loading {
+				dv.loading = true
+				return dv, dv.load()
+			}
+			return dv, nil
+		case "enter":
+			if dv.tabs.Active() == networkTab {
+				if id := dv.network.SelectedID(); id != "" {
+					dv.router.NavigateDetail("vpc", dv.vpcID()+"/"+id)
+				}
+			}
+			return dv, nil
+		}
+
+		prev := dv.tabs.Active()
+		var cmd tea.Cmd
+		dv.tabs, cmd = dv.tabs.Update(msg)
+		if dv.tabs.Active() != prev {
+			return dv, cmd
+		}
+
+		var tableCmd tea.Cmd
+		switch dv.tabs.Active() {
+		case networkTab:
+			dv.network, tableCmd = dv.network.Update(msg)
+		case snapshotsTab:
+			dv.snapshots, tableCmd = dv.snapshots.Update(msg)
+		}
+		return dv, tea.Batch(cmd, tableCmd)
+	}
+
+	return dv, nil
+}
+
+// vpcID returns the VPC the database sits in, or "" if unknown.
+func (dv *DetailView) vpcID() string {
+	switch {
+	case dv.instance != nil:
+		return dv.instance.VPCID
+	case dv.cluster != nil:
+		return dv.cluster.VPCID
+	}
+	return ""
+}
+
+// networkLinks lists the subnets and security groups of the database. They
+// are only linkable once its VPC is known.
+func (dv *DetailView) networkLinks() []networkLink {
+	if dv.vpcID() == "" {
+		return nil
+	}
+	var subnets, groups []string
+	if dv.instance != nil {
+		subnets, groups = dv.instance.Subnets, dv.instance.SecurityGroups
+	} else {
+		subnets, groups = dv.cluster.Subnets, dv.cluster.SecurityGroups
+	}
+	var links []networkLink
+	for _, id := range subnets {
+		links = append(links, networkLink{Kind: "Subnet", ID: id})
+	}
+	for _, id := range groups {
+		links = append(links, networkLink{Kind: "Security Group", ID: id})
+	}
+	return links
+}
+
+func (dv *DetailView) View() tea.View {
+	if dv.loading && dv.instance == nil && dv.cluster == nil {
+		skel := ui.NewSkeleton(60, 8)
+		return tea.NewView(skel.View())
+	}
+	if dv.err != nil {
+		return tea.NewView("Error: " + dv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(dv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch dv.tabs.Active() {
+	case overviewTab:
+		if dv.isCluster {
+			b.WriteString(dv.renderClusterOverview())
+		} else {
+			b.WriteString(dv.renderInstanceOverview())
+		}
+	case networkTab:
+		b.WriteString(dv.renderNetwork())
+	case maintenanceTab:
+		b.WriteString(dv.renderMaintenance())
+	case snapshotsTab:
+		if dv.snapshotsErr != nil {
+			b.WriteString("Error: " + dv.snapshotsErr.Error())
+		} else if dv.snapshots.ItemCount() == 0 {
+			b.WriteString("No automated or manual snapshots.")
+		} else {
+			b.WriteString(dv.snapshots.View())
+		}
+	}
+
+	return tea.NewView(b.String())
+}
+
+func (dv *DetailView) renderInstanceOverview() string {
+	i := dv.instance
+	multiAZ := yesNo(i.MultiAZ)
+	if i.AZ != "" {
+		multiAZ += " (primary in " + i.AZ + ")"
+	}
+	endpoint := i.Endpoint
+	if endpoint == "" {
+		endpoint = "-"
+	}
+	rows := []ui.KV{
+		{K: "Identifier", V: i.ID},
+		{K: "ARN", V: i.ARN},
+		{K: "Status", V: i.Status},
+		{K: "Engine", V: engine(i.Engine, i.EngineVersion)},
+		{K: "Class", V: i.Class},
+		{K: "Multi-AZ", V: multiAZ},
+		{K: "Storage", V: storageDetail(i.StorageGB, i.StorageType, i.IOPS)},
+		{K: "Encrypted", V: yesNo(i.Encrypted)},
+		{K: "Endpoint", V: endpoint},
+	}
+	if i.ClusterID != "" {
+		rows = append(rows, ui.KV{K: "Cluster", V: i.ClusterID})
+	}
+	var groups []string
+	for _, pg := range i.ParameterGroups {
+		groups = append(groups, fmt.Sprintf("%s (%s)", pg.Name, pg.Status))
+	}
+	rows = append(rows,
+		ui.KV{K: "Parameter Groups", V: strings.Join(groups, ", ")},
+		ui.KV{K: "Publicly Accessible", V: yesNo(i.PubliclyAccessible)},
+		ui.KV{K: "Deletion Protection", V: yesNo(i.DeletionProtection)},
+		ui.KV{K: "Backups", V: backups(i.BackupRetention, i.BackupWindow)},
+		ui.KV{K: "Created", V: ui.FormatTime(i.CreatedAt)},
+	)
+	return ui.RenderKV(rows, 20, ui.KVValueWidth(dv.width))
+}
+
+func (dv *DetailView) renderClusterOverview() string {
+	c := dv.cluster
+	mode := c.EngineMode
+	if c.Capacity != "" {
+		mode += ", Serverless v2 " + c.Capacity
+	}
+	var instances []string
+	for _, m := range c.Members {
+		if m.Writer {
+			instances = append(instances, m.InstanceID+" (writer)")
+		} else {
+			instances = append(instances, m.InstanceID)
+		}
+	}
+	rows := []ui.KV{
+		{K: "Identifier", V: c.ID},
+		{K: "ARN", V: c.ARN},
+		{K: "Status", V: c.Status},
+		{K: "Engine", V: engine(c.Engine, c.EngineVersion)},
+		{K: "Mode", V: mode},
+		{K: "Multi-AZ", V: yesNo(c.MultiAZ)},
+		{K: "Storage", V: storageDetail(c.StorageGB, c.StorageType, 0)},
+		{K: "Encrypted", V: yesNo(c.Encrypted)},
+		{K: "Writer Endpoint", V: c.Endpoint},
+		{K: "Reader Endpoint", V: c.ReaderEndpoint},
+		{K: "Instances", V: strings.Join(instances, ", ")},
+		{K: "Parameter Group", V: c.ParameterGroup},
+		{K: "Subnet Group", V: c.SubnetGroup},
+		{K: "Deletion Protection", V: yesNo(c.DeletionProtection)},
+		{K: "Backups", V: backups(c.BackupRetention, c.BackupWindow)},
+		{K: "Created", V: ui.FormatTime(c.CreatedAt)},
+	}
+	return ui.RenderKV(rows, 20, ui.KVValueWidth(dv.width))
+}
+
+// storageDetail describes allocated storage, e.g. "100 GB gp3, 3000 IOPS".
+func storageDetail(gb int, kind string, iops int) string {
+	s := storage(gb)
+	if gb <= 1 {
+		s = "grows on demand"
+	}
+	if kind != "" {
+		s += " " + kind
+	}
+	if iops > 0 {
+		s += fmt.Sprintf(", %d IOPS", iops)
+	}
+	return s
+}
+
+func backups(retention int, window string) string {
+	if retention == 0 {
+		return "disabled"
+	}
+	s := fmt.Sprintf("%d %s", retention, plural(retention, "day"))
+	if window != "" {
+		s += ", daily at " + window + " UTC"
+	}
+	return s
+}
+
+func (dv *DetailView) renderNetwork() string {
+	vpcID := dv.vpcID()
+	if vpcID == "" {
+		return "This database is not in a VPC."
+	}
+	var b strings.Builder
+	b.WriteString(ui.RenderKV([]ui.KV{{K: "VPC", V: vpcID}}, 20, ui.KVValueWidth(dv.width)))
+	b.WriteString("\n\n")
+	if dv.network.ItemCount() == 0 {
+		b.WriteString("No subnets or security groups.")
+		return b.String()
+	}
+	b.WriteString(dv.network.View())
+	return b.String()
+}
+
+func (dv *DetailView) renderMaintenance() string {
+	var b strings.Builder
+	window, pending := "", []string(nil)
+	if dv.instance != nil {
+		window, pending = dv.instance.MaintenanceWindow, dv.instance.PendingChanges
+	} else {
+		window = dv.cluster.MaintenanceWindow
+	}
+	if window != "" {
+		b.WriteString(ui.RenderKV([]ui.KV{{K: "Window", V: window + " UTC"}}, 20, ui.KVValueWidth(dv.width)))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(sectionStyle.Render("Pending Actions"))
+	b.WriteString("\n")
+	switch {
+	case dv.maintenanceErr != nil:
+		b.WriteString("Error: " + dv.maintenanceErr.Error() + "\n")
+	case len(dv.maintenance) == 0:
+		b.WriteString("No pending maintenance.\n")
+	}
+	for _, a := range dv.maintenance {
+		b.WriteString(fmt.Sprintf("%-22s %s\n", a.Action, a.Description))
+		if when := applyDate(a); when != "" {
+			b.WriteString(fmt.Sprintf("%-22s %s\n", "", when))
+		}
+	}
+
+	if len(pending) > 0 {
+		b.WriteString("\n")
+		b.WriteString(sectionStyle.Render("Pending Modifications"))
+		b.WriteString("\n")
+		for _, c := range pending {
+			b.WriteString(c + "\n")
+		}
+	}
+	return b.String()
+}
+
+// applyDate describes when a maintenance action will be applied.
+func applyDate(a awsrds.MaintenanceAction) string {
+	var parts []string
+	if !a.CurrentApply.IsZero() {
+		parts = append(parts, "applies "+ui.FormatTime(a.CurrentApply))
+	}
+	if !a.AutoAppliedAfter.IsZero() {
+		parts = append(parts, "auto-applied after "+ui.FormatTime(a.AutoAppliedAfter))
+	}
+	if !a.ForcedApply.IsZero() {
+		parts = append(parts, "forced on "+ui.FormatTime(a.ForcedApply))
+	}
+	if a.OptIn != "" {
+		parts = append(parts, "opt-in: "+a.OptIn)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (dv *DetailView) Title() string {
+	return dv.id
+}
+
+func (dv *DetailView) KeyHints() []plugin.KeyHint {
+	hints := []plugin.KeyHint{
+		{Key: "esc", Desc: "back"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "[/]", Desc: "switch tab"},
+		{Key: "1-4", Desc: "jump to tab"},
+	}
+	if dv.tabs.Active() == networkTab && dv.network.ItemCount() > 0 {
+		hints = append(hints, plugin.KeyHint{Key: "enter", Desc: "open in VPC"})
+	}
+	return hints
+}
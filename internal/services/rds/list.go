@@ -0,0 +1,314 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	awsrds "tasnim.dev/aws-tui/internal/aws/rds"
+	"tasnim.dev/aws-tui/internal/cache"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// Cache service keys, one per tab. Cached IDs match the table IDs, which are
+// also the IDs DetailView accepts.
+const (
+	instancesCacheKey = "rds:instances"
+	clustersCacheKey  = "rds:clusters"
+)
+
+// Tabs of the list view.
+const (
+	instancesTab = 0
+	clustersTab  = 1
+)
+
+// Fetch result messages.
+type instancesMsg struct {
+	instances []awsrds.Instance
+	err       error
+}
+
+type clustersMsg struct {
+	clusters []awsrds.Cluster
+	err      error
+}
+
+// cachedMsg carries both tabs read from the local cache.
+type cachedMsg struct {
+	instances []awsrds.Instance
+	clusters  []awsrds.Cluster
+	plugin.CacheEntry
+}
+
+// ListView displays DB instances and clusters in a tabbed table view.
+type ListView struct {
+	plugin.Revalidation
+
+	client RDSClient
+	router plugin.Router
+
+	tabs      ui.TabController
+	instances ui.TableView[awsrds.Instance]
+	clusters  ui.TableView[awsrds.Cluster]
+
+	loading bool
+	err     error
+
+	cache *cache.Scope
+}
+
+// NewListView creates a new RDS ListView with tabs for Instances and
+// Clusters.
+func NewListView(client RDSClient, router plugin.Router) *ListView {
+	instanceCols := []ui.Column[awsrds.Instance]{
+		{Title: "Identifier", Width: 28, Field: func(i awsrds.Instance) string { return i.ID }},
+		{Title: "Engine", Width: 24, Field: func(i awsrds.Instance) string { return engine(i.Engine, i.EngineVersion) }},
+		{Title: "Class", Width: 16, Field: func(i awsrds.Instance) string { return i.Class }},
+		{Title: "Status", Width: 14, Field: func(i awsrds.Instance) string { return i.Status }},
+		{Title: "Multi-AZ", Width: 9, Field: func(i awsrds.Instance) string { return yesNo(i.MultiAZ) }},
+		{Title: "Storage", Width: 10, Field: func(i awsrds.Instance) string { return storage(i.StorageGB) }},
+		{Title: "Cluster", Width: 20, Field: func(i awsrds.Instance) string { return i.ClusterID }},
+	}
+
+	clusterCols := []ui.Column[awsrds.Cluster]{
+		{Title: "Identifier", Width: 28, Field: func(c awsrds.Cluster) string { return c.ID }},
+		{Title: "Engine", Width: 28, Field: func(c awsrds.Cluster) string { return engine(c.Engine, c.EngineVersion) }},
+		{Title: "Status", Width: 14, Field: func(c awsrds.Cluster) string { return c.Status }},
+		{Title: "Instances", Width: 20, Field: func(c awsrds.Cluster) string { return members(c.Members) }},
+		{Title: "Capacity", Width: 14, Field: func(c awsrds.Cluster) string {
+			if c.Capacity != "" {
+				return c.Capacity
+			}
+			return c.EngineMode
+		}},
+		{Title: "Multi-AZ", Width: 9, Field: func(c awsrds.Cluster) string { return yesNo(c.MultiAZ) }},
+	}
+
+	return &ListView{
+		client:    client,
+		router:    router,
+		tabs:      ui.NewTabController([]string{"Instances", "Clusters"}),
+		instances: ui.NewTableView(instanceCols, nil, func(i awsrds.Instance) string { return "instance:" + i.ID }),
+		clusters:  ui.NewTableView(clusterCols, nil, func(c awsrds.Cluster) string { return "cluster:" + c.ID }),
+		loading:   true,
+	}
+}
+
+// engine joins an engine and its version, e.g. "postgres 16.3".
+func engine(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + " " + version
+}
+
+// storage formats allocated storage, which Aurora reports as 1 GB because
+// its storage grows on demand.
+func storage(gb int) string {
+	if gb <= 1 {
+		return "-"
+	}
+	return fmt.Sprintf("%d GB", gb)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// members summarises a cluster's instances, e.g. "1 writer, 2 readers".
+func members(ms []awsrds.ClusterMember) string {
+	writers := 0
+	for _, m := range ms {
+		if m.Writer {
+			writers++
+		}
+	}
+	readers := len(ms) - writers
+	return fmt.Sprintf("%d writer, %d %s", writers, readers, plural(readers, "reader"))
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+// fetchAll reloads both tabs.
+func (lv *ListView) fetchAll() tea.Cmd {
+	lv.Expect(2)
+	return tea.Batch(lv.fetchInstances(), lv.fetchClusters())
+}
+
+func (lv *ListView) fetchInstances() tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var instances []awsrds.Instance
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			instances, err = client.ListInstances(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, instancesCacheKey, instances, func(i awsrds.Instance) (string, string) {
+				return "instance:" + i.ID, i.ID
+			})
+		}
+		return instancesMsg{instances: instances, err: err}
+	}
+}
+
+func (lv *ListView) fetchClusters() tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
+	return func() tea.Msg {
+		var clusters []awsrds.Cluster
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			clusters, err = client.ListClusters(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, clustersCacheKey, clusters, func(c awsrds.Cluster) (string, string) {
+				return "cluster:" + c.ID, c.ID
+			})
+		}
+		return clustersMsg{clusters: clusters, err: err}
+	}
+}
+
+func (lv *ListView) Init() tea.Cmd {
+	fetch := lv.fetchAll()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCached(lv.cache, fetch, func(r *plugin.CacheReader) tea.Msg {
+			instances := plugin.ReadCached[awsrds.Instance](r, instancesCacheKey)
+			clusters := plugin.ReadCached[awsrds.Cluster](r, clustersCacheKey)
+			return cachedMsg{instances: instances, clusters: clusters, CacheEntry: r.Entry()}
+		})
+	}
+	return fetch
+}
+
+func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case cachedMsg:
+		lv.loading = false
+		lv.instances.SetItems(msg.instances)
+		lv.clusters.SetItems(msg.clusters)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchAll()
+		}
+		return lv, nil
+
+	case instancesMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.instances.SetItems(msg.instances)
+		return lv, nil
+
+	case clustersMsg:
+		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
+		if msg.err != nil {
+			lv.err = msg.err
+			return lv, nil
+		}
+		lv.clusters.SetItems(msg.clusters)
+		return lv, nil
+
+	case tea.KeyPressMsg:
+		if lv.loading {
+			return lv, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if id := lv.selectedID(); id != "" {
+				view := NewDetailView(lv.client, lv.router, id)
+				lv.router.Push(view)
+				return lv, view.Init()
+			}
+			return lv, nil
+		case "esc", "backspace":
+			lv.router.Pop()
+			return lv, nil
+		case "r":
+			lv.loading = true
+			return lv, lv.fetchAll()
+		}
+	}
+
+	// Forward to tab controller first.
+	var cmd tea.Cmd
+	lv.tabs, cmd = lv.tabs.Update(msg)
+
+	// Forward to the active table.
+	var tableCmd tea.Cmd
+	switch lv.tabs.Active() {
+	case instancesTab:
+		lv.instances, tableCmd = lv.instances.Update(msg)
+	case clustersTab:
+		lv.clusters, tableCmd = lv.clusters.Update(msg)
+	}
+
+	return lv, tea.Batch(cmd, tableCmd)
+}
+
+func (lv *ListView) selectedID() string {
+	switch lv.tabs.Active() {
+	case instancesTab:
+		return lv.instances.SelectedID()
+	case clustersTab:
+		return lv.clusters.SelectedID()
+	}
+	return ""
+}
+
+func (lv *ListView) View() tea.View {
+	if lv.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(skel.View())
+	}
+	if lv.err != nil {
+		return tea.NewView("Error: " + lv.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(lv.tabs.View())
+	b.WriteString("\n\n")
+
+	switch lv.tabs.Active() {
+	case instancesTab:
+		b.WriteString(lv.instances.View())
+	case clustersTab:
+		b.WriteString(lv.clusters.View())
+	}
+
+	return tea.NewView(b.String())
+}
+
+func (lv *ListView) Title() string { return "RDS" }
+
+func (lv *ListView) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view details"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+		{Key: "[/]", Desc: "switch tab"},
+	}
+}
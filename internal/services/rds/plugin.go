@@ -0,0 +1,86 @@
+package rds
+
+import (
+	"context"
+	"time"
+
+	awsrds "tasnim.dev/aws-tui/internal/aws/rds"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+// RDSClient defines the subset of rds.Client methods used by the plugin.
+type RDSClient interface {
+	ListInstances(ctx context.Context) ([]awsrds.Instance, error)
+	GetInstance(ctx context.Context, id string) (awsrds.Instance, error)
+	ListClusters(ctx context.Context) ([]awsrds.Cluster, error)
+	GetCluster(ctx context.Context, id string) (awsrds.Cluster, error)
+	ListPendingMaintenance(ctx context.Context, arn string) ([]awsrds.MaintenanceAction, error)
+	ListSnapshots(ctx context.Context, instanceID string) ([]awsrds.Snapshot, error)
+	ListClusterSnapshots(ctx context.Context, clusterID string) ([]awsrds.Snapshot, error)
+}
+
+// Plugin implements plugin.ServicePlugin for Amazon RDS and Aurora.
+type Plugin struct {
+	plugin.CacheHolder
+
+	client RDSClient
+}
+
+// NewPlugin creates a new RDS service plugin.
+func NewPlugin(client RDSClient) *Plugin {
+	return &Plugin{client: client}
+}
+
+func (p *Plugin) ID() string   { return "rds" }
+func (p *Plugin) Name() string { return "RDS" }
+func (p *Plugin) Icon() string { return "\U000F01BC" } // nf-md-database
+
+// Summary counts DB instances by status. Any instance that is not
+// available, whether stopped, failed or mid-change, makes the service a
+// warning.
+func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
+	instances, err := p.client.ListInstances(ctx)
+	if err != nil {
+		return plugin.ServiceSummary{}, err
+	}
+
+	status := make(map[string]int)
+	health := plugin.HealthHealthy
+	for _, i := range instances {
+		status[i.Status]++
+		if i.Status != "available" {
+			health = plugin.HealthWarning
+		}
+	}
+	return plugin.ServiceSummary{
+		Total:  len(instances),
+		Status: status,
+		Health: health,
+		Label:  "instances",
+	}, nil
+}
+
+func (p *Plugin) ListView(router plugin.Router) plugin.View {
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
+}
+
+func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
+	return NewDetailView(p.client, router, id)
+}
+
+func (p *Plugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{
+			Title:    "RDS Databases",
+			Keywords: []string{"rds", "aurora", "database", "db", "postgres", "mysql"},
+		},
+	}
+}
+
+func (p *Plugin) PollConfig() plugin.PollConfig {
+	return plugin.PollConfig{
+		IdleInterval: 2 * time.Minute,
+	}
+}
@@ -0,0 +1,203 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsrds "tasnim.dev/aws-tui/internal/aws/rds"
+	"tasnim.dev/aws-tui/internal/plugin"
+)
+
+type mockClient struct {
+	instances   []awsrds.Instance
+	clusters    []awsrds.Cluster
+	maintenance []awsrds.MaintenanceAction
+	snapshots   []awsrds.Snapshot
+	err         error
+}
+
+func (m *mockClient) ListInstances(_ context.Context) ([]awsrds.Instance, error) {
+	return m.instances, m.err
+}
+
+func (m *mockClient) GetInstance(_ context.Context, id string) (awsrds.Instance, error) {
+	for _, i := range m.instances {
+		if i.ID == id {
+			return i, nil
+		}
+	}
+	return awsrds.Instance{}, errors.New("DB instance " + id + " not found")
+}
+
+func (m *mockClient) ListClusters(_ context.Context) ([]awsrds.Cluster, error) {
+	return m.clusters, m.err
+}
+
+func (m *mockClient) GetCluster(_ context.Context, id string) (awsrds.Cluster, error) {
+	for _, c := range m.clusters {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return awsrds.Cluster{}, errors.New("DB cluster " + id + " not found")
+}
+
+func (m *mockClient) ListPendingMaintenance(_ context.Context, _ string) ([]awsrds.MaintenanceAction, error) {
+	return m.maintenance, nil
+}
+
+func (m *mockClient) ListSnapshots(_ context.Context, _ string) ([]awsrds.Snapshot, error) {
+	return m.snapshots, nil
+}
+
+func (m *mockClient) ListClusterSnapshots(_ context.Context, _ string) ([]awsrds.Snapshot, error) {
+	return m.snapshots, nil
+}
+
+type mockRouter struct {
+	pushed []plugin.View
+	detail []string // pluginID and id of NavigateDetail calls
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(pluginID, id string)    { m.detail = append(m.detail, pluginID, id) }
+func (m *mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+func key(s string) tea.KeyPressMsg {
+	switch s {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	}
+	return tea.KeyPressMsg{Code: rune(s[0]), Text: s}
+}
+
+func ordersDB() awsrds.Instance {
+	return awsrds.Instance{
+		ID:              "orders-db",
+		ARN:             "arn:aws:rds:us-east-1:123456789012:db:orders-db",
+		Status:          "available",
+		Engine:          "postgres",
+		EngineVersion:   "16.3",
+		Class:           "db.t4g.medium",
+		MultiAZ:         true,
+		StorageGB:       100,
+		StorageType:     "gp3",
+		Endpoint:        "orders-db.abc.rds.amazonaws.com:5432",
+		ParameterGroups: []awsrds.ParameterGroup{{Name: "pg16-tuned", Status: "pending-reboot"}},
+		VPCID:           "vpc-1",
+		Subnets:         []string{"subnet-a", "subnet-b"},
+		SecurityGroups:  []string{"sg-1"},
+	}
+}
+
+func TestPluginMetadata(t *testing.T) {
+	p := NewPlugin(nil)
+	assert.Equal(t, "rds", p.ID())
+	assert.Equal(t, "RDS", p.Name())
+	assert.NotEmpty(t, p.Icon())
+	require.Len(t, p.Commands(), 1)
+	assert.Contains(t, p.Commands()[0].Keywords, "aurora")
+}
+
+func TestSummary(t *testing.T) {
+	client := &mockClient{instances: []awsrds.Instance{ordersDB(), {ID: "reports", Status: "available"}}}
+	s, err := NewPlugin(client).Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Total)
+	assert.Equal(t, plugin.HealthHealthy, s.Health)
+	assert.Equal(t, "instances", s.Label)
+
+	// Any instance that is not available is a warning.
+	client.instances = append(client.instances, awsrds.Instance{ID: "legacy", Status: "stopped"})
+	s, err = NewPlugin(client).Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, plugin.HealthWarning, s.Health)
+	assert.Equal(t, map[string]int{"available": 2, "stopped": 1}, s.Status)
+
+	_, err = NewPlugin(&mockClient{err: errors.New("AccessDenied")}).Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestListViewOpensInstancesAndClusters(t *testing.T) {
+	client := &mockClient{
+		instances: []awsrds.Instance{ordersDB()},
+		clusters: []awsrds.Cluster{{
+			ID:      "analytics",
+			Engine:  "aurora-postgresql",
+			Status:  "available",
+			Members: []awsrds.ClusterMember{{InstanceID: "analytics-1", Writer: true}, {InstanceID: "analytics-2"}},
+		}},
+	}
+	router := &mockRouter{}
+	lv := NewPlugin(client).ListView(router).(*ListView)
+	cmd := lv.Init()
+	for _, msg := range cmd().(tea.BatchMsg) {
+		lv.Update(msg())
+	}
+
+	assert.Equal(t, "instance:orders-db", lv.selectedID())
+	assert.Contains(t, lv.View().Content, "db.t4g.medium")
+
+	lv.Update(key("]"))
+	assert.Equal(t, "cluster:analytics", lv.selectedID())
+	assert.Contains(t, lv.View().Content, "1 writer, 1 reader")
+
+	lv.Update(key("enter"))
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "analytics", router.pushed[0].Title())
+}
+
+func TestDetailViewOverviewAndMaintenance(t *testing.T) {
+	db := ordersDB()
+	db.PendingChanges = []string{"class → db.r6g.large"}
+	client := &mockClient{
+		instances:   []awsrds.Instance{db},
+		maintenance: []awsrds.MaintenanceAction{{Action: "system-update", Description: "New Operating System update is available"}},
+	}
+	dv := NewPlugin(client).DetailView(&mockRouter{}, "instance:orders-db").(*DetailView)
+	dv.Update(dv.Init()())
+
+	view := dv.View().Content
+	assert.Contains(t, view, "postgres 16.3")
+	assert.Contains(t, view, "pg16-tuned (pending-reboot)")
+	assert.Contains(t, view, "100 GB gp3")
+
+	dv.Update(key("3"))
+	view = dv.View().Content
+	assert.Contains(t, view, "system-update")
+	assert.Contains(t, view, "class → db.r6g.large")
+
+	dv.Update(key("4"))
+	assert.Contains(t, dv.View().Content, "No automated or manual snapshots.")
+}
+
+func TestDetailViewLinksToVPC(t *testing.T) {
+	client := &mockClient{instances: []awsrds.Instance{ordersDB()}}
+	router := &mockRouter{}
+	dv := NewPlugin(client).DetailView(router, "instance:orders-db").(*DetailView)
+	dv.Update(dv.Init()())
+
+	// Enter does nothing outside the Network tab.
+	dv.Update(key("enter"))
+	assert.Empty(t, router.detail)
+
+	// Security groups sort before subnets.
+	dv.Update(key("2"))
+	dv.Update(key("enter"))
+	assert.Equal(t, []string{"vpc", "vpc-1/sg-1"}, router.detail)
+
+	dv.Update(key("j"))
+	dv.Update(key("enter"))
+	assert.Equal(t, []string{"vpc", "vpc-1/sg-1", "vpc", "vpc-1/subnet-a"}, router.detail)
+}
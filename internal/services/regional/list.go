@@ -0,0 +1,211 @@
+// Package regional provides the list view service plugins show in
+// all-regions scope.
+package regional
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	internalaws "tasnim.dev/aws-tui/internal/aws"
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+)
+
+// regionTimeout bounds the list call in a single region.
+const regionTimeout = 30 * time.Second
+
+var (
+	summaryStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("245"))
+
+	regionErrStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196"))
+)
+
+// Row pairs a resource with the region it was listed in.
+type Row[T any] struct {
+	Region string
+	Item   T
+}
+
+// RegionError is a region whose list call failed.
+type RegionError struct {
+	Region string
+	Err    error
+}
+
+// resultsMsg carries the outcome of a fan-out.
+type resultsMsg[T any] struct {
+	rows   []Row[T]
+	failed []RegionError
+}
+
+// ListFunc lists resources in one region.
+type ListFunc[T any] func(ctx context.Context, region string) ([]T, error)
+
+// OpenFunc returns the view to push when a row is selected.
+type OpenFunc[T any] func(router plugin.Router, region string, item T) plugin.View
+
+// ListView merges one plugin's resources from every region in a scope into a
+// single table with a Region column. Regions whose call fails are listed
+// above the table so they do not hide the others.
+type ListView[T any] struct {
+	router  plugin.Router
+	title   string
+	scope   plugin.RegionScope
+	list    ListFunc[T]
+	open    OpenFunc[T]
+	table   ui.TableView[Row[T]]
+	failed  []RegionError
+	loading bool
+	updated time.Time
+}
+
+// NewListView creates a ListView over scope. cols and id describe a single
+// resource as in the plugin's own list view; the Region column is added in
+// front. open may be nil if rows have no detail view.
+func NewListView[T any](router plugin.Router, title string, scope plugin.RegionScope, cols []ui.Column[T], id func(T) string, list ListFunc[T], open OpenFunc[T]) *ListView[T] {
+	rowCols := make([]ui.Column[Row[T]], 0, len(cols)+1)
+	rowCols = append(rowCols, ui.Column[Row[T]]{Title: "Region", Width: 16, Field: func(r Row[T]) string { return r.Region }})
+	for _, c := range cols {
+		field := c.Field
+		rowCols = append(rowCols, ui.Column[Row[T]]{Title: c.Title, Width: c.Width, Field: func(r Row[T]) string { return field(r.Item) }})
+	}
+	return &ListView[T]{
+		router:  router,
+		title:   title,
+		scope:   scope,
+		list:    list,
+		open:    open,
+		table:   ui.NewTableView(rowCols, nil, func(r Row[T]) string { return r.Region + "/" + id(r.Item) }),
+		loading: true,
+	}
+}
+
+// fetch lists every region in the scope. The calls are unobserved: a region
+// that is disabled or unreachable is reported inline instead of switching
+// the app offline or prompting for a login.
+func (v *ListView[T]) fetch() tea.Cmd {
+	list, scope := v.list, v.scope
+	ctx := internalaws.Unobserved(v.router.Context(v))
+	return func() tea.Msg {
+		results := internalaws.FanOut(ctx, scope.Regions, scope.Concurrency, func(ctx context.Context, region string) ([]T, error) {
+			ctx, cancel := context.WithTimeout(ctx, regionTimeout)
+			defer cancel()
+			var items []T
+			err := internalaws.Retry(ctx, internalaws.DefaultRetryPolicy, func(ctx context.Context) (err error) {
+				items, err = list(ctx, region)
+				return err
+			}, nil)
+			return items, err
+		})
+
+		var msg resultsMsg[T]
+		for _, r := range results {
+			if r.Err != nil {
+				msg.failed = append(msg.failed, RegionError{Region: r.Region, Err: r.Err})
+				continue
+			}
+			for _, item := range r.Value {
+				msg.rows = append(msg.rows, Row[T]{Region: r.Region, Item: item})
+			}
+		}
+		return msg
+	}
+}
+
+func (v *ListView[T]) Init() tea.Cmd {
+	return v.fetch()
+}
+
+func (v *ListView[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case resultsMsg[T]:
+		v.loading = false
+		v.table.SetItems(msg.rows)
+		v.failed = msg.failed
+		v.updated = time.Now()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.loading {
+			return v, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if v.open == nil || v.table.FilteredCount() == 0 {
+				return v, nil
+			}
+			row := v.table.SelectedItem()
+			view := v.open(v.router, row.Region, row.Item)
+			v.router.Push(view)
+			return v, view.Init()
+		case "esc", "backspace":
+			v.router.Pop()
+			return v, nil
+		case "r":
+			v.loading = true
+			return v, v.fetch()
+		}
+	}
+
+	var cmd tea.Cmd
+	v.table, cmd = v.table.Update(msg)
+	return v, cmd
+}
+
+func (v *ListView[T]) View() tea.View {
+	if v.loading {
+		skel := ui.NewSkeleton(80, 6)
+		return tea.NewView(summaryStyle.Render(fmt.Sprintf("Querying %d regions...", len(v.scope.Regions))) + "\n\n" + skel.View())
+	}
+
+	var b strings.Builder
+	ok := len(v.scope.Regions) - len(v.failed)
+	b.WriteString(summaryStyle.Render(fmt.Sprintf("%d resources in %d of %d regions", v.table.ItemCount(), ok, len(v.scope.Regions))))
+	b.WriteString("\n")
+	for _, f := range v.failed {
+		b.WriteString(regionErrStyle.Render(fmt.Sprintf("✗ %s: %s", f.Region, describe(f.Err))))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(v.table.View())
+	return tea.NewView(b.String())
+}
+
+// describe returns a one-line reason for a failed region.
+func describe(err error) string {
+	switch internalaws.ClassifyError(err) {
+	case internalaws.ErrKindAuth:
+		return "Credentials rejected. The region may not be enabled for this account."
+	case internalaws.ErrKindThrottled:
+		return "Throttled by AWS. Press r to retry."
+	case internalaws.ErrKindUnknown:
+		return err.Error()
+	default:
+		return internalaws.FormatError(err)
+	}
+}
+
+func (v *ListView[T]) Title() string { return v.title + " (all regions)" }
+
+// UpdatedAt returns when the regions were last queried.
+func (v *ListView[T]) UpdatedAt() time.Time { return v.updated }
+
+// Stale is always false: all-regions results are never cached.
+func (v *ListView[T]) Stale() bool { return false }
+
+func (v *ListView[T]) KeyHints() []plugin.KeyHint {
+	return []plugin.KeyHint{
+		{Key: "enter", Desc: "view details"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "/", Desc: "filter"},
+		{Key: "s", Desc: "sort"},
+	}
+}
@@ -0,0 +1,86 @@
+package regional
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/smithy-go"
+
+	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/ui"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRouter struct {
+	pushed []plugin.View
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_ string, _ string)     {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, _ string)   {}
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
+type openedView struct {
+	region, name string
+}
+
+func (v *openedView) Init() tea.Cmd                       { return nil }
+func (v *openedView) Update(tea.Msg) (tea.Model, tea.Cmd) { return v, nil }
+func (v *openedView) View() tea.View                      { return tea.NewView("") }
+func (v *openedView) Title() string                       { return v.name }
+func (v *openedView) KeyHints() []plugin.KeyHint          { return nil }
+
+func TestListView_MergesRegions(t *testing.T) {
+	items := map[string][]string{
+		"us-east-1": {"alpha", "beta"},
+		"eu-west-1": {"gamma"},
+	}
+	list := func(_ context.Context, region string) ([]string, error) {
+		if region == "ap-east-1" {
+			return nil, &smithy.GenericAPIError{Code: "UnrecognizedClientException", Message: "invalid token"}
+		}
+		return items[region], nil
+	}
+	open := func(_ plugin.Router, region string, name string) plugin.View {
+		return &openedView{region: region, name: name}
+	}
+
+	router := &mockRouter{}
+	scope := plugin.RegionScope{Regions: []string{"us-east-1", "ap-east-1", "eu-west-1"}, Concurrency: 2}
+	cols := []ui.Column[string]{{Title: "Name", Width: 20, Field: func(s string) string { return s }}}
+	lv := NewListView(router, "Things", scope, cols, func(s string) string { return s }, list, open)
+
+	lv.Update(lv.Init()())
+	assert.False(t, lv.loading)
+	assert.Equal(t, 3, lv.table.ItemCount())
+	assert.Equal(t, "eu-west-1/gamma", lv.table.SelectedID(), "rows are sorted by region")
+	assert.Equal(t, "Things (all regions)", lv.Title())
+
+	// The failed region is reported inline, the others are still listed.
+	require.Len(t, lv.failed, 1)
+	assert.Equal(t, "ap-east-1", lv.failed[0].Region)
+	content := lv.View().Content
+	assert.Contains(t, content, "3 resources in 2 of 3 regions")
+	assert.Contains(t, content, "ap-east-1: Credentials rejected")
+	assert.Contains(t, content, "us-east-1")
+
+	// Selecting a row opens it in the region it was listed in.
+	lv.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	lv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, &openedView{region: "us-east-1", name: "alpha"}, router.pushed[0])
+}
+
+func TestDescribe(t *testing.T) {
+	assert.Equal(t, "boom", describe(errors.New("boom")))
+	assert.Contains(t, describe(&smithy.GenericAPIError{Code: "ThrottlingException"}), "Throttled")
+}
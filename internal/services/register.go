@@ -2,47 +2,142 @@ package services
 
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsassdk "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	awscfnsdk "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awscwsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	awslogssdk "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awsddbsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awsec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsecrsdk "github.com/aws/aws-sdk-go-v2/service/ecr"
 	awsecssdk "github.com/aws/aws-sdk-go-v2/service/ecs"
 	awsekssdk "github.com/aws/aws-sdk-go-v2/service/eks"
 	awselbsdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	awsiamsdk "github.com/aws/aws-sdk-go-v2/service/iam"
+	awslambdasdk "github.com/aws/aws-sdk-go-v2/service/lambda"
+	awsrdssdk "github.com/aws/aws-sdk-go-v2/service/rds"
 	awss3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	awssnssdk "github.com/aws/aws-sdk-go-v2/service/sns"
+	awssqssdk "github.com/aws/aws-sdk-go-v2/service/sqs"
 
+	awsas "tasnim.dev/aws-tui/internal/aws/autoscaling"
+	awscloudformation "tasnim.dev/aws-tui/internal/aws/cloudformation"
+	awscw "tasnim.dev/aws-tui/internal/aws/cloudwatch"
 	awscost "tasnim.dev/aws-tui/internal/aws/cost"
+	awsdynamodb "tasnim.dev/aws-tui/internal/aws/dynamodb"
 	awsec2 "tasnim.dev/aws-tui/internal/aws/ec2"
 	awsecr "tasnim.dev/aws-tui/internal/aws/ecr"
 	awsecs "tasnim.dev/aws-tui/internal/aws/ecs"
 	awseks "tasnim.dev/aws-tui/internal/aws/eks"
 	awselb "tasnim.dev/aws-tui/internal/aws/elb"
 	awsiam "tasnim.dev/aws-tui/internal/aws/iam"
+	awslambda "tasnim.dev/aws-tui/internal/aws/lambda"
+	awslogs "tasnim.dev/aws-tui/internal/aws/logs"
+	awsrds "tasnim.dev/aws-tui/internal/aws/rds"
 	awss3 "tasnim.dev/aws-tui/internal/aws/s3"
+	awssns "tasnim.dev/aws-tui/internal/aws/sns"
+	awssqs "tasnim.dev/aws-tui/internal/aws/sqs"
 	awsvpc "tasnim.dev/aws-tui/internal/aws/vpc"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
+	svcalarms "tasnim.dev/aws-tui/internal/services/alarms"
+	svccloudformation "tasnim.dev/aws-tui/internal/services/cloudformation"
 	svccost "tasnim.dev/aws-tui/internal/services/cost"
+	svcdynamodb "tasnim.dev/aws-tui/internal/services/dynamodb"
 	svcec2 "tasnim.dev/aws-tui/internal/services/ec2"
 	svcecr "tasnim.dev/aws-tui/internal/services/ecr"
 	svcecs "tasnim.dev/aws-tui/internal/services/ecs"
 	svceks "tasnim.dev/aws-tui/internal/services/eks"
 	svcelb "tasnim.dev/aws-tui/internal/services/elb"
 	svciam "tasnim.dev/aws-tui/internal/services/iam"
+	svclambda "tasnim.dev/aws-tui/internal/services/lambda"
+	svcmessaging "tasnim.dev/aws-tui/internal/services/messaging"
+	svcmetrics "tasnim.dev/aws-tui/internal/services/metrics"
+	svcrds "tasnim.dev/aws-tui/internal/services/rds"
 	svcs3 "tasnim.dev/aws-tui/internal/services/s3"
 	svcvpc "tasnim.dev/aws-tui/internal/services/vpc"
 )
 
+// cacheable is implemented by plugins whose list views read and write the
+// resource cache.
+type cacheable interface {
+	SetCache(scope *cache.Scope)
+}
+
 // Register creates all AWS service clients from the given config and registers
-// their corresponding service plugins with the registry.
-func Register(reg *plugin.Registry, cfg aws.Config, region, profile string) {
+// their corresponding service plugins with the registry. scope, which may be
+// nil, is handed to every plugin that caches its resources.
+func Register(reg *plugin.Registry, cfg aws.Config, region, profile string, scope *cache.Scope) {
 	ec2api := awsec2sdk.NewFromConfig(cfg)
+	ec2In := func(region string) *awsec2sdk.Client {
+		return awsec2sdk.NewFromConfig(cfg, func(o *awsec2sdk.Options) { o.Region = region })
+	}
+	logsClient := awslogs.NewClient(awslogssdk.NewFromConfig(cfg))
+	cwapi := awscwsdk.NewFromConfig(cfg)
+	cwIn := func(region string) svcmetrics.Client {
+		return awscw.NewClient(awscwsdk.NewFromConfig(cfg, func(o *awscwsdk.Options) {
+			if region != "" {
+				o.Region = region
+			}
+		}))
+	}
 
-	reg.Add(svcec2.NewPlugin(awsec2.NewClient(ec2api), region, profile))
-	reg.Add(svcecs.NewPlugin(awsecs.NewClient(awsecssdk.NewFromConfig(cfg)), region, profile))
-	reg.Add(svceks.NewPlugin(awseks.NewClient(awsekssdk.NewFromConfig(cfg)), region, profile))
-	reg.Add(svcvpc.NewPlugin(awsvpc.NewClient(ec2api)))
+	ec2p := svcec2.NewPlugin(awsec2.NewClient(ec2api), region, profile)
+	ec2p.SetRegionalClients(func(region string) svcec2.EC2Client { return awsec2.NewClient(ec2In(region)) })
+	ec2p.SetMetricsClients(cwIn)
+	ecsp := svcecs.NewPlugin(awsecs.NewClient(awsecssdk.NewFromConfig(cfg)), region, profile)
+	ecsp.SetRegionalClients(func(region string) svcecs.ECSClient {
+		return awsecs.NewClient(awsecssdk.NewFromConfig(cfg, func(o *awsecssdk.Options) { o.Region = region }))
+	})
+	ecsp.SetLogsClient(logsClient)
+	ecsp.SetAutoScalingClient(awsas.NewClient(awsassdk.NewFromConfig(cfg)))
+	ecsp.SetMetricsClient(cwIn(""))
+	eksp := svceks.NewPlugin(awseks.NewClient(awsekssdk.NewFromConfig(cfg)), region, profile)
+	eksp.SetRegionalClients(func(region string) *awseks.Client {
+		return awseks.NewClient(awsekssdk.NewFromConfig(cfg, func(o *awsekssdk.Options) { o.Region = region }))
+	})
+	eksp.SetK8sConnector(func(c awseks.EKSCluster, region string) (svceks.K8sAPI, error) {
+		cfg := cfg.Copy()
+		if region != "" {
+			cfg.Region = region
+		}
+		client, err := awseks.NewK8sClient(c.Endpoint, c.CertAuthority, awseks.NewTokenProvider(cfg, c.Name))
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	eksp.SetMetricsClients(cwIn)
+	vpcp := svcvpc.NewPlugin(awsvpc.NewClient(ec2api))
+	vpcp.SetRegionalClients(func(region string) svcvpc.VPCClient { return awsvpc.NewClient(ec2In(region)) })
+	elbp := svcelb.NewPlugin(awselb.NewClient(awselbsdk.NewFromConfig(cfg)))
+	elbp.SetRegionalClients(func(region string) *awselb.Client {
+		return awselb.NewClient(awselbsdk.NewFromConfig(cfg, func(o *awselbsdk.Options) { o.Region = region }))
+	})
+	elbp.SetMetricsClients(cwIn)
+	lambdap := svclambda.NewPlugin(awslambda.NewClient(awslambdasdk.NewFromConfig(cfg)))
+	lambdap.SetLogsClient(logsClient)
+	messagingp := svcmessaging.NewPlugin(awssqs.NewClient(awssqssdk.NewFromConfig(cfg)), awssns.NewClient(awssnssdk.NewFromConfig(cfg)))
+	messagingp.SetMetricsClient(cwIn(""))
+
+	reg.Add(ec2p)
+	reg.Add(ecsp)
+	reg.Add(eksp)
+	reg.Add(vpcp)
 	reg.Add(svcs3.NewPlugin(awss3.NewClient(awss3sdk.NewFromConfig(cfg))))
 	reg.Add(svciam.NewPlugin(awsiam.NewClient(awsiamsdk.NewFromConfig(cfg))))
 	reg.Add(svcecr.NewPlugin(awsecr.NewClient(awsecrsdk.NewFromConfig(cfg))))
-	reg.Add(svcelb.NewPlugin(awselb.NewClient(awselbsdk.NewFromConfig(cfg))))
+	reg.Add(elbp)
+	reg.Add(lambdap)
+	reg.Add(svcrds.NewPlugin(awsrds.NewClient(awsrdssdk.NewFromConfig(cfg))))
+	reg.Add(svcdynamodb.NewPlugin(awsdynamodb.NewClient(awsddbsdk.NewFromConfig(cfg))))
+	reg.Add(svccloudformation.NewPlugin(awscloudformation.NewClient(awscfnsdk.NewFromConfig(cfg))))
+	reg.Add(messagingp)
+	reg.Add(svcalarms.NewPlugin(awscw.NewClient(cwapi)))
 	reg.Add(svccost.NewPlugin(awscost.NewClient(cfg)))
+
+	for _, p := range reg.All() {
+		if c, ok := p.(cacheable); ok {
+			c.SetCache(scope)
+		}
+	}
 }
@@ -1,18 +1,12 @@
 package s3
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"path"
 	"strings"
 	"unicode/utf8"
 
-	"github.com/alecthomas/chroma/v2"
-	"github.com/alecthomas/chroma/v2/formatters"
-	"github.com/alecthomas/chroma/v2/lexers"
-	"github.com/alecthomas/chroma/v2/styles"
-
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
@@ -89,7 +83,7 @@ func objectColumns() []ui.Column[awss3.S3Object] {
 			if o.IsPrefix {
 				return "-"
 			}
-			return formatSize(o.Size)
+			return ui.FormatSize(o.Size)
 		}},
 		{Title: "Last Modified", Width: 20, Field: func(o awss3.S3Object) string {
 			if o.IsPrefix || o.LastModified.IsZero() {
@@ -109,36 +103,33 @@ func objectColumns() []ui.Column[awss3.S3Object] {
 	}
 }
 
-func formatSize(bytes int64) string {
-	switch {
-	case bytes >= 1<<30:
-		return fmt.Sprintf("%.1f GB", float64(bytes)/float64(1<<30))
-	case bytes >= 1<<20:
-		return fmt.Sprintf("%.1f MB", float64(bytes)/float64(1<<20))
-	case bytes >= 1<<10:
-		return fmt.Sprintf("%.1f KB", float64(bytes)/float64(1<<10))
-	default:
-		return fmt.Sprintf("%d B", bytes)
-	}
-}
-
 func (dv *DetailView) fetchObjects() tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	bucket := dv.bucket
 	prefix := dv.prefix
 	region := dv.region
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		result, err := client.ListObjects(context.TODO(), bucket, prefix, "", region)
+		var result awss3.ListObjectsResult
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			result, err = client.ListObjects(ctx, bucket, prefix, "", region)
+			return err
+		})
 		return objectsMsg{result: result, err: err}
 	}
 }
 
 func (dv *DetailView) fetchFileContent(key string) tea.Cmd {
-	client := dv.client
+	client, router := dv.client, dv.router
 	bucket := dv.bucket
 	region := dv.region
+	ctx := router.Context(dv)
 	return func() tea.Msg {
-		content, err := client.GetObject(context.TODO(), bucket, key, region)
+		var content []byte
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			content, err = client.GetObject(ctx, bucket, key, region)
+			return err
+		})
 		return fileContentMsg{key: key, content: content, err: err}
 	}
 }
@@ -175,11 +166,11 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				body = body[:maxPreview] + "\n... (truncated)"
 			}
 			// Apply syntax highlighting based on file extension
-			highlighted := highlightCode(path.Base(msg.key), body)
+			highlighted := ui.HighlightCode(path.Base(msg.key), body)
 			dv.previewBody = highlighted
 			dv.previewLines = strings.Split(highlighted, "\n")
 		} else {
-			dv.previewBody = fmt.Sprintf("[Binary file — %s]", formatSize(int64(len(msg.content))))
+			dv.previewBody = fmt.Sprintf("[Binary file — %s]", ui.FormatSize(int64(len(msg.content))))
 			dv.previewLines = []string{dv.previewBody}
 		}
 		return dv, nil
@@ -399,30 +390,6 @@ func (dv *DetailView) KeyHints() []plugin.KeyHint {
 	return hints
 }
 
-// highlightCode applies syntax highlighting based on the file extension.
-// Returns the original text if highlighting is not available.
-func highlightCode(filename, code string) string {
-	lexer := lexers.Match(filename)
-	if lexer == nil {
-		lexer = lexers.Fallback
-	}
-	lexer = chroma.Coalesce(lexer)
-
-	style := styles.Get("monokai")
-	formatter := formatters.Get("terminal256")
-
-	iterator, err := lexer.Tokenise(nil, code)
-	if err != nil {
-		return code
-	}
-
-	var buf bytes.Buffer
-	if err := formatter.Format(&buf, style, iterator); err != nil {
-		return code
-	}
-	return buf.String()
-}
-
 // isTextContent checks whether the content appears to be valid UTF-8 text.
 func isTextContent(data []byte) bool {
 	if len(data) == 0 {
@@ -6,10 +6,14 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	awss3 "tasnim.dev/aws-tui/internal/aws/s3"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
+// cacheKey is the cache service key for S3 buckets.
+const cacheKey = "s3"
+
 // bucketsMsg carries the result of fetching buckets.
 type bucketsMsg struct {
 	buckets []awss3.S3Bucket
@@ -18,12 +22,15 @@ type bucketsMsg struct {
 
 // ListView displays S3 buckets in a table.
 type ListView struct {
+	plugin.Revalidation
+
 	client  S3Client
 	router  plugin.Router
 	table   ui.TableView[awss3.S3Bucket]
 	buckets []awss3.S3Bucket
 	loading bool
 	err     error
+	cache   *cache.Scope
 }
 
 // NewListView creates a new S3 bucket ListView.
@@ -54,25 +61,52 @@ func bucketColumns() []ui.Column[awss3.S3Bucket] {
 }
 
 func (lv *ListView) fetchBuckets() tea.Cmd {
-	client := lv.client
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
 	return func() tea.Msg {
-		buckets, err := client.ListBuckets(context.TODO())
+		var buckets []awss3.S3Bucket
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			buckets, err = client.ListBuckets(ctx)
+			return err
+		})
+		if err == nil {
+			_ = cache.Store(context.Background(), scope, cacheKey, buckets, func(b awss3.S3Bucket) (string, string) {
+				return b.Name, b.Name
+			})
+		}
 		return bucketsMsg{buckets: buckets, err: err}
 	}
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchBuckets()
+	fetch := lv.fetchBuckets()
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awss3.S3Bucket](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awss3.S3Bucket]:
+		lv.loading = false
+		lv.buckets = msg.Items
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchBuckets()
+		}
+		return lv, nil
+
 	case bucketsMsg:
 		lv.loading = false
+		if lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
 			lv.err = msg.err
 			return lv, nil
 		}
+		lv.err = nil
 		lv.buckets = msg.buckets
 		lv.table.SetItems(msg.buckets)
 		return lv, nil
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	awss3 "tasnim.dev/aws-tui/internal/aws/s3"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 )
 
@@ -18,6 +19,8 @@ type S3Client interface {
 
 // Plugin implements plugin.ServicePlugin for Amazon S3.
 type Plugin struct {
+	plugin.CacheHolder
+
 	client S3Client
 }
 
@@ -60,11 +63,30 @@ func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router)
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
 }
 
+// DetailView opens the bucket named id. The bucket's region is taken from the
+// cached bucket list when available, since objects in buckets outside the
+// session region can only be listed through their own regional endpoint.
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
-	return NewDetailView(p.client, router, id, "")
+	return NewDetailView(p.client, router, id, p.cachedBucketRegion(id))
+}
+
+// cachedBucketRegion returns the cached region of bucket, or "" if unknown.
+func (p *Plugin) cachedBucketRegion(bucket string) string {
+	buckets, _, err := cache.Load[awss3.S3Bucket](context.TODO(), p.Cache(), cacheKey)
+	if err != nil {
+		return ""
+	}
+	for _, b := range buckets {
+		if b.Name == bucket {
+			return b.Region
+		}
+	}
+	return ""
 }
 
 func (p *Plugin) Commands() []plugin.Command {
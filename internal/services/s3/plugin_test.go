@@ -6,6 +6,7 @@ import (
 	"time"
 
 	awss3 "tasnim.dev/aws-tui/internal/aws/s3"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 
 	"github.com/stretchr/testify/assert"
@@ -119,11 +120,22 @@ func TestIsTextContent(t *testing.T) {
 	assert.False(t, isTextContent([]byte{0xFF, 0xFE}))
 }
 
-func TestFormatSize(t *testing.T) {
-	assert.Equal(t, "0 B", formatSize(0))
-	assert.Equal(t, "512 B", formatSize(512))
-	assert.Equal(t, "1.0 KB", formatSize(1024))
-	assert.Equal(t, "1.5 MB", formatSize(1572864))
-	assert.Equal(t, "2.0 GB", formatSize(2147483648))
-}
 
+func TestCachedBucketRegion(t *testing.T) {
+	db, err := cache.NewTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	scope := cache.NewScope(db, "us-east-1", "default", nil)
+	buckets := []awss3.S3Bucket{{Name: "logs", Region: "eu-west-1"}}
+	require.NoError(t, cache.Store(context.Background(), scope, cacheKey, buckets, func(b awss3.S3Bucket) (string, string) {
+		return b.Name, b.Name
+	}))
+
+	p := NewPlugin(&mockClient{})
+	assert.Equal(t, "", p.cachedBucketRegion("logs"), "no cache configured")
+
+	p.SetCache(scope)
+	assert.Equal(t, "eu-west-1", p.cachedBucketRegion("logs"))
+	assert.Equal(t, "", p.cachedBucketRegion("unknown"))
+}
@@ -45,12 +45,18 @@ type (
 		igws []awsvpc.InternetGatewayInfo
 		err  error
 	}
+	// subnetsMsg and securityGroupsMsg carry pages; after is the token
+	// they were fetched from, nil when they start the listing.
 	subnetsMsg struct {
 		items []awsvpc.SubnetInfo
+		after *string
+		next  *string
 		err   error
 	}
 	securityGroupsMsg struct {
 		items []awsvpc.SecurityGroupInfo
+		after *string
+		next  *string
 		err   error
 	}
 	routeTablesMsg struct {
@@ -99,18 +105,30 @@ type DetailView struct {
 	nacls          ui.TableView[awsvpc.NetworkACLInfo]
 	flowLogs       ui.TableView[awsvpc.FlowLogInfo]
 
+	// Next page tokens for the paginated tabs.
+	subnetsNext        *string
+	securityGroupsNext *string
+
+	// focus is the subnet or security group to open once its tab has
+	// loaded, cleared once it has been opened or found missing.
+	focus string
+
 	// Tracks which tabs have been loaded.
 	loaded  [9]bool
 	loading [9]bool
 	errors  [9]error
 }
 
-// NewDetailView creates a VPC DetailView for the given VPC ID.
-func NewDetailView(client VPCClient, router plugin.Router, vpcID string) *DetailView {
-	return &DetailView{
+// NewDetailView creates a VPC DetailView for the given ID. The ID is a VPC
+// ID, optionally followed by "/" and a subnet or security group ID in that
+// VPC, such as "vpc-1a2b/sg-3c4d", which the view then opens.
+func NewDetailView(client VPCClient, router plugin.Router, id string) *DetailView {
+	vpcID, focus, _ := strings.Cut(id, "/")
+	dv := &DetailView{
 		client:         client,
 		router:         router,
 		vpcID:          vpcID,
+		focus:          focus,
 		tabs:           ui.NewTabController(tabTitles),
 		subnets:        newSubnetTable(nil),
 		securityGroups: newSecurityGroupTable(nil),
@@ -121,10 +139,19 @@ func NewDetailView(client VPCClient, router plugin.Router, vpcID string) *Detail
 		nacls:          newNACLTable(nil),
 		flowLogs:       newFlowLogTable(nil),
 	}
+	dv.subnets.OnLoadMore(func() tea.Cmd { return dv.fetchSubnets(dv.subnetsNext, 0) })
+	dv.securityGroups.OnLoadMore(func() tea.Cmd { return dv.fetchSecurityGroups(dv.securityGroupsNext, 0) })
+	return dv
 }
 
 func (dv *DetailView) Init() tea.Cmd {
-	return dv.loadTab(tabOverview)
+	switch {
+	case strings.HasPrefix(dv.focus, "subnet-"):
+		dv.tabs.SetActive(tabSubnets)
+	case strings.HasPrefix(dv.focus, "sg-"):
+		dv.tabs.SetActive(tabSecurityGroups)
+	}
+	return dv.loadTab(dv.tabs.Active())
 }
 
 func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -141,24 +168,60 @@ func (dv *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return dv, nil
 
 	case subnetsMsg:
-		dv.loading[tabSubnets] = false
-		dv.loaded[tabSubnets] = true
-		if msg.err != nil {
-			dv.errors[tabSubnets] = msg.err
-			return dv, nil
+		if msg.after != nil {
+			if msg.after != dv.subnetsNext {
+				// A page of a listing that has since been reloaded.
+				return dv, nil
+			}
+			if msg.err != nil {
+				dv.subnets.SetMore(true)
+				dv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				dv.focus = ""
+				return dv, nil
+			}
+			dv.subnets.AppendItems(msg.items)
+		} else {
+			dv.loading[tabSubnets] = false
+			dv.loaded[tabSubnets] = true
+			if msg.err != nil {
+				dv.errors[tabSubnets] = msg.err
+				dv.focus = ""
+				return dv, nil
+			}
+			dv.errors[tabSubnets] = nil
+			dv.subnets.SetItems(msg.items)
 		}
-		dv.subnets.SetItems(msg.items)
-		return dv, nil
+		dv.subnetsNext = msg.next
+		dv.subnets.SetMore(msg.next != nil)
+		return dv, dv.openFocus()
 
 	case securityGroupsMsg:
-		dv.loading[tabSecurityGroups] = false
-		dv.loaded[tabSecurityGroups] = true
-		if msg.err != nil {
-			dv.errors[tabSecurityGroups] = msg.err
-			return dv, nil
+		if msg.after != nil {
+			if msg.after != dv.securityGroupsNext {
+				// A page of a listing that has since been reloaded.
+				return dv, nil
+			}
+			if msg.err != nil {
+				dv.securityGroups.SetMore(true)
+				dv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				dv.focus = ""
+				return dv, nil
+			}
+			dv.securityGroups.AppendItems(msg.items)
+		} else {
+			dv.loading[tabSecurityGroups] = false
+			dv.loaded[tabSecurityGroups] = true
+			if msg.err != nil {
+				dv.errors[tabSecurityGroups] = msg.err
+				dv.focus = ""
+				return dv, nil
+			}
+			dv.errors[tabSecurityGroups] = nil
+			dv.securityGroups.SetItems(msg.items)
 		}
-		dv.securityGroups.SetItems(msg.items)
-		return dv, nil
+		dv.securityGroupsNext = msg.next
+		dv.securityGroups.SetMore(msg.next != nil)
+		return dv, dv.openFocus()
 
 	case routeTablesMsg:
 		dv.loading[tabRouteTables] = false
@@ -281,67 +344,115 @@ func (dv *DetailView) loadTab(tab int) tea.Cmd {
 		return nil
 	}
 	dv.loading[tab] = true
-	client := dv.client
+	client, router := dv.client, dv.router
 	vpcID := dv.vpcID
+	ctx := router.Context(dv)
 
 	switch tab {
 	case tabOverview:
 		return func() tea.Msg {
-			ctx := context.TODO()
-			tags, err := client.GetVPCTags(ctx, vpcID)
-			if err != nil {
-				return overviewMsg{err: err}
-			}
-			igws, err := client.ListInternetGateways(ctx, vpcID)
+			var tags map[string]string
+			var igws []awsvpc.InternetGatewayInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				if tags, err = client.GetVPCTags(ctx, vpcID); err != nil {
+					return err
+				}
+				igws, err = client.ListInternetGateways(ctx, vpcID)
+				return err
+			})
 			if err != nil {
 				return overviewMsg{err: err}
 			}
 			return overviewMsg{tags: tags, igws: igws}
 		}
 	case tabSubnets:
-		return func() tea.Msg {
-			items, err := client.ListSubnets(context.TODO(), vpcID)
-			return subnetsMsg{items: items, err: err}
-		}
+		return dv.fetchSubnets(nil, dv.subnets.ItemCount())
 	case tabSecurityGroups:
-		return func() tea.Msg {
-			items, err := client.ListSecurityGroups(context.TODO(), vpcID)
-			return securityGroupsMsg{items: items, err: err}
-		}
+		return dv.fetchSecurityGroups(nil, dv.securityGroups.ItemCount())
 	case tabRouteTables:
 		return func() tea.Msg {
-			items, err := client.ListRouteTables(context.TODO(), vpcID)
+			var items []awsvpc.RouteTableInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				items, err = client.ListRouteTables(ctx, vpcID)
+				return err
+			})
 			return routeTablesMsg{items: items, err: err}
 		}
 	case tabNATGateways:
 		return func() tea.Msg {
-			items, err := client.ListNATGateways(context.TODO(), vpcID)
+			var items []awsvpc.NATGatewayInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				items, err = client.ListNATGateways(ctx, vpcID)
+				return err
+			})
 			return natGatewaysMsg{items: items, err: err}
 		}
 	case tabEndpoints:
 		return func() tea.Msg {
-			items, err := client.ListVPCEndpoints(context.TODO(), vpcID)
+			var items []awsvpc.VPCEndpointInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				items, err = client.ListVPCEndpoints(ctx, vpcID)
+				return err
+			})
 			return endpointsMsg{items: items, err: err}
 		}
 	case tabPeering:
 		return func() tea.Msg {
-			items, err := client.ListVPCPeering(context.TODO(), vpcID)
+			var items []awsvpc.VPCPeeringInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				items, err = client.ListVPCPeering(ctx, vpcID)
+				return err
+			})
 			return peeringMsg{items: items, err: err}
 		}
 	case tabNACLs:
 		return func() tea.Msg {
-			items, err := client.ListNetworkACLs(context.TODO(), vpcID)
+			var items []awsvpc.NetworkACLInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				items, err = client.ListNetworkACLs(ctx, vpcID)
+				return err
+			})
 			return naclsMsg{items: items, err: err}
 		}
 	case tabFlowLogs:
 		return func() tea.Msg {
-			items, err := client.ListFlowLogs(context.TODO(), vpcID)
+			var items []awsvpc.FlowLogInfo
+			err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+				items, err = client.ListFlowLogs(ctx, vpcID)
+				return err
+			})
 			return flowLogsMsg{items: items, err: err}
 		}
 	}
 	return nil
 }
 
+// fetchSubnets fetches pages of subnets after token until at least want have
+// been read.
+func (dv *DetailView) fetchSubnets(after *string, want int) tea.Cmd {
+	client, router, vpcID := dv.client, dv.router, dv.vpcID
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		items, next, err := plugin.FetchPages(ctx, router, after, want, func(ctx context.Context, token *string) ([]awsvpc.SubnetInfo, *string, error) {
+			return client.ListSubnetsPage(ctx, vpcID, token)
+		})
+		return subnetsMsg{items: items, after: after, next: next, err: err}
+	}
+}
+
+// fetchSecurityGroups fetches pages of security groups after token until at
+// least want have been read.
+func (dv *DetailView) fetchSecurityGroups(after *string, want int) tea.Cmd {
+	client, router, vpcID := dv.client, dv.router, dv.vpcID
+	ctx := router.Context(dv)
+	return func() tea.Msg {
+		items, next, err := plugin.FetchPages(ctx, router, after, want, func(ctx context.Context, token *string) ([]awsvpc.SecurityGroupInfo, *string, error) {
+			return client.ListSecurityGroupsPage(ctx, vpcID, token)
+		})
+		return securityGroupsMsg{items: items, after: after, next: next, err: err}
+	}
+}
+
 func (dv *DetailView) View() tea.View {
 	var b strings.Builder
 
@@ -414,6 +525,51 @@ func (dv *DetailView) renderOverview() string {
 	return b.String()
 }
 
+// openFocus opens the focused subnet or security group if it has been
+// loaded, otherwise fetches the next page to look in. It gives up with a
+// toast once every page has been read.
+func (dv *DetailView) openFocus() tea.Cmd {
+	if dv.focus == "" {
+		return nil
+	}
+	var view *SubDetailView
+	var fetchNext tea.Cmd
+	switch {
+	case strings.HasPrefix(dv.focus, "subnet-"):
+		for _, s := range dv.subnets.Items() {
+			if s.SubnetID == dv.focus {
+				view = NewSubnetDetailView(dv.client, dv.router, s)
+				break
+			}
+		}
+		if view == nil && dv.subnetsNext != nil {
+			fetchNext = dv.fetchSubnets(dv.subnetsNext, 0)
+		}
+	case strings.HasPrefix(dv.focus, "sg-"):
+		for _, sg := range dv.securityGroups.Items() {
+			if sg.GroupID == dv.focus {
+				view = NewSGDetailView(dv.client, dv.router, sg)
+				break
+			}
+		}
+		if view == nil && dv.securityGroupsNext != nil {
+			fetchNext = dv.fetchSecurityGroups(dv.securityGroupsNext, 0)
+		}
+	}
+	if fetchNext != nil {
+		return fetchNext
+	}
+
+	focus := dv.focus
+	dv.focus = ""
+	if view == nil {
+		dv.router.Toast(plugin.ToastError, fmt.Sprintf("%s not found in %s", focus, dv.vpcID))
+		return nil
+	}
+	dv.router.Push(view)
+	return view.Init()
+}
+
 func (dv *DetailView) drillDown() plugin.View {
 	switch dv.tabs.Active() {
 	case tabSubnets:
@@ -7,23 +7,34 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	awsvpc "tasnim.dev/aws-tui/internal/aws/vpc"
+	"tasnim.dev/aws-tui/internal/cache"
 	"tasnim.dev/aws-tui/internal/plugin"
 	"tasnim.dev/aws-tui/internal/ui"
 )
 
-// vpcsMsg carries the result of fetching VPCs.
+// cacheKey is the cache service key for VPCs.
+const cacheKey = "vpc"
+
+// vpcsMsg carries the result of fetching pages of VPCs. after is the
+// token the pages were fetched from, nil when they start the listing.
 type vpcsMsg struct {
-	vpcs []awsvpc.VPCInfo
-	err  error
+	vpcs  []awsvpc.VPCInfo
+	after *string
+	next  *string
+	err   error
 }
 
 // ListView displays VPCs in a table.
 type ListView struct {
+	plugin.Revalidation
+
 	client  VPCClient
 	router  plugin.Router
 	table   ui.TableView[awsvpc.VPCInfo]
 	loading bool
 	err     error
+	cache   *cache.Scope
+	next    *string
 }
 
 // NewListView creates a new VPC ListView.
@@ -32,12 +43,14 @@ func NewListView(client VPCClient, router plugin.Router) *ListView {
 	tv := ui.NewTableView(cols, nil, func(v awsvpc.VPCInfo) string {
 		return v.VPCID
 	})
-	return &ListView{
+	lv := &ListView{
 		client:  client,
 		router:  router,
 		table:   tv,
 		loading: true,
 	}
+	lv.table.OnLoadMore(func() tea.Cmd { return lv.fetchVPCs(lv.next, 0) })
+	return lv
 }
 
 func vpcColumns() []ui.Column[awsvpc.VPCInfo] {
@@ -55,27 +68,71 @@ func vpcColumns() []ui.Column[awsvpc.VPCInfo] {
 	}
 }
 
-func (lv *ListView) fetchVPCs() tea.Cmd {
-	client := lv.client
+// fetchVPCs fetches pages of VPCs after token until at least want
+// have been read. Pages starting the listing replace the cached rows; later
+// pages are merged into them.
+func (lv *ListView) fetchVPCs(after *string, want int) tea.Cmd {
+	client, scope, router := lv.client, lv.cache, lv.router
+	ctx := router.Context(lv)
 	return func() tea.Msg {
-		vpcs, err := client.ListVPCs(context.TODO())
-		return vpcsMsg{vpcs: vpcs, err: err}
+		vpcs, next, err := plugin.FetchPages(ctx, router, after, want, client.ListVPCsPage)
+		if err == nil {
+			store := cache.Store[awsvpc.VPCInfo]
+			if after != nil {
+				store = cache.Merge[awsvpc.VPCInfo]
+			}
+			_ = store(context.Background(), scope, cacheKey, vpcs, func(v awsvpc.VPCInfo) (string, string) {
+				return v.VPCID, v.Name
+			})
+		}
+		return vpcsMsg{vpcs: vpcs, after: after, next: next, err: err}
 	}
 }
 
 func (lv *ListView) Init() tea.Cmd {
-	return lv.fetchVPCs()
+	fetch := lv.fetchVPCs(nil, lv.table.ItemCount())
+	if lv.UpdatedAt().IsZero() {
+		return plugin.LoadCachedItems[awsvpc.VPCInfo](lv.cache, cacheKey, fetch)
+	}
+	return fetch
 }
 
 func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case plugin.CachedItems[awsvpc.VPCInfo]:
+		lv.loading = false
+		lv.table.SetItems(msg.Items)
+		if lv.FromCache(lv.router, msg.CacheEntry) {
+			return lv, lv.fetchVPCs(nil, lv.table.ItemCount())
+		}
+		return lv, nil
+
 	case vpcsMsg:
+		if msg.after != nil && msg.after != lv.next {
+			// A page of a listing that has since been refreshed.
+			return lv, nil
+		}
 		lv.loading = false
+		if msg.after == nil && lv.Fetched(lv.router, msg.err) {
+			return lv, nil
+		}
 		if msg.err != nil {
+			if msg.after != nil {
+				lv.table.SetMore(true)
+				lv.router.Toast(plugin.ToastError, "Loading more failed: "+msg.err.Error())
+				return lv, nil
+			}
 			lv.err = msg.err
 			return lv, nil
 		}
-		lv.table.SetItems(msg.vpcs)
+		lv.err = nil
+		if msg.after != nil {
+			lv.table.AppendItems(msg.vpcs)
+		} else {
+			lv.table.SetItems(msg.vpcs)
+		}
+		lv.next = msg.next
+		lv.table.SetMore(msg.next != nil)
 		return lv, nil
 
 	case tea.KeyPressMsg:
@@ -96,7 +153,7 @@ func (lv *ListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return lv, nil
 		case "r":
 			lv.loading = true
-			return lv, lv.fetchVPCs()
+			return lv, lv.fetchVPCs(nil, lv.table.ItemCount())
 		}
 	}
 
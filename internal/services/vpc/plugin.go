@@ -7,13 +7,17 @@ import (
 
 	awsvpc "tasnim.dev/aws-tui/internal/aws/vpc"
 	"tasnim.dev/aws-tui/internal/plugin"
+	"tasnim.dev/aws-tui/internal/services/regional"
 )
 
 // VPCClient defines the subset of awsvpc.Client methods used by the plugin.
 type VPCClient interface {
 	ListVPCs(ctx context.Context) ([]awsvpc.VPCInfo, error)
+	ListVPCsPage(ctx context.Context, token *string) ([]awsvpc.VPCInfo, *string, error)
 	ListSubnets(ctx context.Context, vpcID string) ([]awsvpc.SubnetInfo, error)
+	ListSubnetsPage(ctx context.Context, vpcID string, token *string) ([]awsvpc.SubnetInfo, *string, error)
 	ListSecurityGroups(ctx context.Context, vpcID string) ([]awsvpc.SecurityGroupInfo, error)
+	ListSecurityGroupsPage(ctx context.Context, vpcID string, token *string) ([]awsvpc.SecurityGroupInfo, *string, error)
 	ListSecurityGroupRules(ctx context.Context, groupID string) ([]awsvpc.SecurityGroupRule, error)
 	ListRouteTables(ctx context.Context, vpcID string) ([]awsvpc.RouteTableInfo, error)
 	ListNATGateways(ctx context.Context, vpcID string) ([]awsvpc.NATGatewayInfo, error)
@@ -28,7 +32,11 @@ type VPCClient interface {
 
 // Plugin implements plugin.ServicePlugin for AWS VPC.
 type Plugin struct {
-	client VPCClient
+	plugin.CacheHolder
+
+	client    VPCClient
+	scope     plugin.RegionScope
+	clientFor func(region string) VPCClient
 }
 
 // NewPlugin creates a new VPC ServicePlugin.
@@ -36,6 +44,15 @@ func NewPlugin(client VPCClient) *Plugin {
 	return &Plugin{client: client}
 }
 
+// SetRegionalClients sets the factory used to reach other regions in
+// all-regions scope.
+func (p *Plugin) SetRegionalClients(clientFor func(region string) VPCClient) {
+	p.clientFor = clientFor
+}
+
+// SetRegionScope implements plugin.MultiRegion.
+func (p *Plugin) SetRegionScope(scope plugin.RegionScope) { p.scope = scope }
+
 func (p *Plugin) ID() string   { return "vpc" }
 func (p *Plugin) Name() string { return "VPC" }
 func (p *Plugin) Icon() string { return "\U000F0317" } // nf-mdi-sitemap
@@ -65,7 +82,24 @@ func (p *Plugin) Summary(ctx context.Context) (plugin.ServiceSummary, error) {
 }
 
 func (p *Plugin) ListView(router plugin.Router) plugin.View {
-	return NewListView(p.client, router)
+	if p.scope.All() && p.clientFor != nil {
+		return p.regionalListView(router)
+	}
+	lv := NewListView(p.client, router)
+	lv.cache = p.Cache()
+	return lv
+}
+
+// regionalListView lists VPCs across every region in the scope.
+func (p *Plugin) regionalListView(router plugin.Router) plugin.View {
+	return regional.NewListView(router, "VPCs", p.scope, vpcColumns(),
+		func(v awsvpc.VPCInfo) string { return v.VPCID },
+		func(ctx context.Context, region string) ([]awsvpc.VPCInfo, error) {
+			return p.clientFor(region).ListVPCs(ctx)
+		},
+		func(router plugin.Router, region string, v awsvpc.VPCInfo) plugin.View {
+			return NewDetailView(p.clientFor(region), router, v.VPCID)
+		})
 }
 
 func (p *Plugin) DetailView(router plugin.Router, id string) plugin.View {
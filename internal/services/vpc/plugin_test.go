@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	awsvpc "tasnim.dev/aws-tui/internal/aws/vpc"
+	"tasnim.dev/aws-tui/internal/plugin"
 )
 
 // mockVPCClient implements VPCClient for tests.
@@ -36,6 +37,15 @@ func (m *mockVPCClient) ListSubnets(_ context.Context, _ string) ([]awsvpc.Subne
 func (m *mockVPCClient) ListSecurityGroups(_ context.Context, _ string) ([]awsvpc.SecurityGroupInfo, error) {
 	return m.securityGroups, m.err
 }
+func (m *mockVPCClient) ListVPCsPage(_ context.Context, _ *string) ([]awsvpc.VPCInfo, *string, error) {
+	return m.vpcs, nil, m.err
+}
+func (m *mockVPCClient) ListSubnetsPage(_ context.Context, _ string, _ *string) ([]awsvpc.SubnetInfo, *string, error) {
+	return m.subnets, nil, m.err
+}
+func (m *mockVPCClient) ListSecurityGroupsPage(_ context.Context, _ string, _ *string) ([]awsvpc.SecurityGroupInfo, *string, error) {
+	return m.securityGroups, nil, m.err
+}
 func (m *mockVPCClient) ListRouteTables(_ context.Context, _ string) ([]awsvpc.RouteTableInfo, error) {
 	return m.routeTables, m.err
 }
@@ -67,6 +77,22 @@ func (m *mockVPCClient) GetVPCTags(_ context.Context, _ string) (map[string]stri
 	return m.tags, m.err
 }
 
+// mockRouter records pushed views and toasts.
+type mockRouter struct {
+	pushed []plugin.View
+	toasts []string
+}
+
+func (m *mockRouter) Push(v plugin.View)                    { m.pushed = append(m.pushed, v) }
+func (m *mockRouter) Pop()                                  {}
+func (m *mockRouter) Navigate(_ string)                     {}
+func (m *mockRouter) NavigateDetail(_, _ string)            {}
+func (m *mockRouter) Toast(_ plugin.ToastLevel, msg string) { m.toasts = append(m.toasts, msg) }
+func (m *mockRouter) Offline() bool                         { return false }
+func (m *mockRouter) ReadOnly() bool                        { return false }
+func (m *mockRouter) Confirm(_ plugin.Action)               {}
+func (m *mockRouter) Context(_ plugin.View) context.Context { return context.Background() }
+
 func TestPluginIdentity(t *testing.T) {
 	p := NewPlugin(&mockVPCClient{})
 	assert.Equal(t, "vpc", p.ID())
@@ -117,3 +143,35 @@ func TestPollConfig(t *testing.T) {
 	assert.Equal(t, time.Duration(0), cfg.ActiveInterval)
 	assert.False(t, cfg.IsActive())
 }
+
+func TestDetailViewOpensFocusedResource(t *testing.T) {
+	client := &mockVPCClient{
+		subnets: []awsvpc.SubnetInfo{
+			{SubnetID: "subnet-aaa", Name: "public-a"},
+			{SubnetID: "subnet-bbb", Name: "private-b"},
+		},
+		securityGroups: []awsvpc.SecurityGroupInfo{{GroupID: "sg-111", Name: "db"}},
+	}
+
+	router := &mockRouter{}
+	dv := NewPlugin(client).DetailView(router, "vpc-123/subnet-bbb").(*DetailView)
+	dv.Update(dv.Init()())
+	assert.Equal(t, tabSubnets, dv.tabs.Active())
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "Subnet: private-b", router.pushed[0].Title())
+	assert.Equal(t, "VPC: vpc-123", dv.Title())
+
+	router = &mockRouter{}
+	dv = NewPlugin(client).DetailView(router, "vpc-123/sg-111").(*DetailView)
+	dv.Update(dv.Init()())
+	assert.Equal(t, tabSecurityGroups, dv.tabs.Active())
+	require.Len(t, router.pushed, 1)
+	assert.Equal(t, "SG: db", router.pushed[0].Title())
+
+	// A missing resource leaves the tab open and says so.
+	router = &mockRouter{}
+	dv = NewPlugin(client).DetailView(router, "vpc-123/sg-999").(*DetailView)
+	dv.Update(dv.Init()())
+	assert.Empty(t, router.pushed)
+	assert.Equal(t, []string{"sg-999 not found in vpc-123"}, router.toasts)
+}
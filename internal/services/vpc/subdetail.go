@@ -229,19 +229,29 @@ func (v *SubDetailView) Init() tea.Cmd {
 }
 
 func (v *SubDetailView) fetchSGRules() tea.Cmd {
-	client := v.client
+	client, router := v.client, v.router
 	groupID := v.sgGroupID
+	ctx := router.Context(v)
 	return func() tea.Msg {
-		rules, err := client.ListSecurityGroupRules(context.TODO(), groupID)
+		var rules []awsvpc.SecurityGroupRule
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			rules, err = client.ListSecurityGroupRules(ctx, groupID)
+			return err
+		})
 		return sgRulesMsg{rules: rules, err: err}
 	}
 }
 
 func (v *SubDetailView) fetchNACLEntries() tea.Cmd {
-	client := v.client
+	client, router := v.client, v.router
 	naclID := v.naclID
+	ctx := router.Context(v)
 	return func() tea.Msg {
-		entries, err := client.ListNetworkACLEntries(context.TODO(), naclID)
+		var entries []awsvpc.NetworkACLEntry
+		err := plugin.Fetch(ctx, router, func(ctx context.Context) (err error) {
+			entries, err = client.ListNetworkACLEntries(ctx, naclID)
+			return err
+		})
 		return naclEntriesMsg{entries: entries, err: err}
 	}
 }
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	chartTitleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	chartBarStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	chartDimStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+)
+
+// chartBlocks are the eighths a chart cell can be filled to.
+var chartBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Chart renders a series of values as bars scaled from zero to the series
+// maximum, under a title with the latest, average and maximum values. When
+// there are more values than columns, each column shows the largest value
+// it covers so spikes stay visible.
+type Chart struct {
+	title  string
+	format func(float64) string
+	values []float64
+	width  int
+	height int
+}
+
+// NewChart creates a Chart. format renders a value with its unit; nil
+// renders it with two decimals.
+func NewChart(title string, format func(float64) string) Chart {
+	if format == nil {
+		format = func(v float64) string { return fmt.Sprintf("%.2f", v) }
+	}
+	return Chart{title: title, format: format, width: 60, height: 3}
+}
+
+// SetValues replaces the values, oldest first.
+func (c *Chart) SetValues(values []float64) {
+	c.values = values
+}
+
+// Len returns the number of values.
+func (c Chart) Len() int { return len(c.values) }
+
+// SetSize sets the width of the chart in columns and its height in rows.
+func (c *Chart) SetSize(width, height int) {
+	if width > 0 {
+		c.width = width
+	}
+	if height > 0 {
+		c.height = height
+	}
+}
+
+// View returns the title line followed by the bars.
+func (c Chart) View() string {
+	var b strings.Builder
+	if len(c.values) == 0 {
+		b.WriteString(chartTitleStyle.Render(c.title))
+		b.WriteString("  ")
+		b.WriteString(chartDimStyle.Render("no datapoints"))
+		return b.String()
+	}
+
+	var sum, maxVal float64
+	for _, v := range c.values {
+		sum += v
+		maxVal = max(maxVal, v)
+	}
+	stats := fmt.Sprintf("last %s  avg %s  max %s",
+		c.format(c.values[len(c.values)-1]), c.format(sum/float64(len(c.values))), c.format(maxVal))
+	gap := c.width - lipgloss.Width(c.title) - lipgloss.Width(stats)
+	b.WriteString(chartTitleStyle.Render(c.title))
+	b.WriteString(strings.Repeat(" ", max(gap, 2)))
+	b.WriteString(chartDimStyle.Render(stats))
+
+	columns := resample(c.values, c.width)
+	levels := c.height * len(chartBlocks)
+	for row := range c.height {
+		base := (c.height - 1 - row) * len(chartBlocks)
+		var line strings.Builder
+		for _, v := range columns {
+			level := 0
+			if maxVal > 0 {
+				level = int(v/maxVal*float64(levels) + 0.5)
+			}
+			if v > 0 && level == 0 {
+				level = 1 // keep small non-zero values visible
+			}
+			switch fill := level - base; {
+			case fill <= 0:
+				line.WriteRune(' ')
+			case fill >= len(chartBlocks):
+				line.WriteRune(chartBlocks[len(chartBlocks)-1])
+			default:
+				line.WriteRune(chartBlocks[fill-1])
+			}
+		}
+		b.WriteString("\n")
+		b.WriteString(chartBarStyle.Render(strings.TrimRight(line.String(), " ")))
+	}
+	return b.String()
+}
+
+// resample reduces values to at most width columns, each the maximum of the
+// values it covers.
+func resample(values []float64, width int) []float64 {
+	if len(values) <= width {
+		return values
+	}
+	columns := make([]float64, width)
+	for i := range columns {
+		start, end := i*len(values)/width, (i+1)*len(values)/width
+		columns[i] = values[start]
+		for _, v := range values[start:end] {
+			columns[i] = max(columns[i], v)
+		}
+	}
+	return columns
+}
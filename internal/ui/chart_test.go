@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ansiSeq = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+func stripANSI(s string) string { return ansiSeq.ReplaceAllString(s, "") }
+
+func TestChartView(t *testing.T) {
+	c := NewChart("CPU", func(v float64) string { return fmt.Sprintf("%.0f%%", v) })
+	c.SetSize(40, 2)
+	c.SetValues([]float64{0, 25, 50, 100})
+
+	lines := strings.Split(stripANSI(c.View()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "CPU"))
+	assert.True(t, strings.HasSuffix(lines[0], "last 100%  avg 44%  max 100%"))
+	assert.Equal(t, 40, lipgloss.Width(lines[0]))
+	// Two rows give 16 levels: 25% fills 4, 50% fills 8 and 100% both rows.
+	assert.Equal(t, "   █", lines[1])
+	assert.Equal(t, " ▄██", lines[2])
+}
+
+func TestChartView_NoData(t *testing.T) {
+	c := NewChart("Network In", nil)
+	assert.Equal(t, "Network In  no datapoints", stripANSI(c.View()))
+}
+
+func TestChartView_SmallValuesVisible(t *testing.T) {
+	c := NewChart("5xx", nil)
+	c.SetSize(10, 1)
+	c.SetValues([]float64{1000, 0, 1})
+
+	lines := strings.Split(stripANSI(c.View()), "\n")
+	assert.Equal(t, "█ ▁", lines[1])
+}
+
+func TestResample(t *testing.T) {
+	values := []float64{1, 9, 2, 2, 3, 1, 7, 0}
+	assert.Equal(t, []float64{9, 2, 3, 7}, resample(values, 4))
+	assert.Equal(t, values, resample(values, 10))
+}
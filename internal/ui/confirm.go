@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	confirmBoxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("214")).
+			Padding(1, 2)
+
+	confirmTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("214")).
+				MarginBottom(1)
+
+	confirmMessageStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("252"))
+
+	confirmFooterStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("240")).
+				MarginTop(1).
+				Italic(true)
+
+	confirmTypedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("255")).
+				MarginTop(1)
+)
+
+// ConfirmResult is returned as a tea.Msg when the user answers a Confirm.
+// ID is the identifier the Confirm was created with.
+type ConfirmResult struct {
+	ID        string
+	Confirmed bool
+}
+
+// Confirm is a modal yes/no prompt. A typed Confirm is answered by typing a
+// phrase instead, for actions that are hard to undo.
+type Confirm struct {
+	id      string
+	title   string
+	message string
+	phrase  string // empty for a yes/no prompt
+	typed   string
+}
+
+// NewConfirm creates a Confirm. id is echoed back in the ConfirmResult so the
+// caller can tell prompts apart.
+func NewConfirm(id, title, message string) Confirm {
+	return Confirm{id: id, title: title, message: message}
+}
+
+// NewTypedConfirm creates a Confirm that is confirmed only once phrase has
+// been typed exactly and enter pressed.
+func NewTypedConfirm(id, title, message, phrase string) Confirm {
+	return Confirm{id: id, title: title, message: message, phrase: phrase}
+}
+
+// ID returns the identifier the Confirm was created with.
+func (c Confirm) ID() string {
+	return c.id
+}
+
+// Update answers the prompt on y/enter (confirm) or n/esc (cancel). A typed
+// prompt is confirmed by enter once the phrase matches.
+func (c Confirm) Update(msg tea.Msg) (Confirm, tea.Cmd) {
+	km, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return c, nil
+	}
+	if c.phrase != "" {
+		return c.updateTyped(km)
+	}
+
+	switch km.String() {
+	case "y", "enter":
+		return c, c.result(true)
+	case "n", "esc":
+		return c, c.result(false)
+	}
+	return c, nil
+}
+
+func (c Confirm) updateTyped(km tea.KeyPressMsg) (Confirm, tea.Cmd) {
+	switch km.String() {
+	case "esc":
+		return c, c.result(false)
+	case "enter":
+		if c.typed == c.phrase {
+			return c, c.result(true)
+		}
+	case "backspace":
+		if len(c.typed) > 0 {
+			runes := []rune(c.typed)
+			c.typed = string(runes[:len(runes)-1])
+		}
+	default:
+		c.typed += km.Text
+	}
+	return c, nil
+}
+
+func (c Confirm) result(confirmed bool) tea.Cmd {
+	id := c.id
+	return func() tea.Msg {
+		return ConfirmResult{ID: id, Confirmed: confirmed}
+	}
+}
+
+// View renders the prompt.
+func (c Confirm) View() string {
+	var b strings.Builder
+	b.WriteString(confirmTitleStyle.Render(c.title))
+	b.WriteString("\n")
+	b.WriteString(confirmMessageStyle.Render(c.message))
+	b.WriteString("\n")
+	if c.phrase != "" {
+		b.WriteString(confirmTypedStyle.Render("Type " + c.phrase + " to confirm: " + c.typed + "_"))
+		b.WriteString("\n")
+		b.WriteString(confirmFooterStyle.Render("enter: confirm • esc: cancel"))
+		return confirmBoxStyle.Render(b.String())
+	}
+	b.WriteString(confirmFooterStyle.Render("y/enter: confirm • n/esc: cancel"))
+	return confirmBoxStyle.Render(b.String())
+}
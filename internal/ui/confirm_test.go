@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     tea.Msg
+		wantCmd bool
+		want    bool
+	}{
+		{name: "y confirms", msg: keyPress('y'), wantCmd: true, want: true},
+		{name: "enter confirms", msg: specialKey(tea.KeyEnter), wantCmd: true, want: true},
+		{name: "n cancels", msg: keyPress('n'), wantCmd: true, want: false},
+		{name: "esc cancels", msg: specialKey(tea.KeyEscape), wantCmd: true, want: false},
+		{name: "other keys are ignored", msg: keyPress('x'), wantCmd: false},
+		{name: "non-key messages are ignored", msg: tea.WindowSizeMsg{Width: 80, Height: 24}, wantCmd: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewConfirm("login", "Session expired", "Log in again?")
+			_, cmd := c.Update(tc.msg)
+			if !tc.wantCmd {
+				assert.Nil(t, cmd)
+				return
+			}
+			require.NotNil(t, cmd)
+			res, ok := cmd().(ConfirmResult)
+			require.True(t, ok)
+			assert.Equal(t, "login", res.ID)
+			assert.Equal(t, tc.want, res.Confirmed)
+		})
+	}
+}
+
+func TestConfirm_View(t *testing.T) {
+	c := NewConfirm("login", "Session expired", "Log in again?")
+	out := c.View()
+	assert.Contains(t, out, "Session expired")
+	assert.Contains(t, out, "Log in again?")
+}
+
+func TestTypedConfirm(t *testing.T) {
+	c := NewTypedConfirm("stop", "Stop instance", "Stop web-1?", "i-abc")
+
+	// y and enter do not confirm until the phrase is typed.
+	c, cmd := c.Update(keyPress('y'))
+	assert.Nil(t, cmd)
+	c, _ = c.Update(specialKey(tea.KeyBackspace))
+	c, cmd = c.Update(specialKey(tea.KeyEnter))
+	assert.Nil(t, cmd)
+
+	// Backspace removes a whole multi-byte character.
+	c, _ = c.Update(keyPress('é'))
+	c, _ = c.Update(specialKey(tea.KeyBackspace))
+
+	for _, r := range "i-abc" {
+		c, _ = c.Update(keyPress(r))
+	}
+	assert.Contains(t, c.View(), "i-abc_")
+	_, cmd = c.Update(specialKey(tea.KeyEnter))
+	require.NotNil(t, cmd)
+	assert.Equal(t, ConfirmResult{ID: "stop", Confirmed: true}, cmd())
+
+	_, cmd = c.Update(specialKey(tea.KeyEscape))
+	require.NotNil(t, cmd)
+	assert.False(t, cmd().(ConfirmResult).Confirmed)
+}
@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0 B", FormatSize(0))
+	assert.Equal(t, "512 B", FormatSize(512))
+	assert.Equal(t, "1.0 KB", FormatSize(1024))
+	assert.Equal(t, "1.5 MB", FormatSize(1572864))
+	assert.Equal(t, "2.0 GB", FormatSize(2147483648))
+}
+
+func TestFormatTime(t *testing.T) {
+	assert.Equal(t, "-", FormatTime(time.Time{}))
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	assert.Equal(t, ts.Local().Format("2006-01-02 15:04"), FormatTime(ts))
+}
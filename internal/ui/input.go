@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	inputPromptStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("252"))
+
+	inputValueStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("205"))
+
+	inputHintStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Italic(true)
+)
+
+// InputResult is returned as a tea.Msg when the user submits or cancels an
+// Input.
+type InputResult struct {
+	Value    string
+	Canceled bool
+}
+
+// Input is a single-line text prompt overlay. A masked Input shows a bullet
+// for every character typed, for codes and secrets.
+type Input struct {
+	title  string
+	prompt string
+	masked bool
+	value  string
+}
+
+// NewInput creates an Input with the given title and prompt.
+func NewInput(title, prompt string, masked bool) Input {
+	return Input{title: title, prompt: prompt, masked: masked}
+}
+
+// Value returns the text entered so far.
+func (in Input) Value() string {
+	return in.value
+}
+
+// Init satisfies the Bubble Tea model interface. Returns nil.
+func (in Input) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key events for the input.
+func (in Input) Update(msg tea.Msg) (Input, tea.Cmd) {
+	km, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return in, nil
+	}
+
+	switch km.String() {
+	case "enter":
+		if in.value == "" {
+			return in, nil
+		}
+		value := in.value
+		return in, func() tea.Msg {
+			return InputResult{Value: value}
+		}
+
+	case "esc":
+		return in, func() tea.Msg {
+			return InputResult{Canceled: true}
+		}
+
+	case "backspace":
+		if len(in.value) > 0 {
+			runes := []rune(in.value)
+			in.value = string(runes[:len(runes)-1])
+		}
+		return in, nil
+
+	default:
+		for _, r := range km.Text {
+			if !unicode.IsPrint(r) {
+				return in, nil
+			}
+		}
+		in.value += km.Text
+		return in, nil
+	}
+}
+
+// View renders the input overlay.
+func (in Input) View() string {
+	var b strings.Builder
+
+	b.WriteString(pickerTitleStyle.Render(in.title))
+	b.WriteByte('\n')
+
+	value := in.value
+	if in.masked {
+		value = strings.Repeat("•", len([]rune(in.value)))
+	}
+	b.WriteString(inputPromptStyle.Render(in.prompt+" ") + inputValueStyle.Render(value+"_"))
+	b.WriteByte('\n')
+	b.WriteByte('\n')
+	b.WriteString(inputHintStyle.Render("enter: submit • esc: cancel"))
+	b.WriteByte('\n')
+
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInput(t *testing.T) {
+	in := NewInput("MFA", "Code:", true)
+	for _, r := range "1234567" {
+		in, _ = in.Update(keyPress(r))
+	}
+	in, _ = in.Update(specialKey(tea.KeyBackspace))
+	assert.Equal(t, "123456", in.Value())
+
+	out := in.View()
+	assert.Contains(t, out, "••••••")
+	assert.NotContains(t, out, "123456", "masked input hides its value")
+
+	_, cmd := in.Update(specialKey(tea.KeyEnter))
+	require.NotNil(t, cmd)
+	assert.Equal(t, InputResult{Value: "123456"}, cmd())
+
+	_, cmd = in.Update(specialKey(tea.KeyEscape))
+	require.NotNil(t, cmd)
+	assert.Equal(t, InputResult{Canceled: true}, cmd())
+}
+
+func TestInput_EmptySubmitIgnored(t *testing.T) {
+	in := NewInput("Name", "Name:", false)
+	_, cmd := in.Update(specialKey(tea.KeyEnter))
+	assert.Nil(t, cmd)
+
+	in, _ = in.Update(keyPress('a'))
+	assert.Contains(t, in.View(), "a_")
+}
@@ -0,0 +1,379 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	logTimeStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240"))
+
+	logStatusStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("245"))
+
+	logFollowStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("42"))
+
+	logPausedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214")).
+			Bold(true)
+)
+
+// logBufferLimit caps the lines a LogView keeps; the oldest are dropped.
+const logBufferLimit = 10000
+
+// logTimeWidth is the width of the timestamp column plus its separator.
+const logTimeWidth = 9
+
+// LogLine is a single line shown in a LogView.
+type LogLine struct {
+	Time    time.Time
+	Message string
+}
+
+// LogJumpMsg is returned when the user asks to show the log from a point in
+// time. The owner of the LogView fetches from At and calls SetLines.
+type LogJumpMsg struct {
+	At time.Time
+}
+
+// LogSavedMsg is returned when the buffer has been written to a file.
+type LogSavedMsg struct {
+	Path string
+	Err  error
+}
+
+// LogView is a scrollable log buffer. In follow mode it stays at the newest
+// line as lines are appended. It also handles pausing, filtering, line
+// wrapping, jumping to a timestamp and saving the buffer to a file. Fetching
+// is left to the owner, which should stop polling while Paused.
+type LogView struct {
+	name      string // used in the saved file's name
+	lines     []LogLine
+	filtered  []LogLine
+	offset    int // first visible line when not following
+	follow    bool
+	paused    bool
+	wrap      bool
+	filter    string
+	filtering bool
+	jump      *Input
+	jumpErr   string
+	width     int
+	height    int
+}
+
+// NewLogView creates an empty LogView in follow mode. name identifies the
+// log in saved file names.
+func NewLogView(name string) LogView {
+	return LogView{name: name, follow: true, width: 80, height: 20}
+}
+
+// SetSize sets the viewport dimensions.
+func (lv *LogView) SetSize(w, h int) {
+	lv.width = w
+	lv.height = max(h, 3)
+}
+
+// SetLines replaces the buffer. With follow the view shows the newest lines,
+// otherwise it shows the oldest.
+func (lv *LogView) SetLines(lines []LogLine, follow bool) {
+	lv.lines = nil
+	lv.offset = 0
+	lv.follow = follow
+	lv.AppendLines(lines)
+}
+
+// AppendLines adds lines to the end of the buffer.
+func (lv *LogView) AppendLines(lines []LogLine) {
+	lv.lines = append(lv.lines, lines...)
+	if over := len(lv.lines) - logBufferLimit; over > 0 {
+		lv.lines = append([]LogLine(nil), lv.lines[over:]...)
+	}
+	lv.applyFilter()
+}
+
+// Lines returns the number of lines in the buffer.
+func (lv LogView) Lines() int {
+	return len(lv.lines)
+}
+
+// Paused reports whether the user has paused following.
+func (lv LogView) Paused() bool {
+	return lv.paused
+}
+
+// Following reports whether the view is pinned to the newest line.
+func (lv LogView) Following() bool {
+	return lv.follow
+}
+
+// Capturing reports whether the view is reading text input, during which it
+// should receive every key.
+func (lv LogView) Capturing() bool {
+	return lv.filtering || lv.jump != nil
+}
+
+// Update handles key events.
+func (lv LogView) Update(msg tea.Msg) (LogView, tea.Cmd) {
+	km, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return lv, nil
+	}
+	if lv.filtering {
+		return lv.updateFilterMode(km), nil
+	}
+	if lv.jump != nil {
+		return lv.updateJumpMode(km)
+	}
+
+	page := max(lv.bodyHeight()-1, 1)
+	switch km.String() {
+	case "j", "down":
+		lv.scroll(1)
+	case "k", "up":
+		lv.scroll(-1)
+	case "ctrl+d", "pgdown":
+		lv.scroll(page)
+	case "ctrl+u", "pgup":
+		lv.scroll(-page)
+	case "g", "home":
+		lv.follow = false
+		lv.offset = 0
+	case "G", "end":
+		lv.follow = true
+	case "p", "space":
+		lv.paused = !lv.paused
+	case "w":
+		lv.wrap = !lv.wrap
+	case "/":
+		lv.filtering = true
+	case "t":
+		in := NewInput("Jump to Time", "Time (15:04, 2006-01-02 15:04, -10m):", false)
+		lv.jump = &in
+		lv.jumpErr = ""
+	case "S":
+		return lv, lv.save()
+	}
+	return lv, nil
+}
+
+func (lv LogView) updateFilterMode(km tea.KeyPressMsg) LogView {
+	switch km.String() {
+	case "esc":
+		lv.filtering = false
+		lv.filter = ""
+		lv.applyFilter()
+	case "enter":
+		lv.filtering = false
+	case "backspace":
+		if len(lv.filter) > 0 {
+			runes := []rune(lv.filter)
+			lv.filter = string(runes[:len(runes)-1])
+			lv.applyFilter()
+		}
+	default:
+		if km.Text != "" {
+			lv.filter += km.Text
+			lv.applyFilter()
+		}
+	}
+	return lv
+}
+
+func (lv LogView) updateJumpMode(km tea.KeyPressMsg) (LogView, tea.Cmd) {
+	switch km.String() {
+	case "esc":
+		lv.jump = nil
+		return lv, nil
+	case "enter":
+		at, err := ParseLogTime(lv.jump.Value(), time.Now())
+		if err != nil {
+			lv.jumpErr = err.Error()
+			return lv, nil
+		}
+		lv.jump = nil
+		return lv, func() tea.Msg { return LogJumpMsg{At: at} }
+	}
+	in, _ := lv.jump.Update(km)
+	lv.jump = &in
+	return lv, nil
+}
+
+// scroll moves the view by n lines. Reaching the end resumes following.
+func (lv *LogView) scroll(n int) {
+	last := max(len(lv.filtered)-lv.bodyHeight(), 0)
+	if lv.follow {
+		lv.offset = last
+	}
+	lv.offset = min(max(lv.offset+n, 0), last)
+	lv.follow = lv.offset == last
+}
+
+func (lv *LogView) applyFilter() {
+	if lv.filter == "" {
+		lv.filtered = lv.lines
+		return
+	}
+	query := strings.ToLower(lv.filter)
+	lv.filtered = nil
+	for _, l := range lv.lines {
+		if strings.Contains(strings.ToLower(l.Message), query) {
+			lv.filtered = append(lv.filtered, l)
+		}
+	}
+	lv.offset = min(lv.offset, max(len(lv.filtered)-1, 0))
+}
+
+// bodyHeight is the number of rows left for log lines below the status line.
+func (lv LogView) bodyHeight() int {
+	return max(lv.height-2, 1)
+}
+
+// save writes the whole buffer, ignoring the filter, to a file in the
+// working directory.
+func (lv LogView) save() tea.Cmd {
+	name := strings.NewReplacer("/", "-", " ", "-").Replace(lv.name)
+	path := fmt.Sprintf("%s-%s.log", name, time.Now().Format("20060102-150405"))
+	lines := lv.lines
+	return func() tea.Msg {
+		var b strings.Builder
+		for _, l := range lines {
+			b.WriteString(l.Time.Format(time.RFC3339))
+			b.WriteByte(' ')
+			b.WriteString(l.Message)
+			b.WriteByte('\n')
+		}
+		err := os.WriteFile(path, []byte(b.String()), 0o644)
+		return LogSavedMsg{Path: path, Err: err}
+	}
+}
+
+// View renders the status line followed by the visible log lines.
+func (lv LogView) View() string {
+	var b strings.Builder
+	b.WriteString(lv.statusLine())
+	b.WriteByte('\n')
+
+	if lv.jump != nil {
+		b.WriteString(lv.jump.View())
+		if lv.jumpErr != "" {
+			b.WriteString(logPausedStyle.Render(lv.jumpErr))
+			b.WriteByte('\n')
+		}
+		return b.String()
+	}
+
+	if len(lv.filtered) == 0 {
+		if len(lv.lines) == 0 {
+			b.WriteString(logStatusStyle.Render("No log events yet."))
+		} else {
+			b.WriteString(logStatusStyle.Render("No lines match the filter."))
+		}
+		return b.String()
+	}
+
+	rows := lv.visibleRows()
+	b.WriteString(strings.Join(rows, "\n"))
+	return b.String()
+}
+
+func (lv LogView) statusLine() string {
+	var parts []string
+	switch {
+	case lv.paused:
+		parts = append(parts, logPausedStyle.Render("⏸ paused"))
+	case lv.follow:
+		parts = append(parts, logFollowStyle.Render("● following"))
+	default:
+		parts = append(parts, logStatusStyle.Render("○ scrolled"))
+	}
+	parts = append(parts, logStatusStyle.Render(fmt.Sprintf("%d lines", len(lv.lines))))
+	if lv.wrap {
+		parts = append(parts, logStatusStyle.Render("wrap"))
+	}
+	if lv.filtering {
+		parts = append(parts, logStatusStyle.Render("/"+lv.filter+"_"))
+	} else if lv.filter != "" {
+		parts = append(parts, logStatusStyle.Render(fmt.Sprintf("filter: %s (%d)", lv.filter, len(lv.filtered))))
+	}
+	return strings.Join(parts, logStatusStyle.Render(" • "))
+}
+
+// visibleRows renders the lines that fit in the body, wrapping or truncating
+// each to the width. Following shows the newest lines, otherwise lines from
+// offset on.
+func (lv LogView) visibleRows() []string {
+	height := lv.bodyHeight()
+	msgWidth := max(lv.width-logTimeWidth, 10)
+
+	render := func(l LogLine) []string {
+		prefix := logTimeStyle.Render(l.Time.Format("15:04:05")) + " "
+		msg := strings.ReplaceAll(l.Message, "\t", "    ")
+		msg = strings.TrimRight(msg, "\n")
+		if !lv.wrap {
+			if len(msg) > msgWidth {
+				msg = msg[:msgWidth-1] + "…"
+			}
+			return []string{prefix + msg}
+		}
+		wrapped := strings.Split(WrapText(msg, msgWidth, 0), "\n")
+		rows := make([]string, len(wrapped))
+		for i, w := range wrapped {
+			if i == 0 {
+				rows[i] = prefix + w
+			} else {
+				rows[i] = strings.Repeat(" ", logTimeWidth) + w
+			}
+		}
+		return rows
+	}
+
+	if lv.follow {
+		var rows []string
+		for i := len(lv.filtered) - 1; i >= 0 && len(rows) < height; i-- {
+			rows = append(render(lv.filtered[i]), rows...)
+		}
+		if over := len(rows) - height; over > 0 {
+			rows = rows[over:]
+		}
+		return rows
+	}
+
+	var rows []string
+	for i := lv.offset; i < len(lv.filtered) && len(rows) < height; i++ {
+		rows = append(rows, render(lv.filtered[i])...)
+	}
+	if len(rows) > height {
+		rows = rows[:height]
+	}
+	return rows
+}
+
+// ParseLogTime parses a time to jump to: a clock time today ("15:04",
+// "15:04:05"), a date and time ("2006-01-02 15:04"), RFC 3339, or a
+// duration before now ("-10m", "2h").
+func ParseLogTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(strings.TrimPrefix(s, "-")); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			y, m, d := now.Date()
+			return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, now.Location()), nil
+		}
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02 15:04:05", time.RFC3339} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised time %q", s)
+}
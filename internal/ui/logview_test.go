@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logLines(n int) []LogLine {
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	lines := make([]LogLine, n)
+	for i := range lines {
+		lines[i] = LogLine{Time: base.Add(time.Duration(i) * time.Second), Message: fmt.Sprintf("line %d", i)}
+	}
+	return lines
+}
+
+func TestLogView_Follow(t *testing.T) {
+	lv := NewLogView("web")
+	lv.SetSize(80, 5)
+	lv.SetLines(logLines(10), true)
+
+	out := lv.View()
+	assert.Contains(t, out, "following")
+	assert.Contains(t, out, "line 9")
+	assert.NotContains(t, out, "line 5")
+
+	lv.AppendLines([]LogLine{{Time: time.Now(), Message: "line 10"}})
+	assert.Contains(t, lv.View(), "line 10", "following shows appended lines")
+
+	// Scrolling up stops following; appended lines do not move the view.
+	lv, _ = lv.Update(keyPress('k'))
+	assert.False(t, lv.Following())
+	lv.AppendLines([]LogLine{{Time: time.Now(), Message: "line 11"}})
+	assert.NotContains(t, lv.View(), "line 11")
+
+	lv, _ = lv.Update(keyPress('G'))
+	assert.True(t, lv.Following())
+	assert.Contains(t, lv.View(), "line 11")
+
+	lv, _ = lv.Update(keyPress('g'))
+	assert.Contains(t, lv.View(), "line 0")
+}
+
+func TestLogView_PauseWrapFilter(t *testing.T) {
+	lv := NewLogView("web")
+	lv.SetSize(30, 10)
+	lv.SetLines([]LogLine{
+		{Time: time.Now(), Message: "GET /health 200"},
+		{Time: time.Now(), Message: "ERROR connection refused by upstream database host"},
+	}, true)
+
+	lv, _ = lv.Update(keyPress('p'))
+	assert.True(t, lv.Paused())
+	assert.Contains(t, lv.View(), "paused")
+	lv, _ = lv.Update(keyPress('p'))
+	assert.False(t, lv.Paused())
+
+	assert.Contains(t, lv.View(), "…", "long lines are truncated")
+	lv, _ = lv.Update(keyPress('w'))
+	assert.Contains(t, lv.View(), "database")
+
+	lv, _ = lv.Update(keyPress('/'))
+	assert.True(t, lv.Capturing())
+	lv, _ = lv.Update(keyPress('é'))
+	lv, _ = lv.Update(specialKey(tea.KeyBackspace))
+	for _, r := range "error" {
+		lv, _ = lv.Update(keyPress(r))
+	}
+	lv, _ = lv.Update(specialKey(tea.KeyEnter))
+	assert.False(t, lv.Capturing())
+	out := lv.View()
+	assert.Contains(t, out, "filter: error (1)")
+	assert.NotContains(t, out, "/health")
+}
+
+func TestLogView_Jump(t *testing.T) {
+	lv := NewLogView("web")
+	lv, _ = lv.Update(keyPress('t'))
+	require.True(t, lv.Capturing())
+	for _, r := range "nonsense" {
+		lv, _ = lv.Update(keyPress(r))
+	}
+	lv, cmd := lv.Update(specialKey(tea.KeyEnter))
+	assert.Nil(t, cmd)
+	assert.Contains(t, lv.View(), "unrecognised time")
+
+	lv, _ = lv.Update(specialKey(tea.KeyEscape))
+	assert.False(t, lv.Capturing())
+
+	lv, _ = lv.Update(keyPress('t'))
+	for _, r := range "5m" {
+		lv, _ = lv.Update(keyPress(r))
+	}
+	_, cmd = lv.Update(specialKey(tea.KeyEnter))
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(LogJumpMsg)
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(-5*time.Minute), msg.At, time.Second)
+}
+
+func TestLogView_Save(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	lv := NewLogView("web/abc")
+	lv.SetLines(logLines(3), true)
+	_, cmd := lv.Update(keyPress('S'))
+	require.NotNil(t, cmd)
+	msg := cmd().(LogSavedMsg)
+	require.NoError(t, msg.Err)
+	assert.True(t, strings.HasPrefix(msg.Path, "web-abc-"))
+
+	data, err := os.ReadFile(filepath.Join(dir, msg.Path))
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(string(data), "\n"))
+	assert.Contains(t, string(data), "2024-05-01T12:00:02Z line 2")
+}
+
+func TestParseLogTime(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"10m", now.Add(-10 * time.Minute)},
+		{"-2h", now.Add(-2 * time.Hour)},
+		{"09:15", time.Date(2024, 5, 1, 9, 15, 0, 0, time.UTC)},
+		{"09:15:30", time.Date(2024, 5, 1, 9, 15, 30, 0, time.UTC)},
+		{"2024-04-30 23:00", time.Date(2024, 4, 30, 23, 0, 0, 0, time.UTC)},
+		{"2024-04-30T23:00:00Z", time.Date(2024, 4, 30, 23, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLogTime(tt.in, now)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v", got)
+		})
+	}
+
+	_, err := ParseLogTime("yesterday", now)
+	assert.Error(t, err)
+}
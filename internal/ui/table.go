@@ -32,8 +32,21 @@ type TableView[T any] struct {
 
 	width  int
 	height int
+
+	// Incremental loading; see OnLoadMore.
+	loadMore    func() tea.Cmd
+	more        bool
+	loadingMore bool
+
+	// Multi-select; see EnableMarks.
+	marks  bool
+	marked map[string]bool
 }
 
+// loadMoreThreshold is how close to the last row the cursor must be before
+// the next page is requested.
+const loadMoreThreshold = 5
+
 // NewTableView creates a TableView with the given columns, items, and ID function.
 func NewTableView[T any](cols []Column[T], items []T, idFunc func(T) string) TableView[T] {
 	tv := TableView[T]{
@@ -67,6 +80,11 @@ func (tv TableView[T]) Filtering() bool {
 	return tv.filtering
 }
 
+// ItemCount returns the number of loaded items, ignoring the filter.
+func (tv TableView[T]) ItemCount() int {
+	return len(tv.allItems)
+}
+
 // FilteredCount returns the number of visible (filtered) items.
 func (tv TableView[T]) FilteredCount() int {
 	return len(tv.filtered)
@@ -90,13 +108,89 @@ func (tv TableView[T]) SelectedID() string {
 	return tv.idFunc(tv.filtered[tv.cursor])
 }
 
-// SetItems replaces the item list and reapplies filter and sort.
+// Items returns the loaded items, ignoring the filter, in load order.
+func (tv TableView[T]) Items() []T {
+	items := make([]T, len(tv.allItems))
+	copy(items, tv.allItems)
+	return items
+}
+
+// EnableMarks lets space mark rows for a multi-row action. Marked rows are
+// tracked by ID, so they survive sorting, filtering and SetItems.
+func (tv *TableView[T]) EnableMarks() {
+	tv.marks = true
+	tv.marked = make(map[string]bool)
+}
+
+// Marked returns the marked items that are still loaded, in load order.
+func (tv TableView[T]) Marked() []T {
+	var items []T
+	for _, item := range tv.allItems {
+		if tv.marked[tv.idFunc(item)] {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ClearMarks unmarks every row.
+func (tv *TableView[T]) ClearMarks() {
+	clear(tv.marked)
+}
+
+// SetItems replaces the item list and reapplies filter and sort. The table
+// is marked as fully loaded; call SetMore afterwards if it is not.
 func (tv *TableView[T]) SetItems(items []T) {
 	tv.allItems = make([]T, len(items))
 	copy(tv.allItems, items)
+	tv.more = false
+	tv.loadingMore = false
+	tv.applyFilterAndSort()
+}
+
+// AppendItems adds a page of items and reapplies filter and sort.
+func (tv *TableView[T]) AppendItems(items []T) {
+	tv.allItems = append(tv.allItems, items...)
+	tv.loadingMore = false
 	tv.applyFilterAndSort()
 }
 
+// OnLoadMore sets the function called for the next page when the cursor
+// nears the last row while SetMore(true) is in effect. The table will not
+// call it again until the page arrives via AppendItems, or SetMore is called.
+func (tv *TableView[T]) OnLoadMore(fn func() tea.Cmd) {
+	tv.loadMore = fn
+}
+
+// SetMore records whether further pages exist beyond the loaded items.
+// It also ends any pending load, so a failed page can be retried.
+func (tv *TableView[T]) SetMore(more bool) {
+	tv.more = more
+	tv.loadingMore = false
+}
+
+// HasMore reports whether further pages exist beyond the loaded items.
+func (tv TableView[T]) HasMore() bool {
+	return tv.more
+}
+
+// LoadingMore reports whether the next page has been requested.
+func (tv TableView[T]) LoadingMore() bool {
+	return tv.loadingMore
+}
+
+// maybeLoadMore requests the next page when the cursor is near the end.
+func (tv *TableView[T]) maybeLoadMore() tea.Cmd {
+	if !tv.more || tv.loadingMore || tv.loadMore == nil {
+		return nil
+	}
+	if tv.cursor < len(tv.filtered)-loadMoreThreshold {
+		return nil
+	}
+	tv.loadingMore = true
+	return tv.loadMore()
+}
+
 // SetSize sets the viewport dimensions.
 func (tv *TableView[T]) SetSize(w, h int) {
 	tv.width = w
@@ -108,7 +202,9 @@ func (tv TableView[T]) Init() tea.Cmd {
 	return nil
 }
 
-// Update handles key messages for navigation, sorting, and filtering.
+// Update handles key messages for navigation, sorting, and filtering. After
+// any key that moves the cursor or changes the filter it requests the next
+// page if the cursor has come near the end.
 func (tv TableView[T]) Update(msg tea.Msg) (TableView[T], tea.Cmd) {
 	km, ok := msg.(tea.KeyPressMsg)
 	if !ok {
@@ -116,18 +212,35 @@ func (tv TableView[T]) Update(msg tea.Msg) (TableView[T], tea.Cmd) {
 	}
 
 	if tv.filtering {
-		return tv.updateFilterMode(km), nil
+		tv = tv.updateFilterMode(km)
+		return tv, tv.maybeLoadMore()
 	}
 
+	page := max(tv.height-1, 1)
 	switch km.String() {
 	case "j", "down":
-		if len(tv.filtered) > 0 && tv.cursor < len(tv.filtered)-1 {
-			tv.cursor++
-		}
+		tv.moveCursor(1)
 	case "k", "up":
-		if tv.cursor > 0 {
-			tv.cursor--
+		tv.moveCursor(-1)
+	case "ctrl+d", "pgdown":
+		tv.moveCursor(page)
+	case "ctrl+u", "pgup":
+		tv.moveCursor(-page)
+	case "g", "home":
+		tv.cursor = 0
+	case "G", "end":
+		tv.moveCursor(len(tv.filtered))
+	case "space":
+		if !tv.marks || len(tv.filtered) == 0 {
+			break
 		}
+		id := tv.idFunc(tv.filtered[tv.cursor])
+		if tv.marked[id] {
+			delete(tv.marked, id)
+		} else {
+			tv.marked[id] = true
+		}
+		tv.moveCursor(1)
 	case "h":
 		if tv.scrollX > 0 {
 			tv.scrollX -= 4
@@ -155,7 +268,12 @@ func (tv TableView[T]) Update(msg tea.Msg) (TableView[T], tea.Cmd) {
 		tv.filterText = ""
 	}
 
-	return tv, nil
+	return tv, tv.maybeLoadMore()
+}
+
+// moveCursor moves the cursor by n rows, stopping at either end.
+func (tv *TableView[T]) moveCursor(n int) {
+	tv.cursor = max(min(tv.cursor+n, len(tv.filtered)-1), 0)
 }
 
 func (tv TableView[T]) updateFilterMode(km tea.KeyPressMsg) TableView[T] {
@@ -243,8 +361,15 @@ var (
 	filterBarStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("214"))
 
+	loadMoreStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Italic(true)
+
 	sortIndicator = " ▲"
 	sortIndicatorDesc = " ▼"
+
+	markedGutter   = "* "
+	unmarkedGutter = "  "
 )
 
 // totalWidth returns the total character width of all columns plus separators.
@@ -285,8 +410,11 @@ func (tv TableView[T]) View() string {
 		}
 		headerParts = append(headerParts, padRight(title, col.Width))
 	}
-	headerLine := strings.Join(headerParts, " ")
-	b.WriteString(headerStyle.Render(hscroll(headerLine, tv.scrollX)))
+	headerLine := hscroll(strings.Join(headerParts, " "), tv.scrollX)
+	if tv.marks {
+		headerLine = unmarkedGutter + headerLine
+	}
+	b.WriteString(headerStyle.Render(headerLine))
 	b.WriteString("\n")
 
 	// Rows
@@ -296,6 +424,13 @@ func (tv TableView[T]) View() string {
 			rowParts = append(rowParts, padRight(col.Field(item), col.Width))
 		}
 		row := hscroll(strings.Join(rowParts, " "), tv.scrollX)
+		if tv.marks {
+			if tv.marked[tv.idFunc(item)] {
+				row = markedGutter + row
+			} else {
+				row = unmarkedGutter + row
+			}
+		}
 		if i == tv.cursor {
 			row = selectedRowStyle.Render(row)
 		} else {
@@ -305,6 +440,16 @@ func (tv TableView[T]) View() string {
 		b.WriteString("\n")
 	}
 
+	// Pagination indicator
+	if tv.more {
+		status := fmt.Sprintf("loaded %d, more available", len(tv.allItems))
+		if tv.loadingMore {
+			status += " • loading more…"
+		}
+		b.WriteString(loadMoreStyle.Render(status))
+		b.WriteString("\n")
+	}
+
 	// Filter bar
 	if tv.filtering {
 		b.WriteString(filterBarStyle.Render(fmt.Sprintf("/%s", tv.filterText)))
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"fmt"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
@@ -300,3 +301,153 @@ func TestTableViewEmpty(t *testing.T) {
 		tv.View()
 	})
 }
+
+func TestTableViewLoadMore(t *testing.T) {
+	page := func(from, n int) []testItem {
+		items := make([]testItem, n)
+		for i := range items {
+			id := fmt.Sprintf("%02d", from+i)
+			items[i] = testItem{id: id, name: "item-" + id}
+		}
+		return items
+	}
+
+	tv := NewTableView(testColumns(), nil, testIDFunc)
+	tv.SetItems(page(0, 10))
+	tv.SetMore(true)
+
+	calls := 0
+	tv.OnLoadMore(func() tea.Cmd {
+		calls++
+		return func() tea.Msg { return nil }
+	})
+	assert.Contains(t, tv.View(), "loaded 10, more available")
+
+	// Far from the end nothing is requested.
+	tv, cmd := tv.Update(keyPress('j'))
+	assert.Nil(t, cmd)
+
+	// Nearing the end requests the next page exactly once.
+	for range 4 {
+		tv, cmd = tv.Update(keyPress('j'))
+	}
+	assert.NotNil(t, cmd)
+	assert.True(t, tv.LoadingMore())
+	tv, cmd = tv.Update(keyPress('j'))
+	assert.Nil(t, cmd)
+	assert.Equal(t, 1, calls)
+	assert.Contains(t, tv.View(), "loading more")
+
+	// The appended page keeps the cursor and a final page hides the indicator.
+	tv.AppendItems(page(10, 5))
+	tv.SetMore(false)
+	assert.Equal(t, 15, tv.ItemCount())
+	assert.Equal(t, 6, tv.Cursor())
+	assert.False(t, tv.LoadingMore())
+	assert.NotContains(t, tv.View(), "loaded")
+
+	for range 10 {
+		tv, cmd = tv.Update(keyPress('j'))
+		assert.Nil(t, cmd)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestTableViewLoadMoreAfterJumps(t *testing.T) {
+	items := make([]testItem, 30)
+	for i := range items {
+		id := fmt.Sprintf("%02d", i)
+		items[i] = testItem{id: id, name: "item-" + id}
+	}
+	paged := func() (*TableView[testItem], *int) {
+		tv := NewTableView(testColumns(), items, testIDFunc)
+		tv.SetMore(true)
+		tv.SetSize(80, 11)
+		calls := 0
+		tv.OnLoadMore(func() tea.Cmd {
+			calls++
+			return func() tea.Msg { return nil }
+		})
+		return &tv, &calls
+	}
+
+	// Paging moves a screenful, and the page that nears the end loads more.
+	tv, calls := paged()
+	pgdown := specialKeyPress(tea.KeyPgDown)
+	*tv, _ = tv.Update(pgdown)
+	assert.Equal(t, 10, tv.Cursor())
+	*tv, _ = tv.Update(pgdown)
+	assert.Equal(t, 0, *calls)
+	_, cmd := tv.Update(pgdown)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 1, *calls)
+
+	// Jumping to the last row loads more, and g returns to the top.
+	tv, calls = paged()
+	*tv, cmd = tv.Update(keyPress('G'))
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 29, tv.Cursor())
+	*tv, _ = tv.Update(keyPress('g'))
+	assert.Equal(t, 0, tv.Cursor())
+
+	// A filter matching few loaded rows loads more, as later pages may match.
+	tv, calls = paged()
+	*tv, _ = tv.Update(keyPress('/'))
+	assert.Equal(t, 0, *calls)
+	for _, r := range "item-07" {
+		*tv, cmd = tv.Update(keyPress(r))
+	}
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 1, tv.FilteredCount())
+	assert.Equal(t, 1, *calls)
+}
+
+func TestTableViewSetItemsEndsPagination(t *testing.T) {
+	tv := newTestTable()
+	tv.SetMore(true)
+	assert.True(t, tv.HasMore())
+
+	tv.SetItems(testItems())
+	assert.False(t, tv.HasMore())
+	assert.False(t, tv.LoadingMore())
+}
+
+func TestTableViewMarks(t *testing.T) {
+	space := specialKeyPress(tea.KeySpace)
+
+	// Without EnableMarks space does nothing.
+	tv := newTestTable()
+	tv, _ = tv.Update(space)
+	assert.Equal(t, 0, tv.Cursor())
+	assert.Empty(t, tv.Marked())
+
+	tv.EnableMarks()
+	tv, _ = tv.Update(space) // marks alpha, moves to beta
+	assert.Equal(t, 1, tv.Cursor())
+	tv, _ = tv.Update(keyPress('j'))
+	tv, _ = tv.Update(space) // marks gamma, stays on the last row
+	assert.Equal(t, 2, tv.Cursor())
+	assert.Contains(t, tv.View(), "* alpha")
+
+	// Marks follow the item IDs through re-sorting and SetItems, which
+	// drops gamma from the marked items while it is not loaded.
+	tv, _ = tv.Update(keyPress('S'))
+	tv.SetItems(testItems()[:2])
+	marked := tv.Marked()
+	require.Len(t, marked, 1)
+	assert.Equal(t, "alpha", marked[0].name)
+
+	// Space again unmarks.
+	tv, _ = tv.Update(keyPress('S'))
+	tv.SetItems(testItems())
+	tv, _ = tv.Update(keyPress('k'))
+	assert.Equal(t, "alpha", tv.SelectedItem().name)
+	tv, _ = tv.Update(space)
+	marked = tv.Marked()
+	require.Len(t, marked, 1)
+	assert.Equal(t, "gamma", marked[0].name)
+
+	tv.ClearMarks()
+	assert.Empty(t, tv.Marked())
+	assert.Len(t, tv.Items(), 3)
+}
@@ -42,6 +42,13 @@ func (tc TabController) Active() int {
 	return tc.active
 }
 
+// SetActive makes tab i active. Out-of-range indexes are ignored.
+func (tc *TabController) SetActive(i int) {
+	if i >= 0 && i < len(tc.titles) {
+		tc.active = i
+	}
+}
+
 // Count returns the number of tabs.
 func (tc TabController) Count() int {
 	return len(tc.titles)
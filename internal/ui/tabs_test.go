@@ -95,3 +95,11 @@ func TestTabControllerView(t *testing.T) {
 	assert.Contains(t, view, "Services")
 	assert.Contains(t, view, "Nodes")
 }
+
+func TestTabControllerSetActive(t *testing.T) {
+	tc := NewTabController([]string{"A", "B", "C"})
+	tc.SetActive(2)
+	assert.Equal(t, 2, tc.Active())
+	tc.SetActive(3)
+	assert.Equal(t, 2, tc.Active(), "out-of-range index is ignored")
+}
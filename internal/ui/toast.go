@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"sync"
 	"time"
 
 	"tasnim.dev/aws-tui/internal/plugin"
@@ -16,8 +17,10 @@ type Toast struct {
 	Created  time.Time
 }
 
-// ToastStack manages a bounded slice of toasts.
+// ToastStack manages a bounded slice of toasts. It is safe for concurrent
+// use, so fetch commands running off the UI goroutine can report progress.
 type ToastStack struct {
+	mu     sync.Mutex
 	toasts []Toast
 }
 
@@ -41,6 +44,8 @@ func (s *ToastStack) Push(level plugin.ToastLevel, msg string) {
 		dur = 3 * time.Second
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.toasts = append(s.toasts, Toast{
 		Level:    level,
 		Message:  msg,
@@ -55,6 +60,8 @@ func (s *ToastStack) Push(level plugin.ToastLevel, msg string) {
 
 // Visible returns toasts that have not yet expired.
 func (s *ToastStack) Visible() []Toast {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	now := time.Now()
 	var out []Toast
 	for _, t := range s.toasts {
@@ -67,6 +74,8 @@ func (s *ToastStack) Visible() []Toast {
 
 // Tick removes expired toasts from the stack.
 func (s *ToastStack) Tick() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	now := time.Now()
 	var kept []Toast
 	for _, t := range s.toasts {
@@ -79,11 +88,15 @@ func (s *ToastStack) Tick() {
 
 // Len returns the current number of toasts in the stack.
 func (s *ToastStack) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return len(s.toasts)
 }
 
 // Dismiss removes the most recent (last) toast.
 func (s *ToastStack) Dismiss() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if len(s.toasts) == 0 {
 		return
 	}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"sync"
 	"testing"
 	"time"
 
@@ -95,6 +96,18 @@ func TestToastStack(t *testing.T) {
 				assert.Equal(t, "new", visible[0].Message)
 			},
 		},
+		{
+			name: "concurrent push is safe",
+			fn: func(t *testing.T) {
+				s := NewToastStack()
+				var wg sync.WaitGroup
+				for range 10 {
+					wg.Go(func() { s.Push(plugin.ToastInfo, "retrying") })
+				}
+				wg.Wait()
+				assert.Equal(t, maxToasts, s.Len())
+			},
+		},
 		{
 			name: "dismiss on empty stack is no-op",
 			fn: func(t *testing.T) {
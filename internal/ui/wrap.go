@@ -76,6 +76,12 @@ func RenderKV(rows []KV, labelWidth, valueWidth int) string {
 	return b.String()
 }
 
+// KVValueWidth returns the width RenderKV values wrap at in a view of the
+// given width, leaving room for the label column but never below 40.
+func KVValueWidth(viewWidth int) int {
+	return max(viewWidth-22, 40)
+}
+
 // KV is a key-value pair for rendering in detail views.
 type KV struct {
 	K, V string